@@ -15,11 +15,64 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*MintRecord
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(MintRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(MintRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState               protoreflect.MessageDescriptor
 	fd_GenesisState_params        protoreflect.FieldDescriptor
 	fd_GenesisState_prev_block_ts protoreflect.FieldDescriptor
 	fd_GenesisState_max_supply    protoreflect.FieldDescriptor
+	fd_GenesisState_accrued_mint  protoreflect.FieldDescriptor
+	fd_GenesisState_mint_history  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +81,8 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_prev_block_ts = md_GenesisState.Fields().ByName("prev_block_ts")
 	fd_GenesisState_max_supply = md_GenesisState.Fields().ByName("max_supply")
+	fd_GenesisState_accrued_mint = md_GenesisState.Fields().ByName("accrued_mint")
+	fd_GenesisState_mint_history = md_GenesisState.Fields().ByName("mint_history")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -113,6 +168,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.AccruedMint != "" {
+		value := protoreflect.ValueOfString(x.AccruedMint)
+		if !f(fd_GenesisState_accrued_mint, value) {
+			return
+		}
+	}
+	if len(x.MintHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintHistory})
+		if !f(fd_GenesisState_mint_history, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -134,6 +201,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PrevBlockTs != ""
 	case "haqq.coinomics.v1.GenesisState.max_supply":
 		return x.MaxSupply != nil
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		return x.AccruedMint != ""
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		return len(x.MintHistory) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -156,6 +227,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PrevBlockTs = ""
 	case "haqq.coinomics.v1.GenesisState.max_supply":
 		x.MaxSupply = nil
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		x.AccruedMint = ""
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		x.MintHistory = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -181,6 +256,15 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "haqq.coinomics.v1.GenesisState.max_supply":
 		value := x.MaxSupply
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		value := x.AccruedMint
+		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		if len(x.MintHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.MintHistory}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -207,6 +291,12 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.PrevBlockTs = value.Interface().(string)
 	case "haqq.coinomics.v1.GenesisState.max_supply":
 		x.MaxSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		x.AccruedMint = value.Interface().(string)
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.MintHistory = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -237,8 +327,16 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.MaxSupply = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.MaxSupply.ProtoReflect())
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		if x.MintHistory == nil {
+			x.MintHistory = []*MintRecord{}
+		}
+		value := &_GenesisState_5_list{list: &x.MintHistory}
+		return protoreflect.ValueOfList(value)
 	case "haqq.coinomics.v1.GenesisState.prev_block_ts":
 		panic(fmt.Errorf("field prev_block_ts of message haqq.coinomics.v1.GenesisState is not mutable"))
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		panic(fmt.Errorf("field accrued_mint of message haqq.coinomics.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -260,6 +358,11 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "haqq.coinomics.v1.GenesisState.max_supply":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "haqq.coinomics.v1.GenesisState.accrued_mint":
+		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.GenesisState.mint_history":
+		list := []*MintRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.GenesisState"))
@@ -341,6 +444,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.MaxSupply)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.AccruedMint)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MintHistory) > 0 {
+			for _, e := range x.MintHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -370,6 +483,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MintHistory) > 0 {
+			for iNdEx := len(x.MintHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AccruedMint) > 0 {
+			i -= len(x.AccruedMint)
+			copy(dAtA[i:], x.AccruedMint)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccruedMint)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.MaxSupply != nil {
 			encoded, err := options.Marshal(x.MaxSupply)
 			if err != nil {
@@ -558,6 +694,72 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccruedMint", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccruedMint = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintHistory = append(x.MintHistory, &MintRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintHistory[len(x.MintHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -593,11 +795,64 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*MintAllocation
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintAllocation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintAllocation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(MintAllocation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(MintAllocation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_mint_denom         protoreflect.FieldDescriptor
-	fd_Params_enable_coinomics   protoreflect.FieldDescriptor
-	fd_Params_reward_coefficient protoreflect.FieldDescriptor
+	md_Params                       protoreflect.MessageDescriptor
+	fd_Params_mint_denom            protoreflect.FieldDescriptor
+	fd_Params_enable_coinomics      protoreflect.FieldDescriptor
+	fd_Params_reward_coefficient    protoreflect.FieldDescriptor
+	fd_Params_mint_epoch_identifier protoreflect.FieldDescriptor
+	fd_Params_allocations           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -606,6 +861,8 @@ func init() {
 	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
 	fd_Params_enable_coinomics = md_Params.Fields().ByName("enable_coinomics")
 	fd_Params_reward_coefficient = md_Params.Fields().ByName("reward_coefficient")
+	fd_Params_mint_epoch_identifier = md_Params.Fields().ByName("mint_epoch_identifier")
+	fd_Params_allocations = md_Params.Fields().ByName("allocations")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -691,6 +948,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MintEpochIdentifier != "" {
+		value := protoreflect.ValueOfString(x.MintEpochIdentifier)
+		if !f(fd_Params_mint_epoch_identifier, value) {
+			return
+		}
+	}
+	if len(x.Allocations) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.Allocations})
+		if !f(fd_Params_allocations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -712,6 +981,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EnableCoinomics != false
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		return x.RewardCoefficient != ""
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		return x.MintEpochIdentifier != ""
+	case "haqq.coinomics.v1.Params.allocations":
+		return len(x.Allocations) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -734,6 +1007,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EnableCoinomics = false
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		x.RewardCoefficient = ""
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		x.MintEpochIdentifier = ""
+	case "haqq.coinomics.v1.Params.allocations":
+		x.Allocations = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -759,6 +1036,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		value := x.RewardCoefficient
 		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		value := x.MintEpochIdentifier
+		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.Params.allocations":
+		if len(x.Allocations) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.Allocations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -785,6 +1071,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EnableCoinomics = value.Bool()
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		x.RewardCoefficient = value.Interface().(string)
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		x.MintEpochIdentifier = value.Interface().(string)
+	case "haqq.coinomics.v1.Params.allocations":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.Allocations = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -805,12 +1097,20 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "haqq.coinomics.v1.Params.allocations":
+		if x.Allocations == nil {
+			x.Allocations = []*MintAllocation{}
+		}
+		value := &_Params_5_list{list: &x.Allocations}
+		return protoreflect.ValueOfList(value)
 	case "haqq.coinomics.v1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message haqq.coinomics.v1.Params is not mutable"))
 	case "haqq.coinomics.v1.Params.enable_coinomics":
 		panic(fmt.Errorf("field enable_coinomics of message haqq.coinomics.v1.Params is not mutable"))
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		panic(fmt.Errorf("field reward_coefficient of message haqq.coinomics.v1.Params is not mutable"))
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		panic(fmt.Errorf("field mint_epoch_identifier of message haqq.coinomics.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -830,6 +1130,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "haqq.coinomics.v1.Params.reward_coefficient":
 		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.Params.mint_epoch_identifier":
+		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.Params.allocations":
+		list := []*MintAllocation{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.Params"))
@@ -910,6 +1215,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MintEpochIdentifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Allocations) > 0 {
+			for _, e := range x.Allocations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -939,6 +1254,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Allocations) > 0 {
+			for iNdEx := len(x.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Allocations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.MintEpochIdentifier) > 0 {
+			i -= len(x.MintEpochIdentifier)
+			copy(dAtA[i:], x.MintEpochIdentifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintEpochIdentifier)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.RewardCoefficient) > 0 {
 			i -= len(x.RewardCoefficient)
 			copy(dAtA[i:], x.RewardCoefficient)
@@ -1096,6 +1434,72 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.RewardCoefficient = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintEpochIdentifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintEpochIdentifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allocations = append(x.Allocations, &MintAllocation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Allocations[len(x.Allocations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1131,61 +1535,1381 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: haqq/coinomics/v1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MintAllocation           protoreflect.MessageDescriptor
+	fd_MintAllocation_type      protoreflect.FieldDescriptor
+	fd_MintAllocation_recipient protoreflect.FieldDescriptor
+	fd_MintAllocation_weight    protoreflect.FieldDescriptor
 )
 
-// GenesisState defines the coinomics module's genesis state.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_haqq_coinomics_v1_genesis_proto_init()
+	md_MintAllocation = File_haqq_coinomics_v1_genesis_proto.Messages().ByName("MintAllocation")
+	fd_MintAllocation_type = md_MintAllocation.Fields().ByName("type")
+	fd_MintAllocation_recipient = md_MintAllocation.Fields().ByName("recipient")
+	fd_MintAllocation_weight = md_MintAllocation.Fields().ByName("weight")
+}
 
-	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// prev block block ts
-	PrevBlockTs string `protobuf:"bytes,2,opt,name=prev_block_ts,json=prevBlockTs,proto3" json:"prev_block_ts,omitempty"`
-	// max supply
-	MaxSupply *v1beta1.Coin `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MintAllocation)(nil)
+
+type fastReflection_MintAllocation MintAllocation
+
+func (x *MintAllocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintAllocation)(x)
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[0]
+func (x *MintAllocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MintAllocation_messageType fastReflection_MintAllocation_messageType
+var _ protoreflect.MessageType = fastReflection_MintAllocation_messageType{}
 
-func (*GenesisState) ProtoMessage() {}
+type fastReflection_MintAllocation_messageType struct{}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_haqq_coinomics_v1_genesis_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MintAllocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintAllocation)(nil)
 }
-
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+func (x fastReflection_MintAllocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintAllocation)
+}
+func (x fastReflection_MintAllocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAllocation
 }
 
-func (x *GenesisState) GetPrevBlockTs() string {
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintAllocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAllocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintAllocation) Type() protoreflect.MessageType {
+	return _fastReflection_MintAllocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintAllocation) New() protoreflect.Message {
+	return new(fastReflection_MintAllocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintAllocation) Interface() protoreflect.ProtoMessage {
+	return (*MintAllocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintAllocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_MintAllocation_type, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_MintAllocation_recipient, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_MintAllocation_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintAllocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		return x.Type_ != 0
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		return x.Recipient != ""
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		x.Type_ = 0
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		x.Recipient = ""
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintAllocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		x.Type_ = (RecipientType)(value.Enum())
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		x.Recipient = value.Interface().(string)
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		panic(fmt.Errorf("field type of message haqq.coinomics.v1.MintAllocation is not mutable"))
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		panic(fmt.Errorf("field recipient of message haqq.coinomics.v1.MintAllocation is not mutable"))
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		panic(fmt.Errorf("field weight of message haqq.coinomics.v1.MintAllocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintAllocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintAllocation.type":
+		return protoreflect.ValueOfEnum(0)
+	case "haqq.coinomics.v1.MintAllocation.recipient":
+		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.MintAllocation.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintAllocation"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintAllocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintAllocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.coinomics.v1.MintAllocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintAllocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintAllocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintAllocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintAllocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintAllocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintAllocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintAllocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAllocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= RecipientType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MintRecord                    protoreflect.MessageDescriptor
+	fd_MintRecord_epoch_identifier   protoreflect.FieldDescriptor
+	fd_MintRecord_epoch_number       protoreflect.FieldDescriptor
+	fd_MintRecord_height             protoreflect.FieldDescriptor
+	fd_MintRecord_minted             protoreflect.FieldDescriptor
+	fd_MintRecord_bonded_base        protoreflect.FieldDescriptor
+	fd_MintRecord_reward_coefficient protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_coinomics_v1_genesis_proto_init()
+	md_MintRecord = File_haqq_coinomics_v1_genesis_proto.Messages().ByName("MintRecord")
+	fd_MintRecord_epoch_identifier = md_MintRecord.Fields().ByName("epoch_identifier")
+	fd_MintRecord_epoch_number = md_MintRecord.Fields().ByName("epoch_number")
+	fd_MintRecord_height = md_MintRecord.Fields().ByName("height")
+	fd_MintRecord_minted = md_MintRecord.Fields().ByName("minted")
+	fd_MintRecord_bonded_base = md_MintRecord.Fields().ByName("bonded_base")
+	fd_MintRecord_reward_coefficient = md_MintRecord.Fields().ByName("reward_coefficient")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintRecord)(nil)
+
+type fastReflection_MintRecord MintRecord
+
+func (x *MintRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintRecord)(x)
+}
+
+func (x *MintRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintRecord_messageType fastReflection_MintRecord_messageType
+var _ protoreflect.MessageType = fastReflection_MintRecord_messageType{}
+
+type fastReflection_MintRecord_messageType struct{}
+
+func (x fastReflection_MintRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintRecord)(nil)
+}
+func (x fastReflection_MintRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintRecord)
+}
+func (x fastReflection_MintRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintRecord) Type() protoreflect.MessageType {
+	return _fastReflection_MintRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintRecord) New() protoreflect.Message {
+	return new(fastReflection_MintRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintRecord) Interface() protoreflect.ProtoMessage {
+	return (*MintRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochIdentifier != "" {
+		value := protoreflect.ValueOfString(x.EpochIdentifier)
+		if !f(fd_MintRecord_epoch_identifier, value) {
+			return
+		}
+	}
+	if x.EpochNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochNumber)
+		if !f(fd_MintRecord_epoch_number, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_MintRecord_height, value) {
+			return
+		}
+	}
+	if x.Minted != nil {
+		value := protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+		if !f(fd_MintRecord_minted, value) {
+			return
+		}
+	}
+	if x.BondedBase != "" {
+		value := protoreflect.ValueOfString(x.BondedBase)
+		if !f(fd_MintRecord_bonded_base, value) {
+			return
+		}
+	}
+	if x.RewardCoefficient != "" {
+		value := protoreflect.ValueOfString(x.RewardCoefficient)
+		if !f(fd_MintRecord_reward_coefficient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		return x.EpochIdentifier != ""
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		return x.EpochNumber != int64(0)
+	case "haqq.coinomics.v1.MintRecord.height":
+		return x.Height != int64(0)
+	case "haqq.coinomics.v1.MintRecord.minted":
+		return x.Minted != nil
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		return x.BondedBase != ""
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		return x.RewardCoefficient != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		x.EpochIdentifier = ""
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		x.EpochNumber = int64(0)
+	case "haqq.coinomics.v1.MintRecord.height":
+		x.Height = int64(0)
+	case "haqq.coinomics.v1.MintRecord.minted":
+		x.Minted = nil
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		x.BondedBase = ""
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		x.RewardCoefficient = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		value := x.EpochIdentifier
+		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfInt64(value)
+	case "haqq.coinomics.v1.MintRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "haqq.coinomics.v1.MintRecord.minted":
+		value := x.Minted
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		value := x.BondedBase
+		return protoreflect.ValueOfString(value)
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		value := x.RewardCoefficient
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		x.EpochIdentifier = value.Interface().(string)
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		x.EpochNumber = value.Int()
+	case "haqq.coinomics.v1.MintRecord.height":
+		x.Height = value.Int()
+	case "haqq.coinomics.v1.MintRecord.minted":
+		x.Minted = value.Message().Interface().(*v1beta1.Coin)
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		x.BondedBase = value.Interface().(string)
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		x.RewardCoefficient = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintRecord.minted":
+		if x.Minted == nil {
+			x.Minted = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		panic(fmt.Errorf("field epoch_identifier of message haqq.coinomics.v1.MintRecord is not mutable"))
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message haqq.coinomics.v1.MintRecord is not mutable"))
+	case "haqq.coinomics.v1.MintRecord.height":
+		panic(fmt.Errorf("field height of message haqq.coinomics.v1.MintRecord is not mutable"))
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		panic(fmt.Errorf("field bonded_base of message haqq.coinomics.v1.MintRecord is not mutable"))
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		panic(fmt.Errorf("field reward_coefficient of message haqq.coinomics.v1.MintRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.coinomics.v1.MintRecord.epoch_identifier":
+		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.MintRecord.epoch_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "haqq.coinomics.v1.MintRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "haqq.coinomics.v1.MintRecord.minted":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "haqq.coinomics.v1.MintRecord.bonded_base":
+		return protoreflect.ValueOfString("")
+	case "haqq.coinomics.v1.MintRecord.reward_coefficient":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.coinomics.v1.MintRecord"))
+		}
+		panic(fmt.Errorf("message haqq.coinomics.v1.MintRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.coinomics.v1.MintRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.EpochIdentifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Minted != nil {
+			l = options.Size(x.Minted)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondedBase)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardCoefficient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardCoefficient) > 0 {
+			i -= len(x.RewardCoefficient)
+			copy(dAtA[i:], x.RewardCoefficient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardCoefficient)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.BondedBase) > 0 {
+			i -= len(x.BondedBase)
+			copy(dAtA[i:], x.BondedBase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondedBase)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Minted != nil {
+			encoded, err := options.Marshal(x.Minted)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.EpochIdentifier) > 0 {
+			i -= len(x.EpochIdentifier)
+			copy(dAtA[i:], x.EpochIdentifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EpochIdentifier)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Minted == nil {
+					x.Minted = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minted); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondedBase", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondedBase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardCoefficient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardCoefficient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: haqq/coinomics/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecipientType defines the type of a mint allocation recipient.
+type RecipientType int32
+
+const (
+	// RECIPIENT_TYPE_UNSPECIFIED is the unspecified recipient type.
+	RecipientType_RECIPIENT_TYPE_UNSPECIFIED RecipientType = 0
+	// RECIPIENT_TYPE_MODULE allocates to a module account by its name.
+	RecipientType_RECIPIENT_TYPE_MODULE RecipientType = 1
+	// RECIPIENT_TYPE_COMMUNITY_POOL allocates to the distribution community pool.
+	RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL RecipientType = 2
+	// RECIPIENT_TYPE_UCDAO funds the UC DAO on behalf of a share owner address.
+	RecipientType_RECIPIENT_TYPE_UCDAO RecipientType = 3
+	// RECIPIENT_TYPE_ACCOUNT allocates to an account address.
+	RecipientType_RECIPIENT_TYPE_ACCOUNT RecipientType = 4
+)
+
+// Enum value maps for RecipientType.
+var (
+	RecipientType_name = map[int32]string{
+		0: "RECIPIENT_TYPE_UNSPECIFIED",
+		1: "RECIPIENT_TYPE_MODULE",
+		2: "RECIPIENT_TYPE_COMMUNITY_POOL",
+		3: "RECIPIENT_TYPE_UCDAO",
+		4: "RECIPIENT_TYPE_ACCOUNT",
+	}
+	RecipientType_value = map[string]int32{
+		"RECIPIENT_TYPE_UNSPECIFIED":    0,
+		"RECIPIENT_TYPE_MODULE":         1,
+		"RECIPIENT_TYPE_COMMUNITY_POOL": 2,
+		"RECIPIENT_TYPE_UCDAO":          3,
+		"RECIPIENT_TYPE_ACCOUNT":        4,
+	}
+)
+
+func (x RecipientType) Enum() *RecipientType {
+	p := new(RecipientType)
+	*p = x
+	return p
+}
+
+func (x RecipientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecipientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_haqq_coinomics_v1_genesis_proto_enumTypes[0].Descriptor()
+}
+
+func (RecipientType) Type() protoreflect.EnumType {
+	return &file_haqq_coinomics_v1_genesis_proto_enumTypes[0]
+}
+
+func (x RecipientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecipientType.Descriptor instead.
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return file_haqq_coinomics_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+// GenesisState defines the coinomics module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params defines all the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// prev block block ts
+	PrevBlockTs string `protobuf:"bytes,2,opt,name=prev_block_ts,json=prevBlockTs,proto3" json:"prev_block_ts,omitempty"`
+	// max supply
+	MaxSupply *v1beta1.Coin `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// mint accrued since the last settlement when minting is settled per epoch
+	AccruedMint string `protobuf:"bytes,4,opt,name=accrued_mint,json=accruedMint,proto3" json:"accrued_mint,omitempty"`
+	// settled mint records
+	MintHistory []*MintRecord `protobuf:"bytes,5,rep,name=mint_history,json=mintHistory,proto3" json:"mint_history,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_haqq_coinomics_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPrevBlockTs() string {
 	if x != nil {
 		return x.PrevBlockTs
 	}
@@ -1199,6 +2923,20 @@ func (x *GenesisState) GetMaxSupply() *v1beta1.Coin {
 	return nil
 }
 
+func (x *GenesisState) GetAccruedMint() string {
+	if x != nil {
+		return x.AccruedMint
+	}
+	return ""
+}
+
+func (x *GenesisState) GetMintHistory() []*MintRecord {
+	if x != nil {
+		return x.MintHistory
+	}
+	return nil
+}
+
 // Params holds parameters for the coinomics module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1211,6 +2949,12 @@ type Params struct {
 	EnableCoinomics bool `protobuf:"varint,2,opt,name=enable_coinomics,json=enableCoinomics,proto3" json:"enable_coinomics,omitempty"`
 	// current staking reward coefficient
 	RewardCoefficient string `protobuf:"bytes,3,opt,name=reward_coefficient,json=rewardCoefficient,proto3" json:"reward_coefficient,omitempty"`
+	// epoch identifier on which the minted coins are settled, minting is settled
+	// every block if empty
+	MintEpochIdentifier string `protobuf:"bytes,4,opt,name=mint_epoch_identifier,json=mintEpochIdentifier,proto3" json:"mint_epoch_identifier,omitempty"`
+	// allocation of the minted coins between the recipients, the weights must
+	// sum up to one
+	Allocations []*MintAllocation `protobuf:"bytes,5,rep,name=allocations,proto3" json:"allocations,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1254,6 +2998,159 @@ func (x *Params) GetRewardCoefficient() string {
 	return ""
 }
 
+func (x *Params) GetMintEpochIdentifier() string {
+	if x != nil {
+		return x.MintEpochIdentifier
+	}
+	return ""
+}
+
+func (x *Params) GetAllocations() []*MintAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+// MintAllocation defines a recipient of the minted coins and its share.
+type MintAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of the recipient
+	Type_ RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=haqq.coinomics.v1.RecipientType" json:"type,omitempty"`
+	// module name for the module type, bech32 address for the account type,
+	// bech32 share owner address for the UC DAO type and empty for the
+	// community pool type
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share of the minted coins
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *MintAllocation) Reset() {
+	*x = MintAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAllocation) ProtoMessage() {}
+
+// Deprecated: Use MintAllocation.ProtoReflect.Descriptor instead.
+func (*MintAllocation) Descriptor() ([]byte, []int) {
+	return file_haqq_coinomics_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MintAllocation) GetType_() RecipientType {
+	if x != nil {
+		return x.Type_
+	}
+	return RecipientType_RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (x *MintAllocation) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *MintAllocation) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// MintRecord defines the coins minted over a single epoch.
+type MintRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// identifier of the epoch the mint has been settled on
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// number of the settled epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// block height of the settlement
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// coins minted over the epoch
+	Minted *v1beta1.Coin `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	// total bonded tokens at the settlement
+	BondedBase string `protobuf:"bytes,5,opt,name=bonded_base,json=bondedBase,proto3" json:"bonded_base,omitempty"`
+	// staking reward coefficient at the settlement
+	RewardCoefficient string `protobuf:"bytes,6,opt,name=reward_coefficient,json=rewardCoefficient,proto3" json:"reward_coefficient,omitempty"`
+}
+
+func (x *MintRecord) Reset() {
+	*x = MintRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_coinomics_v1_genesis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintRecord) ProtoMessage() {}
+
+// Deprecated: Use MintRecord.ProtoReflect.Descriptor instead.
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return file_haqq_coinomics_v1_genesis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MintRecord) GetEpochIdentifier() string {
+	if x != nil {
+		return x.EpochIdentifier
+	}
+	return ""
+}
+
+func (x *MintRecord) GetEpochNumber() int64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *MintRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MintRecord) GetMinted() *v1beta1.Coin {
+	if x != nil {
+		return x.Minted
+	}
+	return nil
+}
+
+func (x *MintRecord) GetBondedBase() string {
+	if x != nil {
+		return x.BondedBase
+	}
+	return ""
+}
+
+func (x *MintRecord) GetRewardCoefficient() string {
+	if x != nil {
+		return x.RewardCoefficient
+	}
+	return ""
+}
+
 var File_haqq_coinomics_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_haqq_coinomics_v1_genesis_proto_rawDesc = []byte{
@@ -1264,7 +3161,7 @@ var file_haqq_coinomics_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x02,
+	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x03,
 	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c,
 	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e,
@@ -1282,32 +3179,95 @@ var file_haqq_coinomics_v1_genesis_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d,
 	0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xc9, 0x01, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63,
-	0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12,
-	0x57, 0x0a, 0x12, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
+	0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x72, 0x75, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x72,
+	0x75, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x68, 0x61, 0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0xcd, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x12, 0x57, 0x0a, 0x12, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x61,
+	0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x1c, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x68, 0x61, 0x71,
+	0x71, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
 	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x65,
-	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x3a, 0x1c, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x68,
-	0x61, 0x71, 0x71, 0x2f, 0x78, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xbb, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x68,
-	0x61, 0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x31,
-	0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
-	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x11, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x48, 0x61, 0x71,
-	0x71, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x13, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf9, 0x02,
+	0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x67, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x34, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x62,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65,
+	0x12, 0x57, 0x0a, 0x12, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x2a, 0xa3, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52,
+	0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f,
+	0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49,
+	0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43,
+	0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x43, 0x44, 0x41,
+	0x4f, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x42,
+	0xbb, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x63, 0x6f, 0x69,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f,
+	0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa,
+	0x02, 0x11, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x43, 0x6f, 0x69, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x43,
+	0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a,
+	0x43, 0x6f, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1322,20 +3282,28 @@ func file_haqq_coinomics_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_haqq_coinomics_v1_genesis_proto_rawDescData
 }
 
-var file_haqq_coinomics_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_haqq_coinomics_v1_genesis_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_haqq_coinomics_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_haqq_coinomics_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: haqq.coinomics.v1.GenesisState
-	(*Params)(nil),       // 1: haqq.coinomics.v1.Params
-	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
+	(RecipientType)(0),     // 0: haqq.coinomics.v1.RecipientType
+	(*GenesisState)(nil),   // 1: haqq.coinomics.v1.GenesisState
+	(*Params)(nil),         // 2: haqq.coinomics.v1.Params
+	(*MintAllocation)(nil), // 3: haqq.coinomics.v1.MintAllocation
+	(*MintRecord)(nil),     // 4: haqq.coinomics.v1.MintRecord
+	(*v1beta1.Coin)(nil),   // 5: cosmos.base.v1beta1.Coin
 }
 var file_haqq_coinomics_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: haqq.coinomics.v1.GenesisState.params:type_name -> haqq.coinomics.v1.Params
-	2, // 1: haqq.coinomics.v1.GenesisState.max_supply:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: haqq.coinomics.v1.GenesisState.params:type_name -> haqq.coinomics.v1.Params
+	5, // 1: haqq.coinomics.v1.GenesisState.max_supply:type_name -> cosmos.base.v1beta1.Coin
+	4, // 2: haqq.coinomics.v1.GenesisState.mint_history:type_name -> haqq.coinomics.v1.MintRecord
+	3, // 3: haqq.coinomics.v1.Params.allocations:type_name -> haqq.coinomics.v1.MintAllocation
+	0, // 4: haqq.coinomics.v1.MintAllocation.type:type_name -> haqq.coinomics.v1.RecipientType
+	5, // 5: haqq.coinomics.v1.MintRecord.minted:type_name -> cosmos.base.v1beta1.Coin
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_haqq_coinomics_v1_genesis_proto_init() }
@@ -1368,19 +3336,44 @@ func file_haqq_coinomics_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_haqq_coinomics_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_coinomics_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_haqq_coinomics_v1_genesis_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_haqq_coinomics_v1_genesis_proto_goTypes,
 		DependencyIndexes: file_haqq_coinomics_v1_genesis_proto_depIdxs,
+		EnumInfos:         file_haqq_coinomics_v1_genesis_proto_enumTypes,
 		MessageInfos:      file_haqq_coinomics_v1_genesis_proto_msgTypes,
 	}.Build()
 	File_haqq_coinomics_v1_genesis_proto = out.File
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_MaxSupply_FullMethodName         = "/haqq.coinomics.v1.Query/MaxSupply"
-	Query_RewardCoefficient_FullMethodName = "/haqq.coinomics.v1.Query/RewardCoefficient"
-	Query_Params_FullMethodName            = "/haqq.coinomics.v1.Query/Params"
+	Query_MaxSupply_FullMethodName          = "/haqq.coinomics.v1.Query/MaxSupply"
+	Query_RewardCoefficient_FullMethodName  = "/haqq.coinomics.v1.Query/RewardCoefficient"
+	Query_Params_FullMethodName             = "/haqq.coinomics.v1.Query/Params"
+	Query_MintHistory_FullMethodName        = "/haqq.coinomics.v1.Query/MintHistory"
+	Query_ProjectedInflation_FullMethodName = "/haqq.coinomics.v1.Query/ProjectedInflation"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	RewardCoefficient(ctx context.Context, in *QueryRewardCoefficientRequest, opts ...grpc.CallOption) (*QueryRewardCoefficientResponse, error)
 	// Params retrieves coinomics moudle params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MintHistory retrieves the mint records settled within an epoch range.
+	MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error)
+	// ProjectedInflation retrieves the annual provisions and inflation rate
+	// projected from the current state.
+	ProjectedInflation(ctx context.Context, in *QueryProjectedInflationRequest, opts ...grpc.CallOption) (*QueryProjectedInflationResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error) {
+	out := new(QueryMintHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MintHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedInflation(ctx context.Context, in *QueryProjectedInflationRequest, opts ...grpc.CallOption) (*QueryProjectedInflationResponse, error) {
+	out := new(QueryProjectedInflationResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedInflation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	RewardCoefficient(context.Context, *QueryRewardCoefficientRequest) (*QueryRewardCoefficientResponse, error)
 	// Params retrieves coinomics moudle params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MintHistory retrieves the mint records settled within an epoch range.
+	MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error)
+	// ProjectedInflation retrieves the annual provisions and inflation rate
+	// projected from the current state.
+	ProjectedInflation(context.Context, *QueryProjectedInflationRequest) (*QueryProjectedInflationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) RewardCoefficient(context.Context, *QueryRewardC
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintHistory not implemented")
+}
+func (UnimplementedQueryServer) ProjectedInflation(context.Context, *QueryProjectedInflationRequest) (*QueryProjectedInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedInflation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MintHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintHistory(ctx, req.(*QueryMintHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedInflation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedInflation(ctx, req.(*QueryProjectedInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MintHistory",
+			Handler:    _Query_MintHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedInflation",
+			Handler:    _Query_ProjectedInflation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/coinomics/v1/query.proto",
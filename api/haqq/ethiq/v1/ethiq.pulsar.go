@@ -26,6 +26,7 @@ var (
 	fd_BurnApplication_burned_before_amount protoreflect.FieldDescriptor
 	fd_BurnApplication_is_executed          protoreflect.FieldDescriptor
 	fd_BurnApplication_is_canceled          protoreflect.FieldDescriptor
+	fd_BurnApplication_is_approved          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -39,6 +40,7 @@ func init() {
 	fd_BurnApplication_burned_before_amount = md_BurnApplication.Fields().ByName("burned_before_amount")
 	fd_BurnApplication_is_executed = md_BurnApplication.Fields().ByName("is_executed")
 	fd_BurnApplication_is_canceled = md_BurnApplication.Fields().ByName("is_canceled")
+	fd_BurnApplication_is_approved = md_BurnApplication.Fields().ByName("is_approved")
 }
 
 var _ protoreflect.Message = (*fastReflection_BurnApplication)(nil)
@@ -154,6 +156,12 @@ func (x *fastReflection_BurnApplication) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.IsApproved != false {
+		value := protoreflect.ValueOfBool(x.IsApproved)
+		if !f(fd_BurnApplication_is_approved, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -185,6 +193,8 @@ func (x *fastReflection_BurnApplication) Has(fd protoreflect.FieldDescriptor) bo
 		return x.IsExecuted != false
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		return x.IsCanceled != false
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		return x.IsApproved != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -217,6 +227,8 @@ func (x *fastReflection_BurnApplication) Clear(fd protoreflect.FieldDescriptor)
 		x.IsExecuted = false
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		x.IsCanceled = false
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		x.IsApproved = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -257,6 +269,9 @@ func (x *fastReflection_BurnApplication) Get(descriptor protoreflect.FieldDescri
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		value := x.IsCanceled
 		return protoreflect.ValueOfBool(value)
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		value := x.IsApproved
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -293,6 +308,8 @@ func (x *fastReflection_BurnApplication) Set(fd protoreflect.FieldDescriptor, va
 		x.IsExecuted = value.Bool()
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		x.IsCanceled = value.Bool()
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		x.IsApproved = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -335,6 +352,8 @@ func (x *fastReflection_BurnApplication) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field is_executed of message haqq.ethiq.v1.BurnApplication is not mutable"))
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		panic(fmt.Errorf("field is_canceled of message haqq.ethiq.v1.BurnApplication is not mutable"))
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		panic(fmt.Errorf("field is_approved of message haqq.ethiq.v1.BurnApplication is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -366,6 +385,8 @@ func (x *fastReflection_BurnApplication) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfBool(false)
 	case "haqq.ethiq.v1.BurnApplication.is_canceled":
 		return protoreflect.ValueOfBool(false)
+	case "haqq.ethiq.v1.BurnApplication.is_approved":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.BurnApplication"))
@@ -463,6 +484,9 @@ func (x *fastReflection_BurnApplication) ProtoMethods() *protoiface.Methods {
 		if x.IsCanceled {
 			n += 2
 		}
+		if x.IsApproved {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -492,6 +516,16 @@ func (x *fastReflection_BurnApplication) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.IsApproved {
+			i--
+			if x.IsApproved {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
 		if x.IsCanceled {
 			i--
 			if x.IsCanceled {
@@ -827,6 +861,26 @@ func (x *fastReflection_BurnApplication) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.IsCanceled = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsApproved", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsApproved = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -863,31 +917,29 @@ func (x *fastReflection_BurnApplication) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                 protoreflect.MessageDescriptor
-	fd_Params_enabled         protoreflect.FieldDescriptor
-	fd_Params_min_mint_per_tx protoreflect.FieldDescriptor
-	fd_Params_max_mint_per_tx protoreflect.FieldDescriptor
-	fd_Params_max_supply      protoreflect.FieldDescriptor
+	md_PriceLevel       protoreflect.MessageDescriptor
+	fd_PriceLevel_from  protoreflect.FieldDescriptor
+	fd_PriceLevel_to    protoreflect.FieldDescriptor
+	fd_PriceLevel_price protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_ethiq_proto_init()
-	md_Params = File_haqq_ethiq_v1_ethiq_proto.Messages().ByName("Params")
-	fd_Params_enabled = md_Params.Fields().ByName("enabled")
-	fd_Params_min_mint_per_tx = md_Params.Fields().ByName("min_mint_per_tx")
-	fd_Params_max_mint_per_tx = md_Params.Fields().ByName("max_mint_per_tx")
-	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	md_PriceLevel = File_haqq_ethiq_v1_ethiq_proto.Messages().ByName("PriceLevel")
+	fd_PriceLevel_from = md_PriceLevel.Fields().ByName("from")
+	fd_PriceLevel_to = md_PriceLevel.Fields().ByName("to")
+	fd_PriceLevel_price = md_PriceLevel.Fields().ByName("price")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceLevel)(nil)
 
-type fastReflection_Params Params
+type fastReflection_PriceLevel PriceLevel
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *PriceLevel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceLevel)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *PriceLevel) slowProtoReflect() protoreflect.Message {
 	mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,43 +951,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_PriceLevel_messageType fastReflection_PriceLevel_messageType
+var _ protoreflect.MessageType = fastReflection_PriceLevel_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_PriceLevel_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_PriceLevel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceLevel)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_PriceLevel_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceLevel)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_PriceLevel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLevel
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_PriceLevel) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLevel
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_PriceLevel) Type() protoreflect.MessageType {
+	return _fastReflection_PriceLevel_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_PriceLevel) New() protoreflect.Message {
+	return new(fastReflection_PriceLevel)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_PriceLevel) Interface() protoreflect.ProtoMessage {
+	return (*PriceLevel)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -943,28 +995,22 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Enabled != false {
-		value := protoreflect.ValueOfBool(x.Enabled)
-		if !f(fd_Params_enabled, value) {
-			return
-		}
-	}
-	if x.MinMintPerTx != "" {
-		value := protoreflect.ValueOfString(x.MinMintPerTx)
-		if !f(fd_Params_min_mint_per_tx, value) {
+func (x *fastReflection_PriceLevel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_PriceLevel_from, value) {
 			return
 		}
 	}
-	if x.MaxMintPerTx != "" {
-		value := protoreflect.ValueOfString(x.MaxMintPerTx)
-		if !f(fd_Params_max_mint_per_tx, value) {
+	if x.To != "" {
+		value := protoreflect.ValueOfString(x.To)
+		if !f(fd_PriceLevel_to, value) {
 			return
 		}
 	}
-	if x.MaxSupply != "" {
-		value := protoreflect.ValueOfString(x.MaxSupply)
-		if !f(fd_Params_max_supply, value) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_PriceLevel_price, value) {
 			return
 		}
 	}
@@ -981,21 +1027,19 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceLevel) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		return x.Enabled != false
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
-		return x.MinMintPerTx != ""
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
-		return x.MaxMintPerTx != ""
-	case "haqq.ethiq.v1.Params.max_supply":
-		return x.MaxSupply != ""
+	case "haqq.ethiq.v1.PriceLevel.from":
+		return x.From != ""
+	case "haqq.ethiq.v1.PriceLevel.to":
+		return x.To != ""
+	case "haqq.ethiq.v1.PriceLevel.price":
+		return x.Price != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1005,21 +1049,19 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceLevel) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		x.Enabled = false
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
-		x.MinMintPerTx = ""
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
-		x.MaxMintPerTx = ""
-	case "haqq.ethiq.v1.Params.max_supply":
-		x.MaxSupply = ""
+	case "haqq.ethiq.v1.PriceLevel.from":
+		x.From = ""
+	case "haqq.ethiq.v1.PriceLevel.to":
+		x.To = ""
+	case "haqq.ethiq.v1.PriceLevel.price":
+		x.Price = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1029,25 +1071,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceLevel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		value := x.Enabled
-		return protoreflect.ValueOfBool(value)
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
-		value := x.MinMintPerTx
+	case "haqq.ethiq.v1.PriceLevel.from":
+		value := x.From
 		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
-		value := x.MaxMintPerTx
+	case "haqq.ethiq.v1.PriceLevel.to":
+		value := x.To
 		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.Params.max_supply":
-		value := x.MaxSupply
+	case "haqq.ethiq.v1.PriceLevel.price":
+		value := x.Price
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1061,21 +1100,19 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceLevel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		x.Enabled = value.Bool()
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
-		x.MinMintPerTx = value.Interface().(string)
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
-		x.MaxMintPerTx = value.Interface().(string)
-	case "haqq.ethiq.v1.Params.max_supply":
-		x.MaxSupply = value.Interface().(string)
+	case "haqq.ethiq.v1.PriceLevel.from":
+		x.From = value.Interface().(string)
+	case "haqq.ethiq.v1.PriceLevel.to":
+		x.To = value.Interface().(string)
+	case "haqq.ethiq.v1.PriceLevel.price":
+		x.Price = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1089,52 +1126,48 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceLevel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		panic(fmt.Errorf("field enabled of message haqq.ethiq.v1.Params is not mutable"))
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
-		panic(fmt.Errorf("field min_mint_per_tx of message haqq.ethiq.v1.Params is not mutable"))
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
-		panic(fmt.Errorf("field max_mint_per_tx of message haqq.ethiq.v1.Params is not mutable"))
-	case "haqq.ethiq.v1.Params.max_supply":
-		panic(fmt.Errorf("field max_supply of message haqq.ethiq.v1.Params is not mutable"))
+	case "haqq.ethiq.v1.PriceLevel.from":
+		panic(fmt.Errorf("field from of message haqq.ethiq.v1.PriceLevel is not mutable"))
+	case "haqq.ethiq.v1.PriceLevel.to":
+		panic(fmt.Errorf("field to of message haqq.ethiq.v1.PriceLevel is not mutable"))
+	case "haqq.ethiq.v1.PriceLevel.price":
+		panic(fmt.Errorf("field price of message haqq.ethiq.v1.PriceLevel is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceLevel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.Params.enabled":
-		return protoreflect.ValueOfBool(false)
-	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+	case "haqq.ethiq.v1.PriceLevel.from":
 		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+	case "haqq.ethiq.v1.PriceLevel.to":
 		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.Params.max_supply":
+	case "haqq.ethiq.v1.PriceLevel.price":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevel"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevel does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceLevel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.PriceLevel", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1142,7 +1175,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceLevel) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1153,7 +1186,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceLevel) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1165,7 +1198,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_PriceLevel) IsValid() bool {
 	return x != nil
 }
 
@@ -1175,9 +1208,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceLevel) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PriceLevel)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1189,18 +1222,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Enabled {
-			n += 2
-		}
-		l = len(x.MinMintPerTx)
+		l = len(x.From)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxMintPerTx)
+		l = len(x.To)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MaxSupply)
+		l = len(x.Price)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1214,7 +1244,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PriceLevel)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1233,36 +1263,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxSupply) > 0 {
-			i -= len(x.MaxSupply)
-			copy(dAtA[i:], x.MaxSupply)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.MaxMintPerTx) > 0 {
-			i -= len(x.MaxMintPerTx)
-			copy(dAtA[i:], x.MaxMintPerTx)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMintPerTx)))
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.MinMintPerTx) > 0 {
-			i -= len(x.MinMintPerTx)
-			copy(dAtA[i:], x.MinMintPerTx)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinMintPerTx)))
+		if len(x.To) > 0 {
+			i -= len(x.To)
+			copy(dAtA[i:], x.To)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.To)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Enabled {
-			i--
-			if x.Enabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1275,7 +1295,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PriceLevel)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1307,35 +1327,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Enabled = bool(v != 0)
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinMintPerTx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1363,11 +1363,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinMintPerTx = string(dAtA[iNdEx:postIndex])
+				x.From = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerTx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1395,11 +1395,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxMintPerTx = string(dAtA[iNdEx:postIndex])
+				x.To = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1427,7 +1427,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				x.Price = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1464,52 +1464,1250 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: haqq/ethiq/v1/ethiq.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// SourceOfFunds enumerates the valid options for a source of funds.
-type SourceOfFunds int32
-
-const (
-	// SOURCE_OF_FUNDS_BANK defines a bank balance as a source of funds option
-	SourceOfFunds_SOURCE_OF_FUNDS_BANK SourceOfFunds = 0
-	// SOURCE_OF_FUNDS_UCDAO defines a UC DAO module balance as a source of funds option
-	SourceOfFunds_SOURCE_OF_FUNDS_UCDAO SourceOfFunds = 1
-)
-
-// Enum value maps for SourceOfFunds.
 var (
-	SourceOfFunds_name = map[int32]string{
-		0: "SOURCE_OF_FUNDS_BANK",
-		1: "SOURCE_OF_FUNDS_UCDAO",
-	}
-	SourceOfFunds_value = map[string]int32{
-		"SOURCE_OF_FUNDS_BANK":  0,
-		"SOURCE_OF_FUNDS_UCDAO": 1,
-	}
+	md_PriceLevelBreakdown             protoreflect.MessageDescriptor
+	fd_PriceLevelBreakdown_level       protoreflect.FieldDescriptor
+	fd_PriceLevelBreakdown_islm_amount protoreflect.FieldDescriptor
+	fd_PriceLevelBreakdown_price       protoreflect.FieldDescriptor
+	fd_PriceLevelBreakdown_haqq_amount protoreflect.FieldDescriptor
 )
 
-func (x SourceOfFunds) Enum() *SourceOfFunds {
-	p := new(SourceOfFunds)
-	*p = x
-	return p
+func init() {
+	file_haqq_ethiq_v1_ethiq_proto_init()
+	md_PriceLevelBreakdown = File_haqq_ethiq_v1_ethiq_proto.Messages().ByName("PriceLevelBreakdown")
+	fd_PriceLevelBreakdown_level = md_PriceLevelBreakdown.Fields().ByName("level")
+	fd_PriceLevelBreakdown_islm_amount = md_PriceLevelBreakdown.Fields().ByName("islm_amount")
+	fd_PriceLevelBreakdown_price = md_PriceLevelBreakdown.Fields().ByName("price")
+	fd_PriceLevelBreakdown_haqq_amount = md_PriceLevelBreakdown.Fields().ByName("haqq_amount")
 }
 
-func (x SourceOfFunds) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+var _ protoreflect.Message = (*fastReflection_PriceLevelBreakdown)(nil)
+
+type fastReflection_PriceLevelBreakdown PriceLevelBreakdown
+
+func (x *PriceLevelBreakdown) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceLevelBreakdown)(x)
 }
 
-func (SourceOfFunds) Descriptor() protoreflect.EnumDescriptor {
+func (x *PriceLevelBreakdown) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceLevelBreakdown_messageType fastReflection_PriceLevelBreakdown_messageType
+var _ protoreflect.MessageType = fastReflection_PriceLevelBreakdown_messageType{}
+
+type fastReflection_PriceLevelBreakdown_messageType struct{}
+
+func (x fastReflection_PriceLevelBreakdown_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceLevelBreakdown)(nil)
+}
+func (x fastReflection_PriceLevelBreakdown_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceLevelBreakdown)
+}
+func (x fastReflection_PriceLevelBreakdown_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLevelBreakdown
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceLevelBreakdown) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceLevelBreakdown
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceLevelBreakdown) Type() protoreflect.MessageType {
+	return _fastReflection_PriceLevelBreakdown_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceLevelBreakdown) New() protoreflect.Message {
+	return new(fastReflection_PriceLevelBreakdown)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceLevelBreakdown) Interface() protoreflect.ProtoMessage {
+	return (*PriceLevelBreakdown)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceLevelBreakdown) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Level != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Level)
+		if !f(fd_PriceLevelBreakdown_level, value) {
+			return
+		}
+	}
+	if x.IslmAmount != "" {
+		value := protoreflect.ValueOfString(x.IslmAmount)
+		if !f(fd_PriceLevelBreakdown_islm_amount, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_PriceLevelBreakdown_price, value) {
+			return
+		}
+	}
+	if x.HaqqAmount != "" {
+		value := protoreflect.ValueOfString(x.HaqqAmount)
+		if !f(fd_PriceLevelBreakdown_haqq_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceLevelBreakdown) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		return x.Level != uint64(0)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		return x.IslmAmount != ""
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		return x.Price != ""
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		return x.HaqqAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLevelBreakdown) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		x.Level = uint64(0)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		x.IslmAmount = ""
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		x.Price = ""
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		x.HaqqAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceLevelBreakdown) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		value := x.Level
+		return protoreflect.ValueOfUint64(value)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		value := x.IslmAmount
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		value := x.HaqqAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLevelBreakdown) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		x.Level = value.Uint()
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		x.IslmAmount = value.Interface().(string)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		x.Price = value.Interface().(string)
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		x.HaqqAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLevelBreakdown) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		panic(fmt.Errorf("field level of message haqq.ethiq.v1.PriceLevelBreakdown is not mutable"))
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		panic(fmt.Errorf("field islm_amount of message haqq.ethiq.v1.PriceLevelBreakdown is not mutable"))
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		panic(fmt.Errorf("field price of message haqq.ethiq.v1.PriceLevelBreakdown is not mutable"))
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		panic(fmt.Errorf("field haqq_amount of message haqq.ethiq.v1.PriceLevelBreakdown is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceLevelBreakdown) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.PriceLevelBreakdown.level":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "haqq.ethiq.v1.PriceLevelBreakdown.islm_amount":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.PriceLevelBreakdown.price":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.PriceLevelBreakdown.haqq_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.PriceLevelBreakdown"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.PriceLevelBreakdown does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceLevelBreakdown) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.PriceLevelBreakdown", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceLevelBreakdown) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceLevelBreakdown) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceLevelBreakdown) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceLevelBreakdown) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceLevelBreakdown)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Level != 0 {
+			n += 1 + runtime.Sov(uint64(x.Level))
+		}
+		l = len(x.IslmAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HaqqAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLevelBreakdown)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.HaqqAmount) > 0 {
+			i -= len(x.HaqqAmount)
+			copy(dAtA[i:], x.HaqqAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HaqqAmount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.IslmAmount) > 0 {
+			i -= len(x.IslmAmount)
+			copy(dAtA[i:], x.IslmAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IslmAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Level != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Level))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceLevelBreakdown)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLevelBreakdown: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceLevelBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				}
+				x.Level = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Level |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IslmAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HaqqAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Params                 protoreflect.MessageDescriptor
+	fd_Params_enabled         protoreflect.FieldDescriptor
+	fd_Params_min_mint_per_tx protoreflect.FieldDescriptor
+	fd_Params_max_mint_per_tx protoreflect.FieldDescriptor
+	fd_Params_max_supply      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_ethiq_v1_ethiq_proto_init()
+	md_Params = File_haqq_ethiq_v1_ethiq_proto.Messages().ByName("Params")
+	fd_Params_enabled = md_Params.Fields().ByName("enabled")
+	fd_Params_min_mint_per_tx = md_Params.Fields().ByName("min_mint_per_tx")
+	fd_Params_max_mint_per_tx = md_Params.Fields().ByName("max_mint_per_tx")
+	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_Params_enabled, value) {
+			return
+		}
+	}
+	if x.MinMintPerTx != "" {
+		value := protoreflect.ValueOfString(x.MinMintPerTx)
+		if !f(fd_Params_min_mint_per_tx, value) {
+			return
+		}
+	}
+	if x.MaxMintPerTx != "" {
+		value := protoreflect.ValueOfString(x.MaxMintPerTx)
+		if !f(fd_Params_max_mint_per_tx, value) {
+			return
+		}
+	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_Params_max_supply, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		return x.Enabled != false
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		return x.MinMintPerTx != ""
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		return x.MaxMintPerTx != ""
+	case "haqq.ethiq.v1.Params.max_supply":
+		return x.MaxSupply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		x.Enabled = false
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		x.MinMintPerTx = ""
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		x.MaxMintPerTx = ""
+	case "haqq.ethiq.v1.Params.max_supply":
+		x.MaxSupply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		value := x.MinMintPerTx
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		value := x.MaxMintPerTx
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.Params.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		x.Enabled = value.Bool()
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		x.MinMintPerTx = value.Interface().(string)
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		x.MaxMintPerTx = value.Interface().(string)
+	case "haqq.ethiq.v1.Params.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		panic(fmt.Errorf("field enabled of message haqq.ethiq.v1.Params is not mutable"))
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		panic(fmt.Errorf("field min_mint_per_tx of message haqq.ethiq.v1.Params is not mutable"))
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		panic(fmt.Errorf("field max_mint_per_tx of message haqq.ethiq.v1.Params is not mutable"))
+	case "haqq.ethiq.v1.Params.max_supply":
+		panic(fmt.Errorf("field max_supply of message haqq.ethiq.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.Params.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "haqq.ethiq.v1.Params.min_mint_per_tx":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.Params.max_mint_per_tx":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.Params.max_supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.Params"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.MinMintPerTx)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxMintPerTx)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxMintPerTx) > 0 {
+			i -= len(x.MaxMintPerTx)
+			copy(dAtA[i:], x.MaxMintPerTx)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxMintPerTx)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinMintPerTx) > 0 {
+			i -= len(x.MinMintPerTx)
+			copy(dAtA[i:], x.MinMintPerTx)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinMintPerTx)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinMintPerTx", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinMintPerTx = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMintPerTx", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxMintPerTx = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: haqq/ethiq/v1/ethiq.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SourceOfFunds enumerates the valid options for a source of funds.
+type SourceOfFunds int32
+
+const (
+	// SOURCE_OF_FUNDS_BANK defines a bank balance as a source of funds option
+	SourceOfFunds_SOURCE_OF_FUNDS_BANK SourceOfFunds = 0
+	// SOURCE_OF_FUNDS_UCDAO defines a UC DAO module balance as a source of funds option
+	SourceOfFunds_SOURCE_OF_FUNDS_UCDAO SourceOfFunds = 1
+)
+
+// Enum value maps for SourceOfFunds.
+var (
+	SourceOfFunds_name = map[int32]string{
+		0: "SOURCE_OF_FUNDS_BANK",
+		1: "SOURCE_OF_FUNDS_UCDAO",
+	}
+	SourceOfFunds_value = map[string]int32{
+		"SOURCE_OF_FUNDS_BANK":  0,
+		"SOURCE_OF_FUNDS_UCDAO": 1,
+	}
+)
+
+func (x SourceOfFunds) Enum() *SourceOfFunds {
+	p := new(SourceOfFunds)
+	*p = x
+	return p
+}
+
+func (x SourceOfFunds) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceOfFunds) Descriptor() protoreflect.EnumDescriptor {
 	return file_haqq_ethiq_v1_ethiq_proto_enumTypes[0].Descriptor()
 }
 
@@ -1541,12 +2739,17 @@ type BurnApplication struct {
 	Source SourceOfFunds `protobuf:"varint,4,opt,name=source,proto3,enum=haqq.ethiq.v1.SourceOfFunds" json:"source,omitempty"`
 	// burn_amount is an amount to be burned by this BurnApplication
 	BurnAmount *v1beta1.Coin `protobuf:"bytes,5,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount,omitempty"`
-	// burned_before_amount is a sum of burn_amount's of all previous BurnApplication's
+	// burned_before_amount is the position on the price curve the application
+	// starts at, made of the public burns and the burn_amount's of the
+	// BurnApplication's approved before this one. It is assigned on approval
 	BurnedBeforeAmount *v1beta1.Coin `protobuf:"bytes,6,opt,name=burned_before_amount,json=burnedBeforeAmount,proto3" json:"burned_before_amount,omitempty"`
 	// is_executed is a flag of application execution status
 	IsExecuted bool `protobuf:"varint,7,opt,name=is_executed,json=isExecuted,proto3" json:"is_executed,omitempty"`
 	// is_canceled is a flag of application cancellation status
 	IsCanceled bool `protobuf:"varint,8,opt,name=is_canceled,json=isCanceled,proto3" json:"is_canceled,omitempty"`
+	// is_approved is a flag of application approval by governance, only
+	// approved applications can be executed
+	IsApproved bool `protobuf:"varint,9,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`
 }
 
 func (x *BurnApplication) Reset() {
@@ -1625,6 +2828,133 @@ func (x *BurnApplication) GetIsCanceled() bool {
 	return false
 }
 
+func (x *BurnApplication) GetIsApproved() bool {
+	if x != nil {
+		return x.IsApproved
+	}
+	return false
+}
+
+// PriceLevel defines a single segment of the aISLM to aHAQQ burn price curve.
+// The level covers the total burned aISLM range [from, to).
+type PriceLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from is the total burned aISLM amount the level starts at
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the total burned aISLM amount the level ends at, exclusive
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// price is the amount of aISLM to be burned for a single aHAQQ within the level
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PriceLevel) Reset() {
+	*x = PriceLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevel) ProtoMessage() {}
+
+// Deprecated: Use PriceLevel.ProtoReflect.Descriptor instead.
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_ethiq_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceLevel) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PriceLevel) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PriceLevel) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+// PriceLevelBreakdown describes the part of a burn that falls into a single price level.
+type PriceLevelBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// level is the index of the price level on the curve
+	Level uint64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// islm_amount is the amount of aISLM burned within the level
+	IslmAmount string `protobuf:"bytes,2,opt,name=islm_amount,json=islmAmount,proto3" json:"islm_amount,omitempty"`
+	// price is the unit price of aHAQQ within the level
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// haqq_amount is the amount of aHAQQ minted within the level
+	HaqqAmount string `protobuf:"bytes,4,opt,name=haqq_amount,json=haqqAmount,proto3" json:"haqq_amount,omitempty"`
+}
+
+func (x *PriceLevelBreakdown) Reset() {
+	*x = PriceLevelBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceLevelBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLevelBreakdown) ProtoMessage() {}
+
+// Deprecated: Use PriceLevelBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceLevelBreakdown) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_ethiq_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceLevelBreakdown) GetLevel() uint64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *PriceLevelBreakdown) GetIslmAmount() string {
+	if x != nil {
+		return x.IslmAmount
+	}
+	return ""
+}
+
+func (x *PriceLevelBreakdown) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PriceLevelBreakdown) GetHaqqAmount() string {
+	if x != nil {
+		return x.HaqqAmount
+	}
+	return ""
+}
+
 // Params defines the parameters for the ethiq module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1644,7 +2974,7 @@ type Params struct {
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[1]
+		mi := &file_haqq_ethiq_v1_ethiq_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1658,7 +2988,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_ethiq_proto_rawDescGZIP(), []int{1}
+	return file_haqq_ethiq_v1_ethiq_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Params) GetEnabled() bool {
@@ -1700,7 +3030,7 @@ var file_haqq_ethiq_v1_ethiq_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf,
 	0x03, 0x0a, 0x0f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
@@ -1727,40 +3057,75 @@ var file_haqq_ethiq_v1_ethiq_proto_rawDesc = []byte{
 	0x0b, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f,
 	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x22,
-	0x9d, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x22, 0xe2, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x44, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8,
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12,
-	0x49, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x4c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x13, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x0b, 0x69, 0x73, 0x6c, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x61,
-	0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x3a, 0x20, 0x88,
-	0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x68, 0x61, 0x71, 0x71,
-	0x2f, 0x78, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a,
-	0x44, 0x0a, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x66, 0x46, 0x75, 0x6e, 0x64, 0x73,
-	0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x55,
-	0x4e, 0x44, 0x53, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x55, 0x43,
-	0x44, 0x41, 0x4f, 0x10, 0x01, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61,
-	0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x74, 0x68,
-	0x69, 0x71, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71,
-	0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x74, 0x68, 0x69, 0x71, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x45,
-	0x74, 0x68, 0x69, 0x71, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45,
-	0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45,
-	0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74, 0x68, 0x69,
-	0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x69, 0x73, 0x6c, 0x6d,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x68, 0x61, 0x71, 0x71, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x68, 0x61, 0x71,
+	0x71, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9d, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4d, 0x69,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x54, 0x78, 0x12, 0x49, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6d,
+	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x54, 0x78, 0x12, 0x41, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x3a, 0x20, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x13, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x78, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0x44, 0x0a, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4f, 0x66, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x42, 0x41, 0x4e, 0x4b,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x5f,
+	0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x55, 0x43, 0x44, 0x41, 0x4f, 0x10, 0x01, 0x42, 0x9d, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x74, 0x68, 0x69, 0x71, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76,
+	0x31, 0x3b, 0x65, 0x74, 0x68, 0x69, 0x71, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x58, 0xaa,
+	0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x45, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x19, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x61,
+	0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74, 0x68, 0x69, 0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1776,17 +3141,19 @@ func file_haqq_ethiq_v1_ethiq_proto_rawDescGZIP() []byte {
 }
 
 var file_haqq_ethiq_v1_ethiq_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_haqq_ethiq_v1_ethiq_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_haqq_ethiq_v1_ethiq_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_haqq_ethiq_v1_ethiq_proto_goTypes = []interface{}{
-	(SourceOfFunds)(0),      // 0: haqq.ethiq.v1.SourceOfFunds
-	(*BurnApplication)(nil), // 1: haqq.ethiq.v1.BurnApplication
-	(*Params)(nil),          // 2: haqq.ethiq.v1.Params
-	(*v1beta1.Coin)(nil),    // 3: cosmos.base.v1beta1.Coin
+	(SourceOfFunds)(0),          // 0: haqq.ethiq.v1.SourceOfFunds
+	(*BurnApplication)(nil),     // 1: haqq.ethiq.v1.BurnApplication
+	(*PriceLevel)(nil),          // 2: haqq.ethiq.v1.PriceLevel
+	(*PriceLevelBreakdown)(nil), // 3: haqq.ethiq.v1.PriceLevelBreakdown
+	(*Params)(nil),              // 4: haqq.ethiq.v1.Params
+	(*v1beta1.Coin)(nil),        // 5: cosmos.base.v1beta1.Coin
 }
 var file_haqq_ethiq_v1_ethiq_proto_depIdxs = []int32{
 	0, // 0: haqq.ethiq.v1.BurnApplication.source:type_name -> haqq.ethiq.v1.SourceOfFunds
-	3, // 1: haqq.ethiq.v1.BurnApplication.burn_amount:type_name -> cosmos.base.v1beta1.Coin
-	3, // 2: haqq.ethiq.v1.BurnApplication.burned_before_amount:type_name -> cosmos.base.v1beta1.Coin
+	5, // 1: haqq.ethiq.v1.BurnApplication.burn_amount:type_name -> cosmos.base.v1beta1.Coin
+	5, // 2: haqq.ethiq.v1.BurnApplication.burned_before_amount:type_name -> cosmos.base.v1beta1.Coin
 	3, // [3:3] is the sub-list for method output_type
 	3, // [3:3] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
@@ -1813,6 +3180,30 @@ func file_haqq_ethiq_v1_ethiq_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_ethiq_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_ethiq_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceLevelBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_ethiq_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1831,7 +3222,7 @@ func file_haqq_ethiq_v1_ethiq_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_haqq_ethiq_v1_ethiq_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
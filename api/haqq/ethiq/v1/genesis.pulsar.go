@@ -61,11 +61,115 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*PriceLevel
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceLevel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceLevel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(PriceLevel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(PriceLevel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*BurnApplication
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(BurnApplication)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(BurnApplication)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                       protoreflect.MessageDescriptor
 	fd_GenesisState_params                protoreflect.FieldDescriptor
 	fd_GenesisState_total_burned_amount   protoreflect.FieldDescriptor
 	fd_GenesisState_executed_applications protoreflect.FieldDescriptor
+	fd_GenesisState_price_levels          protoreflect.FieldDescriptor
+	fd_GenesisState_applications          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -74,6 +178,8 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_total_burned_amount = md_GenesisState.Fields().ByName("total_burned_amount")
 	fd_GenesisState_executed_applications = md_GenesisState.Fields().ByName("executed_applications")
+	fd_GenesisState_price_levels = md_GenesisState.Fields().ByName("price_levels")
+	fd_GenesisState_applications = md_GenesisState.Fields().ByName("applications")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -159,6 +265,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PriceLevels) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.PriceLevels})
+		if !f(fd_GenesisState_price_levels, value) {
+			return
+		}
+	}
+	if len(x.Applications) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Applications})
+		if !f(fd_GenesisState_applications, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -180,6 +298,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.TotalBurnedAmount != nil
 	case "haqq.ethiq.v1.GenesisState.executed_applications":
 		return len(x.ExecutedApplications) != 0
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		return len(x.PriceLevels) != 0
+	case "haqq.ethiq.v1.GenesisState.applications":
+		return len(x.Applications) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -202,6 +324,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.TotalBurnedAmount = nil
 	case "haqq.ethiq.v1.GenesisState.executed_applications":
 		x.ExecutedApplications = nil
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		x.PriceLevels = nil
+	case "haqq.ethiq.v1.GenesisState.applications":
+		x.Applications = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -230,6 +356,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.ExecutedApplications}
 		return protoreflect.ValueOfList(listValue)
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		if len(x.PriceLevels) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.PriceLevels}
+		return protoreflect.ValueOfList(listValue)
+	case "haqq.ethiq.v1.GenesisState.applications":
+		if len(x.Applications) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Applications}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -258,6 +396,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.ExecutedApplications = *clv.list
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.PriceLevels = *clv.list
+	case "haqq.ethiq.v1.GenesisState.applications":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Applications = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -294,6 +440,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.ExecutedApplications}
 		return protoreflect.ValueOfList(value)
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		if x.PriceLevels == nil {
+			x.PriceLevels = []*PriceLevel{}
+		}
+		value := &_GenesisState_4_list{list: &x.PriceLevels}
+		return protoreflect.ValueOfList(value)
+	case "haqq.ethiq.v1.GenesisState.applications":
+		if x.Applications == nil {
+			x.Applications = []*BurnApplication{}
+		}
+		value := &_GenesisState_5_list{list: &x.Applications}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -316,6 +474,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "haqq.ethiq.v1.GenesisState.executed_applications":
 		list := []uint64{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "haqq.ethiq.v1.GenesisState.price_levels":
+		list := []*PriceLevel{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "haqq.ethiq.v1.GenesisState.applications":
+		list := []*BurnApplication{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.GenesisState"))
@@ -400,6 +564,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			}
 			n += 1 + runtime.Sov(uint64(l)) + l
 		}
+		if len(x.PriceLevels) > 0 {
+			for _, e := range x.PriceLevels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Applications) > 0 {
+			for _, e := range x.Applications {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -429,6 +605,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Applications) > 0 {
+			for iNdEx := len(x.Applications) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Applications[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PriceLevels) > 0 {
+			for iNdEx := len(x.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceLevels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.ExecutedApplications) > 0 {
 			var pksize2 int
 			for _, num := range x.ExecutedApplications {
@@ -674,6 +882,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				} else {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutedApplications", wireType)
 				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceLevels = append(x.PriceLevels, &PriceLevel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceLevels[len(x.PriceLevels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Applications = append(x.Applications, &BurnApplication{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Applications[len(x.Applications)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -734,6 +1010,10 @@ type GenesisState struct {
 	TotalBurnedAmount *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_burned_amount,json=totalBurnedAmount,proto3" json:"total_burned_amount,omitempty"`
 	// executed_applications defines the list of executed applications
 	ExecutedApplications []uint64 `protobuf:"varint,3,rep,packed,name=executed_applications,json=executedApplications,proto3" json:"executed_applications,omitempty"`
+	// price_levels defines the aISLM to aHAQQ burn price curve
+	PriceLevels []*PriceLevel `protobuf:"bytes,4,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels,omitempty"`
+	// applications defines the list of registered burn applications
+	Applications []*BurnApplication `protobuf:"bytes,5,rep,name=applications,proto3" json:"applications,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -777,6 +1057,20 @@ func (x *GenesisState) GetExecutedApplications() []uint64 {
 	return nil
 }
 
+func (x *GenesisState) GetPriceLevels() []*PriceLevel {
+	if x != nil {
+		return x.PriceLevels
+	}
+	return nil
+}
+
+func (x *GenesisState) GetApplications() []*BurnApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
 var File_haqq_ethiq_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_haqq_ethiq_v1_genesis_proto_rawDesc = []byte{
@@ -789,7 +1083,7 @@ var file_haqq_ethiq_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69,
 	0x71, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xda, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x15, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76,
 	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
@@ -802,18 +1096,27 @@ var file_haqq_ethiq_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x12, 0x3a, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x70,
 	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04,
 	0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x9f, 0x01,
-	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71,
-	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x74, 0x68, 0x69, 0x71, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x45,
-	0x58, 0xaa, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x45, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x19, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
-	0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74, 0x68, 0x69, 0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a,
+	0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68,
+	0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72,
+	0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x9f, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61,
+	0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61,
+	0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x74, 0x68, 0x69,
+	0x71, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71,
+	0x2e, 0x45, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71,
+	0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x48, 0x61, 0x71, 0x71,
+	0x5c, 0x45, 0x74, 0x68, 0x69, 0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74,
+	0x68, 0x69, 0x71, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -830,18 +1133,22 @@ func file_haqq_ethiq_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_haqq_ethiq_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_haqq_ethiq_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: haqq.ethiq.v1.GenesisState
-	(*Params)(nil),       // 1: haqq.ethiq.v1.Params
-	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
+	(*GenesisState)(nil),    // 0: haqq.ethiq.v1.GenesisState
+	(*Params)(nil),          // 1: haqq.ethiq.v1.Params
+	(*v1beta1.Coin)(nil),    // 2: cosmos.base.v1beta1.Coin
+	(*PriceLevel)(nil),      // 3: haqq.ethiq.v1.PriceLevel
+	(*BurnApplication)(nil), // 4: haqq.ethiq.v1.BurnApplication
 }
 var file_haqq_ethiq_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: haqq.ethiq.v1.GenesisState.params:type_name -> haqq.ethiq.v1.Params
 	2, // 1: haqq.ethiq.v1.GenesisState.total_burned_amount:type_name -> cosmos.base.v1beta1.Coin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: haqq.ethiq.v1.GenesisState.price_levels:type_name -> haqq.ethiq.v1.PriceLevel
+	4, // 3: haqq.ethiq.v1.GenesisState.applications:type_name -> haqq.ethiq.v1.BurnApplication
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_haqq_ethiq_v1_genesis_proto_init() }
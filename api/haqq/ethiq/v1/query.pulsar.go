@@ -1922,25 +1922,25 @@ func (x *fastReflection_QueryCalculateResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryCalculateForApplicationRequest                protoreflect.MessageDescriptor
-	fd_QueryCalculateForApplicationRequest_application_id protoreflect.FieldDescriptor
+	md_QueryCalculateBreakdownRequest             protoreflect.MessageDescriptor
+	fd_QueryCalculateBreakdownRequest_islm_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryCalculateForApplicationRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateForApplicationRequest")
-	fd_QueryCalculateForApplicationRequest_application_id = md_QueryCalculateForApplicationRequest.Fields().ByName("application_id")
+	md_QueryCalculateBreakdownRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateBreakdownRequest")
+	fd_QueryCalculateBreakdownRequest_islm_amount = md_QueryCalculateBreakdownRequest.Fields().ByName("islm_amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculateForApplicationRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCalculateBreakdownRequest)(nil)
 
-type fastReflection_QueryCalculateForApplicationRequest QueryCalculateForApplicationRequest
+type fastReflection_QueryCalculateBreakdownRequest QueryCalculateBreakdownRequest
 
-func (x *QueryCalculateForApplicationRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculateForApplicationRequest)(x)
+func (x *QueryCalculateBreakdownRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculateBreakdownRequest)(x)
 }
 
-func (x *QueryCalculateForApplicationRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCalculateBreakdownRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1952,43 +1952,43 @@ func (x *QueryCalculateForApplicationRequest) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculateForApplicationRequest_messageType fastReflection_QueryCalculateForApplicationRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculateForApplicationRequest_messageType{}
+var _fastReflection_QueryCalculateBreakdownRequest_messageType fastReflection_QueryCalculateBreakdownRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculateBreakdownRequest_messageType{}
 
-type fastReflection_QueryCalculateForApplicationRequest_messageType struct{}
+type fastReflection_QueryCalculateBreakdownRequest_messageType struct{}
 
-func (x fastReflection_QueryCalculateForApplicationRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculateForApplicationRequest)(nil)
+func (x fastReflection_QueryCalculateBreakdownRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculateBreakdownRequest)(nil)
 }
-func (x fastReflection_QueryCalculateForApplicationRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculateForApplicationRequest)
+func (x fastReflection_QueryCalculateBreakdownRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateBreakdownRequest)
 }
-func (x fastReflection_QueryCalculateForApplicationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculateForApplicationRequest
+func (x fastReflection_QueryCalculateBreakdownRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateBreakdownRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculateForApplicationRequest
+func (x *fastReflection_QueryCalculateBreakdownRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateBreakdownRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculateForApplicationRequest_messageType
+func (x *fastReflection_QueryCalculateBreakdownRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculateBreakdownRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculateForApplicationRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculateForApplicationRequest)
+func (x *fastReflection_QueryCalculateBreakdownRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateBreakdownRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculateForApplicationRequest)(x)
+func (x *fastReflection_QueryCalculateBreakdownRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculateBreakdownRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1996,10 +1996,10 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ApplicationId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ApplicationId)
-		if !f(fd_QueryCalculateForApplicationRequest_application_id, value) {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IslmAmount != "" {
+		value := protoreflect.ValueOfString(x.IslmAmount)
+		if !f(fd_QueryCalculateBreakdownRequest_islm_amount, value) {
 			return
 		}
 	}
@@ -2016,15 +2016,15 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		return x.ApplicationId != uint64(0)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		return x.IslmAmount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2034,15 +2034,15 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		x.ApplicationId = uint64(0)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		x.IslmAmount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2052,16 +2052,16 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		value := x.ApplicationId
-		return protoreflect.ValueOfUint64(value)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		value := x.IslmAmount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2075,15 +2075,15 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		x.ApplicationId = value.Uint()
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		x.IslmAmount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2097,40 +2097,40 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		panic(fmt.Errorf("field application_id of message haqq.ethiq.v1.QueryCalculateForApplicationRequest is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		panic(fmt.Errorf("field islm_amount of message haqq.ethiq.v1.QueryCalculateBreakdownRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculateForApplicationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "haqq.ethiq.v1.QueryCalculateBreakdownRequest.islm_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculateForApplicationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCalculateBreakdownRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateForApplicationRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateBreakdownRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2138,7 +2138,7 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculateForApplicationRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCalculateBreakdownRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2149,7 +2149,7 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCalculateBreakdownRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2161,7 +2161,7 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculateForApplicationRequest) IsValid() bool {
+func (x *fastReflection_QueryCalculateBreakdownRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2171,9 +2171,9 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCalculateBreakdownRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		x := input.Message.Interface().(*QueryCalculateBreakdownRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2185,8 +2185,9 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if x.ApplicationId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ApplicationId))
+		l = len(x.IslmAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2198,7 +2199,7 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		x := input.Message.Interface().(*QueryCalculateBreakdownRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2217,10 +2218,12 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ApplicationId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApplicationId))
+		if len(x.IslmAmount) > 0 {
+			i -= len(x.IslmAmount)
+			copy(dAtA[i:], x.IslmAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IslmAmount)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2233,7 +2236,7 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		x := input.Message.Interface().(*QueryCalculateBreakdownRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2265,17 +2268,17 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateBreakdownRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
 				}
-				x.ApplicationId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2285,11 +2288,24 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ApplicationId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IslmAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2325,34 +2341,83 @@ func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *pro
 	}
 }
 
+var _ protoreflect.List = (*_QueryCalculateBreakdownResponse_1_list)(nil)
+
+type _QueryCalculateBreakdownResponse_1_list struct {
+	list *[]*PriceLevelBreakdown
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceLevelBreakdown)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PriceLevelBreakdown)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceLevelBreakdown)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceLevelBreakdown)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCalculateBreakdownResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCalculateForApplicationResponse                       protoreflect.MessageDescriptor
-	fd_QueryCalculateForApplicationResponse_estimated_haqq_amount protoreflect.FieldDescriptor
-	fd_QueryCalculateForApplicationResponse_supply_before         protoreflect.FieldDescriptor
-	fd_QueryCalculateForApplicationResponse_supply_after          protoreflect.FieldDescriptor
-	fd_QueryCalculateForApplicationResponse_average_price         protoreflect.FieldDescriptor
-	fd_QueryCalculateForApplicationResponse_to_address            protoreflect.FieldDescriptor
+	md_QueryCalculateBreakdownResponse                       protoreflect.MessageDescriptor
+	fd_QueryCalculateBreakdownResponse_levels                protoreflect.FieldDescriptor
+	fd_QueryCalculateBreakdownResponse_estimated_haqq_amount protoreflect.FieldDescriptor
+	fd_QueryCalculateBreakdownResponse_total_burned_after    protoreflect.FieldDescriptor
+	fd_QueryCalculateBreakdownResponse_remaining_capacity    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryCalculateForApplicationResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateForApplicationResponse")
-	fd_QueryCalculateForApplicationResponse_estimated_haqq_amount = md_QueryCalculateForApplicationResponse.Fields().ByName("estimated_haqq_amount")
-	fd_QueryCalculateForApplicationResponse_supply_before = md_QueryCalculateForApplicationResponse.Fields().ByName("supply_before")
-	fd_QueryCalculateForApplicationResponse_supply_after = md_QueryCalculateForApplicationResponse.Fields().ByName("supply_after")
-	fd_QueryCalculateForApplicationResponse_average_price = md_QueryCalculateForApplicationResponse.Fields().ByName("average_price")
-	fd_QueryCalculateForApplicationResponse_to_address = md_QueryCalculateForApplicationResponse.Fields().ByName("to_address")
+	md_QueryCalculateBreakdownResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateBreakdownResponse")
+	fd_QueryCalculateBreakdownResponse_levels = md_QueryCalculateBreakdownResponse.Fields().ByName("levels")
+	fd_QueryCalculateBreakdownResponse_estimated_haqq_amount = md_QueryCalculateBreakdownResponse.Fields().ByName("estimated_haqq_amount")
+	fd_QueryCalculateBreakdownResponse_total_burned_after = md_QueryCalculateBreakdownResponse.Fields().ByName("total_burned_after")
+	fd_QueryCalculateBreakdownResponse_remaining_capacity = md_QueryCalculateBreakdownResponse.Fields().ByName("remaining_capacity")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCalculateForApplicationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCalculateBreakdownResponse)(nil)
 
-type fastReflection_QueryCalculateForApplicationResponse QueryCalculateForApplicationResponse
+type fastReflection_QueryCalculateBreakdownResponse QueryCalculateBreakdownResponse
 
-func (x *QueryCalculateForApplicationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCalculateForApplicationResponse)(x)
+func (x *QueryCalculateBreakdownResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculateBreakdownResponse)(x)
 }
 
-func (x *QueryCalculateForApplicationResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCalculateBreakdownResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2364,43 +2429,43 @@ func (x *QueryCalculateForApplicationResponse) slowProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCalculateForApplicationResponse_messageType fastReflection_QueryCalculateForApplicationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCalculateForApplicationResponse_messageType{}
+var _fastReflection_QueryCalculateBreakdownResponse_messageType fastReflection_QueryCalculateBreakdownResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculateBreakdownResponse_messageType{}
 
-type fastReflection_QueryCalculateForApplicationResponse_messageType struct{}
+type fastReflection_QueryCalculateBreakdownResponse_messageType struct{}
 
-func (x fastReflection_QueryCalculateForApplicationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCalculateForApplicationResponse)(nil)
+func (x fastReflection_QueryCalculateBreakdownResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculateBreakdownResponse)(nil)
 }
-func (x fastReflection_QueryCalculateForApplicationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculateForApplicationResponse)
+func (x fastReflection_QueryCalculateBreakdownResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateBreakdownResponse)
 }
-func (x fastReflection_QueryCalculateForApplicationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculateForApplicationResponse
+func (x fastReflection_QueryCalculateBreakdownResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateBreakdownResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCalculateForApplicationResponse
+func (x *fastReflection_QueryCalculateBreakdownResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateBreakdownResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCalculateForApplicationResponse_messageType
+func (x *fastReflection_QueryCalculateBreakdownResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculateBreakdownResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCalculateForApplicationResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCalculateForApplicationResponse)
+func (x *fastReflection_QueryCalculateBreakdownResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateBreakdownResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCalculateForApplicationResponse)(x)
+func (x *fastReflection_QueryCalculateBreakdownResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculateBreakdownResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2408,34 +2473,28 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Interface() protor
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.EstimatedHaqqAmount != "" {
-		value := protoreflect.ValueOfString(x.EstimatedHaqqAmount)
-		if !f(fd_QueryCalculateForApplicationResponse_estimated_haqq_amount, value) {
-			return
-		}
-	}
-	if x.SupplyBefore != "" {
-		value := protoreflect.ValueOfString(x.SupplyBefore)
-		if !f(fd_QueryCalculateForApplicationResponse_supply_before, value) {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Levels) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCalculateBreakdownResponse_1_list{list: &x.Levels})
+		if !f(fd_QueryCalculateBreakdownResponse_levels, value) {
 			return
 		}
 	}
-	if x.SupplyAfter != "" {
-		value := protoreflect.ValueOfString(x.SupplyAfter)
-		if !f(fd_QueryCalculateForApplicationResponse_supply_after, value) {
+	if x.EstimatedHaqqAmount != "" {
+		value := protoreflect.ValueOfString(x.EstimatedHaqqAmount)
+		if !f(fd_QueryCalculateBreakdownResponse_estimated_haqq_amount, value) {
 			return
 		}
 	}
-	if x.AveragePrice != "" {
-		value := protoreflect.ValueOfString(x.AveragePrice)
-		if !f(fd_QueryCalculateForApplicationResponse_average_price, value) {
+	if x.TotalBurnedAfter != "" {
+		value := protoreflect.ValueOfString(x.TotalBurnedAfter)
+		if !f(fd_QueryCalculateBreakdownResponse_total_burned_after, value) {
 			return
 		}
 	}
-	if x.ToAddress != "" {
-		value := protoreflect.ValueOfString(x.ToAddress)
-		if !f(fd_QueryCalculateForApplicationResponse_to_address, value) {
+	if x.RemainingCapacity != "" {
+		value := protoreflect.ValueOfString(x.RemainingCapacity)
+		if !f(fd_QueryCalculateBreakdownResponse_remaining_capacity, value) {
 			return
 		}
 	}
@@ -2452,23 +2511,21 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Range(f func(proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		return len(x.Levels) != 0
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
 		return x.EstimatedHaqqAmount != ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		return x.SupplyBefore != ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
-		return x.SupplyAfter != ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
-		return x.AveragePrice != ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
-		return x.ToAddress != ""
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
+		return x.TotalBurnedAfter != ""
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
+		return x.RemainingCapacity != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2478,23 +2535,21 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Has(fd protoreflec
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		x.Levels = nil
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
 		x.EstimatedHaqqAmount = ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		x.SupplyBefore = ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
-		x.SupplyAfter = ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
-		x.AveragePrice = ""
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
-		x.ToAddress = ""
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
+		x.TotalBurnedAfter = ""
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
+		x.RemainingCapacity = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2504,28 +2559,28 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Clear(fd protorefl
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		if len(x.Levels) == 0 {
+			return protoreflect.ValueOfList(&_QueryCalculateBreakdownResponse_1_list{})
+		}
+		listValue := &_QueryCalculateBreakdownResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(listValue)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
 		value := x.EstimatedHaqqAmount
 		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		value := x.SupplyBefore
-		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
-		value := x.SupplyAfter
-		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
-		value := x.AveragePrice
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
+		value := x.TotalBurnedAfter
 		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
-		value := x.ToAddress
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
+		value := x.RemainingCapacity
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2539,23 +2594,23 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Get(descriptor pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		lv := value.List()
+		clv := lv.(*_QueryCalculateBreakdownResponse_1_list)
+		x.Levels = *clv.list
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
 		x.EstimatedHaqqAmount = value.Interface().(string)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		x.SupplyBefore = value.Interface().(string)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
-		x.SupplyAfter = value.Interface().(string)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
-		x.AveragePrice = value.Interface().(string)
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
-		x.ToAddress = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
+		x.TotalBurnedAfter = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
+		x.RemainingCapacity = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2569,56 +2624,57 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) Set(fd protoreflec
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
-		panic(fmt.Errorf("field estimated_haqq_amount of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		panic(fmt.Errorf("field supply_before of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
-		panic(fmt.Errorf("field supply_after of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
-		panic(fmt.Errorf("field average_price of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
-		panic(fmt.Errorf("field to_address of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		if x.Levels == nil {
+			x.Levels = []*PriceLevelBreakdown{}
+		}
+		value := &_QueryCalculateBreakdownResponse_1_list{list: &x.Levels}
+		return protoreflect.ValueOfList(value)
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
+		panic(fmt.Errorf("field estimated_haqq_amount of message haqq.ethiq.v1.QueryCalculateBreakdownResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
+		panic(fmt.Errorf("field total_burned_after of message haqq.ethiq.v1.QueryCalculateBreakdownResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
+		panic(fmt.Errorf("field remaining_capacity of message haqq.ethiq.v1.QueryCalculateBreakdownResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCalculateForApplicationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateBreakdownResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
-		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
-		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels":
+		list := []*PriceLevelBreakdown{}
+		return protoreflect.ValueOfList(&_QueryCalculateBreakdownResponse_1_list{list: &list})
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.estimated_haqq_amount":
 		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.total_burned_after":
 		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+	case "haqq.ethiq.v1.QueryCalculateBreakdownResponse.remaining_capacity":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateBreakdownResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateBreakdownResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCalculateForApplicationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCalculateBreakdownResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateForApplicationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateBreakdownResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2626,7 +2682,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) WhichOneof(d proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCalculateForApplicationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCalculateBreakdownResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2637,7 +2693,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) GetUnknown() proto
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCalculateForApplicationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCalculateBreakdownResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2649,7 +2705,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) SetUnknown(fields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCalculateForApplicationResponse) IsValid() bool {
+func (x *fastReflection_QueryCalculateBreakdownResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2659,9 +2715,9 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCalculateBreakdownResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		x := input.Message.Interface().(*QueryCalculateBreakdownResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2673,23 +2729,21 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 		var n int
 		var l int
 		_ = l
-		l = len(x.EstimatedHaqqAmount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.SupplyBefore)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Levels) > 0 {
+			for _, e := range x.Levels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.SupplyAfter)
+		l = len(x.EstimatedHaqqAmount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AveragePrice)
+		l = len(x.TotalBurnedAfter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ToAddress)
+		l = len(x.RemainingCapacity)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2703,7 +2757,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		x := input.Message.Interface().(*QueryCalculateBreakdownResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2722,40 +2776,42 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ToAddress) > 0 {
-			i -= len(x.ToAddress)
-			copy(dAtA[i:], x.ToAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.AveragePrice) > 0 {
-			i -= len(x.AveragePrice)
-			copy(dAtA[i:], x.AveragePrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AveragePrice)))
+		if len(x.RemainingCapacity) > 0 {
+			i -= len(x.RemainingCapacity)
+			copy(dAtA[i:], x.RemainingCapacity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemainingCapacity)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.SupplyAfter) > 0 {
-			i -= len(x.SupplyAfter)
-			copy(dAtA[i:], x.SupplyAfter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyAfter)))
+		if len(x.TotalBurnedAfter) > 0 {
+			i -= len(x.TotalBurnedAfter)
+			copy(dAtA[i:], x.TotalBurnedAfter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBurnedAfter)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.SupplyBefore) > 0 {
-			i -= len(x.SupplyBefore)
-			copy(dAtA[i:], x.SupplyBefore)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyBefore)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.EstimatedHaqqAmount) > 0 {
 			i -= len(x.EstimatedHaqqAmount)
 			copy(dAtA[i:], x.EstimatedHaqqAmount)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EstimatedHaqqAmount)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Levels) > 0 {
+			for iNdEx := len(x.Levels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Levels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2768,7 +2824,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		x := input.Message.Interface().(*QueryCalculateBreakdownResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2800,17 +2856,17 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateBreakdownResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EstimatedHaqqAmount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2820,27 +2876,29 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EstimatedHaqqAmount = string(dAtA[iNdEx:postIndex])
+				x.Levels = append(x.Levels, &PriceLevelBreakdown{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Levels[len(x.Levels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyBefore", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EstimatedHaqqAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2868,11 +2926,11 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SupplyBefore = string(dAtA[iNdEx:postIndex])
+				x.EstimatedHaqqAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyAfter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedAfter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2900,43 +2958,11 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SupplyAfter = string(dAtA[iNdEx:postIndex])
+				x.TotalBurnedAfter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AveragePrice = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2964,7 +2990,7 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ToAddress = string(dAtA[iNdEx:postIndex])
+				x.RemainingCapacity = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3002,25 +3028,25 @@ func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *pr
 }
 
 var (
-	md_QueryGetApplicationsRequest            protoreflect.MessageDescriptor
-	fd_QueryGetApplicationsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryCalculateForApplicationRequest                protoreflect.MessageDescriptor
+	fd_QueryCalculateForApplicationRequest_application_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryGetApplicationsRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetApplicationsRequest")
-	fd_QueryGetApplicationsRequest_pagination = md_QueryGetApplicationsRequest.Fields().ByName("pagination")
+	md_QueryCalculateForApplicationRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateForApplicationRequest")
+	fd_QueryCalculateForApplicationRequest_application_id = md_QueryCalculateForApplicationRequest.Fields().ByName("application_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetApplicationsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCalculateForApplicationRequest)(nil)
 
-type fastReflection_QueryGetApplicationsRequest QueryGetApplicationsRequest
+type fastReflection_QueryCalculateForApplicationRequest QueryCalculateForApplicationRequest
 
-func (x *QueryGetApplicationsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetApplicationsRequest)(x)
+func (x *QueryCalculateForApplicationRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculateForApplicationRequest)(x)
 }
 
-func (x *QueryGetApplicationsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCalculateForApplicationRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3032,43 +3058,43 @@ func (x *QueryGetApplicationsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetApplicationsRequest_messageType fastReflection_QueryGetApplicationsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetApplicationsRequest_messageType{}
+var _fastReflection_QueryCalculateForApplicationRequest_messageType fastReflection_QueryCalculateForApplicationRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculateForApplicationRequest_messageType{}
 
-type fastReflection_QueryGetApplicationsRequest_messageType struct{}
+type fastReflection_QueryCalculateForApplicationRequest_messageType struct{}
 
-func (x fastReflection_QueryGetApplicationsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetApplicationsRequest)(nil)
+func (x fastReflection_QueryCalculateForApplicationRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculateForApplicationRequest)(nil)
 }
-func (x fastReflection_QueryGetApplicationsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetApplicationsRequest)
+func (x fastReflection_QueryCalculateForApplicationRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateForApplicationRequest)
 }
-func (x fastReflection_QueryGetApplicationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetApplicationsRequest
+func (x fastReflection_QueryCalculateForApplicationRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateForApplicationRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetApplicationsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetApplicationsRequest
+func (x *fastReflection_QueryCalculateForApplicationRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateForApplicationRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetApplicationsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetApplicationsRequest_messageType
+func (x *fastReflection_QueryCalculateForApplicationRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculateForApplicationRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetApplicationsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetApplicationsRequest)
+func (x *fastReflection_QueryCalculateForApplicationRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateForApplicationRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetApplicationsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetApplicationsRequest)(x)
+func (x *fastReflection_QueryCalculateForApplicationRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculateForApplicationRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3076,10 +3102,10 @@ func (x *fastReflection_QueryGetApplicationsRequest) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetApplicationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetApplicationsRequest_pagination, value) {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ApplicationId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ApplicationId)
+		if !f(fd_QueryCalculateForApplicationRequest_application_id, value) {
 			return
 		}
 	}
@@ -3096,15 +3122,15 @@ func (x *fastReflection_QueryGetApplicationsRequest) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetApplicationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
-		return x.Pagination != nil
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		return x.ApplicationId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3114,15 +3140,15 @@ func (x *fastReflection_QueryGetApplicationsRequest) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
-		x.Pagination = nil
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		x.ApplicationId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3132,16 +3158,16 @@ func (x *fastReflection_QueryGetApplicationsRequest) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetApplicationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		value := x.ApplicationId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3155,15 +3181,15 @@ func (x *fastReflection_QueryGetApplicationsRequest) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		x.ApplicationId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3177,44 +3203,2153 @@ func (x *fastReflection_QueryGetApplicationsRequest) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCalculateForApplicationRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		panic(fmt.Errorf("field application_id of message haqq.ethiq.v1.QueryCalculateForApplicationRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculateForApplicationRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationRequest.application_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculateForApplicationRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateForApplicationRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculateForApplicationRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculateForApplicationRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculateForApplicationRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculateForApplicationRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ApplicationId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApplicationId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ApplicationId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApplicationId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculateForApplicationRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+				}
+				x.ApplicationId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ApplicationId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCalculateForApplicationResponse                       protoreflect.MessageDescriptor
+	fd_QueryCalculateForApplicationResponse_estimated_haqq_amount protoreflect.FieldDescriptor
+	fd_QueryCalculateForApplicationResponse_supply_before         protoreflect.FieldDescriptor
+	fd_QueryCalculateForApplicationResponse_supply_after          protoreflect.FieldDescriptor
+	fd_QueryCalculateForApplicationResponse_average_price         protoreflect.FieldDescriptor
+	fd_QueryCalculateForApplicationResponse_to_address            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_ethiq_v1_query_proto_init()
+	md_QueryCalculateForApplicationResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryCalculateForApplicationResponse")
+	fd_QueryCalculateForApplicationResponse_estimated_haqq_amount = md_QueryCalculateForApplicationResponse.Fields().ByName("estimated_haqq_amount")
+	fd_QueryCalculateForApplicationResponse_supply_before = md_QueryCalculateForApplicationResponse.Fields().ByName("supply_before")
+	fd_QueryCalculateForApplicationResponse_supply_after = md_QueryCalculateForApplicationResponse.Fields().ByName("supply_after")
+	fd_QueryCalculateForApplicationResponse_average_price = md_QueryCalculateForApplicationResponse.Fields().ByName("average_price")
+	fd_QueryCalculateForApplicationResponse_to_address = md_QueryCalculateForApplicationResponse.Fields().ByName("to_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCalculateForApplicationResponse)(nil)
+
+type fastReflection_QueryCalculateForApplicationResponse QueryCalculateForApplicationResponse
+
+func (x *QueryCalculateForApplicationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCalculateForApplicationResponse)(x)
+}
+
+func (x *QueryCalculateForApplicationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCalculateForApplicationResponse_messageType fastReflection_QueryCalculateForApplicationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCalculateForApplicationResponse_messageType{}
+
+type fastReflection_QueryCalculateForApplicationResponse_messageType struct{}
+
+func (x fastReflection_QueryCalculateForApplicationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCalculateForApplicationResponse)(nil)
+}
+func (x fastReflection_QueryCalculateForApplicationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateForApplicationResponse)
+}
+func (x fastReflection_QueryCalculateForApplicationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateForApplicationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCalculateForApplicationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCalculateForApplicationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCalculateForApplicationResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCalculateForApplicationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCalculateForApplicationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EstimatedHaqqAmount != "" {
+		value := protoreflect.ValueOfString(x.EstimatedHaqqAmount)
+		if !f(fd_QueryCalculateForApplicationResponse_estimated_haqq_amount, value) {
+			return
+		}
+	}
+	if x.SupplyBefore != "" {
+		value := protoreflect.ValueOfString(x.SupplyBefore)
+		if !f(fd_QueryCalculateForApplicationResponse_supply_before, value) {
+			return
+		}
+	}
+	if x.SupplyAfter != "" {
+		value := protoreflect.ValueOfString(x.SupplyAfter)
+		if !f(fd_QueryCalculateForApplicationResponse_supply_after, value) {
+			return
+		}
+	}
+	if x.AveragePrice != "" {
+		value := protoreflect.ValueOfString(x.AveragePrice)
+		if !f(fd_QueryCalculateForApplicationResponse_average_price, value) {
+			return
+		}
+	}
+	if x.ToAddress != "" {
+		value := protoreflect.ValueOfString(x.ToAddress)
+		if !f(fd_QueryCalculateForApplicationResponse_to_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		return x.EstimatedHaqqAmount != ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		return x.SupplyBefore != ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		return x.SupplyAfter != ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		return x.AveragePrice != ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		return x.ToAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		x.EstimatedHaqqAmount = ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		x.SupplyBefore = ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		x.SupplyAfter = ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		x.AveragePrice = ""
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		x.ToAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		value := x.EstimatedHaqqAmount
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		value := x.SupplyBefore
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		value := x.SupplyAfter
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		value := x.AveragePrice
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		value := x.ToAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		x.EstimatedHaqqAmount = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		x.SupplyBefore = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		x.SupplyAfter = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		x.AveragePrice = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		x.ToAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculateForApplicationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		panic(fmt.Errorf("field estimated_haqq_amount of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		panic(fmt.Errorf("field supply_before of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		panic(fmt.Errorf("field supply_after of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		panic(fmt.Errorf("field average_price of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		panic(fmt.Errorf("field to_address of message haqq.ethiq.v1.QueryCalculateForApplicationResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCalculateForApplicationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.estimated_haqq_amount":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_before":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.supply_after":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.average_price":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.QueryCalculateForApplicationResponse.to_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryCalculateForApplicationResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryCalculateForApplicationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCalculateForApplicationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryCalculateForApplicationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCalculateForApplicationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCalculateForApplicationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCalculateForApplicationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCalculateForApplicationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.EstimatedHaqqAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SupplyBefore)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SupplyAfter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AveragePrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ToAddress) > 0 {
+			i -= len(x.ToAddress)
+			copy(dAtA[i:], x.ToAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.AveragePrice) > 0 {
+			i -= len(x.AveragePrice)
+			copy(dAtA[i:], x.AveragePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AveragePrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SupplyAfter) > 0 {
+			i -= len(x.SupplyAfter)
+			copy(dAtA[i:], x.SupplyAfter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyAfter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SupplyBefore) > 0 {
+			i -= len(x.SupplyBefore)
+			copy(dAtA[i:], x.SupplyBefore)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyBefore)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.EstimatedHaqqAmount) > 0 {
+			i -= len(x.EstimatedHaqqAmount)
+			copy(dAtA[i:], x.EstimatedHaqqAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EstimatedHaqqAmount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCalculateForApplicationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCalculateForApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EstimatedHaqqAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EstimatedHaqqAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyBefore", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplyBefore = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyAfter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplyAfter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AveragePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetApplicationsRequest            protoreflect.MessageDescriptor
+	fd_QueryGetApplicationsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_ethiq_v1_query_proto_init()
+	md_QueryGetApplicationsRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetApplicationsRequest")
+	fd_QueryGetApplicationsRequest_pagination = md_QueryGetApplicationsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetApplicationsRequest)(nil)
+
+type fastReflection_QueryGetApplicationsRequest QueryGetApplicationsRequest
+
+func (x *QueryGetApplicationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetApplicationsRequest)(x)
+}
+
+func (x *QueryGetApplicationsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetApplicationsRequest_messageType fastReflection_QueryGetApplicationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetApplicationsRequest_messageType{}
+
+type fastReflection_QueryGetApplicationsRequest_messageType struct{}
+
+func (x fastReflection_QueryGetApplicationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetApplicationsRequest)(nil)
+}
+func (x fastReflection_QueryGetApplicationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetApplicationsRequest)
+}
+func (x fastReflection_QueryGetApplicationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetApplicationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetApplicationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetApplicationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetApplicationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetApplicationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetApplicationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetApplicationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetApplicationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetApplicationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetApplicationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetApplicationsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetApplicationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetApplicationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetApplicationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetApplicationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetApplicationsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetApplicationsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetApplicationsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryGetApplicationsResponse_1_list)(nil)
+
+type _QueryGetApplicationsResponse_1_list struct {
+	list *[]*BurnApplication
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BurnApplication)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BurnApplication)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryGetApplicationsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryGetApplicationsResponse              protoreflect.MessageDescriptor
+	fd_QueryGetApplicationsResponse_applications protoreflect.FieldDescriptor
+	fd_QueryGetApplicationsResponse_pagination   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_ethiq_v1_query_proto_init()
+	md_QueryGetApplicationsResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetApplicationsResponse")
+	fd_QueryGetApplicationsResponse_applications = md_QueryGetApplicationsResponse.Fields().ByName("applications")
+	fd_QueryGetApplicationsResponse_pagination = md_QueryGetApplicationsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetApplicationsResponse)(nil)
+
+type fastReflection_QueryGetApplicationsResponse QueryGetApplicationsResponse
+
+func (x *QueryGetApplicationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetApplicationsResponse)(x)
+}
+
+func (x *QueryGetApplicationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetApplicationsResponse_messageType fastReflection_QueryGetApplicationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetApplicationsResponse_messageType{}
+
+type fastReflection_QueryGetApplicationsResponse_messageType struct{}
+
+func (x fastReflection_QueryGetApplicationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetApplicationsResponse)(nil)
+}
+func (x fastReflection_QueryGetApplicationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetApplicationsResponse)
+}
+func (x fastReflection_QueryGetApplicationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetApplicationsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetApplicationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetApplicationsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetApplicationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetApplicationsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetApplicationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetApplicationsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetApplicationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetApplicationsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetApplicationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Applications) != 0 {
+		value := protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{list: &x.Applications})
+		if !f(fd_QueryGetApplicationsResponse_applications, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetApplicationsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetApplicationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		return len(x.Applications) != 0
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		x.Applications = nil
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetApplicationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		if len(x.Applications) == 0 {
+			return protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{})
+		}
+		listValue := &_QueryGetApplicationsResponse_1_list{list: &x.Applications}
+		return protoreflect.ValueOfList(listValue)
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		lv := value.List()
+		clv := lv.(*_QueryGetApplicationsResponse_1_list)
+		x.Applications = *clv.list
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		if x.Applications == nil {
+			x.Applications = []*BurnApplication{}
+		}
+		value := &_QueryGetApplicationsResponse_1_list{list: &x.Applications}
+		return protoreflect.ValueOfList(value)
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGetApplicationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+		list := []*BurnApplication{}
+		return protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{list: &list})
+	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGetApplicationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetApplicationsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGetApplicationsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetApplicationsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGetApplicationsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Applications) > 0 {
+			for _, e := range x.Applications {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Applications) > 0 {
+			for iNdEx := len(x.Applications) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Applications[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Applications = append(x.Applications, &BurnApplication{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Applications[len(x.Applications)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGetSendersApplicationsRequest                protoreflect.MessageDescriptor
+	fd_QueryGetSendersApplicationsRequest_sender_address protoreflect.FieldDescriptor
+	fd_QueryGetSendersApplicationsRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_ethiq_v1_query_proto_init()
+	md_QueryGetSendersApplicationsRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetSendersApplicationsRequest")
+	fd_QueryGetSendersApplicationsRequest_sender_address = md_QueryGetSendersApplicationsRequest.Fields().ByName("sender_address")
+	fd_QueryGetSendersApplicationsRequest_pagination = md_QueryGetSendersApplicationsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGetSendersApplicationsRequest)(nil)
+
+type fastReflection_QueryGetSendersApplicationsRequest QueryGetSendersApplicationsRequest
+
+func (x *QueryGetSendersApplicationsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetSendersApplicationsRequest)(x)
+}
+
+func (x *QueryGetSendersApplicationsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGetSendersApplicationsRequest_messageType fastReflection_QueryGetSendersApplicationsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetSendersApplicationsRequest_messageType{}
+
+type fastReflection_QueryGetSendersApplicationsRequest_messageType struct{}
+
+func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetSendersApplicationsRequest)(nil)
+}
+func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetSendersApplicationsRequest)
+}
+func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetSendersApplicationsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetSendersApplicationsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetSendersApplicationsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGetSendersApplicationsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetSendersApplicationsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderAddress != "" {
+		value := protoreflect.ValueOfString(x.SenderAddress)
+		if !f(fd_QueryGetSendersApplicationsRequest_sender_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryGetSendersApplicationsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		return x.SenderAddress != ""
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		x.SenderAddress = ""
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		value := x.SenderAddress
+		return protoreflect.ValueOfString(value)
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		x.SenderAddress = value.Interface().(string)
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGetSendersApplicationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		panic(fmt.Errorf("field sender_address of message haqq.ethiq.v1.QueryGetSendersApplicationsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+		}
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetApplicationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsRequest.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
+		return protoreflect.ValueOfString("")
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
 		m := new(v1beta11.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetApplicationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetApplicationsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetSendersApplicationsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3222,7 +5357,7 @@ func (x *fastReflection_QueryGetApplicationsRequest) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetApplicationsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3233,7 +5368,7 @@ func (x *fastReflection_QueryGetApplicationsRequest) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3245,7 +5380,7 @@ func (x *fastReflection_QueryGetApplicationsRequest) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetApplicationsRequest) IsValid() bool {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3255,9 +5390,9 @@ func (x *fastReflection_QueryGetApplicationsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3269,6 +5404,10 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
+		l = len(x.SenderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -3283,7 +5422,7 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3314,6 +5453,13 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SenderAddress) > 0 {
+			i -= len(x.SenderAddress)
+			copy(dAtA[i:], x.SenderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -3327,7 +5473,7 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetApplicationsRequest)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3359,13 +5505,45 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SenderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
@@ -3436,80 +5614,80 @@ func (x *fastReflection_QueryGetApplicationsRequest) ProtoMethods() *protoiface.
 	}
 }
 
-var _ protoreflect.List = (*_QueryGetApplicationsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryGetSendersApplicationsResponse_1_list)(nil)
 
-type _QueryGetApplicationsResponse_1_list struct {
+type _QueryGetSendersApplicationsResponse_1_list struct {
 	list *[]*BurnApplication
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) Len() int {
+func (x *_QueryGetSendersApplicationsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryGetSendersApplicationsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryGetSendersApplicationsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryGetSendersApplicationsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryGetSendersApplicationsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(BurnApplication)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) Truncate(n int) {
+func (x *_QueryGetSendersApplicationsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryGetSendersApplicationsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(BurnApplication)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetApplicationsResponse_1_list) IsValid() bool {
+func (x *_QueryGetSendersApplicationsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGetApplicationsResponse              protoreflect.MessageDescriptor
-	fd_QueryGetApplicationsResponse_applications protoreflect.FieldDescriptor
-	fd_QueryGetApplicationsResponse_pagination   protoreflect.FieldDescriptor
+	md_QueryGetSendersApplicationsResponse              protoreflect.MessageDescriptor
+	fd_QueryGetSendersApplicationsResponse_applications protoreflect.FieldDescriptor
+	fd_QueryGetSendersApplicationsResponse_pagination   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryGetApplicationsResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetApplicationsResponse")
-	fd_QueryGetApplicationsResponse_applications = md_QueryGetApplicationsResponse.Fields().ByName("applications")
-	fd_QueryGetApplicationsResponse_pagination = md_QueryGetApplicationsResponse.Fields().ByName("pagination")
+	md_QueryGetSendersApplicationsResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetSendersApplicationsResponse")
+	fd_QueryGetSendersApplicationsResponse_applications = md_QueryGetSendersApplicationsResponse.Fields().ByName("applications")
+	fd_QueryGetSendersApplicationsResponse_pagination = md_QueryGetSendersApplicationsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetApplicationsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGetSendersApplicationsResponse)(nil)
 
-type fastReflection_QueryGetApplicationsResponse QueryGetApplicationsResponse
+type fastReflection_QueryGetSendersApplicationsResponse QueryGetSendersApplicationsResponse
 
-func (x *QueryGetApplicationsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetApplicationsResponse)(x)
+func (x *QueryGetSendersApplicationsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGetSendersApplicationsResponse)(x)
 }
 
-func (x *QueryGetApplicationsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[7]
+func (x *QueryGetSendersApplicationsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3520,43 +5698,43 @@ func (x *QueryGetApplicationsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetApplicationsResponse_messageType fastReflection_QueryGetApplicationsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetApplicationsResponse_messageType{}
+var _fastReflection_QueryGetSendersApplicationsResponse_messageType fastReflection_QueryGetSendersApplicationsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGetSendersApplicationsResponse_messageType{}
 
-type fastReflection_QueryGetApplicationsResponse_messageType struct{}
+type fastReflection_QueryGetSendersApplicationsResponse_messageType struct{}
 
-func (x fastReflection_QueryGetApplicationsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetApplicationsResponse)(nil)
+func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGetSendersApplicationsResponse)(nil)
 }
-func (x fastReflection_QueryGetApplicationsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetApplicationsResponse)
+func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGetSendersApplicationsResponse)
 }
-func (x fastReflection_QueryGetApplicationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetApplicationsResponse
+func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetSendersApplicationsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetApplicationsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetApplicationsResponse
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGetSendersApplicationsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetApplicationsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetApplicationsResponse_messageType
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGetSendersApplicationsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetApplicationsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetApplicationsResponse)
+func (x *fastReflection_QueryGetSendersApplicationsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGetSendersApplicationsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetApplicationsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetApplicationsResponse)(x)
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGetSendersApplicationsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3564,16 +5742,16 @@ func (x *fastReflection_QueryGetApplicationsResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetApplicationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Applications) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{list: &x.Applications})
-		if !f(fd_QueryGetApplicationsResponse_applications, value) {
+		value := protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications})
+		if !f(fd_QueryGetSendersApplicationsResponse_applications, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetApplicationsResponse_pagination, value) {
+		if !f(fd_QueryGetSendersApplicationsResponse_pagination, value) {
 			return
 		}
 	}
@@ -3590,17 +5768,17 @@ func (x *fastReflection_QueryGetApplicationsResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetApplicationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		return len(x.Applications) != 0
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3610,17 +5788,17 @@ func (x *fastReflection_QueryGetApplicationsResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		x.Applications = nil
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3630,22 +5808,22 @@ func (x *fastReflection_QueryGetApplicationsResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetApplicationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		if len(x.Applications) == 0 {
-			return protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{})
 		}
-		listValue := &_QueryGetApplicationsResponse_1_list{list: &x.Applications}
+		listValue := &_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications}
 		return protoreflect.ValueOfList(listValue)
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3659,19 +5837,19 @@ func (x *fastReflection_QueryGetApplicationsResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		lv := value.List()
-		clv := lv.(*_QueryGetApplicationsResponse_1_list)
+		clv := lv.(*_QueryGetSendersApplicationsResponse_1_list)
 		x.Applications = *clv.list
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3685,53 +5863,53 @@ func (x *fastReflection_QueryGetApplicationsResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		if x.Applications == nil {
 			x.Applications = []*BurnApplication{}
 		}
-		value := &_QueryGetApplicationsResponse_1_list{list: &x.Applications}
+		value := &_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications}
 		return protoreflect.ValueOfList(value)
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta11.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetApplicationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
 		list := []*BurnApplication{}
-		return protoreflect.ValueOfList(&_QueryGetApplicationsResponse_1_list{list: &list})
-	case "haqq.ethiq.v1.QueryGetApplicationsResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{list: &list})
+	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
 		m := new(v1beta11.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetApplicationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetApplicationsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetSendersApplicationsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3739,7 +5917,7 @@ func (x *fastReflection_QueryGetApplicationsResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetApplicationsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3750,7 +5928,7 @@ func (x *fastReflection_QueryGetApplicationsResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetApplicationsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3762,7 +5940,7 @@ func (x *fastReflection_QueryGetApplicationsResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetApplicationsResponse) IsValid() bool {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3772,9 +5950,9 @@ func (x *fastReflection_QueryGetApplicationsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3806,7 +5984,7 @@ func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3866,7 +6044,7 @@ func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetApplicationsResponse)
+		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3898,10 +6076,10 @@ func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetApplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4010,28 +6188,24 @@ func (x *fastReflection_QueryGetApplicationsResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_QueryGetSendersApplicationsRequest                protoreflect.MessageDescriptor
-	fd_QueryGetSendersApplicationsRequest_sender_address protoreflect.FieldDescriptor
-	fd_QueryGetSendersApplicationsRequest_pagination     protoreflect.FieldDescriptor
+	md_QueryPriceLevelsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryGetSendersApplicationsRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetSendersApplicationsRequest")
-	fd_QueryGetSendersApplicationsRequest_sender_address = md_QueryGetSendersApplicationsRequest.Fields().ByName("sender_address")
-	fd_QueryGetSendersApplicationsRequest_pagination = md_QueryGetSendersApplicationsRequest.Fields().ByName("pagination")
+	md_QueryPriceLevelsRequest = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryPriceLevelsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetSendersApplicationsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPriceLevelsRequest)(nil)
 
-type fastReflection_QueryGetSendersApplicationsRequest QueryGetSendersApplicationsRequest
+type fastReflection_QueryPriceLevelsRequest QueryPriceLevelsRequest
 
-func (x *QueryGetSendersApplicationsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetSendersApplicationsRequest)(x)
+func (x *QueryPriceLevelsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceLevelsRequest)(x)
 }
 
-func (x *QueryGetSendersApplicationsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[8]
+func (x *QueryPriceLevelsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,43 +6216,43 @@ func (x *QueryGetSendersApplicationsRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetSendersApplicationsRequest_messageType fastReflection_QueryGetSendersApplicationsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetSendersApplicationsRequest_messageType{}
+var _fastReflection_QueryPriceLevelsRequest_messageType fastReflection_QueryPriceLevelsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceLevelsRequest_messageType{}
 
-type fastReflection_QueryGetSendersApplicationsRequest_messageType struct{}
+type fastReflection_QueryPriceLevelsRequest_messageType struct{}
 
-func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetSendersApplicationsRequest)(nil)
+func (x fastReflection_QueryPriceLevelsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceLevelsRequest)(nil)
 }
-func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetSendersApplicationsRequest)
+func (x fastReflection_QueryPriceLevelsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceLevelsRequest)
 }
-func (x fastReflection_QueryGetSendersApplicationsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetSendersApplicationsRequest
+func (x fastReflection_QueryPriceLevelsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceLevelsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetSendersApplicationsRequest
+func (x *fastReflection_QueryPriceLevelsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceLevelsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetSendersApplicationsRequest_messageType
+func (x *fastReflection_QueryPriceLevelsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceLevelsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGetSendersApplicationsRequest)
+func (x *fastReflection_QueryPriceLevelsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceLevelsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetSendersApplicationsRequest)(x)
+func (x *fastReflection_QueryPriceLevelsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceLevelsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4086,19 +6260,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SenderAddress != "" {
-		value := protoreflect.ValueOfString(x.SenderAddress)
-		if !f(fd_QueryGetSendersApplicationsRequest_sender_address, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetSendersApplicationsRequest_pagination, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryPriceLevelsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4112,17 +6274,13 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceLevelsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		return x.SenderAddress != ""
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4132,17 +6290,13 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceLevelsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		x.SenderAddress = ""
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4152,19 +6306,13 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		value := x.SenderAddress
-		return protoreflect.ValueOfString(value)
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4178,17 +6326,13 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceLevelsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		x.SenderAddress = value.Interface().(string)
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4202,48 +6346,36 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		panic(fmt.Errorf("field sender_address of message haqq.ethiq.v1.QueryGetSendersApplicationsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.sender_address":
-		return protoreflect.ValueOfString("")
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination":
-		m := new(v1beta11.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsRequest"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceLevelsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetSendersApplicationsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryPriceLevelsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4251,7 +6383,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceLevelsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4262,7 +6394,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceLevelsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4274,7 +6406,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) IsValid() bool {
+func (x *fastReflection_QueryPriceLevelsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4284,9 +6416,9 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceLevelsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
+		x := input.Message.Interface().(*QueryPriceLevelsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4298,14 +6430,6 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.SenderAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4316,7 +6440,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
+		x := input.Message.Interface().(*QueryPriceLevelsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4335,27 +6459,6 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.SenderAddress) > 0 {
-			i -= len(x.SenderAddress)
-			copy(dAtA[i:], x.SenderAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4367,7 +6470,7 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsRequest)
+		x := input.Message.Interface().(*QueryPriceLevelsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4399,80 +6502,12 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceLevelsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceLevelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.SenderAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4508,80 +6543,78 @@ func (x *fastReflection_QueryGetSendersApplicationsRequest) ProtoMethods() *prot
 	}
 }
 
-var _ protoreflect.List = (*_QueryGetSendersApplicationsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryPriceLevelsResponse_1_list)(nil)
 
-type _QueryGetSendersApplicationsResponse_1_list struct {
-	list *[]*BurnApplication
+type _QueryPriceLevelsResponse_1_list struct {
+	list *[]*PriceLevel
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) Len() int {
+func (x *_QueryPriceLevelsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryPriceLevelsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryPriceLevelsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	concreteValue := valueUnwrapped.Interface().(*PriceLevel)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryPriceLevelsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BurnApplication)
+	concreteValue := valueUnwrapped.Interface().(*PriceLevel)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(BurnApplication)
+func (x *_QueryPriceLevelsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PriceLevel)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) Truncate(n int) {
+func (x *_QueryPriceLevelsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(BurnApplication)
+func (x *_QueryPriceLevelsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PriceLevel)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryGetSendersApplicationsResponse_1_list) IsValid() bool {
+func (x *_QueryPriceLevelsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryGetSendersApplicationsResponse              protoreflect.MessageDescriptor
-	fd_QueryGetSendersApplicationsResponse_applications protoreflect.FieldDescriptor
-	fd_QueryGetSendersApplicationsResponse_pagination   protoreflect.FieldDescriptor
+	md_QueryPriceLevelsResponse              protoreflect.MessageDescriptor
+	fd_QueryPriceLevelsResponse_price_levels protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_haqq_ethiq_v1_query_proto_init()
-	md_QueryGetSendersApplicationsResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryGetSendersApplicationsResponse")
-	fd_QueryGetSendersApplicationsResponse_applications = md_QueryGetSendersApplicationsResponse.Fields().ByName("applications")
-	fd_QueryGetSendersApplicationsResponse_pagination = md_QueryGetSendersApplicationsResponse.Fields().ByName("pagination")
+	md_QueryPriceLevelsResponse = File_haqq_ethiq_v1_query_proto.Messages().ByName("QueryPriceLevelsResponse")
+	fd_QueryPriceLevelsResponse_price_levels = md_QueryPriceLevelsResponse.Fields().ByName("price_levels")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGetSendersApplicationsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryPriceLevelsResponse)(nil)
 
-type fastReflection_QueryGetSendersApplicationsResponse QueryGetSendersApplicationsResponse
+type fastReflection_QueryPriceLevelsResponse QueryPriceLevelsResponse
 
-func (x *QueryGetSendersApplicationsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGetSendersApplicationsResponse)(x)
+func (x *QueryPriceLevelsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPriceLevelsResponse)(x)
 }
 
-func (x *QueryGetSendersApplicationsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[9]
+func (x *QueryPriceLevelsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4592,43 +6625,43 @@ func (x *QueryGetSendersApplicationsResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGetSendersApplicationsResponse_messageType fastReflection_QueryGetSendersApplicationsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGetSendersApplicationsResponse_messageType{}
+var _fastReflection_QueryPriceLevelsResponse_messageType fastReflection_QueryPriceLevelsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPriceLevelsResponse_messageType{}
 
-type fastReflection_QueryGetSendersApplicationsResponse_messageType struct{}
+type fastReflection_QueryPriceLevelsResponse_messageType struct{}
 
-func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGetSendersApplicationsResponse)(nil)
+func (x fastReflection_QueryPriceLevelsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPriceLevelsResponse)(nil)
 }
-func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGetSendersApplicationsResponse)
+func (x fastReflection_QueryPriceLevelsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceLevelsResponse)
 }
-func (x fastReflection_QueryGetSendersApplicationsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetSendersApplicationsResponse
+func (x fastReflection_QueryPriceLevelsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceLevelsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGetSendersApplicationsResponse
+func (x *fastReflection_QueryPriceLevelsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPriceLevelsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGetSendersApplicationsResponse_messageType
+func (x *fastReflection_QueryPriceLevelsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPriceLevelsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGetSendersApplicationsResponse)
+func (x *fastReflection_QueryPriceLevelsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPriceLevelsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGetSendersApplicationsResponse)(x)
+func (x *fastReflection_QueryPriceLevelsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPriceLevelsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4636,16 +6669,10 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Applications) != 0 {
-		value := protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications})
-		if !f(fd_QueryGetSendersApplicationsResponse_applications, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryGetSendersApplicationsResponse_pagination, value) {
+func (x *fastReflection_QueryPriceLevelsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PriceLevels) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPriceLevelsResponse_1_list{list: &x.PriceLevels})
+		if !f(fd_QueryPriceLevelsResponse_price_levels, value) {
 			return
 		}
 	}
@@ -4662,17 +6689,15 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryPriceLevelsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
-		return len(x.Applications) != 0
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		return x.Pagination != nil
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
+		return len(x.PriceLevels) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4682,17 +6707,15 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryPriceLevelsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
-		x.Applications = nil
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		x.Pagination = nil
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
+		x.PriceLevels = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4702,22 +6725,19 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
-		if len(x.Applications) == 0 {
-			return protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{})
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
+		if len(x.PriceLevels) == 0 {
+			return protoreflect.ValueOfList(&_QueryPriceLevelsResponse_1_list{})
 		}
-		listValue := &_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications}
+		listValue := &_QueryPriceLevelsResponse_1_list{list: &x.PriceLevels}
 		return protoreflect.ValueOfList(listValue)
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4731,19 +6751,17 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryPriceLevelsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
 		lv := value.List()
-		clv := lv.(*_QueryGetSendersApplicationsResponse_1_list)
-		x.Applications = *clv.list
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+		clv := lv.(*_QueryPriceLevelsResponse_1_list)
+		x.PriceLevels = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4757,53 +6775,45 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
-		if x.Applications == nil {
-			x.Applications = []*BurnApplication{}
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
+		if x.PriceLevels == nil {
+			x.PriceLevels = []*PriceLevel{}
 		}
-		value := &_QueryGetSendersApplicationsResponse_1_list{list: &x.Applications}
+		value := &_QueryPriceLevelsResponse_1_list{list: &x.PriceLevels}
 		return protoreflect.ValueOfList(value)
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta11.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryPriceLevelsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications":
-		list := []*BurnApplication{}
-		return protoreflect.ValueOfList(&_QueryGetSendersApplicationsResponse_1_list{list: &list})
-	case "haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination":
-		m := new(v1beta11.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels":
+		list := []*PriceLevel{}
+		return protoreflect.ValueOfList(&_QueryPriceLevelsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryGetSendersApplicationsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.ethiq.v1.QueryPriceLevelsResponse"))
 		}
-		panic(fmt.Errorf("message haqq.ethiq.v1.QueryGetSendersApplicationsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message haqq.ethiq.v1.QueryPriceLevelsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryPriceLevelsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryGetSendersApplicationsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in haqq.ethiq.v1.QueryPriceLevelsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4811,7 +6821,7 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryPriceLevelsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4822,7 +6832,7 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryPriceLevelsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4834,7 +6844,7 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) IsValid() bool {
+func (x *fastReflection_QueryPriceLevelsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4844,9 +6854,9 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryPriceLevelsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
+		x := input.Message.Interface().(*QueryPriceLevelsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4858,16 +6868,12 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 		var n int
 		var l int
 		_ = l
-		if len(x.Applications) > 0 {
-			for _, e := range x.Applications {
+		if len(x.PriceLevels) > 0 {
+			for _, e := range x.PriceLevels {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4878,7 +6884,7 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
+		x := input.Message.Interface().(*QueryPriceLevelsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4897,23 +6903,9 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Applications) > 0 {
-			for iNdEx := len(x.Applications) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Applications[iNdEx])
+		if len(x.PriceLevels) > 0 {
+			for iNdEx := len(x.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceLevels[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4938,7 +6930,7 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGetSendersApplicationsResponse)
+		x := input.Message.Interface().(*QueryPriceLevelsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4954,65 +6946,31 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 			preIndex := iNdEx
 			var wire uint64
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGetSendersApplicationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Applications = append(x.Applications, &BurnApplication{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Applications[len(x.Applications)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 2:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceLevelsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPriceLevelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5039,10 +6997,8 @@ func (x *fastReflection_QueryGetSendersApplicationsResponse) ProtoMethods() *pro
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta11.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.PriceLevels = append(x.PriceLevels, &PriceLevel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceLevels[len(x.PriceLevels)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5099,7 +7055,7 @@ func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[10]
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5457,7 +7413,7 @@ func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[11]
+	mi := &file_haqq_ethiq_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6060,6 +8016,109 @@ func (x *QueryCalculateResponse) GetAveragePrice() string {
 	return ""
 }
 
+// QueryCalculateBreakdownRequest is the request type for the Query/CalculateBreakdown RPC method.
+type QueryCalculateBreakdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// islm_amount is the amount of aISLM coins to burn in exchange for aHAQQ
+	// using string as grpc-gateway doesn't support custom types (eg. cosmossdk.io/math.Int)
+	IslmAmount string `protobuf:"bytes,1,opt,name=islm_amount,json=islmAmount,proto3" json:"islm_amount,omitempty"`
+}
+
+func (x *QueryCalculateBreakdownRequest) Reset() {
+	*x = QueryCalculateBreakdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCalculateBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCalculateBreakdownRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCalculateBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*QueryCalculateBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryCalculateBreakdownRequest) GetIslmAmount() string {
+	if x != nil {
+		return x.IslmAmount
+	}
+	return ""
+}
+
+// QueryCalculateBreakdownResponse is the response type for the Query/CalculateBreakdown RPC method.
+type QueryCalculateBreakdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// levels is the list of price levels the burn spreads over, in curve order
+	Levels []*PriceLevelBreakdown `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	// estimated_haqq_amount is the total amount of aHAQQ coins to be minted
+	EstimatedHaqqAmount string `protobuf:"bytes,2,opt,name=estimated_haqq_amount,json=estimatedHaqqAmount,proto3" json:"estimated_haqq_amount,omitempty"`
+	// total_burned_after is the cumulative burned aISLM amount on the price curve after the burn
+	TotalBurnedAfter string `protobuf:"bytes,3,opt,name=total_burned_after,json=totalBurnedAfter,proto3" json:"total_burned_after,omitempty"`
+	// remaining_capacity is the amount of aISLM that can still be burned after the burn
+	// before the price curve is exhausted
+	RemainingCapacity string `protobuf:"bytes,4,opt,name=remaining_capacity,json=remainingCapacity,proto3" json:"remaining_capacity,omitempty"`
+}
+
+func (x *QueryCalculateBreakdownResponse) Reset() {
+	*x = QueryCalculateBreakdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCalculateBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCalculateBreakdownResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCalculateBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*QueryCalculateBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryCalculateBreakdownResponse) GetLevels() []*PriceLevelBreakdown {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *QueryCalculateBreakdownResponse) GetEstimatedHaqqAmount() string {
+	if x != nil {
+		return x.EstimatedHaqqAmount
+	}
+	return ""
+}
+
+func (x *QueryCalculateBreakdownResponse) GetTotalBurnedAfter() string {
+	if x != nil {
+		return x.TotalBurnedAfter
+	}
+	return ""
+}
+
+func (x *QueryCalculateBreakdownResponse) GetRemainingCapacity() string {
+	if x != nil {
+		return x.RemainingCapacity
+	}
+	return ""
+}
+
 // QueryCalculateForApplicationRequest is the request type for the Query/CalculateForApplication RPC method.
 type QueryCalculateForApplicationRequest struct {
 	state         protoimpl.MessageState
@@ -6074,7 +8133,7 @@ type QueryCalculateForApplicationRequest struct {
 func (x *QueryCalculateForApplicationRequest) Reset() {
 	*x = QueryCalculateForApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[4]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6088,7 +8147,7 @@ func (*QueryCalculateForApplicationRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculateForApplicationRequest.ProtoReflect.Descriptor instead.
 func (*QueryCalculateForApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryCalculateForApplicationRequest) GetApplicationId() uint64 {
@@ -6119,7 +8178,7 @@ type QueryCalculateForApplicationResponse struct {
 func (x *QueryCalculateForApplicationResponse) Reset() {
 	*x = QueryCalculateForApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[5]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6133,7 +8192,7 @@ func (*QueryCalculateForApplicationResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCalculateForApplicationResponse.ProtoReflect.Descriptor instead.
 func (*QueryCalculateForApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryCalculateForApplicationResponse) GetEstimatedHaqqAmount() string {
@@ -6183,7 +8242,7 @@ type QueryGetApplicationsRequest struct {
 func (x *QueryGetApplicationsRequest) Reset() {
 	*x = QueryGetApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[6]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6197,7 +8256,7 @@ func (*QueryGetApplicationsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetApplicationsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryGetApplicationsRequest) GetPagination() *v1beta11.PageRequest {
@@ -6222,7 +8281,7 @@ type QueryGetApplicationsResponse struct {
 func (x *QueryGetApplicationsResponse) Reset() {
 	*x = QueryGetApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[7]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6236,7 +8295,7 @@ func (*QueryGetApplicationsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetApplicationsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryGetApplicationsResponse) GetApplications() []*BurnApplication {
@@ -6267,7 +8326,7 @@ type QueryGetSendersApplicationsRequest struct {
 func (x *QueryGetSendersApplicationsRequest) Reset() {
 	*x = QueryGetSendersApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[8]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6281,7 +8340,7 @@ func (*QueryGetSendersApplicationsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGetSendersApplicationsRequest.ProtoReflect.Descriptor instead.
 func (*QueryGetSendersApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryGetSendersApplicationsRequest) GetSenderAddress() string {
@@ -6313,7 +8372,7 @@ type QueryGetSendersApplicationsResponse struct {
 func (x *QueryGetSendersApplicationsResponse) Reset() {
 	*x = QueryGetSendersApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[9]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6327,7 +8386,7 @@ func (*QueryGetSendersApplicationsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGetSendersApplicationsResponse.ProtoReflect.Descriptor instead.
 func (*QueryGetSendersApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryGetSendersApplicationsResponse) GetApplications() []*BurnApplication {
@@ -6344,6 +8403,70 @@ func (x *QueryGetSendersApplicationsResponse) GetPagination() *v1beta11.PageResp
 	return nil
 }
 
+// QueryPriceLevelsRequest is the request type for the Query/PriceLevels RPC method.
+type QueryPriceLevelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryPriceLevelsRequest) Reset() {
+	*x = QueryPriceLevelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceLevelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceLevelsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceLevelsRequest.ProtoReflect.Descriptor instead.
+func (*QueryPriceLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryPriceLevelsResponse is the response type for the Query/PriceLevels RPC method.
+type QueryPriceLevelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// price_levels is the current aISLM to aHAQQ burn price curve.
+	PriceLevels []*PriceLevel `protobuf:"bytes,1,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels,omitempty"`
+}
+
+func (x *QueryPriceLevelsResponse) Reset() {
+	*x = QueryPriceLevelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPriceLevelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPriceLevelsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPriceLevelsResponse.ProtoReflect.Descriptor instead.
+func (*QueryPriceLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryPriceLevelsResponse) GetPriceLevels() []*PriceLevel {
+	if x != nil {
+		return x.PriceLevels
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the request type for querying x/ethiq parameters.
 type QueryParamsRequest struct {
 	state         protoimpl.MessageState
@@ -6354,7 +8477,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[10]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6368,7 +8491,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 // QueryParamsResponse defines the response type for querying x/ethiq parameters.
@@ -6383,7 +8506,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[11]
+		mi := &file_haqq_ethiq_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6397,7 +8520,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_haqq_ethiq_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -6471,161 +8594,219 @@ var file_haqq_ethiq_v1_query_proto_rawDesc = []byte{
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
 	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
 	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c,
-	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xce, 0x03, 0x0a,
-	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x68, 0x61, 0x71, 0x71, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
-	0x64, 0x48, 0x61, 0x71, 0x71, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a,
-	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb6,
-	0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4d, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68,
-	0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x4b, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x6c, 0x6d, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x73, 0x6c,
+	0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x00, 0x22, 0x8f, 0x03, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68,
+	0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x64, 0x0a, 0x15,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x71, 0x71, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x48, 0x61, 0x71, 0x71, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x5f, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x22, 0x56, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xce, 0x03, 0x0a, 0x24,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x68, 0x61, 0x71, 0x71, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x48, 0x61, 0x71, 0x71, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0d, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x53, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x1b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xb6, 0x01,
+	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69,
+	0x71, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
+	0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46,
 	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
-	0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
-	0x00, 0x22, 0xbd, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x75, 0x72, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0xc8, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x88, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x12, 0x26, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x61, 0x71,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x22, 0xbd, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x72, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x18, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73,
+	0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32, 0x88, 0x0a, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x88, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x26, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x61, 0x71, 0x71,
+	0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x8d, 0x01, 0x0a,
+	0x09, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x68, 0x61, 0x71,
 	0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x28, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x12, 0x1b, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x8d, 0x01,
-	0x0a, 0x09, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x68, 0x61,
-	0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68,
-	0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x2f,
-	0x7b, 0x69, 0x73, 0x6c, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x12, 0xca, 0x01,
-	0x0a, 0x17, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x68, 0x61, 0x71, 0x71,
-	0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69,
+	0x71, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x2f, 0x7b,
+	0x69, 0x73, 0x6c, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x12, 0xb2, 0x01, 0x0a,
+	0x12, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x2d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3d, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12,
+	0x30, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x2f, 0x7b, 0x69, 0x73, 0x6c, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x7d, 0x12, 0xca, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e,
 	0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x46, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12,
-	0x39, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x2d, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x2d, 0x66, 0x6f,
+	0x72, 0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x98,
+	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
 	0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x61, 0x71,
-	0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69,
-	0x71, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x88, 0xe7, 0xb0,
+	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f,
+	0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69,
+	0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e,
 	0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x31, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69,
-	0x71, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73,
-	0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x73,
-	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e,
-	0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x68, 0x61,
-	0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x68,
-	0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71,
-	0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65,
-	0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x74, 0x68, 0x69, 0x71, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x45, 0x74, 0x68,
-	0x69, 0x71, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68,
-	0x69, 0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68,
-	0x69, 0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74, 0x68, 0x69, 0x71, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65,
+	0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x88, 0xe7, 0xb0,
+	0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f,
+	0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x2d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x12, 0x26, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x61, 0x71,
+	0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x73, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x65,
+	0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x68, 0x61, 0x71,
+	0x71, 0x2e, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x68, 0x61,
+	0x71, 0x71, 0x2f, 0x65, 0x74, 0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e,
+	0x65, 0x74, 0x68, 0x69, 0x71, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x65, 0x74,
+	0x68, 0x69, 0x71, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x74, 0x68, 0x69, 0x71, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x45, 0x74, 0x68, 0x69,
+	0x71, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69,
+	0x71, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x45, 0x74, 0x68, 0x69,
+	0x71, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0f, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x45, 0x74, 0x68, 0x69, 0x71, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6640,53 +8821,65 @@ func file_haqq_ethiq_v1_query_proto_rawDescGZIP() []byte {
 	return file_haqq_ethiq_v1_query_proto_rawDescData
 }
 
-var file_haqq_ethiq_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_haqq_ethiq_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_haqq_ethiq_v1_query_proto_goTypes = []interface{}{
 	(*QueryTotalBurnedRequest)(nil),              // 0: haqq.ethiq.v1.QueryTotalBurnedRequest
 	(*QueryTotalBurnedResponse)(nil),             // 1: haqq.ethiq.v1.QueryTotalBurnedResponse
 	(*QueryCalculateRequest)(nil),                // 2: haqq.ethiq.v1.QueryCalculateRequest
 	(*QueryCalculateResponse)(nil),               // 3: haqq.ethiq.v1.QueryCalculateResponse
-	(*QueryCalculateForApplicationRequest)(nil),  // 4: haqq.ethiq.v1.QueryCalculateForApplicationRequest
-	(*QueryCalculateForApplicationResponse)(nil), // 5: haqq.ethiq.v1.QueryCalculateForApplicationResponse
-	(*QueryGetApplicationsRequest)(nil),          // 6: haqq.ethiq.v1.QueryGetApplicationsRequest
-	(*QueryGetApplicationsResponse)(nil),         // 7: haqq.ethiq.v1.QueryGetApplicationsResponse
-	(*QueryGetSendersApplicationsRequest)(nil),   // 8: haqq.ethiq.v1.QueryGetSendersApplicationsRequest
-	(*QueryGetSendersApplicationsResponse)(nil),  // 9: haqq.ethiq.v1.QueryGetSendersApplicationsResponse
-	(*QueryParamsRequest)(nil),                   // 10: haqq.ethiq.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                  // 11: haqq.ethiq.v1.QueryParamsResponse
-	(*v1beta1.Coin)(nil),                         // 12: cosmos.base.v1beta1.Coin
-	(*v1beta11.PageRequest)(nil),                 // 13: cosmos.base.query.v1beta1.PageRequest
-	(*BurnApplication)(nil),                      // 14: haqq.ethiq.v1.BurnApplication
-	(*v1beta11.PageResponse)(nil),                // 15: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                               // 16: haqq.ethiq.v1.Params
+	(*QueryCalculateBreakdownRequest)(nil),       // 4: haqq.ethiq.v1.QueryCalculateBreakdownRequest
+	(*QueryCalculateBreakdownResponse)(nil),      // 5: haqq.ethiq.v1.QueryCalculateBreakdownResponse
+	(*QueryCalculateForApplicationRequest)(nil),  // 6: haqq.ethiq.v1.QueryCalculateForApplicationRequest
+	(*QueryCalculateForApplicationResponse)(nil), // 7: haqq.ethiq.v1.QueryCalculateForApplicationResponse
+	(*QueryGetApplicationsRequest)(nil),          // 8: haqq.ethiq.v1.QueryGetApplicationsRequest
+	(*QueryGetApplicationsResponse)(nil),         // 9: haqq.ethiq.v1.QueryGetApplicationsResponse
+	(*QueryGetSendersApplicationsRequest)(nil),   // 10: haqq.ethiq.v1.QueryGetSendersApplicationsRequest
+	(*QueryGetSendersApplicationsResponse)(nil),  // 11: haqq.ethiq.v1.QueryGetSendersApplicationsResponse
+	(*QueryPriceLevelsRequest)(nil),              // 12: haqq.ethiq.v1.QueryPriceLevelsRequest
+	(*QueryPriceLevelsResponse)(nil),             // 13: haqq.ethiq.v1.QueryPriceLevelsResponse
+	(*QueryParamsRequest)(nil),                   // 14: haqq.ethiq.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                  // 15: haqq.ethiq.v1.QueryParamsResponse
+	(*v1beta1.Coin)(nil),                         // 16: cosmos.base.v1beta1.Coin
+	(*PriceLevelBreakdown)(nil),                  // 17: haqq.ethiq.v1.PriceLevelBreakdown
+	(*v1beta11.PageRequest)(nil),                 // 18: cosmos.base.query.v1beta1.PageRequest
+	(*BurnApplication)(nil),                      // 19: haqq.ethiq.v1.BurnApplication
+	(*v1beta11.PageResponse)(nil),                // 20: cosmos.base.query.v1beta1.PageResponse
+	(*PriceLevel)(nil),                           // 21: haqq.ethiq.v1.PriceLevel
+	(*Params)(nil),                               // 22: haqq.ethiq.v1.Params
 }
 var file_haqq_ethiq_v1_query_proto_depIdxs = []int32{
-	12, // 0: haqq.ethiq.v1.QueryTotalBurnedResponse.total_burned:type_name -> cosmos.base.v1beta1.Coin
-	12, // 1: haqq.ethiq.v1.QueryTotalBurnedResponse.total_burned_from_applications:type_name -> cosmos.base.v1beta1.Coin
-	13, // 2: haqq.ethiq.v1.QueryGetApplicationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	14, // 3: haqq.ethiq.v1.QueryGetApplicationsResponse.applications:type_name -> haqq.ethiq.v1.BurnApplication
-	15, // 4: haqq.ethiq.v1.QueryGetApplicationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	13, // 5: haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	14, // 6: haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications:type_name -> haqq.ethiq.v1.BurnApplication
-	15, // 7: haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 8: haqq.ethiq.v1.QueryParamsResponse.params:type_name -> haqq.ethiq.v1.Params
-	0,  // 9: haqq.ethiq.v1.Query.TotalBurned:input_type -> haqq.ethiq.v1.QueryTotalBurnedRequest
-	2,  // 10: haqq.ethiq.v1.Query.Calculate:input_type -> haqq.ethiq.v1.QueryCalculateRequest
-	4,  // 11: haqq.ethiq.v1.Query.CalculateForApplication:input_type -> haqq.ethiq.v1.QueryCalculateForApplicationRequest
-	6,  // 12: haqq.ethiq.v1.Query.GetApplications:input_type -> haqq.ethiq.v1.QueryGetApplicationsRequest
-	8,  // 13: haqq.ethiq.v1.Query.GetSendersApplications:input_type -> haqq.ethiq.v1.QueryGetSendersApplicationsRequest
-	10, // 14: haqq.ethiq.v1.Query.Params:input_type -> haqq.ethiq.v1.QueryParamsRequest
-	1,  // 15: haqq.ethiq.v1.Query.TotalBurned:output_type -> haqq.ethiq.v1.QueryTotalBurnedResponse
-	3,  // 16: haqq.ethiq.v1.Query.Calculate:output_type -> haqq.ethiq.v1.QueryCalculateResponse
-	5,  // 17: haqq.ethiq.v1.Query.CalculateForApplication:output_type -> haqq.ethiq.v1.QueryCalculateForApplicationResponse
-	7,  // 18: haqq.ethiq.v1.Query.GetApplications:output_type -> haqq.ethiq.v1.QueryGetApplicationsResponse
-	9,  // 19: haqq.ethiq.v1.Query.GetSendersApplications:output_type -> haqq.ethiq.v1.QueryGetSendersApplicationsResponse
-	11, // 20: haqq.ethiq.v1.Query.Params:output_type -> haqq.ethiq.v1.QueryParamsResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	16, // 0: haqq.ethiq.v1.QueryTotalBurnedResponse.total_burned:type_name -> cosmos.base.v1beta1.Coin
+	16, // 1: haqq.ethiq.v1.QueryTotalBurnedResponse.total_burned_from_applications:type_name -> cosmos.base.v1beta1.Coin
+	17, // 2: haqq.ethiq.v1.QueryCalculateBreakdownResponse.levels:type_name -> haqq.ethiq.v1.PriceLevelBreakdown
+	18, // 3: haqq.ethiq.v1.QueryGetApplicationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	19, // 4: haqq.ethiq.v1.QueryGetApplicationsResponse.applications:type_name -> haqq.ethiq.v1.BurnApplication
+	20, // 5: haqq.ethiq.v1.QueryGetApplicationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	18, // 6: haqq.ethiq.v1.QueryGetSendersApplicationsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	19, // 7: haqq.ethiq.v1.QueryGetSendersApplicationsResponse.applications:type_name -> haqq.ethiq.v1.BurnApplication
+	20, // 8: haqq.ethiq.v1.QueryGetSendersApplicationsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	21, // 9: haqq.ethiq.v1.QueryPriceLevelsResponse.price_levels:type_name -> haqq.ethiq.v1.PriceLevel
+	22, // 10: haqq.ethiq.v1.QueryParamsResponse.params:type_name -> haqq.ethiq.v1.Params
+	0,  // 11: haqq.ethiq.v1.Query.TotalBurned:input_type -> haqq.ethiq.v1.QueryTotalBurnedRequest
+	2,  // 12: haqq.ethiq.v1.Query.Calculate:input_type -> haqq.ethiq.v1.QueryCalculateRequest
+	4,  // 13: haqq.ethiq.v1.Query.CalculateBreakdown:input_type -> haqq.ethiq.v1.QueryCalculateBreakdownRequest
+	6,  // 14: haqq.ethiq.v1.Query.CalculateForApplication:input_type -> haqq.ethiq.v1.QueryCalculateForApplicationRequest
+	8,  // 15: haqq.ethiq.v1.Query.GetApplications:input_type -> haqq.ethiq.v1.QueryGetApplicationsRequest
+	10, // 16: haqq.ethiq.v1.Query.GetSendersApplications:input_type -> haqq.ethiq.v1.QueryGetSendersApplicationsRequest
+	12, // 17: haqq.ethiq.v1.Query.PriceLevels:input_type -> haqq.ethiq.v1.QueryPriceLevelsRequest
+	14, // 18: haqq.ethiq.v1.Query.Params:input_type -> haqq.ethiq.v1.QueryParamsRequest
+	1,  // 19: haqq.ethiq.v1.Query.TotalBurned:output_type -> haqq.ethiq.v1.QueryTotalBurnedResponse
+	3,  // 20: haqq.ethiq.v1.Query.Calculate:output_type -> haqq.ethiq.v1.QueryCalculateResponse
+	5,  // 21: haqq.ethiq.v1.Query.CalculateBreakdown:output_type -> haqq.ethiq.v1.QueryCalculateBreakdownResponse
+	7,  // 22: haqq.ethiq.v1.Query.CalculateForApplication:output_type -> haqq.ethiq.v1.QueryCalculateForApplicationResponse
+	9,  // 23: haqq.ethiq.v1.Query.GetApplications:output_type -> haqq.ethiq.v1.QueryGetApplicationsResponse
+	11, // 24: haqq.ethiq.v1.Query.GetSendersApplications:output_type -> haqq.ethiq.v1.QueryGetSendersApplicationsResponse
+	13, // 25: haqq.ethiq.v1.Query.PriceLevels:output_type -> haqq.ethiq.v1.QueryPriceLevelsResponse
+	15, // 26: haqq.ethiq.v1.Query.Params:output_type -> haqq.ethiq.v1.QueryParamsResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_haqq_ethiq_v1_query_proto_init() }
@@ -6745,7 +8938,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculateForApplicationRequest); i {
+			switch v := v.(*QueryCalculateBreakdownRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6757,7 +8950,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCalculateForApplicationResponse); i {
+			switch v := v.(*QueryCalculateBreakdownResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6769,7 +8962,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetApplicationsRequest); i {
+			switch v := v.(*QueryCalculateForApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6781,7 +8974,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetApplicationsResponse); i {
+			switch v := v.(*QueryCalculateForApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6793,7 +8986,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetSendersApplicationsRequest); i {
+			switch v := v.(*QueryGetApplicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6805,7 +8998,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetSendersApplicationsResponse); i {
+			switch v := v.(*QueryGetApplicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6817,7 +9010,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryGetSendersApplicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6829,6 +9022,54 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			}
 		}
 		file_haqq_ethiq_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetSendersApplicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceLevelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPriceLevelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_ethiq_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
@@ -6847,7 +9088,7 @@ func file_haqq_ethiq_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_haqq_ethiq_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,9 +21,11 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_TotalBurned_FullMethodName             = "/haqq.ethiq.v1.Query/TotalBurned"
 	Query_Calculate_FullMethodName               = "/haqq.ethiq.v1.Query/Calculate"
+	Query_CalculateBreakdown_FullMethodName      = "/haqq.ethiq.v1.Query/CalculateBreakdown"
 	Query_CalculateForApplication_FullMethodName = "/haqq.ethiq.v1.Query/CalculateForApplication"
 	Query_GetApplications_FullMethodName         = "/haqq.ethiq.v1.Query/GetApplications"
 	Query_GetSendersApplications_FullMethodName  = "/haqq.ethiq.v1.Query/GetSendersApplications"
+	Query_PriceLevels_FullMethodName             = "/haqq.ethiq.v1.Query/PriceLevels"
 	Query_Params_FullMethodName                  = "/haqq.ethiq.v1.Query/Params"
 )
 
@@ -35,12 +37,17 @@ type QueryClient interface {
 	TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error)
 	// Calculate returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
 	Calculate(ctx context.Context, in *QueryCalculateRequest, opts ...grpc.CallOption) (*QueryCalculateResponse, error)
+	// CalculateBreakdown returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
+	// split by the price levels the burn spreads over.
+	CalculateBreakdown(ctx context.Context, in *QueryCalculateBreakdownRequest, opts ...grpc.CallOption) (*QueryCalculateBreakdownResponse, error)
 	// CalculateForApplication returns the estimated amount of aHAQQ coins to be minted for given BurnApplication ID.
 	CalculateForApplication(ctx context.Context, in *QueryCalculateForApplicationRequest, opts ...grpc.CallOption) (*QueryCalculateForApplicationResponse, error)
 	// GetApplications returns the paginated list of all registered applications.
 	GetApplications(ctx context.Context, in *QueryGetApplicationsRequest, opts ...grpc.CallOption) (*QueryGetApplicationsResponse, error)
 	// GetSendersApplications returns the paginated list of all applications registered for a given sender_address.
 	GetSendersApplications(ctx context.Context, in *QueryGetSendersApplicationsRequest, opts ...grpc.CallOption) (*QueryGetSendersApplicationsResponse, error)
+	// PriceLevels returns the aISLM to aHAQQ burn price curve.
+	PriceLevels(ctx context.Context, in *QueryPriceLevelsRequest, opts ...grpc.CallOption) (*QueryPriceLevelsResponse, error)
 	// Params queries the parameters of x/ethiq module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -71,6 +78,15 @@ func (c *queryClient) Calculate(ctx context.Context, in *QueryCalculateRequest,
 	return out, nil
 }
 
+func (c *queryClient) CalculateBreakdown(ctx context.Context, in *QueryCalculateBreakdownRequest, opts ...grpc.CallOption) (*QueryCalculateBreakdownResponse, error) {
+	out := new(QueryCalculateBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_CalculateBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateForApplication(ctx context.Context, in *QueryCalculateForApplicationRequest, opts ...grpc.CallOption) (*QueryCalculateForApplicationResponse, error) {
 	out := new(QueryCalculateForApplicationResponse)
 	err := c.cc.Invoke(ctx, Query_CalculateForApplication_FullMethodName, in, out, opts...)
@@ -98,6 +114,15 @@ func (c *queryClient) GetSendersApplications(ctx context.Context, in *QueryGetSe
 	return out, nil
 }
 
+func (c *queryClient) PriceLevels(ctx context.Context, in *QueryPriceLevelsRequest, opts ...grpc.CallOption) (*QueryPriceLevelsResponse, error) {
+	out := new(QueryPriceLevelsResponse)
+	err := c.cc.Invoke(ctx, Query_PriceLevels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -115,12 +140,17 @@ type QueryServer interface {
 	TotalBurned(context.Context, *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error)
 	// Calculate returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
 	Calculate(context.Context, *QueryCalculateRequest) (*QueryCalculateResponse, error)
+	// CalculateBreakdown returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
+	// split by the price levels the burn spreads over.
+	CalculateBreakdown(context.Context, *QueryCalculateBreakdownRequest) (*QueryCalculateBreakdownResponse, error)
 	// CalculateForApplication returns the estimated amount of aHAQQ coins to be minted for given BurnApplication ID.
 	CalculateForApplication(context.Context, *QueryCalculateForApplicationRequest) (*QueryCalculateForApplicationResponse, error)
 	// GetApplications returns the paginated list of all registered applications.
 	GetApplications(context.Context, *QueryGetApplicationsRequest) (*QueryGetApplicationsResponse, error)
 	// GetSendersApplications returns the paginated list of all applications registered for a given sender_address.
 	GetSendersApplications(context.Context, *QueryGetSendersApplicationsRequest) (*QueryGetSendersApplicationsResponse, error)
+	// PriceLevels returns the aISLM to aHAQQ burn price curve.
+	PriceLevels(context.Context, *QueryPriceLevelsRequest) (*QueryPriceLevelsResponse, error)
 	// Params queries the parameters of x/ethiq module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -136,6 +166,9 @@ func (UnimplementedQueryServer) TotalBurned(context.Context, *QueryTotalBurnedRe
 func (UnimplementedQueryServer) Calculate(context.Context, *QueryCalculateRequest) (*QueryCalculateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Calculate not implemented")
 }
+func (UnimplementedQueryServer) CalculateBreakdown(context.Context, *QueryCalculateBreakdownRequest) (*QueryCalculateBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateBreakdown not implemented")
+}
 func (UnimplementedQueryServer) CalculateForApplication(context.Context, *QueryCalculateForApplicationRequest) (*QueryCalculateForApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateForApplication not implemented")
 }
@@ -145,6 +178,9 @@ func (UnimplementedQueryServer) GetApplications(context.Context, *QueryGetApplic
 func (UnimplementedQueryServer) GetSendersApplications(context.Context, *QueryGetSendersApplicationsRequest) (*QueryGetSendersApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSendersApplications not implemented")
 }
+func (UnimplementedQueryServer) PriceLevels(context.Context, *QueryPriceLevelsRequest) (*QueryPriceLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLevels not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -197,6 +233,24 @@ func _Query_Calculate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CalculateBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculateBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculateBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CalculateBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculateBreakdown(ctx, req.(*QueryCalculateBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculateForApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCalculateForApplicationRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +305,24 @@ func _Query_GetSendersApplications_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PriceLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLevels(ctx, req.(*QueryPriceLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -284,6 +356,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Calculate",
 			Handler:    _Query_Calculate_Handler,
 		},
+		{
+			MethodName: "CalculateBreakdown",
+			Handler:    _Query_CalculateBreakdown_Handler,
+		},
 		{
 			MethodName: "CalculateForApplication",
 			Handler:    _Query_CalculateForApplication_Handler,
@@ -296,6 +372,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSendersApplications",
 			Handler:    _Query_GetSendersApplications_Handler,
 		},
+		{
+			MethodName: "PriceLevels",
+			Handler:    _Query_PriceLevels_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_MintHaqq_FullMethodName              = "/haqq.ethiq.v1.Msg/MintHaqq"
-	Msg_MintHaqqByApplication_FullMethodName = "/haqq.ethiq.v1.Msg/MintHaqqByApplication"
+	Msg_MintHaqq_FullMethodName               = "/haqq.ethiq.v1.Msg/MintHaqq"
+	Msg_MintHaqqByApplication_FullMethodName  = "/haqq.ethiq.v1.Msg/MintHaqqByApplication"
+	Msg_UpdatePriceLevels_FullMethodName      = "/haqq.ethiq.v1.Msg/UpdatePriceLevels"
+	Msg_SubmitBurnApplication_FullMethodName  = "/haqq.ethiq.v1.Msg/SubmitBurnApplication"
+	Msg_CancelBurnApplication_FullMethodName  = "/haqq.ethiq.v1.Msg/CancelBurnApplication"
+	Msg_ApproveBurnApplication_FullMethodName = "/haqq.ethiq.v1.Msg/ApproveBurnApplication"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +36,16 @@ type MsgClient interface {
 	// MintHaqqByApplication defines a method to mint aHAQQ coins in exchange for aISLM coins
 	// trough whitelisted application submitted earlier via smart-contract
 	MintHaqqByApplication(ctx context.Context, in *MsgMintHaqqByApplication, opts ...grpc.CallOption) (*MsgMintHaqqByApplicationResponse, error)
+	// UpdatePriceLevels defines a governance operation for replacing the aISLM to aHAQQ burn price curve.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePriceLevels(ctx context.Context, in *MsgUpdatePriceLevels, opts ...grpc.CallOption) (*MsgUpdatePriceLevelsResponse, error)
+	// SubmitBurnApplication defines a method to register a new burn application
+	SubmitBurnApplication(ctx context.Context, in *MsgSubmitBurnApplication, opts ...grpc.CallOption) (*MsgSubmitBurnApplicationResponse, error)
+	// CancelBurnApplication defines a method to cancel a burn application which is not executed yet
+	CancelBurnApplication(ctx context.Context, in *MsgCancelBurnApplication, opts ...grpc.CallOption) (*MsgCancelBurnApplicationResponse, error)
+	// ApproveBurnApplication defines a governance operation for approving a submitted burn application.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ApproveBurnApplication(ctx context.Context, in *MsgApproveBurnApplication, opts ...grpc.CallOption) (*MsgApproveBurnApplicationResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +74,42 @@ func (c *msgClient) MintHaqqByApplication(ctx context.Context, in *MsgMintHaqqBy
 	return out, nil
 }
 
+func (c *msgClient) UpdatePriceLevels(ctx context.Context, in *MsgUpdatePriceLevels, opts ...grpc.CallOption) (*MsgUpdatePriceLevelsResponse, error) {
+	out := new(MsgUpdatePriceLevelsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePriceLevels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBurnApplication(ctx context.Context, in *MsgSubmitBurnApplication, opts ...grpc.CallOption) (*MsgSubmitBurnApplicationResponse, error) {
+	out := new(MsgSubmitBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitBurnApplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBurnApplication(ctx context.Context, in *MsgCancelBurnApplication, opts ...grpc.CallOption) (*MsgCancelBurnApplicationResponse, error) {
+	out := new(MsgCancelBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelBurnApplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveBurnApplication(ctx context.Context, in *MsgApproveBurnApplication, opts ...grpc.CallOption) (*MsgApproveBurnApplicationResponse, error) {
+	out := new(MsgApproveBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveBurnApplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +119,16 @@ type MsgServer interface {
 	// MintHaqqByApplication defines a method to mint aHAQQ coins in exchange for aISLM coins
 	// trough whitelisted application submitted earlier via smart-contract
 	MintHaqqByApplication(context.Context, *MsgMintHaqqByApplication) (*MsgMintHaqqByApplicationResponse, error)
+	// UpdatePriceLevels defines a governance operation for replacing the aISLM to aHAQQ burn price curve.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePriceLevels(context.Context, *MsgUpdatePriceLevels) (*MsgUpdatePriceLevelsResponse, error)
+	// SubmitBurnApplication defines a method to register a new burn application
+	SubmitBurnApplication(context.Context, *MsgSubmitBurnApplication) (*MsgSubmitBurnApplicationResponse, error)
+	// CancelBurnApplication defines a method to cancel a burn application which is not executed yet
+	CancelBurnApplication(context.Context, *MsgCancelBurnApplication) (*MsgCancelBurnApplicationResponse, error)
+	// ApproveBurnApplication defines a governance operation for approving a submitted burn application.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ApproveBurnApplication(context.Context, *MsgApproveBurnApplication) (*MsgApproveBurnApplicationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +142,18 @@ func (UnimplementedMsgServer) MintHaqq(context.Context, *MsgMintHaqq) (*MsgMintH
 func (UnimplementedMsgServer) MintHaqqByApplication(context.Context, *MsgMintHaqqByApplication) (*MsgMintHaqqByApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintHaqqByApplication not implemented")
 }
+func (UnimplementedMsgServer) UpdatePriceLevels(context.Context, *MsgUpdatePriceLevels) (*MsgUpdatePriceLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceLevels not implemented")
+}
+func (UnimplementedMsgServer) SubmitBurnApplication(context.Context, *MsgSubmitBurnApplication) (*MsgSubmitBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBurnApplication not implemented")
+}
+func (UnimplementedMsgServer) CancelBurnApplication(context.Context, *MsgCancelBurnApplication) (*MsgCancelBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBurnApplication not implemented")
+}
+func (UnimplementedMsgServer) ApproveBurnApplication(context.Context, *MsgApproveBurnApplication) (*MsgApproveBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBurnApplication not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +203,78 @@ func _Msg_MintHaqqByApplication_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePriceLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceLevels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePriceLevels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceLevels(ctx, req.(*MsgUpdatePriceLevels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitBurnApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBurnApplication(ctx, req.(*MsgSubmitBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelBurnApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBurnApplication(ctx, req.(*MsgCancelBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveBurnApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveBurnApplication(ctx, req.(*MsgApproveBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +290,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintHaqqByApplication",
 			Handler:    _Msg_MintHaqqByApplication_Handler,
 		},
+		{
+			MethodName: "UpdatePriceLevels",
+			Handler:    _Msg_UpdatePriceLevels_Handler,
+		},
+		{
+			MethodName: "SubmitBurnApplication",
+			Handler:    _Msg_SubmitBurnApplication_Handler,
+		},
+		{
+			MethodName: "CancelBurnApplication",
+			Handler:    _Msg_CancelBurnApplication_Handler,
+		},
+		{
+			MethodName: "ApproveBurnApplication",
+			Handler:    _Msg_ApproveBurnApplication_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/ethiq/v1/tx.proto",
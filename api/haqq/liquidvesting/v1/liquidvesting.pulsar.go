@@ -67,14 +67,67 @@ func (x *_Denom_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Denom_7_list)(nil)
+
+type _Denom_7_list struct {
+	list *[]*v1beta1.Period
+}
+
+func (x *_Denom_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Denom_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Denom_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Period)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Denom_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Period)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Denom_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Period)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Denom_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Denom_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Period)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Denom_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Denom                protoreflect.MessageDescriptor
-	fd_Denom_base_denom     protoreflect.FieldDescriptor
-	fd_Denom_display_denom  protoreflect.FieldDescriptor
-	fd_Denom_original_denom protoreflect.FieldDescriptor
-	fd_Denom_start_time     protoreflect.FieldDescriptor
-	fd_Denom_end_time       protoreflect.FieldDescriptor
-	fd_Denom_lockup_periods protoreflect.FieldDescriptor
+	md_Denom                 protoreflect.MessageDescriptor
+	fd_Denom_base_denom      protoreflect.FieldDescriptor
+	fd_Denom_display_denom   protoreflect.FieldDescriptor
+	fd_Denom_original_denom  protoreflect.FieldDescriptor
+	fd_Denom_start_time      protoreflect.FieldDescriptor
+	fd_Denom_end_time        protoreflect.FieldDescriptor
+	fd_Denom_lockup_periods  protoreflect.FieldDescriptor
+	fd_Denom_vesting_periods protoreflect.FieldDescriptor
+	fd_Denom_funder_address  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -86,6 +139,8 @@ func init() {
 	fd_Denom_start_time = md_Denom.Fields().ByName("start_time")
 	fd_Denom_end_time = md_Denom.Fields().ByName("end_time")
 	fd_Denom_lockup_periods = md_Denom.Fields().ByName("lockup_periods")
+	fd_Denom_vesting_periods = md_Denom.Fields().ByName("vesting_periods")
+	fd_Denom_funder_address = md_Denom.Fields().ByName("funder_address")
 }
 
 var _ protoreflect.Message = (*fastReflection_Denom)(nil)
@@ -189,6 +244,18 @@ func (x *fastReflection_Denom) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if len(x.VestingPeriods) != 0 {
+		value := protoreflect.ValueOfList(&_Denom_7_list{list: &x.VestingPeriods})
+		if !f(fd_Denom_vesting_periods, value) {
+			return
+		}
+	}
+	if x.FunderAddress != "" {
+		value := protoreflect.ValueOfString(x.FunderAddress)
+		if !f(fd_Denom_funder_address, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -216,6 +283,10 @@ func (x *fastReflection_Denom) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EndTime != nil
 	case "haqq.liquidvesting.v1.Denom.lockup_periods":
 		return len(x.LockupPeriods) != 0
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		return len(x.VestingPeriods) != 0
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		return x.FunderAddress != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -244,6 +315,10 @@ func (x *fastReflection_Denom) Clear(fd protoreflect.FieldDescriptor) {
 		x.EndTime = nil
 	case "haqq.liquidvesting.v1.Denom.lockup_periods":
 		x.LockupPeriods = nil
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		x.VestingPeriods = nil
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		x.FunderAddress = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -281,6 +356,15 @@ func (x *fastReflection_Denom) Get(descriptor protoreflect.FieldDescriptor) prot
 		}
 		listValue := &_Denom_6_list{list: &x.LockupPeriods}
 		return protoreflect.ValueOfList(listValue)
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		if len(x.VestingPeriods) == 0 {
+			return protoreflect.ValueOfList(&_Denom_7_list{})
+		}
+		listValue := &_Denom_7_list{list: &x.VestingPeriods}
+		return protoreflect.ValueOfList(listValue)
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		value := x.FunderAddress
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -315,6 +399,12 @@ func (x *fastReflection_Denom) Set(fd protoreflect.FieldDescriptor, value protor
 		lv := value.List()
 		clv := lv.(*_Denom_6_list)
 		x.LockupPeriods = *clv.list
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		lv := value.List()
+		clv := lv.(*_Denom_7_list)
+		x.VestingPeriods = *clv.list
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		x.FunderAddress = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -351,12 +441,20 @@ func (x *fastReflection_Denom) Mutable(fd protoreflect.FieldDescriptor) protoref
 		}
 		value := &_Denom_6_list{list: &x.LockupPeriods}
 		return protoreflect.ValueOfList(value)
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		if x.VestingPeriods == nil {
+			x.VestingPeriods = []*v1beta1.Period{}
+		}
+		value := &_Denom_7_list{list: &x.VestingPeriods}
+		return protoreflect.ValueOfList(value)
 	case "haqq.liquidvesting.v1.Denom.base_denom":
 		panic(fmt.Errorf("field base_denom of message haqq.liquidvesting.v1.Denom is not mutable"))
 	case "haqq.liquidvesting.v1.Denom.display_denom":
 		panic(fmt.Errorf("field display_denom of message haqq.liquidvesting.v1.Denom is not mutable"))
 	case "haqq.liquidvesting.v1.Denom.original_denom":
 		panic(fmt.Errorf("field original_denom of message haqq.liquidvesting.v1.Denom is not mutable"))
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		panic(fmt.Errorf("field funder_address of message haqq.liquidvesting.v1.Denom is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -385,6 +483,11 @@ func (x *fastReflection_Denom) NewField(fd protoreflect.FieldDescriptor) protore
 	case "haqq.liquidvesting.v1.Denom.lockup_periods":
 		list := []*v1beta1.Period{}
 		return protoreflect.ValueOfList(&_Denom_6_list{list: &list})
+	case "haqq.liquidvesting.v1.Denom.vesting_periods":
+		list := []*v1beta1.Period{}
+		return protoreflect.ValueOfList(&_Denom_7_list{list: &list})
+	case "haqq.liquidvesting.v1.Denom.funder_address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.Denom"))
@@ -480,6 +583,16 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.VestingPeriods) > 0 {
+			for _, e := range x.VestingPeriods {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FunderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -509,6 +622,29 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FunderAddress) > 0 {
+			i -= len(x.FunderAddress)
+			copy(dAtA[i:], x.FunderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunderAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.VestingPeriods) > 0 {
+			for iNdEx := len(x.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingPeriods[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.LockupPeriods) > 0 {
 			for iNdEx := len(x.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.LockupPeriods[iNdEx])
@@ -825,6 +961,72 @@ func (x *fastReflection_Denom) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingPeriods = append(x.VestingPeriods, &v1beta1.Period{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingPeriods[len(x.VestingPeriods)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -891,6 +1093,12 @@ type Denom struct {
 	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	// lockup periods
 	LockupPeriods []*v1beta1.Period `protobuf:"bytes,6,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods,omitempty"`
+	// vesting periods, empty for denoms whose coins are all vested
+	VestingPeriods []*v1beta1.Period `protobuf:"bytes,7,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods,omitempty"`
+	// funder_address of the vesting account the coins were liquidated from, set
+	// for denoms which carry unvested coins. Redeem applies the vesting schedule
+	// with this funder, so the unvested coins stay within its clawback reach.
+	FunderAddress string `protobuf:"bytes,8,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
 }
 
 func (x *Denom) Reset() {
@@ -955,6 +1163,20 @@ func (x *Denom) GetLockupPeriods() []*v1beta1.Period {
 	return nil
 }
 
+func (x *Denom) GetVestingPeriods() []*v1beta1.Period {
+	if x != nil {
+		return x.VestingPeriods
+	}
+	return nil
+}
+
+func (x *Denom) GetFunderAddress() string {
+	if x != nil {
+		return x.FunderAddress
+	}
+	return ""
+}
+
 var File_haqq_liquidvesting_v1_liquidvesting_proto protoreflect.FileDescriptor
 
 var file_haqq_liquidvesting_v1_liquidvesting_proto_rawDesc = []byte{
@@ -969,7 +1191,7 @@ var file_haqq_liquidvesting_v1_liquidvesting_proto_rawDesc = []byte{
 	0x74, 0x61, 0x31, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb3, 0x03, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x22, 0xec, 0x04, 0x0a, 0x05, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
 	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x64,
 	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
@@ -994,23 +1216,35 @@ var file_haqq_liquidvesting_v1_liquidvesting_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x69,
 	0x6f, 0x64, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x3a, 0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x68, 0x61,
-	0x71, 0x71, 0x2f, 0x78, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x2f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0xdd, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d,
-	0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68,
-	0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x4c, 0x58, 0xaa, 0x02, 0x15, 0x48, 0x61, 0x71,
-	0x71, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x15, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x48, 0x61, 0x71,
-	0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x17, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x3a, 0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x78, 0x2f, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x42, 0xdd, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42,
+	0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x48, 0x4c, 0x58, 0xaa, 0x02, 0x15, 0x48, 0x61, 0x71, 0x71, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x48, 0x61,
+	0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1035,11 +1269,12 @@ var file_haqq_liquidvesting_v1_liquidvesting_proto_depIdxs = []int32{
 	1, // 0: haqq.liquidvesting.v1.Denom.start_time:type_name -> google.protobuf.Timestamp
 	1, // 1: haqq.liquidvesting.v1.Denom.end_time:type_name -> google.protobuf.Timestamp
 	2, // 2: haqq.liquidvesting.v1.Denom.lockup_periods:type_name -> cosmos.vesting.v1beta1.Period
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 3: haqq.liquidvesting.v1.Denom.vesting_periods:type_name -> cosmos.vesting.v1beta1.Period
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_haqq_liquidvesting_v1_liquidvesting_proto_init() }
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Denom_FullMethodName  = "/haqq.liquidvesting.v1.Query/Denom"
-	Query_Denoms_FullMethodName = "/haqq.liquidvesting.v1.Query/Denoms"
+	Query_Denom_FullMethodName                  = "/haqq.liquidvesting.v1.Query/Denom"
+	Query_Denoms_FullMethodName                 = "/haqq.liquidvesting.v1.Query/Denoms"
+	Query_DenomSchedule_FullMethodName          = "/haqq.liquidvesting.v1.Query/DenomSchedule"
+	Query_AccountLiquidPositions_FullMethodName = "/haqq.liquidvesting.v1.Query/AccountLiquidPositions"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,12 @@ type QueryClient interface {
 	Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error)
 	// Denoms queries liquid vesting tokens info
 	Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	// DenomSchedule queries liquid vesting token schedule resolved at current
+	// block time
+	DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error)
+	// AccountLiquidPositions queries liquid vesting tokens held by account with
+	// their schedules resolved at current block time
+	AccountLiquidPositions(ctx context.Context, in *QueryAccountLiquidPositionsRequest, opts ...grpc.CallOption) (*QueryAccountLiquidPositionsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +67,24 @@ func (c *queryClient) Denoms(ctx context.Context, in *QueryDenomsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error) {
+	out := new(QueryDenomScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_DenomSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLiquidPositions(ctx context.Context, in *QueryAccountLiquidPositionsRequest, opts ...grpc.CallOption) (*QueryAccountLiquidPositionsResponse, error) {
+	out := new(QueryAccountLiquidPositionsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLiquidPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +93,12 @@ type QueryServer interface {
 	Denom(context.Context, *QueryDenomRequest) (*QueryDenomResponse, error)
 	// Denoms queries liquid vesting tokens info
 	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
+	// DenomSchedule queries liquid vesting token schedule resolved at current
+	// block time
+	DenomSchedule(context.Context, *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error)
+	// AccountLiquidPositions queries liquid vesting tokens held by account with
+	// their schedules resolved at current block time
+	AccountLiquidPositions(context.Context, *QueryAccountLiquidPositionsRequest) (*QueryAccountLiquidPositionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +112,12 @@ func (UnimplementedQueryServer) Denom(context.Context, *QueryDenomRequest) (*Que
 func (UnimplementedQueryServer) Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
 }
+func (UnimplementedQueryServer) DenomSchedule(context.Context, *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSchedule not implemented")
+}
+func (UnimplementedQueryServer) AccountLiquidPositions(context.Context, *QueryAccountLiquidPositionsRequest) (*QueryAccountLiquidPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLiquidPositions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +167,42 @@ func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSchedule(ctx, req.(*QueryDenomScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLiquidPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLiquidPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLiquidPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLiquidPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLiquidPositions(ctx, req.(*QueryAccountLiquidPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +218,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Denoms",
 			Handler:    _Query_Denoms_Handler,
 		},
+		{
+			MethodName: "DenomSchedule",
+			Handler:    _Query_DenomSchedule_Handler,
+		},
+		{
+			MethodName: "AccountLiquidPositions",
+			Handler:    _Query_AccountLiquidPositions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/liquidvesting/v1/query.proto",
@@ -1998,6 +1998,2189 @@ func (x *fastReflection_MsgRedeemResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgMergeLiquid_3_list)(nil)
+
+type _MsgMergeLiquid_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgMergeLiquid_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgMergeLiquid_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgMergeLiquid_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgMergeLiquid_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgMergeLiquid_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgMergeLiquid_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgMergeLiquid_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgMergeLiquid_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgMergeLiquid            protoreflect.MessageDescriptor
+	fd_MsgMergeLiquid_merge_from protoreflect.FieldDescriptor
+	fd_MsgMergeLiquid_merge_to   protoreflect.FieldDescriptor
+	fd_MsgMergeLiquid_amounts    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_liquidvesting_v1_tx_proto_init()
+	md_MsgMergeLiquid = File_haqq_liquidvesting_v1_tx_proto.Messages().ByName("MsgMergeLiquid")
+	fd_MsgMergeLiquid_merge_from = md_MsgMergeLiquid.Fields().ByName("merge_from")
+	fd_MsgMergeLiquid_merge_to = md_MsgMergeLiquid.Fields().ByName("merge_to")
+	fd_MsgMergeLiquid_amounts = md_MsgMergeLiquid.Fields().ByName("amounts")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMergeLiquid)(nil)
+
+type fastReflection_MsgMergeLiquid MsgMergeLiquid
+
+func (x *MsgMergeLiquid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMergeLiquid)(x)
+}
+
+func (x *MsgMergeLiquid) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMergeLiquid_messageType fastReflection_MsgMergeLiquid_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMergeLiquid_messageType{}
+
+type fastReflection_MsgMergeLiquid_messageType struct{}
+
+func (x fastReflection_MsgMergeLiquid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMergeLiquid)(nil)
+}
+func (x fastReflection_MsgMergeLiquid_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMergeLiquid)
+}
+func (x fastReflection_MsgMergeLiquid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMergeLiquid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMergeLiquid) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMergeLiquid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMergeLiquid) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMergeLiquid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMergeLiquid) New() protoreflect.Message {
+	return new(fastReflection_MsgMergeLiquid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMergeLiquid) Interface() protoreflect.ProtoMessage {
+	return (*MsgMergeLiquid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMergeLiquid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MergeFrom != "" {
+		value := protoreflect.ValueOfString(x.MergeFrom)
+		if !f(fd_MsgMergeLiquid_merge_from, value) {
+			return
+		}
+	}
+	if x.MergeTo != "" {
+		value := protoreflect.ValueOfString(x.MergeTo)
+		if !f(fd_MsgMergeLiquid_merge_to, value) {
+			return
+		}
+	}
+	if len(x.Amounts) != 0 {
+		value := protoreflect.ValueOfList(&_MsgMergeLiquid_3_list{list: &x.Amounts})
+		if !f(fd_MsgMergeLiquid_amounts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMergeLiquid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		return x.MergeFrom != ""
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		return x.MergeTo != ""
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		return len(x.Amounts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		x.MergeFrom = ""
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		x.MergeTo = ""
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		x.Amounts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMergeLiquid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		value := x.MergeFrom
+		return protoreflect.ValueOfString(value)
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		value := x.MergeTo
+		return protoreflect.ValueOfString(value)
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		if len(x.Amounts) == 0 {
+			return protoreflect.ValueOfList(&_MsgMergeLiquid_3_list{})
+		}
+		listValue := &_MsgMergeLiquid_3_list{list: &x.Amounts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		x.MergeFrom = value.Interface().(string)
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		x.MergeTo = value.Interface().(string)
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		lv := value.List()
+		clv := lv.(*_MsgMergeLiquid_3_list)
+		x.Amounts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		if x.Amounts == nil {
+			x.Amounts = []*v1beta1.Coin{}
+		}
+		value := &_MsgMergeLiquid_3_list{list: &x.Amounts}
+		return protoreflect.ValueOfList(value)
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		panic(fmt.Errorf("field merge_from of message haqq.liquidvesting.v1.MsgMergeLiquid is not mutable"))
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		panic(fmt.Errorf("field merge_to of message haqq.liquidvesting.v1.MsgMergeLiquid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMergeLiquid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_from":
+		return protoreflect.ValueOfString("")
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.merge_to":
+		return protoreflect.ValueOfString("")
+	case "haqq.liquidvesting.v1.MsgMergeLiquid.amounts":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgMergeLiquid_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMergeLiquid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.liquidvesting.v1.MsgMergeLiquid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMergeLiquid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMergeLiquid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMergeLiquid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMergeLiquid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MergeFrom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeTo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amounts) > 0 {
+			for _, e := range x.Amounts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMergeLiquid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amounts) > 0 {
+			for iNdEx := len(x.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MergeTo) > 0 {
+			i -= len(x.MergeTo)
+			copy(dAtA[i:], x.MergeTo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeTo)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MergeFrom) > 0 {
+			i -= len(x.MergeFrom)
+			copy(dAtA[i:], x.MergeFrom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeFrom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMergeLiquid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMergeLiquid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMergeLiquid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MergeFrom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MergeFrom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MergeTo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MergeTo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amounts = append(x.Amounts, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amounts[len(x.Amounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgMergeLiquidResponse               protoreflect.MessageDescriptor
+	fd_MsgMergeLiquidResponse_minted        protoreflect.FieldDescriptor
+	fd_MsgMergeLiquidResponse_contract_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_liquidvesting_v1_tx_proto_init()
+	md_MsgMergeLiquidResponse = File_haqq_liquidvesting_v1_tx_proto.Messages().ByName("MsgMergeLiquidResponse")
+	fd_MsgMergeLiquidResponse_minted = md_MsgMergeLiquidResponse.Fields().ByName("minted")
+	fd_MsgMergeLiquidResponse_contract_addr = md_MsgMergeLiquidResponse.Fields().ByName("contract_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgMergeLiquidResponse)(nil)
+
+type fastReflection_MsgMergeLiquidResponse MsgMergeLiquidResponse
+
+func (x *MsgMergeLiquidResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgMergeLiquidResponse)(x)
+}
+
+func (x *MsgMergeLiquidResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgMergeLiquidResponse_messageType fastReflection_MsgMergeLiquidResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgMergeLiquidResponse_messageType{}
+
+type fastReflection_MsgMergeLiquidResponse_messageType struct{}
+
+func (x fastReflection_MsgMergeLiquidResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgMergeLiquidResponse)(nil)
+}
+func (x fastReflection_MsgMergeLiquidResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgMergeLiquidResponse)
+}
+func (x fastReflection_MsgMergeLiquidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMergeLiquidResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgMergeLiquidResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgMergeLiquidResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgMergeLiquidResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgMergeLiquidResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgMergeLiquidResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgMergeLiquidResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgMergeLiquidResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgMergeLiquidResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgMergeLiquidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Minted != nil {
+		value := protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+		if !f(fd_MsgMergeLiquidResponse_minted, value) {
+			return
+		}
+	}
+	if x.ContractAddr != "" {
+		value := protoreflect.ValueOfString(x.ContractAddr)
+		if !f(fd_MsgMergeLiquidResponse_contract_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgMergeLiquidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		return x.Minted != nil
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		return x.ContractAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquidResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		x.Minted = nil
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		x.ContractAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgMergeLiquidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		value := x.Minted
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		value := x.ContractAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		x.Minted = value.Message().Interface().(*v1beta1.Coin)
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		x.ContractAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		if x.Minted == nil {
+			x.Minted = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		panic(fmt.Errorf("field contract_addr of message haqq.liquidvesting.v1.MsgMergeLiquidResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgMergeLiquidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgMergeLiquidResponse.contract_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgMergeLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgMergeLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgMergeLiquidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.liquidvesting.v1.MsgMergeLiquidResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgMergeLiquidResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgMergeLiquidResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgMergeLiquidResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgMergeLiquidResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgMergeLiquidResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Minted != nil {
+			l = options.Size(x.Minted)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMergeLiquidResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractAddr) > 0 {
+			i -= len(x.ContractAddr)
+			copy(dAtA[i:], x.ContractAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Minted != nil {
+			encoded, err := options.Marshal(x.Minted)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgMergeLiquidResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMergeLiquidResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgMergeLiquidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Minted == nil {
+					x.Minted = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minted); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSplitLiquid            protoreflect.MessageDescriptor
+	fd_MsgSplitLiquid_split_from protoreflect.FieldDescriptor
+	fd_MsgSplitLiquid_split_to   protoreflect.FieldDescriptor
+	fd_MsgSplitLiquid_amount     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_liquidvesting_v1_tx_proto_init()
+	md_MsgSplitLiquid = File_haqq_liquidvesting_v1_tx_proto.Messages().ByName("MsgSplitLiquid")
+	fd_MsgSplitLiquid_split_from = md_MsgSplitLiquid.Fields().ByName("split_from")
+	fd_MsgSplitLiquid_split_to = md_MsgSplitLiquid.Fields().ByName("split_to")
+	fd_MsgSplitLiquid_amount = md_MsgSplitLiquid.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSplitLiquid)(nil)
+
+type fastReflection_MsgSplitLiquid MsgSplitLiquid
+
+func (x *MsgSplitLiquid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSplitLiquid)(x)
+}
+
+func (x *MsgSplitLiquid) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSplitLiquid_messageType fastReflection_MsgSplitLiquid_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSplitLiquid_messageType{}
+
+type fastReflection_MsgSplitLiquid_messageType struct{}
+
+func (x fastReflection_MsgSplitLiquid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSplitLiquid)(nil)
+}
+func (x fastReflection_MsgSplitLiquid_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSplitLiquid)
+}
+func (x fastReflection_MsgSplitLiquid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSplitLiquid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSplitLiquid) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSplitLiquid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSplitLiquid) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSplitLiquid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSplitLiquid) New() protoreflect.Message {
+	return new(fastReflection_MsgSplitLiquid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSplitLiquid) Interface() protoreflect.ProtoMessage {
+	return (*MsgSplitLiquid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSplitLiquid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SplitFrom != "" {
+		value := protoreflect.ValueOfString(x.SplitFrom)
+		if !f(fd_MsgSplitLiquid_split_from, value) {
+			return
+		}
+	}
+	if x.SplitTo != "" {
+		value := protoreflect.ValueOfString(x.SplitTo)
+		if !f(fd_MsgSplitLiquid_split_to, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_MsgSplitLiquid_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSplitLiquid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		return x.SplitFrom != ""
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		return x.SplitTo != ""
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		x.SplitFrom = ""
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		x.SplitTo = ""
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSplitLiquid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		value := x.SplitFrom
+		return protoreflect.ValueOfString(value)
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		value := x.SplitTo
+		return protoreflect.ValueOfString(value)
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		x.SplitFrom = value.Interface().(string)
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		x.SplitTo = value.Interface().(string)
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		panic(fmt.Errorf("field split_from of message haqq.liquidvesting.v1.MsgSplitLiquid is not mutable"))
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		panic(fmt.Errorf("field split_to of message haqq.liquidvesting.v1.MsgSplitLiquid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSplitLiquid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_from":
+		return protoreflect.ValueOfString("")
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.split_to":
+		return protoreflect.ValueOfString("")
+	case "haqq.liquidvesting.v1.MsgSplitLiquid.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquid"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSplitLiquid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.liquidvesting.v1.MsgSplitLiquid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSplitLiquid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSplitLiquid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSplitLiquid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSplitLiquid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SplitFrom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SplitTo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSplitLiquid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.SplitTo) > 0 {
+			i -= len(x.SplitTo)
+			copy(dAtA[i:], x.SplitTo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SplitTo)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SplitFrom) > 0 {
+			i -= len(x.SplitFrom)
+			copy(dAtA[i:], x.SplitFrom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SplitFrom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSplitLiquid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSplitLiquid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSplitLiquid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SplitFrom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SplitFrom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SplitTo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SplitTo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSplitLiquidResponse               protoreflect.MessageDescriptor
+	fd_MsgSplitLiquidResponse_minted        protoreflect.FieldDescriptor
+	fd_MsgSplitLiquidResponse_contract_addr protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_haqq_liquidvesting_v1_tx_proto_init()
+	md_MsgSplitLiquidResponse = File_haqq_liquidvesting_v1_tx_proto.Messages().ByName("MsgSplitLiquidResponse")
+	fd_MsgSplitLiquidResponse_minted = md_MsgSplitLiquidResponse.Fields().ByName("minted")
+	fd_MsgSplitLiquidResponse_contract_addr = md_MsgSplitLiquidResponse.Fields().ByName("contract_addr")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSplitLiquidResponse)(nil)
+
+type fastReflection_MsgSplitLiquidResponse MsgSplitLiquidResponse
+
+func (x *MsgSplitLiquidResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSplitLiquidResponse)(x)
+}
+
+func (x *MsgSplitLiquidResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSplitLiquidResponse_messageType fastReflection_MsgSplitLiquidResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSplitLiquidResponse_messageType{}
+
+type fastReflection_MsgSplitLiquidResponse_messageType struct{}
+
+func (x fastReflection_MsgSplitLiquidResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSplitLiquidResponse)(nil)
+}
+func (x fastReflection_MsgSplitLiquidResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSplitLiquidResponse)
+}
+func (x fastReflection_MsgSplitLiquidResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSplitLiquidResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSplitLiquidResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSplitLiquidResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSplitLiquidResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSplitLiquidResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSplitLiquidResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSplitLiquidResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSplitLiquidResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSplitLiquidResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSplitLiquidResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Minted != nil {
+		value := protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+		if !f(fd_MsgSplitLiquidResponse_minted, value) {
+			return
+		}
+	}
+	if x.ContractAddr != "" {
+		value := protoreflect.ValueOfString(x.ContractAddr)
+		if !f(fd_MsgSplitLiquidResponse_contract_addr, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSplitLiquidResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		return x.Minted != nil
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		return x.ContractAddr != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquidResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		x.Minted = nil
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		x.ContractAddr = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSplitLiquidResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		value := x.Minted
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		value := x.ContractAddr
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquidResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		x.Minted = value.Message().Interface().(*v1beta1.Coin)
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		x.ContractAddr = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquidResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		if x.Minted == nil {
+			x.Minted = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Minted.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		panic(fmt.Errorf("field contract_addr of message haqq.liquidvesting.v1.MsgSplitLiquidResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSplitLiquidResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "haqq.liquidvesting.v1.MsgSplitLiquidResponse.contract_addr":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: haqq.liquidvesting.v1.MsgSplitLiquidResponse"))
+		}
+		panic(fmt.Errorf("message haqq.liquidvesting.v1.MsgSplitLiquidResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSplitLiquidResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in haqq.liquidvesting.v1.MsgSplitLiquidResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSplitLiquidResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSplitLiquidResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSplitLiquidResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSplitLiquidResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSplitLiquidResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Minted != nil {
+			l = options.Size(x.Minted)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSplitLiquidResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractAddr) > 0 {
+			i -= len(x.ContractAddr)
+			copy(dAtA[i:], x.ContractAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddr)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Minted != nil {
+			encoded, err := options.Marshal(x.Minted)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSplitLiquidResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSplitLiquidResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSplitLiquidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Minted == nil {
+					x.Minted = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minted); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddr = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -2195,6 +4378,210 @@ func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
 	return file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP(), []int{3}
 }
 
+// MsgMergeLiquid represents message to merge several liquid tokens into a new
+// one
+type MsgMergeLiquid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account holding liquid tokens subject for merge
+	MergeFrom string `protobuf:"bytes,1,opt,name=merge_from,json=mergeFrom,proto3" json:"merge_from,omitempty"`
+	// account to send resulted liquid token
+	MergeTo string `protobuf:"bytes,2,opt,name=merge_to,json=mergeTo,proto3" json:"merge_to,omitempty"`
+	// amounts of liquid tokens subject for merge, at least two different denoms
+	Amounts []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amounts,proto3" json:"amounts,omitempty"`
+}
+
+func (x *MsgMergeLiquid) Reset() {
+	*x = MsgMergeLiquid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMergeLiquid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMergeLiquid) ProtoMessage() {}
+
+// Deprecated: Use MsgMergeLiquid.ProtoReflect.Descriptor instead.
+func (*MsgMergeLiquid) Descriptor() ([]byte, []int) {
+	return file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgMergeLiquid) GetMergeFrom() string {
+	if x != nil {
+		return x.MergeFrom
+	}
+	return ""
+}
+
+func (x *MsgMergeLiquid) GetMergeTo() string {
+	if x != nil {
+		return x.MergeTo
+	}
+	return ""
+}
+
+func (x *MsgMergeLiquid) GetAmounts() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+// MsgMergeLiquidResponse defines the Msg/MergeLiquid response type
+type MsgMergeLiquidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount of liquid tokens minted
+	Minted *v1beta1.Coin `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted,omitempty"`
+	// address of erc20 the merged liquid denom contract
+	ContractAddr string `protobuf:"bytes,2,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+}
+
+func (x *MsgMergeLiquidResponse) Reset() {
+	*x = MsgMergeLiquidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgMergeLiquidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMergeLiquidResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgMergeLiquidResponse.ProtoReflect.Descriptor instead.
+func (*MsgMergeLiquidResponse) Descriptor() ([]byte, []int) {
+	return file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgMergeLiquidResponse) GetMinted() *v1beta1.Coin {
+	if x != nil {
+		return x.Minted
+	}
+	return nil
+}
+
+func (x *MsgMergeLiquidResponse) GetContractAddr() string {
+	if x != nil {
+		return x.ContractAddr
+	}
+	return ""
+}
+
+// MsgSplitLiquid represents message to split arbitrary amount of liquid token
+// into a new one
+type MsgSplitLiquid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account holding liquid token subject for split
+	SplitFrom string `protobuf:"bytes,1,opt,name=split_from,json=splitFrom,proto3" json:"split_from,omitempty"`
+	// account to send resulted liquid token
+	SplitTo string `protobuf:"bytes,2,opt,name=split_to,json=splitTo,proto3" json:"split_to,omitempty"`
+	// amount of liquid token subject for split
+	Amount *v1beta1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgSplitLiquid) Reset() {
+	*x = MsgSplitLiquid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSplitLiquid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSplitLiquid) ProtoMessage() {}
+
+// Deprecated: Use MsgSplitLiquid.ProtoReflect.Descriptor instead.
+func (*MsgSplitLiquid) Descriptor() ([]byte, []int) {
+	return file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgSplitLiquid) GetSplitFrom() string {
+	if x != nil {
+		return x.SplitFrom
+	}
+	return ""
+}
+
+func (x *MsgSplitLiquid) GetSplitTo() string {
+	if x != nil {
+		return x.SplitTo
+	}
+	return ""
+}
+
+func (x *MsgSplitLiquid) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// MsgSplitLiquidResponse defines the Msg/SplitLiquid response type
+type MsgSplitLiquidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount of liquid tokens minted
+	Minted *v1beta1.Coin `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted,omitempty"`
+	// address of erc20 the split liquid denom contract
+	ContractAddr string `protobuf:"bytes,2,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+}
+
+func (x *MsgSplitLiquidResponse) Reset() {
+	*x = MsgSplitLiquidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_haqq_liquidvesting_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSplitLiquidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSplitLiquidResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSplitLiquidResponse.ProtoReflect.Descriptor instead.
+func (*MsgSplitLiquidResponse) Descriptor() ([]byte, []int) {
+	return file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgSplitLiquidResponse) GetMinted() *v1beta1.Coin {
+	if x != nil {
+		return x.Minted
+	}
+	return nil
+}
+
+func (x *MsgSplitLiquidResponse) GetContractAddr() string {
+	if x != nil {
+		return x.ContractAddr
+	}
+	return ""
+}
+
 var File_haqq_liquidvesting_v1_tx_proto protoreflect.FileDescriptor
 
 var file_haqq_liquidvesting_v1_tx_proto_rawDesc = []byte{
@@ -2243,7 +4630,50 @@ var file_haqq_liquidvesting_v1_tx_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x78, 0x2f, 0x6c, 0x69,
 	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x4d, 0x73, 0x67, 0x52,
 	0x65, 0x64, 0x65, 0x65, 0x6d, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x64, 0x65,
-	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x99, 0x02, 0x0a, 0x03, 0x4d,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0e, 0x4d,
+	0x73, 0x67, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x6a, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x78, 0x2f,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x4d, 0x73,
+	0x67, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x16,
+	0x4d, 0x73, 0x67, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x69,
+	0x6e, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x4d, 0x73,
+	0x67, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x6f, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x78,
+	0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x4d,
+	0x73, 0x67, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x22, 0x7b, 0x0a,
+	0x16, 0x4d, 0x73, 0x67, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x32, 0xc5, 0x04, 0x0a, 0x03, 0x4d,
 	0x73, 0x67, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x12, 0x23, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x69, 0x71, 0x75,
@@ -2260,22 +4690,40 @@ var file_haqq_liquidvesting_v1_tx_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f,
 	0x68, 0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x1a,
-	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x68,
+	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12,
+	0x93, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x12,
+	0x25, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x1a, 0x2d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x22, 0x26, 0x2f,
+	0x68, 0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x25, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x1a, 0x2d, 0x2e, 0x68,
 	0x61, 0x71, 0x71, 0x2e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x4c, 0x58, 0xaa, 0x02, 0x15,
-	0x48, 0x61, 0x71, 0x71, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21,
-	0x48, 0x61, 0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x17, 0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x22, 0x26, 0x2f, 0x68, 0x61, 0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x1a, 0x05, 0x80, 0xe7, 0xb0,
+	0x2a, 0x01, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x71, 0x71, 0x2e,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61,
+	0x71, 0x71, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x4c, 0x58, 0xaa, 0x02, 0x15, 0x48, 0x61, 0x71, 0x71,
+	0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x15, 0x48, 0x61, 0x71, 0x71, 0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x48, 0x61, 0x71, 0x71,
+	0x5c, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x48, 0x61, 0x71, 0x71, 0x3a, 0x3a, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2290,27 +4738,39 @@ func file_haqq_liquidvesting_v1_tx_proto_rawDescGZIP() []byte {
 	return file_haqq_liquidvesting_v1_tx_proto_rawDescData
 }
 
-var file_haqq_liquidvesting_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_haqq_liquidvesting_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_haqq_liquidvesting_v1_tx_proto_goTypes = []interface{}{
-	(*MsgLiquidate)(nil),         // 0: haqq.liquidvesting.v1.MsgLiquidate
-	(*MsgLiquidateResponse)(nil), // 1: haqq.liquidvesting.v1.MsgLiquidateResponse
-	(*MsgRedeem)(nil),            // 2: haqq.liquidvesting.v1.MsgRedeem
-	(*MsgRedeemResponse)(nil),    // 3: haqq.liquidvesting.v1.MsgRedeemResponse
-	(*v1beta1.Coin)(nil),         // 4: cosmos.base.v1beta1.Coin
+	(*MsgLiquidate)(nil),           // 0: haqq.liquidvesting.v1.MsgLiquidate
+	(*MsgLiquidateResponse)(nil),   // 1: haqq.liquidvesting.v1.MsgLiquidateResponse
+	(*MsgRedeem)(nil),              // 2: haqq.liquidvesting.v1.MsgRedeem
+	(*MsgRedeemResponse)(nil),      // 3: haqq.liquidvesting.v1.MsgRedeemResponse
+	(*MsgMergeLiquid)(nil),         // 4: haqq.liquidvesting.v1.MsgMergeLiquid
+	(*MsgMergeLiquidResponse)(nil), // 5: haqq.liquidvesting.v1.MsgMergeLiquidResponse
+	(*MsgSplitLiquid)(nil),         // 6: haqq.liquidvesting.v1.MsgSplitLiquid
+	(*MsgSplitLiquidResponse)(nil), // 7: haqq.liquidvesting.v1.MsgSplitLiquidResponse
+	(*v1beta1.Coin)(nil),           // 8: cosmos.base.v1beta1.Coin
 }
 var file_haqq_liquidvesting_v1_tx_proto_depIdxs = []int32{
-	4, // 0: haqq.liquidvesting.v1.MsgLiquidate.amount:type_name -> cosmos.base.v1beta1.Coin
-	4, // 1: haqq.liquidvesting.v1.MsgLiquidateResponse.minted:type_name -> cosmos.base.v1beta1.Coin
-	4, // 2: haqq.liquidvesting.v1.MsgRedeem.amount:type_name -> cosmos.base.v1beta1.Coin
-	0, // 3: haqq.liquidvesting.v1.Msg.Liquidate:input_type -> haqq.liquidvesting.v1.MsgLiquidate
-	2, // 4: haqq.liquidvesting.v1.Msg.Redeem:input_type -> haqq.liquidvesting.v1.MsgRedeem
-	1, // 5: haqq.liquidvesting.v1.Msg.Liquidate:output_type -> haqq.liquidvesting.v1.MsgLiquidateResponse
-	3, // 6: haqq.liquidvesting.v1.Msg.Redeem:output_type -> haqq.liquidvesting.v1.MsgRedeemResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: haqq.liquidvesting.v1.MsgLiquidate.amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 1: haqq.liquidvesting.v1.MsgLiquidateResponse.minted:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 2: haqq.liquidvesting.v1.MsgRedeem.amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 3: haqq.liquidvesting.v1.MsgMergeLiquid.amounts:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 4: haqq.liquidvesting.v1.MsgMergeLiquidResponse.minted:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 5: haqq.liquidvesting.v1.MsgSplitLiquid.amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 6: haqq.liquidvesting.v1.MsgSplitLiquidResponse.minted:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 7: haqq.liquidvesting.v1.Msg.Liquidate:input_type -> haqq.liquidvesting.v1.MsgLiquidate
+	2,  // 8: haqq.liquidvesting.v1.Msg.Redeem:input_type -> haqq.liquidvesting.v1.MsgRedeem
+	4,  // 9: haqq.liquidvesting.v1.Msg.MergeLiquid:input_type -> haqq.liquidvesting.v1.MsgMergeLiquid
+	6,  // 10: haqq.liquidvesting.v1.Msg.SplitLiquid:input_type -> haqq.liquidvesting.v1.MsgSplitLiquid
+	1,  // 11: haqq.liquidvesting.v1.Msg.Liquidate:output_type -> haqq.liquidvesting.v1.MsgLiquidateResponse
+	3,  // 12: haqq.liquidvesting.v1.Msg.Redeem:output_type -> haqq.liquidvesting.v1.MsgRedeemResponse
+	5,  // 13: haqq.liquidvesting.v1.Msg.MergeLiquid:output_type -> haqq.liquidvesting.v1.MsgMergeLiquidResponse
+	7,  // 14: haqq.liquidvesting.v1.Msg.SplitLiquid:output_type -> haqq.liquidvesting.v1.MsgSplitLiquidResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_haqq_liquidvesting_v1_tx_proto_init() }
@@ -2367,6 +4827,54 @@ func file_haqq_liquidvesting_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_haqq_liquidvesting_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMergeLiquid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_liquidvesting_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgMergeLiquidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_liquidvesting_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSplitLiquid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_haqq_liquidvesting_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSplitLiquidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2374,7 +4882,7 @@ func file_haqq_liquidvesting_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_haqq_liquidvesting_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
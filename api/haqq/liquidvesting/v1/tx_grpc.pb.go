@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Liquidate_FullMethodName = "/haqq.liquidvesting.v1.Msg/Liquidate"
-	Msg_Redeem_FullMethodName    = "/haqq.liquidvesting.v1.Msg/Redeem"
+	Msg_Liquidate_FullMethodName   = "/haqq.liquidvesting.v1.Msg/Liquidate"
+	Msg_Redeem_FullMethodName      = "/haqq.liquidvesting.v1.Msg/Redeem"
+	Msg_MergeLiquid_FullMethodName = "/haqq.liquidvesting.v1.Msg/MergeLiquid"
+	Msg_SplitLiquid_FullMethodName = "/haqq.liquidvesting.v1.Msg/SplitLiquid"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +35,12 @@ type MsgClient interface {
 	// Redeem burns liquid token and deposits corresponding amount of vesting
 	// token to the specified account
 	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	// MergeLiquid burns several liquid tokens and mints a new liquid token with
+	// the schedule combined from the burnt ones
+	MergeLiquid(ctx context.Context, in *MsgMergeLiquid, opts ...grpc.CallOption) (*MsgMergeLiquidResponse, error)
+	// SplitLiquid burns part of liquid token and mints a new liquid token with
+	// the proportional part of its schedule
+	SplitLiquid(ctx context.Context, in *MsgSplitLiquid, opts ...grpc.CallOption) (*MsgSplitLiquidResponse, error)
 }
 
 type msgClient struct {
@@ -61,6 +69,24 @@ func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) MergeLiquid(ctx context.Context, in *MsgMergeLiquid, opts ...grpc.CallOption) (*MsgMergeLiquidResponse, error) {
+	out := new(MsgMergeLiquidResponse)
+	err := c.cc.Invoke(ctx, Msg_MergeLiquid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitLiquid(ctx context.Context, in *MsgSplitLiquid, opts ...grpc.CallOption) (*MsgSplitLiquidResponse, error) {
+	out := new(MsgSplitLiquidResponse)
+	err := c.cc.Invoke(ctx, Msg_SplitLiquid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -71,6 +97,12 @@ type MsgServer interface {
 	// Redeem burns liquid token and deposits corresponding amount of vesting
 	// token to the specified account
 	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	// MergeLiquid burns several liquid tokens and mints a new liquid token with
+	// the schedule combined from the burnt ones
+	MergeLiquid(context.Context, *MsgMergeLiquid) (*MsgMergeLiquidResponse, error)
+	// SplitLiquid burns part of liquid token and mints a new liquid token with
+	// the proportional part of its schedule
+	SplitLiquid(context.Context, *MsgSplitLiquid) (*MsgSplitLiquidResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -84,6 +116,12 @@ func (UnimplementedMsgServer) Liquidate(context.Context, *MsgLiquidate) (*MsgLiq
 func (UnimplementedMsgServer) Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
 }
+func (UnimplementedMsgServer) MergeLiquid(context.Context, *MsgMergeLiquid) (*MsgMergeLiquidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLiquid not implemented")
+}
+func (UnimplementedMsgServer) SplitLiquid(context.Context, *MsgSplitLiquid) (*MsgSplitLiquidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLiquid not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -133,6 +171,42 @@ func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MergeLiquid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeLiquid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeLiquid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MergeLiquid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeLiquid(ctx, req.(*MsgMergeLiquid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitLiquid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLiquid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLiquid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SplitLiquid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLiquid(ctx, req.(*MsgSplitLiquid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -148,6 +222,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Redeem",
 			Handler:    _Msg_Redeem_Handler,
 		},
+		{
+			MethodName: "MergeLiquid",
+			Handler:    _Msg_MergeLiquid_Handler,
+		},
+		{
+			MethodName: "SplitLiquid",
+			Handler:    _Msg_SplitLiquid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/liquidvesting/v1/tx.proto",
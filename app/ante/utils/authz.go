@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+// maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message
+const maxNestedMsgs = 7
+
+// DisabledAuthzMsgTypes are the Msg types that cannot be granted or included on an
+// authz.MsgExec msgs field.
+var DisabledAuthzMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
+// CheckDisabledAuthzMsgs iterates through the msgs and returns an error if it finds
+// an authz MsgGrant or MsgExec for any of the disabled msg types.
+func CheckDisabledAuthzMsgs(msgs []sdk.Msg, disabledMsgTypes []string) error {
+	return checkDisabledMsgs(msgs, disabledMsgTypes, false, 1)
+}
+
+// checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
+//
+// When isAuthzInnerMsg is disabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
+// Otherwise, any msg matching the disabled types are blocked, regardless of being in an authz msg or not.
+//
+// This method is recursive as MsgExec's can wrap other MsgExecs. The check for nested messages is performed up to the
+// maxNestedMsgs threshold. If there are more than that limit, it returns an error
+func checkDisabledMsgs(msgs []sdk.Msg, disabledMsgTypes []string, isAuthzInnerMsg bool, nestedLvl int) error {
+	if nestedLvl >= maxNestedMsgs {
+		return fmt.Errorf("found more nested msgs than permitted. Limit is : %d", maxNestedMsgs)
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			nestedLvl++
+			if err := checkDisabledMsgs(innerMsgs, disabledMsgTypes, true, nestedLvl); err != nil {
+				return err
+			}
+		case *authz.MsgGrant:
+			authorization, err := msg.GetAuthorization()
+			if err != nil {
+				return err
+			}
+
+			url := authorization.MsgTypeURL()
+			if isDisabledMsg(disabledMsgTypes, url) {
+				return fmt.Errorf("found disabled msg type: %s", url)
+			}
+		default:
+			url := sdk.MsgTypeURL(msg)
+			if isAuthzInnerMsg && isDisabledMsg(disabledMsgTypes, url) {
+				return fmt.Errorf("found disabled msg type: %s", url)
+			}
+		}
+	}
+	return nil
+}
+
+// isDisabledMsg returns true if the given message is in the list of restricted
+// messages from the AnteHandler.
+func isDisabledMsg(disabledMsgTypes []string, msgTypeURL string) bool {
+	for _, disabledType := range disabledMsgTypes {
+		if msgTypeURL == disabledType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var ErrCommunitySpendingComingLater = errorsmod.Register("haqq-ante", 6001, "community pool spend coming later")
+
+// ValidateCommunityPoolSpend returns an error if the given message is a governance
+// proposal spending the community pool, which is not enabled on Haqq yet.
+func ValidateCommunityPoolSpend(msg sdk.Msg) error {
+	isValid := true
+
+	switch msg := msg.(type) {
+	case *govv1beta1.MsgSubmitProposal:
+		if strings.HasSuffix(msg.Content.TypeUrl, "CommunityPoolSpendProposal") {
+			isValid = false
+		}
+	case *govv1.MsgExecLegacyContent:
+		if strings.HasSuffix(msg.Content.TypeUrl, "CommunityPoolSpendProposal") {
+			isValid = false
+		}
+	case *govv1.MsgSubmitProposal:
+		proposalMsgs, err := msg.GetMsgs()
+		if err != nil {
+			return errorsmod.Wrap(err, "proposal contains invalid message(s)")
+		}
+		for _, proposalMsg := range proposalMsgs {
+			if _, ok := proposalMsg.(*distrtypes.MsgCommunityPoolSpend); ok {
+				isValid = false
+				break
+			}
+		}
+	}
+
+	if !isValid {
+		return ErrCommunitySpendingComingLater
+	}
+
+	return nil
+}
@@ -165,6 +165,7 @@ import (
 	ucdaokeeper "github.com/haqq-network/haqq/x/ucdao/keeper"
 	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
 
+	v1100 "github.com/haqq-network/haqq/app/upgrades/v1.10.0"
 	v190 "github.com/haqq-network/haqq/app/upgrades/v1.9.0"
 	v191 "github.com/haqq-network/haqq/app/upgrades/v1.9.1"
 	v192 "github.com/haqq-network/haqq/app/upgrades/v1.9.2"
@@ -499,13 +500,6 @@ func NewHaqq(
 		stakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), govConfig, authAddr,
 	)
 
-	// Haqq Keeper
-	app.CoinomicsKeeper = coinomicskeeper.NewKeeper(
-		keys[coinomicstypes.StoreKey], appCodec, app.GetSubspace(coinomicstypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper,
-		authtypes.FeeCollectorName,
-	)
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	// NOTE: Distr, Slashing and Claim must be created before calling the Hooks method to avoid returning a Keeper without its table generated
@@ -531,7 +525,7 @@ func NewHaqq(
 
 	app.LiquidVestingKeeper = liquidvestingkeeper.NewKeeper(
 		keys[liquidvestingtypes.StoreKey], appCodec, app.GetSubspace(liquidvestingtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, &app.Erc20Keeper, app.VestingKeeper,
+		app.AccountKeeper, &haqqBankKeeper, &app.Erc20Keeper, app.VestingKeeper,
 	)
 
 	app.DaoKeeper = ucdaokeeper.NewBaseKeeper(
@@ -547,6 +541,7 @@ func NewHaqq(
 	app.EthiqKeeper = ethiqkeeper.NewKeeper(
 		keys[ethiqtypes.StoreKey],
 		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.GetSubspace(ethiqtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -555,6 +550,12 @@ func NewHaqq(
 		app.DaoKeeper,
 	)
 
+	app.CoinomicsKeeper = coinomicskeeper.NewKeeper(
+		keys[coinomicstypes.StoreKey], appCodec, app.GetSubspace(coinomicstypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper,
+		app.DaoKeeper,
+	)
+
 	// Initialize the packet forward middleware Keeper
 	// It's important to note that the PFM Keeper must be initialized before the Transfer Keeper
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
@@ -578,7 +579,10 @@ func NewHaqq(
 
 	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
-		epochskeeper.NewMultiEpochHooks(), // < insert epoch hooks receivers here
+		epochskeeper.NewMultiEpochHooks(
+			// insert epoch hooks receivers here
+			app.CoinomicsKeeper.Hooks(),
+		),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -599,6 +603,11 @@ func NewHaqq(
 			app.EthiqKeeper,
 			app.DaoKeeper,
 			app.LiquidVestingKeeper,
+			app.GovKeeper,
+			app.SlashingKeeper,
+			app.CoinomicsKeeper,
+			app.EpochsKeeper,
+			appCodec,
 		),
 	)
 
@@ -712,7 +721,7 @@ func NewHaqq(
 		// Haqq app modules
 		coinomics.NewAppModule(app.CoinomicsKeeper, app.AccountKeeper, *app.StakingKeeper.Keeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper.Keeper),
-		liquidvesting.NewAppModule(appCodec, app.LiquidVestingKeeper, app.AccountKeeper, app.BankKeeper, &app.Erc20Keeper),
+		liquidvesting.NewAppModule(appCodec, app.LiquidVestingKeeper, app.AccountKeeper, &haqqBankKeeper, &app.Erc20Keeper),
 		ucdao.NewAppModule(appCodec, app.DaoKeeper, app.GetSubspace(ucdaotypes.ModuleName)),
 		ethiq.NewAppModule(app.EthiqKeeper, app.AccountKeeper),
 	)
@@ -1307,6 +1316,12 @@ func (app *Haqq) setupUpgradeHandlers() {
 		v194.CreateUpgradeHandler(app.mm, app.configurator, app.EvmKeeper),
 	)
 
+	// v1.10.0 Migrate module state and add new precompiles
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1100.UpgradeName,
+		v1100.CreateUpgradeHandler(app.mm, app.configurator, app.EvmKeeper),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
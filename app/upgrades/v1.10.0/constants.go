@@ -0,0 +1,4 @@
+package v1100
+
+// UpgradeName is the shared upgrade plan name for mainnet
+const UpgradeName = "v1.10.0"
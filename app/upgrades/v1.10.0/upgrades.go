@@ -0,0 +1,62 @@
+package v1100
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmkeeper "github.com/haqq-network/haqq/x/evm/keeper"
+	"github.com/haqq-network/haqq/x/evm/types"
+)
+
+// newPrecompiles are the static precompiles enabled by the upgrade
+var newPrecompiles = []string{
+	types.VestingPrecompileAddress,
+	types.GovPrecompileAddress,
+	types.CoinomicsPrecompileAddress,
+	types.SlashingPrecompileAddress,
+	types.AuthzPrecompileAddress,
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for Haqq v1.10.0
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ek *evmkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// run the v1.10.0 migrations:
+		// - ethiq v1 -> v2: price levels moved into the store
+		// - coinomics v1 -> v2: mint epoch identifier param
+		// - coinomics v2 -> v3: mint allocation table param
+		// - liquidvesting v1 -> v2: cleanup of the liquid denoms exhausted by redeem
+		// - ethiq v2 -> v3: burn applications waitlist moved into the store
+		logger.Info("Running module migrations...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// Enable the new precompiles
+		var addPrecompiles []common.Address
+		params := ek.GetParams(ctx)
+		for _, hexAddr := range newPrecompiles {
+			addr := common.HexToAddress(hexAddr)
+			if !ek.IsAvailableStaticPrecompile(&params, addr) {
+				addPrecompiles = append(addPrecompiles, addr)
+			}
+		}
+
+		if len(addPrecompiles) > 0 {
+			err = ek.EnableStaticPrecompiles(ctx, addPrecompiles...)
+		}
+
+		return vm, err
+	}
+}
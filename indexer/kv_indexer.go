@@ -1,7 +1,9 @@
 package indexer
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -13,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	rpctypes "github.com/haqq-network/haqq/rpc/types"
 	haqqtypes "github.com/haqq-network/haqq/types"
@@ -22,9 +25,20 @@ import (
 const (
 	KeyPrefixTxHash  = 1
 	KeyPrefixTxIndex = 2
+	// KeyPrefixLogAddress and KeyPrefixLogTopic prefix the log index entries:
+	// `(address, height, tx index, log index)` and `(topic position, topic, height, tx index, log index)`.
+	KeyPrefixLogAddress = 3
+	KeyPrefixLogTopic   = 4
+	// KeyPrefixLogBlock prefixes the markers of the blocks covered by the log index.
+	KeyPrefixLogBlock = 5
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// LogBlockKeyLength is the length of log-block key
+	LogBlockKeyLength = 1 + 8
+
+	// maxLogTopics is the maximum number of topics of an EVM log (LOG4).
+	maxLogTopics = 4
 )
 
 var _ haqqtypes.EVMTxIndexer = &KVIndexer{}
@@ -46,6 +60,7 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the log index entries of the addresses and topics of every emitted log
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Header.Height
 
@@ -113,6 +128,22 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
+
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		logs, err := txLogsFromEvents(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to parse logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		if err := saveLogs(batch, height, logs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set log-block key", height)
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
@@ -130,6 +161,123 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// FirstLogIndexedBlock returns the first block number covered by the log index, returns -1 if none
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	return LoadFirstLogBlock(kv.db)
+}
+
+// LastLogIndexedBlock returns the latest block number covered by the log index, returns -1 if none
+func (kv *KVIndexer) LastLogIndexedBlock() (int64, error) {
+	return LoadLastLogBlock(kv.db)
+}
+
+// GetLogHeights returns, in ascending order, the heights within [from, to] of the blocks holding
+// logs that may match the given addresses and topics, along with the last height served by the
+// log index. The heights after it are not covered by the index and need to be scanned. It returns
+// from-1 as the last height if the index can't serve the range, or if there are no criteria.
+//
+// The criteria are matched per block, so the caller still has to filter the logs of the returned
+// blocks. The log index is expected to cover a contiguous range of blocks, as the indexer does.
+func (kv *KVIndexer) GetLogHeights(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, int64, error) {
+	hasCriteria := len(addresses) > 0
+	for _, sub := range topics {
+		hasCriteria = hasCriteria || len(sub) > 0
+	}
+	if !hasCriteria {
+		return nil, from - 1, nil
+	}
+
+	first, err := kv.FirstLogIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+	last, err := kv.LastLogIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+	if first == -1 || from < first || from > last {
+		return nil, from - 1, nil
+	}
+	if to > last {
+		to = last
+	}
+
+	// nil means that no criteria has been applied yet
+	var matched map[int64]struct{}
+	if len(addresses) > 0 {
+		prefixes := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			prefixes[i] = append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+		}
+		if matched, err = kv.loadLogHeights(prefixes, from, to); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	for pos, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		if pos >= maxLogTopics {
+			// no log has that many topics
+			return nil, to, nil
+		}
+
+		prefixes := make([][]byte, len(sub))
+		for i, topic := range sub {
+			prefixes[i] = append([]byte{KeyPrefixLogTopic, byte(pos)}, topic.Bytes()...)
+		}
+		heights, err := kv.loadLogHeights(prefixes, from, to)
+		if err != nil {
+			return nil, 0, err
+		}
+		if matched == nil {
+			matched = heights
+			continue
+		}
+		for height := range matched {
+			if _, ok := heights[height]; !ok {
+				delete(matched, height)
+			}
+		}
+	}
+
+	res := make([]int64, 0, len(matched))
+	for height := range matched {
+		res = append(res, height)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, to, nil
+}
+
+// loadLogHeights returns the set of heights within [from, to] of the log index entries under any of
+// the given prefixes.
+func (kv *KVIndexer) loadLogHeights(prefixes [][]byte, from, to int64) (map[int64]struct{}, error) {
+	heights := make(map[int64]struct{})
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint: gosec // G115 from can't be negative here
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint: gosec // G115 to can't be negative here
+
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "loadLogHeights")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			//nolint: gosec // G115 height can't overflow in normal conditions
+			heights[int64(sdk.BigEndianToUint64(key[len(prefix):len(prefix)+8]))] = struct{}{}
+		}
+		if err := it.Close(); err != nil {
+			return nil, errorsmod.Wrap(err, "loadLogHeights")
+		}
+	}
+	return heights, nil
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*haqqtypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -170,6 +318,23 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogAddressKey returns the key for db entry: `(address, block number, tx index, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, txIndex, logIndex uint) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, logPositionBytes(blockNumber, txIndex, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, tx index, log index) -> nil`
+func LogTopicKey(position uint8, topic common.Hash, blockNumber int64, txIndex, logIndex uint) []byte {
+	key := append([]byte{KeyPrefixLogTopic, position}, topic.Bytes()...)
+	return append(key, logPositionBytes(blockNumber, txIndex, logIndex)...)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, marking the block as log indexed
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint: gosec // G115 blockNumber can't overflow in normal conditions
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -196,6 +361,32 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// LoadLastLogBlock returns the latest log indexed block number, returns -1 if none
+func LoadLastLogBlock(db dbm.DB) (int64, error) {
+	it, err := db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LoadLastLogBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromLogBlockKey(it.Key())
+}
+
+// LoadFirstLogBlock returns the first log indexed block number, returns -1 if none
+func LoadFirstLogBlock(db dbm.DB) (int64, error) {
+	it, err := db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LoadFirstLogBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseBlockNumberFromLogBlockKey(it.Key())
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -221,6 +412,61 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveLogs index the addresses and topics of the logs into the kv db batch
+func saveLogs(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, txLog := range logs {
+		if err := batch.Set(LogAddressKey(txLog.Address, height, txLog.TxIndex, txLog.Index), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for i, topic := range txLog.Topics {
+			if i >= maxLogTopics {
+				break
+			}
+			if err := batch.Set(LogTopicKey(uint8(i), topic, height, txLog.TxIndex, txLog.Index), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// txLogsFromEvents parses the ethereum logs of all the messages of a tx from its events
+func txLogsFromEvents(events []abci.Event) ([]*ethtypes.Log, error) {
+	var logs []*evmtypes.Log
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+			var txLog evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &txLog)
+		}
+	}
+	return evmtypes.LogsToEthereum(logs), nil
+}
+
+// logPositionBytes returns the `(block number, tx index, log index)` suffix of the log index keys
+func logPositionBytes(blockNumber int64, txIndex, logIndex uint) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint: gosec // G115 blockNumber can't overflow in normal conditions
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(txIndex))...)
+	return append(bz, sdk.Uint64ToBigEndian(uint64(logIndex))...)
+}
+
+func parseBlockNumberFromLogBlockKey(key []byte) (int64, error) {
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+
+	//nolint: gosec // G115 blockNumber can't overflow in normal conditions
+	return int64(sdk.BigEndianToUint64(key[1:9])), nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -188,3 +189,109 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{To: &to, GasLimit: 100000})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	addrA, addrB := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	topic1, topic2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+
+	// buildResult returns the result of the eth tx emitting the given logs
+	buildResult := func(logs ...*ethtypes.Log) *abci.ExecTxResult {
+		attrs := make([]abci.EventAttribute, len(logs))
+		for i, ethLog := range logs {
+			bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+			require.NoError(t, err)
+			attrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+		return &abci.ExecTxResult{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "50000"},
+				}},
+				{Type: types.EventTypeTxLog, Attributes: attrs},
+			},
+		}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	// block 1: A(topic1), block 2: empty, block 3: A(), B(topic1, topic2)
+	require.NoError(t, idxer.IndexBlock(
+		&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+		[]*abci.ExecTxResult{buildResult(&ethtypes.Log{Address: addrA, Topics: []common.Hash{topic1}, BlockNumber: 1})},
+	))
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+	require.NoError(t, idxer.IndexBlock(
+		&cmttypes.Block{Header: cmttypes.Header{Height: 3}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+		[]*abci.ExecTxResult{buildResult(
+			&ethtypes.Log{Address: addrA, Topics: []common.Hash{}, BlockNumber: 3},
+			&ethtypes.Log{Address: addrB, Topics: []common.Hash{topic1, topic2}, BlockNumber: 3, Index: 1},
+		)},
+	))
+
+	first, err = idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		addresses  []common.Address
+		topics     [][]common.Hash
+		expHeights []int64
+		expLast    int64
+	}{
+		{"no criteria", 1, 3, nil, [][]common.Hash{nil}, nil, 0},
+		{"range not covered", 0, 3, []common.Address{addrA}, nil, nil, -1},
+		{"range after the index", 4, 5, []common.Address{addrA}, nil, nil, 3},
+		{"address", 1, 3, []common.Address{addrA}, nil, []int64{1, 3}, 3},
+		{"address in range", 2, 3, []common.Address{addrA}, nil, []int64{3}, 3},
+		{"addresses", 1, 3, []common.Address{addrA, addrB}, nil, []int64{1, 3}, 3},
+		{"topic", 1, 3, nil, [][]common.Hash{{topic1}}, []int64{1, 3}, 3},
+		{"topic in position", 1, 3, nil, [][]common.Hash{nil, {topic2}}, []int64{3}, 3},
+		{"topic not in position", 1, 3, nil, [][]common.Hash{{topic2}}, []int64{}, 3},
+		{"address and topics", 1, 3, []common.Address{addrB}, [][]common.Hash{{topic1}, {topic1, topic2}}, []int64{3}, 3},
+		{"to after the index", 1, 10, nil, [][]common.Hash{{topic1}}, []int64{1, 3}, 3},
+		{"too many topics", 1, 3, nil, [][]common.Hash{nil, nil, nil, nil, {topic1}}, nil, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heights, last, err := idxer.GetLogHeights(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeights, heights)
+			require.Equal(t, tc.expLast, last)
+		})
+	}
+}
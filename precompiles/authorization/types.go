@@ -156,6 +156,40 @@ func CheckAuthzExists(
 	return msgAuthz, expiration, nil
 }
 
+// CheckAndAcceptAuthz checks if the authorization for the given msg exists for the given granter
+// and accepts it, updating or deleting the grant as requested by the authorization.
+func CheckAndAcceptAuthz(
+	ctx sdk.Context,
+	authzKeeper authzkeeper.Keeper,
+	grantee, granter common.Address,
+	msg sdk.Msg,
+) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	msgAuthz, expiration, err := CheckAuthzExists(ctx, authzKeeper, grantee, granter, msgURL)
+	if err != nil {
+		return err
+	}
+
+	resp, err := msgAuthz.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return fmt.Errorf(ErrAuthzNotAccepted, msgURL, grantee)
+	}
+
+	if resp.Delete {
+		return authzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	}
+
+	if resp.Updated != nil {
+		return authzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), resp.Updated, expiration)
+	}
+
+	return nil
+}
+
 // CheckAuthzAndAllowanceForGranter checks if the authorization exists and is not expired for the
 // given spender and the allowance is not exceeded.
 // If the authorization has a limit, checks that the provided amount does not exceed the current limit.
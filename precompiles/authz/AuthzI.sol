@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The Authz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The Authz contract's instance.
+AuthzI constant AUTHZ_CONTRACT = AuthzI(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev The type URLs of the supported authorizations.
+string constant GENERIC_AUTHORIZATION = "/cosmos.authz.v1beta1.GenericAuthorization";
+string constant SEND_AUTHORIZATION = "/cosmos.bank.v1beta1.SendAuthorization";
+
+/// @dev GrantData represents an authorization granted by a granter to a grantee.
+/// @param granter the account that granted the authorization
+/// @param grantee the account that received the authorization
+/// @param authorizationType the type URL of the authorization
+/// @param msgTypeUrl the type URL of the message the authorization allows to execute
+/// @param spendLimit the remaining spend limit of a send authorization
+/// @param allowList the allowed recipients of a send authorization, empty if any recipient is allowed
+/// @param expiration the expiration time as a unix timestamp in seconds, 0 if the grant doesn't expire
+struct GrantData {
+  address granter;
+  address grantee;
+  string authorizationType;
+  string msgTypeUrl;
+  Coin[] spendLimit;
+  address[] allowList;
+  int64 expiration;
+}
+
+/// @author Haqq Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the authz module.
+/// The granter of grants and revocations and the grantee executing messages is always
+/// the caller of the precompile.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface AuthzI {
+  /// @dev Grant defines an Event emitted when an authorization is granted
+  /// @param granter The account that granted the authorization
+  /// @param grantee The account that received the authorization
+  /// @param msgTypeUrl The type URL of the message the authorization allows to execute
+  event Grant(
+    address indexed granter,
+    address indexed grantee,
+    string msgTypeUrl
+  );
+
+  /// @dev Revoke defines an Event emitted when an authorization is revoked
+  /// @param granter The account that revoked the authorization
+  /// @param grantee The account whose authorization was revoked
+  /// @param msgTypeUrl The type URL of the revoked message
+  event Revoke(
+    address indexed granter,
+    address indexed grantee,
+    string msgTypeUrl
+  );
+
+  /// @dev Exec defines an Event emitted when messages are executed on behalf of granters
+  /// @param grantee The account that executed the messages
+  /// @param msgTypeUrls The type URLs of the executed messages
+  event Exec(address indexed grantee, string[] msgTypeUrls);
+
+  /// @dev Grants a generic authorization to execute any message of the given type.
+  /// @param grantee The account receiving the authorization
+  /// @param msgTypeUrl The type URL of the message to authorize
+  /// @param expiration The expiration time as a unix timestamp in seconds, 0 for no expiration
+  /// @return success Whether the transaction was successful or not
+  function grant(
+    address grantee,
+    string calldata msgTypeUrl,
+    int64 expiration
+  ) external returns (bool success);
+
+  /// @dev Grants a send authorization limited to the given coins and recipients.
+  /// @param grantee The account receiving the authorization
+  /// @param spendLimit The coins the grantee is allowed to send
+  /// @param allowList The allowed recipients, empty to allow any recipient
+  /// @param expiration The expiration time as a unix timestamp in seconds, 0 for no expiration
+  /// @return success Whether the transaction was successful or not
+  function grantSend(
+    address grantee,
+    Coin[] calldata spendLimit,
+    address[] calldata allowList,
+    int64 expiration
+  ) external returns (bool success);
+
+  /// @dev Revokes the authorization of a grantee for the given message type.
+  /// @param grantee The account whose authorization is revoked
+  /// @param msgTypeUrl The type URL of the message to revoke
+  /// @return success Whether the transaction was successful or not
+  function revoke(
+    address grantee,
+    string calldata msgTypeUrl
+  ) external returns (bool success);
+
+  /// @dev Executes messages on behalf of their granters. Each message is JSON encoded
+  /// with its type URL in the "@type" field and must be signed by a granter other
+  /// than the caller. Messages invoking the EVM (e.g. Ethereum txs, ERC20 conversions
+  /// or IBC transfers) can't be executed.
+  /// @param msgs The JSON encoded messages
+  /// @return results The results of the executed messages
+  function exec(bytes[] calldata msgs) external returns (bytes[] memory results);
+
+  /// @dev Returns the grants of a granter to a grantee.
+  /// @param granter The account that granted the authorizations
+  /// @param grantee The account that received the authorizations
+  /// @param msgTypeUrl The type URL of the message, empty for all the grants
+  /// @param pagination The pagination options
+  /// @return grants The grants
+  /// @return pageResponse The pagination response
+  function getGrants(
+    address granter,
+    address grantee,
+    string calldata msgTypeUrl,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+  /// @dev Returns the grants given by a granter.
+  /// @param granter The account that granted the authorizations
+  /// @param pagination The pagination options
+  /// @return grants The grants
+  /// @return pageResponse The pagination response
+  function getGranterGrants(
+    address granter,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+  /// @dev Returns the grants received by a grantee.
+  /// @param grantee The account that received the authorizations
+  /// @param pagination The pagination options
+  /// @return grants The grants
+  /// @return pageResponse The pagination response
+  function getGranteeGrants(
+    address grantee,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
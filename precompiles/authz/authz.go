@@ -0,0 +1,154 @@
+package authz
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the authz module.
+type Precompile struct {
+	cmn.Precompile
+	codec codec.Codec
+}
+
+// NewPrecompile creates a new authz Precompile instance as a PrecompiledContract.
+// The codec is used to decode the executed messages.
+func NewPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	cdc codec.Codec,
+) (*Precompile, error) {
+	loadedAbi, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  loadedAbi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		codec: cdc,
+	}
+
+	// SetAddress defines the address of the authz precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.AuthzPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoids panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err, stateDB, snapshot)()
+
+	return p.RunAtomic(
+		snapshot,
+		stateDB,
+		func() ([]byte, error) {
+			switch method.Name {
+			// Authz transactions
+			case GrantMethod:
+				bz, err = p.Grant(ctx, contract, stateDB, method, args)
+			case GrantSendMethod:
+				bz, err = p.GrantSend(ctx, contract, stateDB, method, args)
+			case RevokeMethod:
+				bz, err = p.Revoke(ctx, contract, stateDB, method, args)
+			case ExecMethod:
+				bz, err = p.Exec(ctx, evm.Origin, contract, stateDB, method, args)
+			// Authz queries
+			case GetGrantsMethod:
+				bz, err = p.GetGrants(ctx, method, contract, args)
+			case GetGranterGrantsMethod:
+				bz, err = p.GetGranterGrants(ctx, method, contract, args)
+			case GetGranteeGrantsMethod:
+				bz, err = p.GetGranteeGrants(ctx, method, contract, args)
+			default:
+				return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			cost := ctx.GasMeter().GasConsumed() - initialGas
+
+			if !contract.UseGas(cost) {
+				return nil, vm.ErrOutOfGas
+			}
+
+			if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+				return nil, err
+			}
+
+			return bz, nil
+		},
+	)
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available authz transactions are:
+//   - Grant
+//   - GrantSend
+//   - Revoke
+//   - Exec
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case GrantMethod,
+		GrantSendMethod,
+		RevokeMethod,
+		ExecMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "authz")
+}
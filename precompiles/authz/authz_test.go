@@ -0,0 +1,43 @@
+package authz_test
+
+import (
+	"github.com/haqq-network/haqq/precompiles/authz"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method string
+		isTx   bool
+	}{
+		{authz.GrantMethod, s.precompile.Methods[authz.GrantMethod].Name, true},
+		{authz.GrantSendMethod, s.precompile.Methods[authz.GrantSendMethod].Name, true},
+		{authz.RevokeMethod, s.precompile.Methods[authz.RevokeMethod].Name, true},
+		{authz.ExecMethod, s.precompile.Methods[authz.ExecMethod].Name, true},
+		{authz.GetGrantsMethod, s.precompile.Methods[authz.GetGrantsMethod].Name, false},
+		{authz.GetGranterGrantsMethod, s.precompile.Methods[authz.GetGranterGrantsMethod].Name, false},
+		{authz.GetGranteeGrantsMethod, s.precompile.Methods[authz.GetGranteeGrantsMethod].Name, false},
+		{"invalid", "invalid", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(tc.method))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	for _, name := range []string{authz.GrantMethod, authz.GetGrantsMethod} {
+		s.Run(name, func() {
+			method := s.precompile.Methods[name]
+			// Use methodID + padding to test RequiredGas
+			input := append(method.ID, make([]byte, 32)...) //nolint: gocritic
+			s.Require().Positive(s.precompile.RequiredGas(input))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas_ShortInput() {
+	s.Require().Equal(uint64(0), s.precompile.RequiredGas([]byte{0x01}))
+}
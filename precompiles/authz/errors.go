@@ -0,0 +1,22 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is invalid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is invalid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is invalid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration is invalid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is invalid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list is invalid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidMsgs is raised when the executed messages are invalid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrInvalidMsgJSON is raised when a JSON encoded message can't be decoded.
+	ErrInvalidMsgJSON = "invalid message JSON at index %d: %v"
+	// ErrGranteeSignedMsg is raised when an executed message is signed by the grantee itself.
+	ErrGranteeSignedMsg = "message %s must be signed by a granter, not by the grantee %s"
+)
@@ -0,0 +1,103 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant and GrantSend transactions.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz Revoke transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeExec defines the event type for the authz Exec transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitGrantEvent creates a new event emitted on a Grant or GrantSend transaction.
+func (p Precompile) EmitGrantEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGranterGranteeEvent(ctx, stateDB, EventTypeGrant, granter, grantee, msgTypeURL)
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGranterGranteeEvent(ctx, stateDB, EventTypeRevoke, granter, grantee, msgTypeURL)
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(ctx sdk.Context, stateDB vm.StateDB, grantee common.Address, msgTypeURLs []string) error {
+	event := p.ABI.Events[EventTypeExec]
+
+	topics, err := makeTopics(event, grantee)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(msgTypeURLs)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// emitGranterGranteeEvent emits an event indexed by the granter and the grantee
+// with the message type URL as data.
+func (p Precompile) emitGranterGranteeEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+	msgTypeURL string,
+) error {
+	event := p.ABI.Events[eventType]
+
+	topics, err := makeTopics(event, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(msgTypeURL)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// makeTopics returns the topics of an event indexed by the given addresses.
+func makeTopics(event abi.Event, addrs ...common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(addrs)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, addr := range addrs {
+		topics[i+1], err = cmn.MakeTopic(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
+
+// addLog adds a precompile log with the given topics and data to the stateDB.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint: gosec // G115 blockHeight is positive int64 and can't overflow uint64
+	})
+}
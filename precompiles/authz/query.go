@@ -0,0 +1,93 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// GetGrantsMethod defines the ABI method name for the authz Grants query.
+	GetGrantsMethod = "getGrants"
+	// GetGranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GetGranterGrantsMethod = "getGranterGrants"
+	// GetGranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GetGranteeGrantsMethod = "getGranteeGrants"
+)
+
+// GetGrants returns the grants from the granter to the grantee, optionally
+// filtered by message type.
+func (p *Precompile) GetGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantsResponse(req, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetGranterGrants returns the grants issued by the granter.
+func (p *Precompile) GetGranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetGranteeGrants returns the grants received by the grantee.
+func (p *Precompile) GetGranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
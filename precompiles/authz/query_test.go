@@ -0,0 +1,123 @@
+package authz_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authz"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+)
+
+func (s *PrecompileTestSuite) TestGetGrants() {
+	method := s.precompile.Methods[authz.GetGrantsMethod]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetGrants(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0))
+	})
+
+	s.Run("fail - empty granter", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetGrants(s.network.GetContext(), &method, nil, []any{
+			common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{},
+		})
+		s.Require().ErrorContains(err, "invalid granter address")
+	})
+
+	s.Run("success - send authorization", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+		s.grantSend(ctx, granter, grantee, 100)
+
+		bz, err := s.precompile.GetGrants(ctx, &method, nil, []any{
+			granter, grantee, sendMsgTypeURL, query.PageRequest{},
+		})
+		s.Require().NoError(err)
+
+		var out authz.GrantsOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Grants, 1)
+		s.Require().Equal(granter, out.Grants[0].Granter)
+		s.Require().Equal(grantee, out.Grants[0].Grantee)
+		s.Require().Equal("/cosmos.bank.v1beta1.SendAuthorization", out.Grants[0].AuthorizationType)
+		s.Require().Equal(sendMsgTypeURL, out.Grants[0].MsgTypeURL)
+		s.Require().Equal(baseCoins(100), out.Grants[0].SpendLimit)
+		s.Require().Empty(out.Grants[0].AllowList)
+		s.Require().Zero(out.Grants[0].Expiration)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetGranterGrants() {
+	method := s.precompile.Methods[authz.GetGranterGrantsMethod]
+
+	s.Run("fail - empty granter", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetGranterGrants(s.network.GetContext(), &method, nil, []any{
+			common.Address{}, query.PageRequest{},
+		})
+		s.Require().ErrorContains(err, "invalid granter address")
+	})
+
+	s.Run("success - grants to two grantees with pagination", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		granter := s.keyring.GetAddr(0)
+		s.grantSend(ctx, granter, s.keyring.GetAddr(1), 100)
+		s.grantSend(ctx, granter, s.keyring.GetAddr(2), 200)
+
+		bz, err := s.precompile.GetGranterGrants(ctx, &method, nil, []any{
+			granter, query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out authz.GrantsOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Grants, 1)
+		s.Require().Equal(granter, out.Grants[0].Granter)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetGranteeGrants() {
+	method := s.precompile.Methods[authz.GetGranteeGrantsMethod]
+
+	s.Run("fail - empty grantee", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetGranteeGrants(s.network.GetContext(), &method, nil, []any{
+			common.Address{}, query.PageRequest{},
+		})
+		s.Require().ErrorContains(err, "invalid grantee address")
+	})
+
+	s.Run("success - grants from two granters", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		grantee := s.keyring.GetAddr(0)
+		s.grantSend(ctx, s.keyring.GetAddr(1), grantee, 100)
+		s.grantSend(ctx, s.keyring.GetAddr(2), grantee, 200)
+
+		bz, err := s.precompile.GetGranteeGrants(ctx, &method, nil, []any{
+			grantee, query.PageRequest{},
+		})
+		s.Require().NoError(err)
+
+		var out authz.GrantsOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Grants, 2)
+		for _, grant := range out.Grants {
+			s.Require().Equal(grantee, grant.Grantee)
+		}
+	})
+}
+
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
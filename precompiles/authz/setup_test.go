@@ -0,0 +1,42 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/haqq-network/haqq/precompiles/authz"
+	testkeyring "github.com/haqq-network/haqq/testutil/integration/haqq/keyring"
+	"github.com/haqq-network/haqq/testutil/integration/haqq/network"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	keyring    testkeyring.Keyring
+	precompile *authz.Precompile
+}
+
+func TestPrecompileUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	s.precompile, err = authz.NewPrecompile(
+		s.network.App.AuthzKeeper,
+		s.network.App.AppCodec(),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,273 @@
+package authz
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	anteutils "github.com/haqq-network/haqq/app/ante/utils"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz Grant transaction
+	// of a bank send authorization.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// ExecDisabledMsgTypes are the Msg types that can't be executed through the precompile.
+// On top of the Msg types disabled for authz, it includes every Msg type whose handler
+// invokes the EVM, as executing them from the EVM would re-enter it outside of the
+// journal of the running transaction. Contracts should call the corresponding
+// precompile (e.g. ICS20 or ERC20) instead.
+var ExecDisabledMsgTypes = append(
+	slices.Clone(anteutils.DisabledAuthzMsgTypes),
+	sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+)
+
+// Grant grants a generic authorization for the given message type from the caller to the grantee.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgGrant(method, args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granteeHexAddr)
+}
+
+// GrantSend grants a bank send authorization with the given spend limit and allow list
+// from the caller to the grantee.
+func (p *Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgGrantSend(method, args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granteeHexAddr)
+}
+
+// grant stores the authorization of the given MsgGrant. The granter is always the
+// caller, so a contract can't grant authorizations on behalf of the transaction origin.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *sdkauthz.MsgGrant,
+	granteeHexAddr common.Address,
+) ([]byte, error) {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type_url: %s, expiration: %v }",
+			msg.Granter,
+			msg.Grantee,
+			authorization.MsgTypeURL(),
+			msg.Grant.Expiration,
+		),
+	)
+
+	if err := anteutils.CheckDisabledAuthzMsgs([]sdk.Msg{msg}, anteutils.DisabledAuthzMsgTypes); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantEvent(ctx, stateDB, contract.CallerAddress, granteeHexAddr, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the authorization for the given message type granted by the caller to the grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgRevoke(args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type_url: %s }",
+			msg.Granter,
+			msg.Grantee,
+			msg.MsgTypeUrl,
+		),
+	)
+
+	if _, err := p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeEvent(ctx, stateDB, contract.CallerAddress, granteeHexAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages on behalf of their granters, using the
+// authorizations granted to the caller.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, msgTypeURLs, err := NewMsgExec(args, contract.CallerAddress, p.codec)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ grantee: %s, msgs: [%s] }",
+			msg.Grantee,
+			strings.Join(msgTypeURLs, ", "),
+		),
+	)
+
+	if err := anteutils.CheckDisabledAuthzMsgs([]sdk.Msg{msg}, ExecDisabledMsgTypes); err != nil {
+		return nil, err
+	}
+
+	eventsBefore := len(ctx.EventManager().Events())
+
+	res, err := p.AuthzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.mirrorBankBaseDeltas(ctx.EventManager().Events()[eventsBefore:])
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, contract.CallerAddress, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// mirrorBankBaseDeltas adds a single balance change entry per account with the net
+// base denom amount moved by the bank coin_spent and coin_received events.
+// The executed messages are arbitrary, so the moved amounts can only be known from
+// the events emitted by the bank keeper.
+func (p *Precompile) mirrorBankBaseDeltas(events sdk.Events) {
+	var addrs []common.Address
+	deltas := make(map[common.Address]math.Int)
+
+	add := func(bech32Addr, amount string, sign int64) {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return
+		}
+		baseAmount := coins.AmountOf(utils.BaseDenom)
+		if baseAmount.IsZero() {
+			return
+		}
+		accAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return
+		}
+		hexAddr := common.BytesToAddress(accAddr)
+		if _, ok := deltas[hexAddr]; !ok {
+			addrs = append(addrs, hexAddr)
+			deltas[hexAddr] = math.ZeroInt()
+		}
+		deltas[hexAddr] = deltas[hexAddr].Add(baseAmount.MulRaw(sign))
+	}
+
+	for _, event := range events {
+		var addrKey string
+		var sign int64
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey, sign = banktypes.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+
+		var addr, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addr = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		add(addr, amount, sign)
+	}
+
+	for _, addr := range addrs {
+		delta := deltas[addr]
+		switch {
+		case delta.IsPositive():
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(addr, delta.BigInt(), cmn.Add))
+		case delta.IsNegative():
+			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(addr, delta.Neg().BigInt(), cmn.Sub))
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package authz_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authz"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name        string
+		args        func() []any
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any { return []any{} },
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty grantee",
+			func() []any { return []any{common.Address{}, sendMsgTypeURL, int64(0)} },
+			"invalid grantee address",
+		},
+		{
+			"fail - empty msg type URL",
+			func() []any { return []any{s.keyring.GetAddr(1), "", int64(0)} },
+			"invalid message type URL",
+		},
+		{
+			"fail - negative expiration",
+			func() []any { return []any{s.keyring.GetAddr(1), sendMsgTypeURL, int64(-1)} },
+			"invalid expiration",
+		},
+		{
+			"fail - disabled msg type",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			"found disabled msg type",
+		},
+		{
+			"success - grant with expiration",
+			func() []any { return []any{s.keyring.GetAddr(1), sendMsgTypeURL, expiration} },
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+			granter := s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile, 200000)
+			_, err := s.precompile.Grant(ctx, contract, stDB, &method, tc.args())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			authorization, exp := s.network.App.AuthzKeeper.GetAuthorization(
+				ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+			)
+			s.Require().IsType(&sdkauthz.GenericAuthorization{}, authorization)
+			s.Require().Equal(expiration, exp.Unix())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[authz.EventTypeGrant].ID, logs[len(logs)-1].Topics[0])
+			s.Require().Equal(common.BytesToHash(granter.Bytes()), logs[len(logs)-1].Topics[1])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+
+	testCases := []struct {
+		name        string
+		args        func() []any
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any { return []any{} },
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid spend limit",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), []cmn.Coin{{Denom: utils.BaseDenom}}, []common.Address{}, int64(0)}
+			},
+			"invalid spend limit",
+		},
+		{
+			"fail - empty address in allow list",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), baseCoins(100), []common.Address{{}}, int64(0)}
+			},
+			"invalid allow list",
+		},
+		{
+			"success - send authorization with allow list",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), baseCoins(100), []common.Address{s.keyring.GetAddr(2)}, int64(0)}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			_, err := s.precompile.GrantSend(ctx, contract, stDB, &method, tc.args())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			authorization, exp := s.network.App.AuthzKeeper.GetAuthorization(
+				ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+			)
+			s.Require().Nil(exp)
+			sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+			s.Require().True(ok)
+			s.Require().Equal(utils.BaseDenom, sendAuthz.SpendLimit[0].Denom)
+			s.Require().Equal(int64(100), sendAuthz.SpendLimit[0].Amount.Int64())
+			s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthz.AllowList)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	s.Run("fail - no grant", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+		_, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, []any{s.keyring.GetAddr(1), sendMsgTypeURL})
+		s.Require().ErrorContains(err, "authorization not found")
+	})
+
+	s.Run("success - revoke granted authorization", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		stDB := s.network.GetStateDB()
+		s.grantSend(ctx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), 100)
+
+		contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+		_, err := s.precompile.Revoke(ctx, contract, stDB, &method, []any{s.keyring.GetAddr(1), sendMsgTypeURL})
+		s.Require().NoError(err)
+
+		authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(
+			ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+		)
+		s.Require().Nil(authorization)
+
+		logs := stDB.Logs()
+		s.Require().NotEmpty(logs)
+		s.Require().Equal(s.precompile.Events[authz.EventTypeRevoke].ID, logs[len(logs)-1].Topics[0])
+	})
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+
+	testCases := []struct {
+		name        string
+		args        func() []any
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any { return []any{} },
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - no messages",
+			func() []any { return []any{[][]byte{}} },
+			"invalid messages",
+		},
+		{
+			"fail - invalid message JSON",
+			func() []any { return []any{[][]byte{[]byte("{}")}} },
+			"invalid message JSON at index 0",
+		},
+		{
+			"fail - message signed by the grantee",
+			func() []any {
+				return []any{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(0), s.keyring.GetAddr(2), 10)}}
+			},
+			"must be signed by a granter",
+		},
+		{
+			"fail - message invoking the EVM",
+			func() []any {
+				msg := erc20types.NewMsgConvertERC20(
+					math.NewInt(10), s.keyring.GetAccAddr(2), utiltx.GenerateAddress(), s.keyring.GetAddr(1),
+				)
+				bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+				s.Require().NoError(err)
+				return []any{[][]byte{bz}}
+			},
+			fmt.Sprintf("found disabled msg type: %s", sdk.MsgTypeURL(&erc20types.MsgConvertERC20{})),
+		},
+		{
+			"fail - spend limit exceeded",
+			func() []any {
+				return []any{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(1), s.keyring.GetAddr(2), 1000)}}
+			},
+			"requested amount is more than spend limit",
+		},
+		{
+			"success - send on behalf of the granter",
+			func() []any {
+				return []any{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(1), s.keyring.GetAddr(2), 10)}}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+			grantee := s.keyring.GetAddr(0)
+			s.grantSend(ctx, s.keyring.GetAddr(1), grantee, 100)
+			balanceBefore := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), utils.BaseDenom)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, grantee, s.precompile, 200000)
+			bz, err := s.precompile.Exec(ctx, grantee, contract, stDB, &method, tc.args())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Len(out[0], 1)
+
+			balanceAfter := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), utils.BaseDenom)
+			s.Require().Equal(int64(10), balanceAfter.Amount.Sub(balanceBefore.Amount).Int64())
+
+			authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(
+				ctx, grantee.Bytes(), s.keyring.GetAccAddr(1), sendMsgTypeURL,
+			)
+			s.Require().Equal(int64(90), authorization.(*banktypes.SendAuthorization).SpendLimit[0].Amount.Int64())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[authz.EventTypeExec].ID, logs[len(logs)-1].Topics[0])
+			s.Require().Equal(common.BytesToHash(grantee.Bytes()), logs[len(logs)-1].Topics[1])
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+)
+
+// GrantInput is a struct to represent the input of the grant transaction.
+type GrantInput struct {
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+	Expiration int64
+}
+
+// GrantSendInput is a struct to represent the input of the grantSend transaction.
+type GrantSendInput struct {
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	AllowList  []common.Address
+	Expiration int64
+}
+
+// NewMsgGrant creates a new MsgGrant instance of a generic authorization and does sanity
+// checks on the given arguments before populating the message.
+func NewMsgGrant(method *abi.Method, args []interface{}, granter common.Address) (*sdkauthz.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input GrantInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	if input.MsgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, "empty type URL")
+	}
+
+	expiration, err := newExpiration(input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := sdkauthz.NewMsgGrant(
+		granter.Bytes(),
+		input.Grantee.Bytes(),
+		sdkauthz.NewGenericAuthorization(input.MsgTypeURL),
+		expiration,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant instance of a send authorization and does sanity
+// checks on the given arguments before populating the message.
+func NewMsgGrantSend(method *abi.Method, args []interface{}, granter common.Address) (*sdkauthz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	spendLimit, err := newSDKCoins(input.SpendLimit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		if addr == (common.Address{}) {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowList, addr)
+		}
+		allowList[i] = addr.Bytes()
+	}
+
+	expiration, err := newExpiration(input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := sdkauthz.NewMsgGrant(
+		granter.Bytes(),
+		input.Grantee.Bytes(),
+		banktypes.NewSendAuthorization(spendLimit, allowList),
+		expiration,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgRevoke(args []interface{}, granter common.Address) (*sdkauthz.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	msg := sdkauthz.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+
+	return &msg, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the JSON encoded messages and does sanity
+// checks on the given arguments before populating the message. The messages must be signed
+// by granters, as the grantee can't act on its own behalf through the precompile.
+func NewMsgExec(args []interface{}, grantee common.Address, cdc codec.Codec) (*sdkauthz.MsgExec, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	jsonMsgs, ok := args[0].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, args[0])
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	msgTypeURLs := make([]string, len(jsonMsgs))
+	for i, bz := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgJSON, i, err)
+		}
+
+		signers, _, err := cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgJSON, i, err)
+		}
+		for _, signer := range signers {
+			if bytes.Equal(signer, grantee.Bytes()) {
+				return nil, nil, fmt.Errorf(ErrGranteeSignedMsg, sdk.MsgTypeURL(msg), grantee)
+			}
+		}
+
+		msgs[i] = msg
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	msg := sdkauthz.NewMsgExec(grantee.Bytes(), msgs)
+
+	return &msg, msgTypeURLs, nil
+}
+
+// newExpiration returns the expiration time of a grant from its unix timestamp
+// in seconds. A zero timestamp means the grant doesn't expire.
+func newExpiration(expiration int64) (*time.Time, error) {
+	switch {
+	case expiration < 0:
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	case expiration == 0:
+		return nil, nil
+	default:
+		t := time.Unix(expiration, 0).UTC()
+		return &t, nil
+	}
+}
+
+// newSDKCoins converts the given coins into sorted and validated SDK coins.
+func newSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	amount := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidSpendLimit, coin.Amount)
+		}
+		amount[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	amount = amount.Sort()
+	if err := amount.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	return amount, nil
+}
+
+// GrantsInput is a struct to represent the input of the getGrants query.
+// Needed to unpack arguments into the PageRequest struct.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+	Pagination query.PageRequest
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGrantsRequest(method *abi.Method, args []interface{}) (*sdkauthz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &sdkauthz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeURL,
+		Pagination: normalizePageRequest(input.Pagination),
+	}, nil
+}
+
+// AccountGrantsInput is a struct to represent the input of the getGranterGrants
+// and getGranteeGrants queries. Needed to unpack arguments into the PageRequest struct.
+type AccountGrantsInput struct {
+	Account    common.Address
+	Pagination query.PageRequest
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}) (*sdkauthz.QueryGranterGrantsRequest, error) {
+	input, err := newAccountGrantsInput(method, args, ErrInvalidGranter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdkauthz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Account.Bytes()).String(),
+		Pagination: normalizePageRequest(input.Pagination),
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}) (*sdkauthz.QueryGranteeGrantsRequest, error) {
+	input, err := newAccountGrantsInput(method, args, ErrInvalidGrantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdkauthz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Account.Bytes()).String(),
+		Pagination: normalizePageRequest(input.Pagination),
+	}, nil
+}
+
+// newAccountGrantsInput unpacks the account and the page request of the
+// getGranterGrants and getGranteeGrants queries.
+func newAccountGrantsInput(method *abi.Method, args []interface{}, errInvalidAccount string) (*AccountGrantsInput, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return nil, fmt.Errorf(errInvalidAccount, args[0])
+	}
+
+	// The ABI names the account argument after the queried role, so only the
+	// page request is unpacked by position.
+	var pageRequest struct{ Pagination query.PageRequest }
+	if err := method.Inputs[1:].Copy(&pageRequest, args[1:]); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PageRequest struct: %s", err)
+	}
+
+	return &AccountGrantsInput{Account: account, Pagination: pageRequest.Pagination}, nil
+}
+
+// normalizePageRequest returns the page request to be used by the authz queries.
+// A key of a single zero byte is treated as no key.
+func normalizePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
+
+// GrantData represents an authorization granted by a granter to a grantee.
+type GrantData struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType string
+	MsgTypeURL        string `abi:"msgTypeUrl"`
+	SpendLimit        []cmn.Coin
+	AllowList         []common.Address
+	Expiration        int64
+}
+
+// NewGrantData converts the grant of an authorization to its EVM representation.
+// The spend limit and the allow list are only populated for send authorizations.
+func NewGrantData(granter, grantee string, authorizationAny *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	authorization, ok := authorizationAny.GetCachedValue().(sdkauthz.Authorization)
+	if !ok {
+		return GrantData{}, fmt.Errorf("unexpected authorization type %s", authorizationAny.TypeUrl)
+	}
+
+	data := GrantData{
+		Granter:           common.BytesToAddress(granterAddr),
+		Grantee:           common.BytesToAddress(granteeAddr),
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeURL:        authorization.MsgTypeURL(),
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+
+	if sendAuthz, ok := authorization.(*banktypes.SendAuthorization); ok {
+		data.SpendLimit = cmn.NewCoinsResponse(sendAuthz.SpendLimit)
+		for _, addr := range sendAuthz.AllowList {
+			allowed, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				return GrantData{}, fmt.Errorf(ErrInvalidAllowList, err)
+			}
+			data.AllowList = append(data.AllowList, common.BytesToAddress(allowed))
+		}
+	}
+
+	if expiration != nil {
+		data.Expiration = expiration.Unix()
+	}
+
+	return data, nil
+}
+
+// GrantsOutput is a struct to represent the output of the grants queries.
+type GrantsOutput struct {
+	Grants       []GrantData
+	PageResponse query.PageResponse
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse of the given
+// granter and grantee.
+func (gout *GrantsOutput) FromGrantsResponse(req *sdkauthz.QueryGrantsRequest, res *sdkauthz.QueryGrantsResponse) (*GrantsOutput, error) {
+	gout.Grants = make([]GrantData, len(res.Grants))
+	for i, grant := range res.Grants {
+		data, err := NewGrantData(req.Granter, req.Grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gout.Grants[i] = data
+	}
+
+	gout.setPageResponse(res.Pagination)
+	return gout, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grants of a
+// QueryGranterGrantsResponse or QueryGranteeGrantsResponse.
+func (gout *GrantsOutput) FromGrantAuthorizations(grants []*sdkauthz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	gout.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		data, err := NewGrantData(grant.Granter, grant.Grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gout.Grants[i] = data
+	}
+
+	gout.setPageResponse(pageRes)
+	return gout, nil
+}
+
+func (gout *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		gout.PageResponse.Total = pageRes.Total
+		gout.PageResponse.NextKey = pageRes.NextKey
+	}
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (gout *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(gout.Grants, gout.PageResponse)
+}
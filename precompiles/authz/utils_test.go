@@ -0,0 +1,38 @@
+package authz_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authz"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	"github.com/haqq-network/haqq/utils"
+)
+
+// grantSend grants a send authorization of the given base denom amount from the granter to the grantee.
+func (s *PrecompileTestSuite) grantSend(ctx sdk.Context, granter, grantee common.Address, amount int64) {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, granter, s.precompile, 200000)
+	_, err := s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, []any{
+		grantee, baseCoins(amount), []common.Address{}, int64(0),
+	})
+	s.Require().NoError(err)
+}
+
+// baseCoins returns the given amount of the base denom as precompile coins.
+func baseCoins(amount int64) []cmn.Coin {
+	return []cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(amount)}}
+}
+
+// sendMsgJSON returns the JSON encoded bank send of the given base denom amount.
+func (s *PrecompileTestSuite) sendMsgJSON(from, to common.Address, amount int64) []byte {
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(amount))))
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	return bz
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The Coinomics contract's address.
+address constant COINOMICS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000903;
+
+/// @dev The Coinomics contract's instance.
+CoinomicsI constant COINOMICS_CONTRACT = CoinomicsI(COINOMICS_PRECOMPILE_ADDRESS);
+
+/// @author Haqq Team
+/// @title Coinomics Precompile Contract
+/// @dev The read-only interface through which solidity contracts will query the coinomics and epochs modules
+/// @custom:address 0x0000000000000000000000000000000000000903
+interface CoinomicsI {
+  /// @dev Returns the current staking reward coefficient.
+  /// @return rewardCoefficient The reward coefficient as a fixed point decimal with 18 decimals
+  function rewardCoefficient()
+    external
+    view
+    returns (Dec memory rewardCoefficient);
+
+  /// @dev Returns the maximum supply of the mint denom.
+  /// @return maxSupply The maximum supply
+  function maxSupply() external view returns (Coin memory maxSupply);
+
+  /// @dev Returns the amount of the mint denom that can still be minted before the
+  /// maximum supply is reached. The amount accrued and not yet settled counts as minted.
+  /// @return remainingSupply The remaining mintable supply
+  function remainingMintableSupply()
+    external
+    view
+    returns (Coin memory remainingSupply);
+
+  /// @dev Returns whether minting is enabled.
+  /// @return enabled True if coinomics is enabled
+  function isCoinomicsEnabled() external view returns (bool enabled);
+
+  /// @dev Returns the current epoch of the given epoch identifier.
+  /// @param identifier The epoch identifier (e.g. "day", "week")
+  /// @return currentEpoch The current epoch number
+  /// @return currentEpochStartTime The start time of the current epoch as a unix timestamp in seconds
+  function currentEpoch(
+    string memory identifier
+  ) external view returns (int64 currentEpoch, int64 currentEpochStartTime);
+}
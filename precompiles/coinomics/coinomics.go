@@ -0,0 +1,137 @@
+package coinomics
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	coinomicskeeper "github.com/haqq-network/haqq/x/coinomics/keeper"
+	epochskeeper "github.com/haqq-network/haqq/x/epochs/keeper"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the read-only precompiled contract for the coinomics and epochs modules.
+type Precompile struct {
+	cmn.Precompile
+	coinomicsKeeper coinomicskeeper.Keeper
+	epochsKeeper    epochskeeper.Keeper
+}
+
+// NewPrecompile creates a new coinomics Precompile instance as a PrecompiledContract.
+func NewPrecompile(
+	coinomicsKeeper coinomicskeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+) (*Precompile, error) {
+	loadedAbi, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  loadedAbi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		coinomicsKeeper: coinomicsKeeper,
+		epochsKeeper:    epochsKeeper,
+	}
+
+	// SetAddress defines the address of the coinomics precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.CoinomicsPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoids panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err, stateDB, snapshot)()
+
+	return p.RunAtomic(
+		snapshot,
+		stateDB,
+		func() ([]byte, error) {
+			switch method.Name {
+			// Coinomics queries
+			case RewardCoefficientMethod:
+				bz, err = p.RewardCoefficient(ctx, contract, method, args)
+			case MaxSupplyMethod:
+				bz, err = p.MaxSupply(ctx, contract, method, args)
+			case RemainingMintableSupplyMethod:
+				bz, err = p.RemainingMintableSupply(ctx, contract, method, args)
+			case IsCoinomicsEnabledMethod:
+				bz, err = p.IsCoinomicsEnabled(ctx, contract, method, args)
+			// Epochs queries
+			case CurrentEpochMethod:
+				bz, err = p.CurrentEpoch(ctx, contract, method, args)
+			default:
+				return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			cost := ctx.GasMeter().GasConsumed() - initialGas
+
+			if !contract.UseGas(cost) {
+				return nil, vm.ErrOutOfGas
+			}
+
+			if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+				return nil, err
+			}
+
+			return bz, nil
+		},
+	)
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all coinomics methods are queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "coinomics")
+}
@@ -0,0 +1,31 @@
+package coinomics_test
+
+import (
+	"github.com/haqq-network/haqq/precompiles/coinomics"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	for _, name := range []string{
+		coinomics.RewardCoefficientMethod,
+		coinomics.MaxSupplyMethod,
+		coinomics.RemainingMintableSupplyMethod,
+		coinomics.IsCoinomicsEnabledMethod,
+		coinomics.CurrentEpochMethod,
+		"invalid",
+	} {
+		s.Run(name, func() {
+			s.Require().False(s.precompile.IsTransaction(name))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	method := s.precompile.Methods[coinomics.CurrentEpochMethod]
+	// Use methodID + padding to test RequiredGas
+	input := append(method.ID, make([]byte, 32)...) //nolint: gocritic
+	s.Require().Positive(s.precompile.RequiredGas(input))
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas_ShortInput() {
+	s.Require().Equal(uint64(0), s.precompile.RequiredGas([]byte{0x01}))
+}
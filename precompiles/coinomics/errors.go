@@ -0,0 +1,8 @@
+package coinomics
+
+const (
+	// ErrInvalidEpochIdentifier is raised when the epoch identifier is invalid.
+	ErrInvalidEpochIdentifier = "invalid epoch identifier: %v"
+	// ErrEpochNotFound is raised when no epoch is registered for the identifier.
+	ErrEpochNotFound = "epoch info not found: %s"
+)
@@ -0,0 +1,100 @@
+package coinomics
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	coinomicstypes "github.com/haqq-network/haqq/x/coinomics/types"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// RewardCoefficientMethod defines the ABI method name for the coinomics RewardCoefficient query.
+	RewardCoefficientMethod = "rewardCoefficient"
+	// MaxSupplyMethod defines the ABI method name for the coinomics MaxSupply query.
+	MaxSupplyMethod = "maxSupply"
+	// RemainingMintableSupplyMethod defines the ABI method name for the remaining mintable supply query.
+	RemainingMintableSupplyMethod = "remainingMintableSupply"
+	// IsCoinomicsEnabledMethod defines the ABI method name for the coinomics enabled flag query.
+	IsCoinomicsEnabledMethod = "isCoinomicsEnabled"
+	// CurrentEpochMethod defines the ABI method name for the epochs CurrentEpoch query.
+	CurrentEpochMethod = "currentEpoch"
+)
+
+// RewardCoefficient returns the current staking reward coefficient.
+func (p Precompile) RewardCoefficient(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.coinomicsKeeper.RewardCoefficient(ctx, &coinomicstypes.QueryRewardCoefficientRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewDec(res.RewardCoefficient))
+}
+
+// MaxSupply returns the maximum supply of the mint denom.
+func (p Precompile) MaxSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.coinomicsKeeper.MaxSupply(ctx, &coinomicstypes.QueryMaxSupplyRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewCoin(res.MaxSupply))
+}
+
+// RemainingMintableSupply returns the amount of the mint denom that can still be
+// minted before the maximum supply is reached.
+func (p Precompile) RemainingMintableSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	remaining := p.coinomicsKeeper.GetRemainingMintableSupply(ctx)
+
+	return method.Outputs.Pack(NewCoin(remaining))
+}
+
+// IsCoinomicsEnabled returns whether minting is enabled.
+func (p Precompile) IsCoinomicsEnabled(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	params := p.coinomicsKeeper.GetParams(ctx)
+
+	return method.Outputs.Pack(params.EnableCoinomics)
+}
+
+// CurrentEpoch returns the current epoch number and its start time for the given
+// epoch identifier.
+func (p Precompile) CurrentEpoch(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	identifier, err := ParseCurrentEpochArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	info, found := p.epochsKeeper.GetEpochInfo(ctx, identifier)
+	if !found {
+		return nil, fmt.Errorf(ErrEpochNotFound, identifier)
+	}
+
+	return method.Outputs.Pack(info.CurrentEpoch, info.CurrentEpochStartTime.Unix())
+}
@@ -0,0 +1,151 @@
+package coinomics_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/precompiles/coinomics"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
+)
+
+func (s *PrecompileTestSuite) TestRewardCoefficient() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[coinomics.RewardCoefficientMethod]
+
+	params := s.network.App.CoinomicsKeeper.GetParams(ctx)
+	params.RewardCoefficient = math.LegacyNewDecWithPrec(78, 3)
+	s.network.App.CoinomicsKeeper.SetParams(ctx, params)
+
+	bz, err := s.precompile.RewardCoefficient(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct{ RewardCoefficient cmn.Dec }
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+	s.Require().Equal(params.RewardCoefficient.BigInt(), out.RewardCoefficient.Value)
+	s.Require().Equal(uint8(math.LegacyPrecision), out.RewardCoefficient.Precision)
+}
+
+func (s *PrecompileTestSuite) TestMaxSupply() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[coinomics.MaxSupplyMethod]
+
+	maxSupply := s.network.App.CoinomicsKeeper.GetMaxSupply(ctx)
+
+	bz, err := s.precompile.MaxSupply(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct{ MaxSupply cmn.Coin }
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+	s.Require().Equal(maxSupply.Denom, out.MaxSupply.Denom)
+	s.Require().Equal(maxSupply.Amount.BigInt(), out.MaxSupply.Amount)
+}
+
+func (s *PrecompileTestSuite) TestRemainingMintableSupply() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[coinomics.RemainingMintableSupplyMethod]
+
+	denom := s.network.App.CoinomicsKeeper.GetParams(ctx).MintDenom
+	totalSupply := s.network.App.CoinomicsKeeper.TokenSupply(ctx, denom)
+	s.network.App.CoinomicsKeeper.SetMaxSupply(ctx, sdk.NewCoin(denom, totalSupply.Add(math.NewInt(1000))))
+	s.network.App.CoinomicsKeeper.SetAccruedMint(ctx, math.LegacyNewDec(300))
+
+	bz, err := s.precompile.RemainingMintableSupply(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct{ RemainingSupply cmn.Coin }
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+	s.Require().Equal(denom, out.RemainingSupply.Denom)
+	s.Require().Equal(int64(700), out.RemainingSupply.Amount.Int64())
+}
+
+func (s *PrecompileTestSuite) TestIsCoinomicsEnabled() {
+	method := s.precompile.Methods[coinomics.IsCoinomicsEnabledMethod]
+
+	for _, enabled := range []bool{true, false} {
+		s.Run(fmt.Sprintf("enabled %t", enabled), func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			params := s.network.App.CoinomicsKeeper.GetParams(ctx)
+			params.EnableCoinomics = enabled
+			s.network.App.CoinomicsKeeper.SetParams(ctx, params)
+
+			bz, err := s.precompile.IsCoinomicsEnabled(ctx, nil, &method, nil)
+			s.Require().NoError(err)
+			s.Require().Equal([]any{enabled}, mustUnpack(s, method, bz))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCurrentEpoch() {
+	method := s.precompile.Methods[coinomics.CurrentEpochMethod]
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]any{},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid identifier type",
+			[]any{uint64(1)},
+			"invalid epoch identifier",
+		},
+		{
+			"fail - blank identifier",
+			[]any{" "},
+			"invalid epoch identifier",
+		},
+		{
+			"fail - unknown identifier",
+			[]any{"month"},
+			fmt.Sprintf(coinomics.ErrEpochNotFound, "month"),
+		},
+		{
+			"success - day epoch",
+			[]any{epochstypes.DayEpochID},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			info, found := s.network.App.EpochsKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+			s.Require().True(found)
+			info.CurrentEpoch = 42
+			info.CurrentEpochStartTime = startTime
+			s.network.App.EpochsKeeper.SetEpochInfo(ctx, info)
+
+			bz, err := s.precompile.CurrentEpoch(ctx, nil, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal([]any{int64(42), startTime.Unix()}, mustUnpack(s, method, bz))
+		})
+	}
+}
+
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
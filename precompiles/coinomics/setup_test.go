@@ -0,0 +1,42 @@
+package coinomics_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/haqq-network/haqq/precompiles/coinomics"
+	testkeyring "github.com/haqq-network/haqq/testutil/integration/haqq/keyring"
+	"github.com/haqq-network/haqq/testutil/integration/haqq/network"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	keyring    testkeyring.Keyring
+	precompile *coinomics.Precompile
+}
+
+func TestPrecompileUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(1)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	s.precompile, err = coinomics.NewPrecompile(
+		s.network.App.CoinomicsKeeper,
+		s.network.App.EpochsKeeper,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,45 @@
+package coinomics
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
+)
+
+// NewDec converts the decimal to its EVM representation.
+func NewDec(dec math.LegacyDec) cmn.Dec {
+	return cmn.Dec{
+		Value:     dec.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
+
+// NewCoin converts the coin to its EVM representation.
+func NewCoin(coin sdk.Coin) cmn.Coin {
+	return cmn.Coin{
+		Denom:  coin.Denom,
+		Amount: coin.Amount.BigInt(),
+	}
+}
+
+// ParseCurrentEpochArgs parses the epoch identifier from the currentEpoch arguments.
+func ParseCurrentEpochArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	identifier, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidEpochIdentifier, args[0])
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(identifier); err != nil {
+		return "", fmt.Errorf(ErrInvalidEpochIdentifier, err)
+	}
+
+	return identifier, nil
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/utils"
+)
+
+// BankKeeper defines the bank method the precompiles use to sample the aISLM
+// balances mirrored into the EVM StateDB.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// BaseDenomBankBalance returns the bank balance of aISLM for addr (utils.BaseDenom).
+// On Haqq, EvmDenom defaults to utils.BaseDenom; this is the balance the EVM keeper
+// reconciles for native EVM accounts on tx commit.
+func BaseDenomBankBalance(ctx sdk.Context, bankKeeper BankKeeper, addr sdk.AccAddress) sdkmath.Int {
+	return bankKeeper.GetBalance(ctx, addr, utils.BaseDenom).Amount
+}
+
+// BankBaseSnapshot pairs an account with its aISLM bank balance, sampled
+// immediately before a keeper call. It is the input unit for MirrorBankBaseDeltas.
+type BankBaseSnapshot struct {
+	Addr       sdk.AccAddress
+	BaseBefore sdkmath.Int
+}
+
+// SnapshotBaseBalances samples the aISLM bank balances of the given accounts.
+func SnapshotBaseBalances(ctx sdk.Context, bankKeeper BankKeeper, addrs ...common.Address) []BankBaseSnapshot {
+	snapshots := make([]BankBaseSnapshot, len(addrs))
+	for i, addr := range addrs {
+		accAddr := sdk.AccAddress(addr.Bytes())
+		snapshots[i] = BankBaseSnapshot{Addr: accAddr, BaseBefore: BaseDenomBankBalance(ctx, bankKeeper, accAddr)}
+	}
+	return snapshots
+}
+
+// MirrorBankBaseDeltas mirrors per-account bank deltas of the EVM gas denom
+// (aISLM) into the EVM StateDB journal when the precompile is invoked from
+// another contract (caller != origin).
+//
+// Why this is needed:
+//   - Keeper calls move aISLM through the bank keeper directly. Those calls update
+//     the SDK bank state but do not touch the EVM journal.
+//   - On EVM tx commit, x/evm reconciles native EVM account balances against the
+//     SDK bank for accounts touched by the EVM journal. If the contract account that
+//     was actually debited/credited in bank is not in the journal, its EVM-side balance
+//     is left unchanged, while bank already reflects the move - leading to a state
+//     mismatch and effectively "phantom" coins on the EVM side.
+//   - When caller == origin (EOA), x/evm intentionally skips reconciling the origin's
+//     balance for these direct keeper movements, so no journal entry is required.
+//
+// Each snapshot BaseBefore must be sampled immediately before the keeper/msg work;
+// the resulting delta matches the actual bank movement, which may differ from the
+// nominal msg amount.
+//
+// IMPORTANT - duplicate accounts must be mirrored exactly once. A given account
+// can appear in multiple roles in the same call (e.g. redeemFrom == redeemTo or
+// funder == destination). Snapshots are deduplicated by address, so a single
+// account contributes a single Sub/Add journal entry whose magnitude is its net
+// bank delta. Without this, x/evm would over-credit/-debit the account at commit.
+func (p *Precompile) MirrorBankBaseDeltas(
+	ctx sdk.Context,
+	bankKeeper BankKeeper,
+	isCallerOrigin bool,
+	snapshots ...BankBaseSnapshot,
+) {
+	if isCallerOrigin {
+		return
+	}
+	seen := make(map[string]struct{}, len(snapshots))
+	for _, s := range snapshots {
+		key := s.Addr.String()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		netBaseDelta := s.BaseBefore.Sub(BaseDenomBankBalance(ctx, bankKeeper, s.Addr))
+		if netBaseDelta.IsZero() {
+			continue
+		}
+		hexAddr := common.BytesToAddress(s.Addr.Bytes())
+		if netBaseDelta.IsNegative() {
+			// Bank balance grew: account was credited - mirror as Add.
+			p.AddBalanceChangeEntries(NewBalanceChangeEntry(hexAddr, netBaseDelta.Neg().BigInt(), Add))
+			continue
+		}
+		// Bank balance shrank: account was debited - mirror as Sub.
+		p.AddBalanceChangeEntries(NewBalanceChangeEntry(hexAddr, netBaseDelta.BigInt(), Sub))
+	}
+}
@@ -14,6 +14,52 @@ EthiqI constant ETHIQ_CONTRACT = EthiqI(ETHIQ_PRECOMPILE_ADDRESS);
 string constant MSG_MINT_HAQQ = "/haqq.ethiq.v1.MsgMintHaqq";
 string constant MSG_MINT_HAQQ_BY_APPLICATION = "/haqq.ethiq.v1.MsgMintHaqqByApplication";
 
+/// @dev PriceLevelBreakdown describes the part of a burn that falls into a single price level.
+/// @param level The index of the price level on the curve
+/// @param islmAmount The amount of aISLM burned within the level
+/// @param price The unit price of aHAQQ within the level as a decimal string
+/// @param haqqAmount The amount of aHAQQ minted within the level
+struct PriceLevelBreakdown {
+    uint256 level;
+    uint256 islmAmount;
+    string price;
+    uint256 haqqAmount;
+}
+
+/// @dev BurnApplication describes an application to burn aISLM in exchange for aHAQQ.
+/// @param id The application ID
+/// @param fromAddress The address that burns the aISLM coins
+/// @param toAddress The address that receives the minted aHAQQ coins
+/// @param source The source of funds (0 for the account balance, 1 for the UC DAO)
+/// @param burnAmount The amount of aISLM to be burned
+/// @param burnedBeforeAmount The sum of the burn amounts of all previous applications
+/// @param isExecuted True if the application was executed
+/// @param isCanceled True if the application was canceled
+/// @param isApproved True if the application was approved by governance
+struct BurnApplication {
+    uint64 id;
+    address fromAddress;
+    address toAddress;
+    uint8 source;
+    uint256 burnAmount;
+    uint256 burnedBeforeAmount;
+    bool isExecuted;
+    bool isCanceled;
+    bool isApproved;
+}
+
+/// @dev EthiqParams defines the parameters of the ethiq module.
+/// @param enabled True if minting is enabled
+/// @param minMintPerTx The minimum amount of aHAQQ that can be minted per transaction
+/// @param maxMintPerTx The maximum amount of aHAQQ that can be minted per transaction
+/// @param maxSupply The maximum supply of aHAQQ
+struct EthiqParams {
+    bool enabled;
+    uint256 minMintPerTx;
+    uint256 maxMintPerTx;
+    uint256 maxSupply;
+}
+
 /// @author Haqq Team
 /// @title Ethiq Precompile Contract
 /// @dev The interface through which solidity contracts will interact with the ethiq module
@@ -107,6 +153,25 @@ interface EthiqI is AuthorizationI {
             string memory pricePerUnit
         );
 
+    /// @dev Calculates the estimated amount of aHAQQ coins to be minted for a given aISLM amount
+    /// split by the price levels the burn spreads over
+    /// @param islmAmount The amount of aISLM coins to burn
+    /// @return levels The price levels the burn spreads over
+    /// @return estimatedHaqqAmount The estimated amount of aHAQQ coins to be minted
+    /// @return totalBurnedAfter The cumulative burned aISLM amount on the price curve after the burn
+    /// @return remainingCapacity The amount of aISLM that can still be burned before the price curve is exhausted
+    function calculateBreakdown(
+        uint256 islmAmount
+    )
+        external
+        view
+        returns (
+            PriceLevelBreakdown[] memory levels,
+            uint256 estimatedHaqqAmount,
+            uint256 totalBurnedAfter,
+            uint256 remainingCapacity
+        );
+
     /// @dev Calculates the estimated amount of aHAQQ coins to be minted for a given application ID
     /// @param applicationId The application ID used for minting
     /// @return estimatedHaqqAmount The estimated amount of aHAQQ coins to be minted
@@ -126,4 +191,50 @@ interface EthiqI is AuthorizationI {
             string memory pricePerUnit,
             address receiver
         );
+
+    /// @dev Returns the total amount of aISLM burned on the price curve.
+    /// @return totalBurned The total amount of aISLM burned
+    /// @return totalBurnedFromApplications The part of the total burned by applications
+    function totalBurned()
+        external
+        view
+        returns (uint256 totalBurned, uint256 totalBurnedFromApplications);
+
+    /// @dev Returns the registered burn applications.
+    /// @param pagination The pagination parameters of the request
+    /// @return applications The burn applications
+    /// @return pageResponse The pagination response
+    function getApplications(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            BurnApplication[] memory applications,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the burn applications of a sender.
+    /// @param sender The address that burns the aISLM coins
+    /// @param pagination The pagination parameters of the request
+    /// @return applications The burn applications of the sender
+    /// @return pageResponse The pagination response
+    function getSendersApplications(
+        address sender,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            BurnApplication[] memory applications,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the parameters of the ethiq module.
+    /// @return params The ethiq module parameters
+    function params() external view returns (EthiqParams memory params);
+
+    /// @dev Returns the current supply of aHAQQ.
+    /// @return supply The aHAQQ supply
+    function haqqSupply() external view returns (uint256 supply);
 }
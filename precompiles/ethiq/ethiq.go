@@ -119,8 +119,20 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 			// Queries
 			case Calculate:
 				bz, err = p.Calculate(ctx, contract, method, args)
+			case CalculateBreakdown:
+				bz, err = p.CalculateBreakdown(ctx, contract, method, args)
 			case CalculateForApplication:
 				bz, err = p.CalculateForApplication(ctx, contract, method, args)
+			case TotalBurned:
+				bz, err = p.TotalBurned(ctx, contract, method, args)
+			case GetApplications:
+				bz, err = p.GetApplications(ctx, contract, method, args)
+			case GetSendersApplications:
+				bz, err = p.GetSendersApplications(ctx, contract, method, args)
+			case ParamsMethod:
+				bz, err = p.Params(ctx, contract, method, args)
+			case HaqqSupply:
+				bz, err = p.HaqqSupply(ctx, contract, method, args)
 			case authorization.AllowanceMethod:
 				bz, err = p.Allowance(ctx, method, contract, args)
 			default:
@@ -1840,11 +1840,10 @@ var _ = Describe("Full Safe (Smart Contract Wallet) flow", Ordered, func() {
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred(), "waitlist application item must be valid before push")
 
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
-		waitlistApplication, err := ethiqtypes.GetApplicationByID(waitlistAppID)
-		Expect(err).ToNot(HaveOccurred(), "registered application must be readable by ID")
+		waitlistApplication, found := s.network.App.EthiqKeeper.GetApplication(s.network.GetContext(), waitlistAppID)
+		Expect(found).To(BeTrue(), "registered application must be readable by ID")
 		Expect(waitlistApplication.Id).To(Equal(waitlistAppID))
 		Expect(waitlistApplication.FromAddress).To(Equal(safeBech32))
 		Expect(waitlistApplication.ToAddress).To(Equal(safeBech32))
@@ -1856,9 +1855,10 @@ var _ = Describe("Full Safe (Smart Contract Wallet) flow", Ordered, func() {
 		Expect(waitlistApplication.IsCanceled).To(BeFalse())
 		Expect(waitlistApplication.IsExecuted).To(BeFalse())
 
-		Expect(ethiqtypes.TotalNumberOfApplicationsBySender(safeBech32)).To(Equal(uint64(1)), "sender index must list one application for Safe")
-		senderApp, err := ethiqtypes.GetSendersApplicationIDByIndex(safeBech32, 0)
+		sendersApps, err := s.network.App.EthiqKeeper.GetSendersApplications(s.network.GetContext(), &ethiqtypes.QueryGetSendersApplicationsRequest{SenderAddress: safeBech32})
 		Expect(err).ToNot(HaveOccurred())
+		Expect(sendersApps.Applications).To(HaveLen(1), "sender index must list one application for Safe")
+		senderApp := sendersApps.Applications[0]
 		Expect(senderApp.Id).To(Equal(waitlistAppID))
 		Expect(senderApp.FromAddress).To(Equal(safeBech32))
 		Expect(senderApp.ToAddress).To(Equal(safeBech32))
@@ -2180,8 +2180,7 @@ var _ = Describe("EOA waitlist application flow", Ordered, func() {
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
 
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		mintByAppArgs := factory.CallArgs{
@@ -2265,8 +2264,7 @@ var _ = Describe("EOA waitlist application flow (UCDAO funds)", Ordered, func()
 		_, err := waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
 
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		mintByAppArgs := factory.CallArgs{
@@ -2389,8 +2387,7 @@ var _ = Describe("EOA waitlist application flow (UCDAO funds, liquid vesting)",
 		_, err := waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
 
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		mintByAppArgs := factory.CallArgs{
@@ -2877,8 +2874,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds)", Ordered, func()
 		}
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		approveArgs := factory.CallArgs{
@@ -3198,8 +3194,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds, liquid vesting)",
 		}
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		approveArgs := factory.CallArgs{
@@ -3348,8 +3343,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds, liquid vesting)",
 		}
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		helperAcc, _, liquidDenom, _ := prepareHelperWithLiquidVesting()
 		liquidTransferToSafe := sdk.NewCoins(
@@ -3495,8 +3489,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds, liquid vesting)",
 		}
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 		secondWaitlistItem := ethiqtypes.ApplicationListItem{
 			FromAddress:                safeBech32,
 			ToAddress:                  safeBech32,
@@ -3506,8 +3499,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds, liquid vesting)",
 		}
 		_, err = secondWaitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		secondWaitlistAppID, restoreSecondWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(secondWaitlistItem)
-		defer restoreSecondWaitlist()
+		secondWaitlistAppID := s.registerApplication(secondWaitlistItem)
 
 		precompileAddr := s.precompile.Address()
 		approveByAppArgs := factory.CallArgs{
@@ -3676,8 +3668,7 @@ var _ = Describe("Safe waitlist application flow (UCDAO funds, liquid vesting)",
 		}
 		_, err = waitlistItem.AsBurnApplication()
 		Expect(err).ToNot(HaveOccurred())
-		waitlistAppID, restoreWaitlist := ethiqtypes.PushRegisteredApplicationForIntegrationTest(waitlistItem)
-		defer restoreWaitlist()
+		waitlistAppID := s.registerApplication(waitlistItem)
 
 		helperAcc, _, liquidDenom, _ := prepareHelperWithLiquidVesting()
 		liquidTransferToSafe := sdk.NewCoins(
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -17,9 +18,22 @@ import (
 const (
 	// Calculate defines the ABI query method name for the calculation of ethiq coins to be minted for a given aISLM.
 	Calculate = "calculate"
+	// CalculateBreakdown defines the ABI query method name for the calculation of ethiq coins to be minted
+	// for a given aISLM split by price levels.
+	CalculateBreakdown = "calculateBreakdown"
 	// CalculateForApplication defines the ABI query method name for the calculation of ethiq coins
 	// to be minted for a given application ID.
 	CalculateForApplication = "calculateForApplication"
+	// TotalBurned defines the ABI query method name for the total amount of burned aISLM.
+	TotalBurned = "totalBurned"
+	// GetApplications defines the ABI query method name for the paginated burn applications.
+	GetApplications = "getApplications"
+	// GetSendersApplications defines the ABI query method name for the burn applications of a sender.
+	GetSendersApplications = "getSendersApplications"
+	// ParamsMethod defines the ABI query method name for the ethiq module parameters.
+	ParamsMethod = "params"
+	// HaqqSupply defines the ABI query method name for the current aHAQQ supply.
+	HaqqSupply = "haqqSupply"
 )
 
 // Calculate returns the estimated amount of aHAQQ coins to be minted for a given aISLM.
@@ -47,6 +61,32 @@ func (p Precompile) Calculate(
 	)
 }
 
+// CalculateBreakdown returns the estimated amount of aHAQQ coins to be minted for a given aISLM
+// split by the price levels the burn spreads over.
+func (p Precompile) CalculateBreakdown(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewCalculateBreakdownRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.ethiqKeeper.CalculateBreakdown(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(
+		NewPriceLevelBreakdowns(res.Levels),
+		res.EstimatedHaqqAmount.BigInt(),
+		res.TotalBurnedAfter.BigInt(),
+		res.RemainingCapacity.BigInt(),
+	)
+}
+
 // CalculateForApplication returns the estimated amount of aHAQQ coins to be minted for a given application ID.
 func (p Precompile) CalculateForApplication(
 	ctx sdk.Context,
@@ -75,6 +115,111 @@ func (p Precompile) CalculateForApplication(
 	)
 }
 
+// TotalBurned returns the total amount of aISLM burned and the part of it burned by applications.
+func (p Precompile) TotalBurned(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.ethiqKeeper.TotalBurned(ctx, &ethiqtypes.QueryTotalBurnedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(
+		res.TotalBurned.Amount.BigInt(),
+		res.TotalBurnedFromApplications.Amount.BigInt(),
+	)
+}
+
+// GetApplications returns the burn applications with pagination.
+func (p Precompile) GetApplications(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGetApplicationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.ethiqKeeper.GetApplications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := NewBurnApplications(res.Applications)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageResponse query.PageResponse
+	if res.Pagination != nil {
+		pageResponse.NextKey = res.Pagination.NextKey
+		pageResponse.Total = res.Pagination.Total
+	}
+
+	return method.Outputs.Pack(applications, pageResponse)
+}
+
+// GetSendersApplications returns the paginated burn applications of the given sender.
+func (p Precompile) GetSendersApplications(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGetSendersApplicationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.ethiqKeeper.GetSendersApplications(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := NewBurnApplications(res.Applications)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageResponse query.PageResponse
+	if res.Pagination != nil {
+		pageResponse.NextKey = res.Pagination.NextKey
+		pageResponse.Total = res.Pagination.Total
+	}
+
+	return method.Outputs.Pack(applications, pageResponse)
+}
+
+// Params returns the ethiq module parameters.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.ethiqKeeper.Params(ctx, &ethiqtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewParams(res.Params))
+}
+
+// HaqqSupply returns the current supply of aHAQQ.
+func (p Precompile) HaqqSupply(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.ethiqKeeper.GetHaqqSupply(ctx).BigInt())
+}
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
@@ -3,13 +3,19 @@ package ethiq_test
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/haqq-network/haqq/precompiles/authorization"
 	"github.com/haqq-network/haqq/precompiles/ethiq"
 	"github.com/haqq-network/haqq/precompiles/testutil"
 	utiltx "github.com/haqq-network/haqq/testutil/tx"
 	ethiqtypes "github.com/haqq-network/haqq/x/ethiq/types"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
 )
 
 // mintHaqqMsgURL is the msg type URL for MintHaqq authorization
@@ -87,16 +93,85 @@ func (s *PrecompileTestSuite) TestCalculate() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestCalculateBreakdown() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[ethiq.CalculateBreakdown]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			200000,
+			true,
+			"invalid input arguments",
+		},
+		{
+			"fail - zero amount returns error",
+			func() []any {
+				return []any{big.NewInt(0)}
+			},
+			200000,
+			true,
+			"islm_amount must be positive",
+		},
+		{
+			"success - valid positive amount",
+			func() []any {
+				return []any{big.NewInt(1e18)}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+			bz, err := s.precompile.CalculateBreakdown(ctx, contract, &method, tc.malleate())
+			if tc.expError {
+				s.Require().Error(err)
+				if tc.errContains != "" {
+					s.Require().ErrorContains(err, tc.errContains)
+				}
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct {
+				Levels              []ethiq.PriceLevelBreakdown
+				EstimatedHaqqAmount *big.Int
+				TotalBurnedAfter    *big.Int
+				RemainingCapacity   *big.Int
+			}
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, ethiq.CalculateBreakdown, bz))
+			s.Require().NotEmpty(out.Levels)
+			s.Require().Equal(1, out.EstimatedHaqqAmount.Sign())
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestCalculateForApplication() {
 	var ctx sdk.Context
 	method := s.precompile.Methods[ethiq.CalculateForApplication]
 
 	// Find a valid application ID that has non-zero burn amount
 	validAppID := uint64(0)
-	for i := validAppID; i < ethiqtypes.TotalNumberOfApplications(); i++ {
-		app, err := ethiqtypes.GetApplicationByID(i)
-		if err == nil && app.BurnAmount.Amount.IsPositive() {
-			validAppID = i
+	for _, app := range ethiqtypes.WaitlistApplications() {
+		if app.BurnAmount.Amount.IsPositive() {
+			validAppID = app.Id
 			break
 		}
 	}
@@ -213,3 +288,209 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTotalBurned() {
+	method := s.precompile.Methods[ethiq.TotalBurned]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.network.App.EthiqKeeper.AddToTotalBurnedAmount(ctx, math.NewInt(300))
+	s.network.App.EthiqKeeper.AddToTotalBurnedFromApplicationsAmount(ctx, math.NewInt(100))
+
+	bz, err := s.precompile.TotalBurned(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct {
+		TotalBurned                 *big.Int
+		TotalBurnedFromApplications *big.Int
+	}
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+
+	res, err := s.network.App.EthiqKeeper.TotalBurned(ctx, &ethiqtypes.QueryTotalBurnedRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(res.TotalBurned.Amount.BigInt(), out.TotalBurned)
+	s.Require().Equal(res.TotalBurnedFromApplications.Amount.BigInt(), out.TotalBurnedFromApplications)
+}
+
+func (s *PrecompileTestSuite) TestGetApplications() {
+	method := s.precompile.Methods[ethiq.GetApplications]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetApplications(s.network.GetContext(), nil, &method, []any{})
+		s.Require().ErrorContains(err, "invalid number of arguments")
+	})
+
+	s.Run("success - paginated applications", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		item := ethiqtypes.ApplicationListItem{
+			FromAddress:                sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(),
+			ToAddress:                  sdk.AccAddress(s.keyring.GetAddr(1).Bytes()).String(),
+			FundSource:                 ethiqtypes.SourceOfFunds_SOURCE_OF_FUNDS_BANK,
+			IslmAmount:                 "1000",
+			IslmAccumulatedBurntAmount: "0",
+		}
+		RegisterTestingT(s.T())
+		firstID := s.registerApplication(item)
+		secondID := s.registerApplication(item)
+
+		pageRequest := query.PageRequest{Limit: 1, CountTotal: true}
+		bz, err := s.precompile.GetApplications(ctx, nil, &method, []any{pageRequest})
+		s.Require().NoError(err)
+
+		var out struct {
+			Applications []ethiq.BurnApplication
+			PageResponse query.PageResponse
+		}
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Applications, 1)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+		s.Require().GreaterOrEqual(out.PageResponse.Total, uint64(2))
+
+		res, err := s.network.App.EthiqKeeper.GetApplications(ctx, &ethiqtypes.QueryGetApplicationsRequest{
+			Pagination: &query.PageRequest{Limit: query.PaginationMaxLimit},
+		})
+		s.Require().NoError(err)
+		expected, err := ethiq.NewBurnApplications(res.Applications)
+		s.Require().NoError(err)
+		s.Require().Equal(expected[0].ID, out.Applications[0].ID)
+		s.Require().Equal(expected[0].FromAddress, out.Applications[0].FromAddress)
+		s.Require().Equal(expected[0].BurnAmount.String(), out.Applications[0].BurnAmount.String())
+
+		ids := make([]uint64, len(expected))
+		for i, application := range expected {
+			ids[i] = application.ID
+		}
+		s.Require().Contains(ids, firstID)
+		s.Require().Contains(ids, secondID)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetSendersApplications() {
+	method := s.precompile.Methods[ethiq.GetSendersApplications]
+
+	type output struct {
+		Applications []ethiq.BurnApplication
+		PageResponse query.PageResponse
+	}
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSendersApplications(s.network.GetContext(), nil, &method, []any{})
+		s.Require().ErrorContains(err, "invalid number of arguments")
+	})
+
+	s.Run("fail - invalid sender", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSendersApplications(s.network.GetContext(), nil, &method, []any{"sender", query.PageRequest{}})
+		s.Require().ErrorContains(err, "invalid sender")
+	})
+
+	s.Run("success - no applications", func() {
+		s.SetupTest()
+		bz, err := s.precompile.GetSendersApplications(s.network.GetContext(), nil, &method, []any{utiltx.GenerateAddress(), query.PageRequest{}})
+		s.Require().NoError(err)
+
+		var out output
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Empty(out.Applications)
+	})
+
+	s.Run("success - sender applications", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		sender := utiltx.GenerateAddress()
+		receiver := s.keyring.GetAddr(1)
+		RegisterTestingT(s.T())
+		id := s.registerApplication(ethiqtypes.ApplicationListItem{
+			FromAddress:                sdk.AccAddress(sender.Bytes()).String(),
+			ToAddress:                  sdk.AccAddress(receiver.Bytes()).String(),
+			FundSource:                 ethiqtypes.SourceOfFunds_SOURCE_OF_FUNDS_UCDAO,
+			IslmAmount:                 "1000",
+			IslmAccumulatedBurntAmount: "500",
+		})
+
+		bz, err := s.precompile.GetSendersApplications(ctx, nil, &method, []any{sender, query.PageRequest{}})
+		s.Require().NoError(err)
+
+		var out output
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Applications, 1)
+		s.Require().Equal(id, out.Applications[0].ID)
+		s.Require().Equal(sender, out.Applications[0].FromAddress)
+		s.Require().Equal(receiver, out.Applications[0].ToAddress)
+		s.Require().Equal(uint8(ethiqtypes.SourceOfFunds_SOURCE_OF_FUNDS_UCDAO), out.Applications[0].Source)
+		s.Require().Equal(big.NewInt(1000), out.Applications[0].BurnAmount)
+		s.Require().Equal(big.NewInt(500), out.Applications[0].BurnedBeforeAmount)
+		s.Require().False(out.Applications[0].IsExecuted)
+		s.Require().Empty(out.PageResponse.NextKey)
+	})
+
+	s.Run("success - paginated sender applications", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		sender := utiltx.GenerateAddress()
+		item := ethiqtypes.ApplicationListItem{
+			FromAddress:                sdk.AccAddress(sender.Bytes()).String(),
+			ToAddress:                  sdk.AccAddress(s.keyring.GetAddr(1).Bytes()).String(),
+			FundSource:                 ethiqtypes.SourceOfFunds_SOURCE_OF_FUNDS_BANK,
+			IslmAmount:                 "1000",
+			IslmAccumulatedBurntAmount: "0",
+		}
+		RegisterTestingT(s.T())
+		firstID := s.registerApplication(item)
+		secondID := s.registerApplication(item)
+
+		bz, err := s.precompile.GetSendersApplications(ctx, nil, &method, []any{sender, query.PageRequest{Limit: 1, CountTotal: true}})
+		s.Require().NoError(err)
+
+		var out output
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Applications, 1)
+		s.Require().Equal(firstID, out.Applications[0].ID)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+
+		bz, err = s.precompile.GetSendersApplications(ctx, nil, &method, []any{sender, query.PageRequest{Key: out.PageResponse.NextKey, Limit: 1}})
+		s.Require().NoError(err)
+
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Applications, 1)
+		s.Require().Equal(secondID, out.Applications[0].ID)
+		s.Require().Empty(out.PageResponse.NextKey)
+	})
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[ethiq.ParamsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	bz, err := s.precompile.Params(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var out struct{ Params ethiq.Params }
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+	s.Require().Equal(ethiq.NewParams(s.network.App.EthiqKeeper.GetParams(ctx)), out.Params)
+}
+
+func (s *PrecompileTestSuite) TestHaqqSupply() {
+	method := s.precompile.Methods[ethiq.HaqqSupply]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	bz, err := s.precompile.HaqqSupply(ctx, nil, &method, nil)
+	s.Require().NoError(err)
+
+	var supply *big.Int
+	s.Require().NoError(method.Outputs.Copy(&supply, mustUnpack(s, method, bz)))
+	s.Require().Equal(s.network.App.EthiqKeeper.GetHaqqSupply(ctx).String(), supply.String())
+}
+
+// mustUnpack unpacks the ABI-encoded output of the method and fails the test on error.
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
@@ -3,6 +3,7 @@ package ethiq
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -165,9 +166,9 @@ func (p *Precompile) MintHaqqByApplication(
 		return nil, err
 	}
 
-	application, err := ethiqtypes.GetApplicationByID(msg.ApplicationId)
-	if err != nil {
-		return nil, err
+	application, found := p.ethiqKeeper.GetApplication(ctx, msg.ApplicationId)
+	if !found {
+		return nil, errorsmod.Wrapf(ethiqtypes.ErrInvalidApplicationID, "application %d not found", msg.ApplicationId)
 	}
 
 	// Same debit account as BurnIslmForHaqqByApplicationID (owner bank vs UCDAO escrow).
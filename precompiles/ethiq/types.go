@@ -7,7 +7,10 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/haqq-network/haqq/utils"
 
@@ -86,6 +89,47 @@ func NewCalculateRequest(args []interface{}) (*ethiqtypes.QueryCalculateRequest,
 	return req, nil
 }
 
+func NewCalculateBreakdownRequest(args []interface{}) (*ethiqtypes.QueryCalculateBreakdownRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid input arguments. Expected 1, got %d", len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil {
+		return nil, errorsmod.Wrapf(ethiqtypes.ErrInvalidAmount, cmn.ErrInvalidAmount, args[0])
+	}
+
+	req := &ethiqtypes.QueryCalculateBreakdownRequest{
+		IslmAmount: amount.String(),
+	}
+
+	return req, nil
+}
+
+// PriceLevelBreakdown is the ABI representation of the part of a burn
+// that falls into a single price level.
+type PriceLevelBreakdown struct {
+	Level      *big.Int
+	IslmAmount *big.Int
+	Price      string
+	HaqqAmount *big.Int
+}
+
+// NewPriceLevelBreakdowns converts the x/ethiq price level breakdown into its ABI representation.
+func NewPriceLevelBreakdowns(levels []ethiqtypes.PriceLevelBreakdown) []PriceLevelBreakdown {
+	breakdown := make([]PriceLevelBreakdown, len(levels))
+	for i, level := range levels {
+		breakdown[i] = PriceLevelBreakdown{
+			Level:      new(big.Int).SetUint64(level.Level),
+			IslmAmount: level.IslmAmount.BigInt(),
+			Price:      level.Price.String(),
+			HaqqAmount: level.HaqqAmount.BigInt(),
+		}
+	}
+
+	return breakdown
+}
+
 func NewCalculateForApplicationRequest(args []interface{}) (*ethiqtypes.QueryCalculateForApplicationRequest, error) {
 	if len(args) != 1 {
 		return nil, fmt.Errorf("invalid input arguments. Expected 1, got %d", len(args))
@@ -192,3 +236,118 @@ func CheckAndAcceptAuthorizationIfNeeded(
 
 	return nil
 }
+
+// BurnApplication is the ABI representation of an x/ethiq burn application.
+type BurnApplication struct {
+	ID                 uint64 `abi:"id"`
+	FromAddress        common.Address
+	ToAddress          common.Address
+	Source             uint8
+	BurnAmount         *big.Int
+	BurnedBeforeAmount *big.Int
+	IsExecuted         bool
+	IsCanceled         bool
+	IsApproved         bool
+}
+
+// NewBurnApplications converts the x/ethiq burn applications into their ABI representation.
+func NewBurnApplications(applications []ethiqtypes.BurnApplication) ([]BurnApplication, error) {
+	res := make([]BurnApplication, len(applications))
+	for i, application := range applications {
+		// the seeded waitlist applications use the mainnet prefix,
+		// so the addresses are decoded regardless of the configured one.
+		_, from, err := bech32.DecodeAndConvert(application.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		_, to, err := bech32.DecodeAndConvert(application.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = BurnApplication{
+			ID:                 application.Id,
+			FromAddress:        common.BytesToAddress(from),
+			ToAddress:          common.BytesToAddress(to),
+			Source:             uint8(application.Source), //nolint:gosec // enum values fit into uint8
+			BurnAmount:         application.BurnAmount.Amount.BigInt(),
+			BurnedBeforeAmount: application.BurnedBeforeAmount.Amount.BigInt(),
+			IsExecuted:         application.IsExecuted,
+			IsCanceled:         application.IsCanceled,
+			IsApproved:         application.IsApproved,
+		}
+	}
+
+	return res, nil
+}
+
+// GetApplicationsInput is a struct to represent the input information for
+// the getApplications query. Needed to unpack arguments into the PageRequest struct.
+type GetApplicationsInput struct {
+	Pagination query.PageRequest
+}
+
+// NewGetApplicationsRequest creates a new QueryGetApplicationsRequest instance from the given arguments.
+func NewGetApplicationsRequest(method *abi.Method, args []interface{}) (*ethiqtypes.QueryGetApplicationsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input GetApplicationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GetApplicationsInput struct: %s", err)
+	}
+
+	return &ethiqtypes.QueryGetApplicationsRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// GetSendersApplicationsInput is a struct to represent the input information for
+// the getSendersApplications query. Needed to unpack arguments into the PageRequest struct.
+type GetSendersApplicationsInput struct {
+	Sender     common.Address
+	Pagination query.PageRequest
+}
+
+// NewGetSendersApplicationsRequest creates a new QueryGetSendersApplicationsRequest instance
+// from the given arguments.
+func NewGetSendersApplicationsRequest(method *abi.Method, args []interface{}) (*ethiqtypes.QueryGetSendersApplicationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	sender, ok := args[0].(common.Address)
+	if !ok || sender == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidSender, args[0])
+	}
+
+	var input GetSendersApplicationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GetSendersApplicationsInput struct: %s", err)
+	}
+
+	return &ethiqtypes.QueryGetSendersApplicationsRequest{
+		SenderAddress: sdk.AccAddress(sender.Bytes()).String(),
+		Pagination:    &input.Pagination,
+	}, nil
+}
+
+// Params is the ABI representation of the x/ethiq module parameters.
+type Params struct {
+	Enabled      bool
+	MinMintPerTx *big.Int
+	MaxMintPerTx *big.Int
+	MaxSupply    *big.Int
+}
+
+// NewParams converts the x/ethiq module parameters into their ABI representation.
+func NewParams(params ethiqtypes.Params) Params {
+	return Params{
+		Enabled:      params.Enabled,
+		MinMintPerTx: params.MinMintPerTx.BigInt(),
+		MaxMintPerTx: params.MaxMintPerTx.BigInt(),
+		MaxSupply:    params.MaxSupply.BigInt(),
+	}
+}
@@ -25,6 +25,20 @@ import (
 	evmtypes "github.com/haqq-network/haqq/x/evm/types"
 )
 
+// registerApplication stores an approved burn application built from the waitlist item
+// as the next application in the module state and returns its ID
+func (s *PrecompileTestSuite) registerApplication(item ethiqtypes.ApplicationListItem) uint64 {
+	application, err := item.AsBurnApplication()
+	Expect(err).ToNot(HaveOccurred(), "waitlist application item must be valid")
+
+	ctx := s.network.GetContext()
+	application.Id = s.network.App.EthiqKeeper.GetNextApplicationID(ctx)
+	s.network.App.EthiqKeeper.SetApplication(ctx, *application)
+	s.network.App.EthiqKeeper.SetNextApplicationID(ctx, application.Id+1)
+
+	return application.Id
+}
+
 func (s *PrecompileTestSuite) SetupApproval(
 	granterPriv types.PrivKey,
 	grantee common.Address,
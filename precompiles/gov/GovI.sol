@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The Gov contract's address.
+address constant GOV_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The Gov contract's instance.
+GovI constant GOV_CONTRACT = GovI(GOV_PRECOMPILE_ADDRESS);
+
+/// @dev VoteOption defines the options of a governance vote.
+enum VoteOption {
+  // Unspecified defines a no-op vote option.
+  Unspecified,
+  // Yes defines a yes vote option.
+  Yes,
+  // Abstain defines an abstain vote option.
+  Abstain,
+  // No defines a no vote option.
+  No,
+  // NoWithVeto defines a no with veto vote option.
+  NoWithVeto
+}
+
+/// @dev WeightedVoteOption defines a vote option with the weight of the vote.
+/// @param option The vote option
+/// @param weight The decimal weight of the option, the weights of a vote must sum up to 1
+struct WeightedVoteOption {
+  VoteOption option;
+  string weight;
+}
+
+/// @dev WeightedVote defines the vote of an account on a proposal.
+/// @param proposalId The ID of the proposal
+/// @param voter The address of the voter
+/// @param options The weighted options of the vote
+/// @param metadata The metadata attached to the vote
+struct WeightedVote {
+  uint64 proposalId;
+  address voter;
+  WeightedVoteOption[] options;
+  string metadata;
+}
+
+/// @dev DepositData defines the deposit of an account on a proposal.
+/// @param proposalId The ID of the proposal
+/// @param depositor The address of the depositor
+/// @param amount The deposited coins
+struct DepositData {
+  uint64 proposalId;
+  address depositor;
+  Coin[] amount;
+}
+
+/// @dev TallyResultData defines the tally of the votes of a proposal.
+/// All the counts are decimal strings of the voting power.
+struct TallyResultData {
+  string yes;
+  string abstain;
+  string no;
+  string noWithVeto;
+}
+
+/// @dev ProposalData defines a governance proposal.
+/// @param id The ID of the proposal
+/// @param messages The type URLs of the messages executed if the proposal passes
+/// @param status The status of the proposal, as defined by the gov module ProposalStatus enum
+/// @param finalTallyResult The tally result, only set once the voting period is over
+/// @param submitTime The unix time the proposal was submitted at
+/// @param depositEndTime The unix time the deposit period ends at
+/// @param totalDeposit The total coins deposited on the proposal
+/// @param votingStartTime The unix time the voting period started at
+/// @param votingEndTime The unix time the voting period ends at
+/// @param metadata The metadata attached to the proposal
+/// @param title The title of the proposal
+/// @param summary The summary of the proposal
+/// @param proposer The address of the proposer
+/// @param expedited True if the proposal is expedited
+struct ProposalData {
+  uint64 id;
+  string[] messages;
+  uint32 status;
+  TallyResultData finalTallyResult;
+  uint64 submitTime;
+  uint64 depositEndTime;
+  Coin[] totalDeposit;
+  uint64 votingStartTime;
+  uint64 votingEndTime;
+  string metadata;
+  string title;
+  string summary;
+  address proposer;
+  bool expedited;
+}
+
+/// @author Haqq Team
+/// @title Gov Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the governance module.
+/// The voter, depositor or proposer must be either the calling contract, or the transaction
+/// origin that granted an authorization for the corresponding gov message to the calling contract.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface GovI {
+  /// @dev SubmitProposal defines an Event emitted when a proposal is submitted
+  /// @param proposer The address of the proposer
+  /// @param proposalId The ID of the proposal
+  event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+  /// @dev Vote defines an Event emitted when a vote is cast
+  /// @param voter The address of the voter
+  /// @param proposalId The ID of the proposal
+  /// @param option The vote option
+  event Vote(address indexed voter, uint64 proposalId, uint8 option);
+
+  /// @dev VoteWeighted defines an Event emitted when a weighted vote is cast
+  /// @param voter The address of the voter
+  /// @param proposalId The ID of the proposal
+  /// @param options The weighted options of the vote
+  event VoteWeighted(address indexed voter, uint64 proposalId, WeightedVoteOption[] options);
+
+  /// @dev Deposit defines an Event emitted when coins are deposited on a proposal
+  /// @param depositor The address of the depositor
+  /// @param proposalId The ID of the proposal
+  /// @param amount The deposited coins
+  event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+  /// TRANSACTIONS
+
+  /// @dev Submits a new governance proposal.
+  /// Proposals spending the community pool are rejected.
+  /// @param proposer The address of the proposer
+  /// @param jsonProposal The JSON encoded proposal: messages, metadata, title, summary and expedited
+  /// @param deposit The initial deposit of the proposal
+  /// @return proposalId The ID of the submitted proposal
+  function submitProposal(
+    address proposer,
+    bytes calldata jsonProposal,
+    Coin[] calldata deposit
+  ) external returns (uint64 proposalId);
+
+  /// @dev Casts a vote on a proposal.
+  /// @param voter The address of the voter
+  /// @param proposalId The ID of the proposal
+  /// @param option The vote option
+  /// @param metadata The metadata attached to the vote
+  /// @return success True if the vote was cast
+  function vote(
+    address voter,
+    uint64 proposalId,
+    VoteOption option,
+    string memory metadata
+  ) external returns (bool success);
+
+  /// @dev Casts a weighted vote on a proposal.
+  /// @param voter The address of the voter
+  /// @param proposalId The ID of the proposal
+  /// @param options The weighted options of the vote, the weights must sum up to 1
+  /// @param metadata The metadata attached to the vote
+  /// @return success True if the vote was cast
+  function voteWeighted(
+    address voter,
+    uint64 proposalId,
+    WeightedVoteOption[] calldata options,
+    string memory metadata
+  ) external returns (bool success);
+
+  /// @dev Deposits coins on a proposal.
+  /// @param depositor The address of the depositor
+  /// @param proposalId The ID of the proposal
+  /// @param amount The coins to deposit
+  /// @return success True if the deposit succeeded
+  function deposit(
+    address depositor,
+    uint64 proposalId,
+    Coin[] calldata amount
+  ) external returns (bool success);
+
+  /// QUERIES
+
+  /// @dev Returns a proposal by its ID.
+  /// @param proposalId The ID of the proposal
+  /// @return proposal The proposal data
+  function getProposal(
+    uint64 proposalId
+  ) external view returns (ProposalData memory proposal);
+
+  /// @dev Returns the proposals matching the given filters.
+  /// @param proposalStatus The status to filter by, 0 for any status
+  /// @param voter The address of a voter to filter by, the zero address for any voter
+  /// @param depositor The address of a depositor to filter by, the zero address for any depositor
+  /// @param pagination The pagination options
+  /// @return proposals The proposals
+  /// @return pageResponse The pagination response
+  function getProposals(
+    uint32 proposalStatus,
+    address voter,
+    address depositor,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (ProposalData[] memory proposals, PageResponse memory pageResponse);
+
+  /// @dev Returns the tally of the votes of a proposal.
+  /// @param proposalId The ID of the proposal
+  /// @return tallyResult The current tally, or the final one once the voting period is over
+  function getTallyResult(
+    uint64 proposalId
+  ) external view returns (TallyResultData memory tallyResult);
+
+  /// @dev Returns the vote of an account on a proposal.
+  /// @param proposalId The ID of the proposal
+  /// @param voter The address of the voter
+  /// @return vote The vote
+  function getVote(
+    uint64 proposalId,
+    address voter
+  ) external view returns (WeightedVote memory vote);
+
+  /// @dev Returns the votes on a proposal.
+  /// @param proposalId The ID of the proposal
+  /// @param pagination The pagination options
+  /// @return votes The votes
+  /// @return pageResponse The pagination response
+  function getVotes(
+    uint64 proposalId,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (WeightedVote[] memory votes, PageResponse memory pageResponse);
+
+  /// @dev Returns the deposit of an account on a proposal.
+  /// @param proposalId The ID of the proposal
+  /// @param depositor The address of the depositor
+  /// @return deposit The deposit
+  function getDeposit(
+    uint64 proposalId,
+    address depositor
+  ) external view returns (DepositData memory deposit);
+
+  /// @dev Returns the deposits on a proposal.
+  /// @param proposalId The ID of the proposal
+  /// @param pagination The pagination options
+  /// @return deposits The deposits
+  /// @return pageResponse The pagination response
+  function getDeposits(
+    uint64 proposalId,
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (DepositData[] memory deposits, PageResponse memory pageResponse);
+}
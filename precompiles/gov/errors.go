@@ -0,0 +1,22 @@
+package gov
+
+const (
+	// ErrDifferentOrigin is raised when a contract acts as an account that is not the origin.
+	ErrDifferentOrigin = "tx origin address %s does not match the %s address %s"
+	// ErrInvalidProposer is raised when the proposer address is invalid.
+	ErrInvalidProposer = "invalid proposer address: %v"
+	// ErrInvalidVoter is raised when the voter address is invalid.
+	ErrInvalidVoter = "invalid voter address: %v"
+	// ErrInvalidDepositor is raised when the depositor address is invalid.
+	ErrInvalidDepositor = "invalid depositor address: %v"
+	// ErrInvalidProposalID is raised when the proposal ID is invalid.
+	ErrInvalidProposalID = "invalid proposal id: %v"
+	// ErrInvalidProposalJSON is raised when the JSON encoded proposal can't be decoded.
+	ErrInvalidProposalJSON = "invalid proposal JSON: %v"
+	// ErrInvalidOption is raised when the vote option is invalid.
+	ErrInvalidOption = "invalid vote option: %v"
+	// ErrInvalidMetadata is raised when the metadata is invalid.
+	ErrInvalidMetadata = "invalid metadata: %v"
+	// ErrInvalidStatus is raised when the proposal status is invalid.
+	ErrInvalidStatus = "invalid proposal status: %v"
+)
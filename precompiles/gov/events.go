@@ -0,0 +1,138 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// EventTypeSubmitProposal defines the event type for the gov SubmitProposal transaction.
+	EventTypeSubmitProposal = "SubmitProposal"
+	// EventTypeVote defines the event type for the gov Vote transaction.
+	EventTypeVote = "Vote"
+	// EventTypeVoteWeighted defines the event type for the gov VoteWeighted transaction.
+	EventTypeVoteWeighted = "VoteWeighted"
+	// EventTypeDeposit defines the event type for the gov Deposit transaction.
+	EventTypeDeposit = "Deposit"
+)
+
+// EmitSubmitProposalEvent creates a new event emitted on a SubmitProposal transaction.
+func (p Precompile) EmitSubmitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposer common.Address, proposalID uint64) error {
+	event := p.ABI.Events[EventTypeSubmitProposal]
+
+	topics, err := makeTopics(event, proposer)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(proposalID)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitVoteEvent creates a new event emitted on a Vote transaction.
+func (p Precompile) EmitVoteEvent(ctx sdk.Context, stateDB vm.StateDB, voter common.Address, proposalID uint64, option uint8) error {
+	event := p.ABI.Events[EventTypeVote]
+
+	topics, err := makeTopics(event, voter)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, option)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitVoteWeightedEvent creates a new event emitted on a VoteWeighted transaction.
+func (p Precompile) EmitVoteWeightedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	voter common.Address,
+	proposalID uint64,
+	options []WeightedVoteOption,
+) error {
+	event := p.ABI.Events[EventTypeVoteWeighted]
+
+	topics, err := makeTopics(event, voter)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, options)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitDepositEvent creates a new event emitted on a Deposit transaction.
+func (p Precompile) EmitDepositEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	depositor common.Address,
+	proposalID uint64,
+	amount sdk.Coins,
+) error {
+	event := p.ABI.Events[EventTypeDeposit]
+
+	topics, err := makeTopics(event, depositor)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// makeTopics returns the event topics: the event signature followed by the indexed addresses.
+func makeTopics(event abi.Event, addrs ...common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(addrs)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, addr := range addrs {
+		topics[i+1], err = cmn.MakeTopic(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
+
+// addLog adds a precompile log with the given topics and data to the stateDB.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint: gosec // G115 blockHeight is positive int64 and can't overflow uint64
+	})
+}
@@ -0,0 +1,166 @@
+package gov
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for gov.
+type Precompile struct {
+	cmn.Precompile
+	govKeeper govkeeper.Keeper
+	codec     codec.Codec
+}
+
+// NewPrecompile creates a new gov Precompile instance as a PrecompiledContract.
+// The codec is used to decode the messages of the submitted proposals.
+func NewPrecompile(
+	govKeeper govkeeper.Keeper,
+	cdc codec.Codec,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	loadedAbi, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  loadedAbi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			AuthzKeeper:          authzKeeper,
+		},
+		govKeeper: govKeeper,
+		codec:     cdc,
+	}
+
+	// SetAddress defines the address of the gov precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.GovPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas returns the required bare minimum gas to execute precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoids panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err, stateDB, snapshot)()
+
+	return p.RunAtomic(
+		snapshot,
+		stateDB,
+		func() ([]byte, error) {
+			switch method.Name {
+			// Gov transactions
+			case SubmitProposalMethod:
+				bz, err = p.SubmitProposal(ctx, evm.Origin, contract, stateDB, method, args)
+			case VoteMethod:
+				bz, err = p.Vote(ctx, evm.Origin, contract, stateDB, method, args)
+			case VoteWeightedMethod:
+				bz, err = p.VoteWeighted(ctx, evm.Origin, contract, stateDB, method, args)
+			case DepositMethod:
+				bz, err = p.Deposit(ctx, evm.Origin, contract, stateDB, method, args)
+			// Gov queries
+			case GetProposalMethod:
+				bz, err = p.GetProposal(ctx, method, contract, args)
+			case GetProposalsMethod:
+				bz, err = p.GetProposals(ctx, method, contract, args)
+			case GetTallyResultMethod:
+				bz, err = p.GetTallyResult(ctx, method, contract, args)
+			case GetVoteMethod:
+				bz, err = p.GetVote(ctx, method, contract, args)
+			case GetVotesMethod:
+				bz, err = p.GetVotes(ctx, method, contract, args)
+			case GetDepositMethod:
+				bz, err = p.GetDeposit(ctx, method, contract, args)
+			case GetDepositsMethod:
+				bz, err = p.GetDeposits(ctx, method, contract, args)
+			default:
+				return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			cost := ctx.GasMeter().GasConsumed() - initialGas
+
+			if !contract.UseGas(cost) {
+				return nil, vm.ErrOutOfGas
+			}
+
+			if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+				return nil, err
+			}
+
+			return bz, nil
+		},
+	)
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available gov transactions are:
+//   - SubmitProposal
+//   - Vote
+//   - VoteWeighted
+//   - Deposit
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case SubmitProposalMethod,
+		VoteMethod,
+		VoteWeightedMethod,
+		DepositMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "gov")
+}
@@ -0,0 +1,47 @@
+package gov_test
+
+import (
+	"github.com/haqq-network/haqq/precompiles/gov"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method string
+		isTx   bool
+	}{
+		{gov.SubmitProposalMethod, s.precompile.Methods[gov.SubmitProposalMethod].Name, true},
+		{gov.VoteMethod, s.precompile.Methods[gov.VoteMethod].Name, true},
+		{gov.VoteWeightedMethod, s.precompile.Methods[gov.VoteWeightedMethod].Name, true},
+		{gov.DepositMethod, s.precompile.Methods[gov.DepositMethod].Name, true},
+		{gov.GetProposalMethod, s.precompile.Methods[gov.GetProposalMethod].Name, false},
+		{gov.GetProposalsMethod, s.precompile.Methods[gov.GetProposalsMethod].Name, false},
+		{gov.GetTallyResultMethod, s.precompile.Methods[gov.GetTallyResultMethod].Name, false},
+		{gov.GetVoteMethod, s.precompile.Methods[gov.GetVoteMethod].Name, false},
+		{gov.GetVotesMethod, s.precompile.Methods[gov.GetVotesMethod].Name, false},
+		{gov.GetDepositMethod, s.precompile.Methods[gov.GetDepositMethod].Name, false},
+		{gov.GetDepositsMethod, s.precompile.Methods[gov.GetDepositsMethod].Name, false},
+		{"invalid", "invalid", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(tc.method))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	for _, name := range []string{gov.VoteMethod, gov.GetProposalMethod} {
+		s.Run(name, func() {
+			method := s.precompile.Methods[name]
+			// Use methodID + padding to test RequiredGas
+			input := append(method.ID, make([]byte, 32)...) //nolint: gocritic
+			s.Require().Positive(s.precompile.RequiredGas(input))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas_ShortInput() {
+	s.Require().Equal(uint64(0), s.precompile.RequiredGas([]byte{0x01}))
+}
@@ -0,0 +1,197 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// GetProposalMethod defines the ABI method name for the gov Proposal query.
+	GetProposalMethod = "getProposal"
+	// GetProposalsMethod defines the ABI method name for the gov Proposals query.
+	GetProposalsMethod = "getProposals"
+	// GetTallyResultMethod defines the ABI method name for the gov TallyResult query.
+	GetTallyResultMethod = "getTallyResult"
+	// GetVoteMethod defines the ABI method name for the gov Vote query.
+	GetVoteMethod = "getVote"
+	// GetVotesMethod defines the ABI method name for the gov Votes query.
+	GetVotesMethod = "getVotes"
+	// GetDepositMethod defines the ABI method name for the gov Deposit query.
+	GetDepositMethod = "getDeposit"
+	// GetDepositsMethod defines the ABI method name for the gov Deposits query.
+	GetDepositsMethod = "getDeposits"
+)
+
+// GetProposal returns the proposal with the given ID.
+func (p *Precompile) GetProposal(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewProposalRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Proposal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewProposalData(res.Proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetProposals returns the proposals matching the given status, voter and depositor.
+func (p *Precompile) GetProposals(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewProposalsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Proposals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(ProposalsOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetTallyResult returns the tally of the votes of a proposal.
+// The tally is computed on the fly while the proposal is in its voting period.
+func (p *Precompile) GetTallyResult(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTallyResultRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).TallyResult(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewTallyResultData(res.Tally))
+}
+
+// GetVote returns the vote of an account on a proposal.
+func (p *Precompile) GetVote(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVoteRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Vote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewWeightedVote(res.Vote)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetVotes returns the votes on a proposal.
+func (p *Precompile) GetVotes(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVotesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Votes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(VotesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetDeposit returns the deposit of an account on a proposal.
+func (p *Precompile) GetDeposit(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDepositRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Deposit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewDepositData(res.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetDeposits returns the deposits on a proposal.
+func (p *Precompile) GetDeposits(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDepositsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := govkeeper.NewQueryServer(&p.govKeeper).Deposits(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(DepositsOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
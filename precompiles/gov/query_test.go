@@ -0,0 +1,203 @@
+package gov_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/gov"
+	"github.com/haqq-network/haqq/utils"
+)
+
+func (s *PrecompileTestSuite) TestGetProposal() {
+	method := s.precompile.Methods[gov.GetProposalMethod]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetProposal(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+	})
+
+	s.Run("fail - unknown proposal", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetProposal(s.network.GetContext(), &method, nil, []any{uint64(100)})
+		s.Require().ErrorContains(err, "doesn't exist")
+	})
+
+	s.Run("success - proposal with messages", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitProposal(ctx, sendProposal())
+
+		bz, err := s.precompile.GetProposal(ctx, &method, nil, []any{proposalID})
+		s.Require().NoError(err)
+
+		var out struct{ Proposal gov.ProposalData }
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Equal(proposalID, out.Proposal.ID)
+		s.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, out.Proposal.Messages)
+		s.Require().Equal(uint32(govv1.StatusVotingPeriod), out.Proposal.Status)
+		s.Require().Equal(s.keyring.GetAddr(0), out.Proposal.Proposer)
+		s.Require().Equal("send", out.Proposal.Title)
+		s.Require().Equal(depositCoins(minDeposit), out.Proposal.TotalDeposit)
+		s.Require().NotZero(out.Proposal.VotingEndTime)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetProposals() {
+	method := s.precompile.Methods[gov.GetProposalsMethod]
+
+	s.Run("fail - invalid status", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetProposals(s.network.GetContext(), &method, nil, []any{
+			uint32(100), common.Address{}, common.Address{}, query.PageRequest{},
+		})
+		s.Require().ErrorContains(err, "invalid proposal status")
+	})
+
+	s.Run("success - filter by status and voter", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		s.submitProposal(ctx, textProposal("first"))
+		votedID := s.submitProposal(ctx, textProposal("second"))
+		s.Require().NoError(s.network.App.GovKeeper.AddVote(ctx, votedID, s.keyring.GetAccAddr(1), govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+
+		bz, err := s.precompile.GetProposals(ctx, &method, nil, []any{
+			uint32(govv1.StatusVotingPeriod), common.Address{}, common.Address{}, query.PageRequest{CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out gov.ProposalsOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Proposals, 2)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+
+		bz, err = s.precompile.GetProposals(ctx, &method, nil, []any{
+			uint32(govv1.StatusNil), s.keyring.GetAddr(1), common.Address{}, query.PageRequest{},
+		})
+		s.Require().NoError(err)
+
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.Proposals, 1)
+		s.Require().Equal(votedID, out.Proposals[0].ID)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetTallyResult() {
+	method := s.precompile.Methods[gov.GetTallyResultMethod]
+
+	s.Run("fail - unknown proposal", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetTallyResult(s.network.GetContext(), &method, nil, []any{uint64(100)})
+		s.Require().ErrorContains(err, "doesn't exist")
+	})
+
+	s.Run("success - tally of a validator vote", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitProposal(ctx, textProposal("title"))
+
+		// the validators of the test network are self-delegated by their operators
+		validator := s.network.GetValidators()[0]
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		s.Require().NoError(err)
+		s.Require().NoError(s.network.App.GovKeeper.AddVote(ctx, proposalID, sdk.AccAddress(valAddr), govv1.NewNonSplitVoteOption(govv1.OptionNo), ""))
+
+		bz, err := s.precompile.GetTallyResult(ctx, &method, nil, []any{proposalID})
+		s.Require().NoError(err)
+
+		var out struct{ TallyResult gov.TallyResultData }
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Equal("0", out.TallyResult.Yes)
+		no, ok := math.NewIntFromString(out.TallyResult.No)
+		s.Require().True(ok)
+		s.Require().True(no.IsPositive())
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetVotes() {
+	voteMethod := s.precompile.Methods[gov.GetVoteMethod]
+	votesMethod := s.precompile.Methods[gov.GetVotesMethod]
+
+	s.Run("fail - no vote", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitProposal(ctx, textProposal("title"))
+
+		_, err := s.precompile.GetVote(ctx, &voteMethod, nil, []any{proposalID, s.keyring.GetAddr(1)})
+		s.Require().ErrorContains(err, "not found")
+	})
+
+	s.Run("success - weighted vote", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitProposal(ctx, textProposal("title"))
+		options := govv1.WeightedVoteOptions{
+			govv1.NewWeightedVoteOption(govv1.OptionYes, math.LegacyNewDecWithPrec(6, 1)),
+			govv1.NewWeightedVoteOption(govv1.OptionAbstain, math.LegacyNewDecWithPrec(4, 1)),
+		}
+		s.Require().NoError(s.network.App.GovKeeper.AddVote(ctx, proposalID, s.keyring.GetAccAddr(1), options, "metadata"))
+
+		bz, err := s.precompile.GetVote(ctx, &voteMethod, nil, []any{proposalID, s.keyring.GetAddr(1)})
+		s.Require().NoError(err)
+
+		var out struct{ Vote gov.WeightedVote }
+		s.Require().NoError(voteMethod.Outputs.Copy(&out, mustUnpack(s, voteMethod, bz)))
+		s.Require().Equal(s.keyring.GetAddr(1), out.Vote.Voter)
+		s.Require().Equal("metadata", out.Vote.Metadata)
+		s.Require().Equal([]gov.WeightedVoteOption{
+			{Option: uint8(govv1.OptionYes), Weight: options[0].Weight},
+			{Option: uint8(govv1.OptionAbstain), Weight: options[1].Weight},
+		}, out.Vote.Options)
+
+		bz, err = s.precompile.GetVotes(ctx, &votesMethod, nil, []any{proposalID, query.PageRequest{CountTotal: true}})
+		s.Require().NoError(err)
+
+		var votes gov.VotesOutput
+		s.Require().NoError(votesMethod.Outputs.Copy(&votes, mustUnpack(s, votesMethod, bz)))
+		s.Require().Len(votes.Votes, 1)
+		s.Require().Equal(uint64(1), votes.PageResponse.Total)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetDeposits() {
+	depositMethod := s.precompile.Methods[gov.GetDepositMethod]
+	depositsMethod := s.precompile.Methods[gov.GetDepositsMethod]
+
+	s.Run("success - proposer deposit", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		proposalID := s.submitProposal(ctx, textProposal("title"))
+
+		bz, err := s.precompile.GetDeposit(ctx, &depositMethod, nil, []any{proposalID, s.keyring.GetAddr(0)})
+		s.Require().NoError(err)
+
+		var out struct{ Deposit gov.DepositData }
+		s.Require().NoError(depositMethod.Outputs.Copy(&out, mustUnpack(s, depositMethod, bz)))
+		s.Require().Equal(proposalID, out.Deposit.ProposalID)
+		s.Require().Equal(s.keyring.GetAddr(0), out.Deposit.Depositor)
+		s.Require().Equal(depositCoins(minDeposit), out.Deposit.Amount)
+
+		bz, err = s.precompile.GetDeposits(ctx, &depositsMethod, nil, []any{proposalID, query.PageRequest{}})
+		s.Require().NoError(err)
+
+		var deposits gov.DepositsOutput
+		s.Require().NoError(depositsMethod.Outputs.Copy(&deposits, mustUnpack(s, depositsMethod, bz)))
+		s.Require().Len(deposits.Deposits, 1)
+		s.Require().Equal(utils.BaseDenom, deposits.Deposits[0].Amount[0].Denom)
+	})
+}
+
+// mustUnpack unpacks the ABI-encoded output of the method and fails the test on error.
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
@@ -0,0 +1,243 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	anteutils "github.com/haqq-network/haqq/app/ante/utils"
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// VoteMethod defines the ABI method name for the gov Vote transaction.
+	VoteMethod = "vote"
+	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
+	VoteWeightedMethod = "voteWeighted"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+)
+
+// checkAuthorization ensures the contract calling the precompile may act as the account.
+// No grant is needed when the caller is the account itself, e.g. an EOA calling the
+// precompile directly or a DAO voting with its own stake. Otherwise the account must
+// have originated the transaction and granted an authorization for the msg to the caller.
+func (p Precompile) checkAuthorization(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	account common.Address,
+	role string,
+	msg sdk.Msg,
+) error {
+	if contract.CallerAddress == account {
+		return nil
+	}
+
+	if origin != account {
+		return fmt.Errorf(ErrDifferentOrigin, origin.String(), role, account.String())
+	}
+
+	return authorization.CheckAndAcceptAuthz(ctx, p.AuthzKeeper, contract.CallerAddress, account, msg)
+}
+
+// SubmitProposal submits a new governance proposal with an initial deposit.
+// Proposals spending the community pool are rejected, as they are in Cosmos transactions.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(method, args, p.codec)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ proposer: %s, title: %s, messages: %d, deposit: %s }",
+			msg.Proposer,
+			msg.Title,
+			len(msg.Messages),
+			msg.InitialDeposit,
+		),
+	)
+
+	if err := p.checkAuthorization(ctx, origin, contract, proposerHexAddr, "proposer", msg); err != nil {
+		return nil, err
+	}
+
+	if err := anteutils.ValidateCommunityPoolSpend(msg); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(proposerHexAddr, sdk.Coins(msg.InitialDeposit).AmountOf(utils.BaseDenom).BigInt(), cmn.Sub))
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Vote casts a vote on a proposal.
+func (p *Precompile) Vote(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterHexAddr, err := NewMsgVote(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, option: %s }",
+			msg.Voter,
+			msg.ProposalId,
+			msg.Option,
+		),
+	)
+
+	if err := p.checkAuthorization(ctx, origin, contract, voterHexAddr, "voter", msg); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Vote(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVoteEvent(ctx, stateDB, voterHexAddr, msg.ProposalId, uint8(msg.Option)); err != nil { //nolint: gosec // G115 the option was unpacked from an uint8
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// VoteWeighted casts a weighted vote on a proposal.
+func (p *Precompile) VoteWeighted(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterHexAddr, options, err := NewMsgVoteWeighted(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, options: %v }",
+			msg.Voter,
+			msg.ProposalId,
+			msg.Options,
+		),
+	)
+
+	if err := p.checkAuthorization(ctx, origin, contract, voterHexAddr, "voter", msg); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.VoteWeighted(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVoteWeightedEvent(ctx, stateDB, voterHexAddr, msg.ProposalId, options); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Deposit deposits coins on a proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ depositor: %s, proposal_id: %d, amount: %s }",
+			msg.Depositor,
+			msg.ProposalId,
+			msg.Amount,
+		),
+	)
+
+	if err := p.checkAuthorization(ctx, origin, contract, depositorHexAddr, "depositor", msg); err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositorHexAddr, sdk.Coins(msg.Amount).AmountOf(utils.BaseDenom).BigInt(), cmn.Sub))
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,516 @@
+package gov_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/gov"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	"github.com/haqq-network/haqq/x/evm/statedb"
+)
+
+var (
+	// minDeposit is the minimum deposit of the test network, which starts the voting period.
+	minDeposit = big.NewInt(1e18)
+	// extraDeposit is a deposit above the minimum deposit ratio of the test network.
+	extraDeposit = big.NewInt(1e17)
+)
+
+// textProposal returns a JSON encoded proposal without messages.
+func textProposal(title string) []byte {
+	return []byte(fmt.Sprintf(`{"metadata":"ipfs://CID","title":%q,"summary":"summary"}`, title))
+}
+
+// sendProposal returns a JSON encoded proposal sending coins from the gov module account.
+func sendProposal() []byte {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	return []byte(fmt.Sprintf(
+		`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":%q,"to_address":%q,"amount":[{"denom":%q,"amount":"1"}]}],"title":"send","summary":"send"}`,
+		authority, authority, utils.BaseDenom,
+	))
+}
+
+// communityPoolSpendProposal returns a JSON encoded proposal spending the community pool.
+func communityPoolSpendProposal(recipient sdk.AccAddress) []byte {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	return []byte(fmt.Sprintf(
+		`{"messages":[{"@type":"/cosmos.distribution.v1beta1.MsgCommunityPoolSpend","authority":%q,"recipient":%q,"amount":[{"denom":%q,"amount":"1"}]}],"title":"spend","summary":"spend"}`,
+		authority, recipient.String(), utils.BaseDenom,
+	))
+}
+
+// depositCoins returns the given aISLM amount as precompile coins.
+func depositCoins(amount *big.Int) []cmn.Coin {
+	return []cmn.Coin{{Denom: utils.BaseDenom, Amount: amount}}
+}
+
+// submitProposal submits a proposal from the first keyring account through the precompile,
+// with the minimum deposit so that the voting period starts right away.
+func (s *PrecompileTestSuite) submitProposal(ctx sdk.Context, jsonProposal []byte) uint64 {
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	proposer := s.keyring.GetAddr(0)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, proposer, s.precompile, 200000)
+	bz, err := s.precompile.SubmitProposal(ctx, proposer, contract, s.network.GetStateDB(), &method, []any{
+		proposer, jsonProposal, depositCoins(minDeposit),
+	})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out[0].(uint64)
+}
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - different origin from proposer",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), textProposal("title"), depositCoins(minDeposit)}
+			},
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - invalid proposal JSON",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), []byte("{"), depositCoins(minDeposit)}
+			},
+			true,
+			"invalid proposal JSON",
+		},
+		{
+			"fail - community pool spend",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), communityPoolSpendProposal(s.keyring.GetAccAddr(1)), depositCoins(minDeposit)}
+			},
+			true,
+			"community pool spend coming later",
+		},
+		{
+			"success - text proposal",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), textProposal("title"), depositCoins(minDeposit)}
+			},
+			false,
+			"",
+		},
+		{
+			"success - proposal with messages",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), sendProposal(), depositCoins(minDeposit)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			proposalID := out[0].(uint64)
+
+			proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+			s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[gov.EventTypeSubmitProposal].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVote() {
+	var (
+		ctx        sdk.Context
+		proposalID uint64
+	)
+	method := s.precompile.Methods[gov.VoteMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - different origin from voter",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), proposalID, uint8(govv1.OptionYes), ""}
+			},
+			true,
+			"does not match the voter address",
+		},
+		{
+			"fail - invalid option",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID, uint8(10), ""}
+			},
+			true,
+			"invalid vote",
+		},
+		{
+			"fail - unknown proposal",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID + 100, uint8(govv1.OptionYes), ""}
+			},
+			true,
+			"inactive proposal",
+		},
+		{
+			"success - vote yes",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID, uint8(govv1.OptionYes), "metadata"}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			proposalID = s.submitProposal(ctx, textProposal("title"))
+			stDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			_, err := s.precompile.Vote(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			vote, err := s.network.App.GovKeeper.Votes.Get(ctx, voteKey(proposalID, s.keyring.GetAccAddr(0)))
+			s.Require().NoError(err)
+			s.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+			s.Require().Equal("metadata", vote.Metadata)
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[gov.EventTypeVote].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVoteWeighted() {
+	var (
+		ctx        sdk.Context
+		proposalID uint64
+	)
+	method := s.precompile.Methods[gov.VoteWeightedMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - weights don't sum up to one",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID, []gov.WeightedVoteOption{
+					{Option: uint8(govv1.OptionYes), Weight: "0.5"},
+				}, ""}
+			},
+			true,
+			"total weight lower than 1.00",
+		},
+		{
+			"success - split vote",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID, []gov.WeightedVoteOption{
+					{Option: uint8(govv1.OptionYes), Weight: "0.7"},
+					{Option: uint8(govv1.OptionNo), Weight: "0.3"},
+				}, ""}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			proposalID = s.submitProposal(ctx, textProposal("title"))
+			stDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			_, err := s.precompile.VoteWeighted(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			vote, err := s.network.App.GovKeeper.Votes.Get(ctx, voteKey(proposalID, s.keyring.GetAccAddr(0)))
+			s.Require().NoError(err)
+			s.Require().Len(vote.Options, 2)
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[gov.EventTypeVoteWeighted].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var (
+		ctx        sdk.Context
+		proposalID uint64
+	)
+	method := s.precompile.Methods[gov.DepositMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - different origin from depositor",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), proposalID, depositCoins(extraDeposit)}
+			},
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - unknown proposal",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID + 100, depositCoins(extraDeposit)}
+			},
+			true,
+			"not found",
+		},
+		{
+			"success - deposit",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), proposalID, depositCoins(extraDeposit)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			proposalID = s.submitProposal(ctx, textProposal("title"))
+			stDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+			s.Require().NoError(err)
+			expTotal := new(big.Int).Add(minDeposit, extraDeposit)
+			s.Require().Equal(expTotal, sdk.Coins(proposal.TotalDeposit).AmountOf(utils.BaseDenom).BigInt())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[gov.EventTypeDeposit].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+// TestContractVoter checks that a contract calling the precompile can vote with its own
+// address, while it can't vote on behalf of another account than the origin.
+func (s *PrecompileTestSuite) TestContractVoter() {
+	method := s.precompile.Methods[gov.VoteMethod]
+
+	testCases := []struct {
+		name        string
+		voter       func() int
+		expError    bool
+		errContains string
+	}{
+		{"success - contract votes for itself", func() int { return 2 }, false, ""},
+		{"fail - contract votes for the origin without a grant", func() int { return 0 }, true, "authorization to /cosmos.gov.v1.MsgVote"},
+		{"fail - contract votes for a third account", func() int { return 1 }, true, "does not match the voter address"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			proposalID := s.submitProposal(ctx, textProposal("title"))
+			origin := s.keyring.GetAddr(0)
+			voter := s.keyring.GetAddr(tc.voter())
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(2), s.precompile, 200000)
+			_, err := s.precompile.Vote(ctx, origin, contract, s.network.GetStateDB(), &method, []any{
+				voter, proposalID, uint8(govv1.OptionAbstain), "",
+			})
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.network.App.GovKeeper.Votes.Get(ctx, voteKey(proposalID, s.keyring.GetAccAddr(tc.voter())))
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// TestThirdPartyContract checks that a contract acting on behalf of the origin needs an
+// authorization granted by the origin for each gov transaction.
+func (s *PrecompileTestSuite) TestThirdPartyContract() {
+	testCases := []struct {
+		name   string
+		msgURL string
+		call   func(ctx sdk.Context, origin common.Address, contract *vm.Contract, proposalID uint64) error
+	}{
+		{
+			"submit proposal",
+			sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+			func(ctx sdk.Context, origin common.Address, contract *vm.Contract, _ uint64) error {
+				method := s.precompile.Methods[gov.SubmitProposalMethod]
+				_, err := s.precompile.SubmitProposal(ctx, origin, contract, s.network.GetStateDB(), &method, []any{
+					origin, textProposal("title"), depositCoins(minDeposit),
+				})
+				return err
+			},
+		},
+		{
+			"deposit",
+			sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+			func(ctx sdk.Context, origin common.Address, contract *vm.Contract, proposalID uint64) error {
+				method := s.precompile.Methods[gov.DepositMethod]
+				_, err := s.precompile.Deposit(ctx, origin, contract, s.network.GetStateDB(), &method, []any{
+					origin, proposalID, depositCoins(extraDeposit),
+				})
+				return err
+			},
+		},
+		{
+			"vote",
+			sdk.MsgTypeURL(&govv1.MsgVote{}),
+			func(ctx sdk.Context, origin common.Address, contract *vm.Contract, proposalID uint64) error {
+				method := s.precompile.Methods[gov.VoteMethod]
+				_, err := s.precompile.Vote(ctx, origin, contract, s.network.GetStateDB(), &method, []any{
+					origin, proposalID, uint8(govv1.OptionYes), "",
+				})
+				return err
+			},
+		},
+		{
+			"vote weighted",
+			sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+			func(ctx sdk.Context, origin common.Address, contract *vm.Contract, proposalID uint64) error {
+				method := s.precompile.Methods[gov.VoteWeightedMethod]
+				_, err := s.precompile.VoteWeighted(ctx, origin, contract, s.network.GetStateDB(), &method, []any{
+					origin, proposalID, []gov.WeightedVoteOption{
+						{Option: uint8(govv1.OptionYes), Weight: "0.5"},
+						{Option: uint8(govv1.OptionNo), Weight: "0.5"},
+					}, "",
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, withGrant := range []bool{false, true} {
+			s.Run(fmt.Sprintf("%s - grant: %t", tc.name, withGrant), func() {
+				s.SetupTest()
+				ctx := s.network.GetContext()
+				proposalID := s.submitProposal(ctx, textProposal("title"))
+				origin := s.keyring.GetAddr(0)
+				caller := s.keyring.GetAddr(2)
+
+				if withGrant {
+					expiration := ctx.BlockTime().Add(time.Hour)
+					err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), origin.Bytes(), authz.NewGenericAuthorization(tc.msgURL), &expiration)
+					s.Require().NoError(err)
+				}
+
+				contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, 200000)
+				err := tc.call(ctx, origin, contract, proposalID)
+				if !withGrant {
+					s.Require().ErrorContains(err, fmt.Sprintf("authorization to %s", tc.msgURL))
+					return
+				}
+				s.Require().NoError(err)
+			})
+		}
+	}
+}
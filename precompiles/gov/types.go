@@ -0,0 +1,592 @@
+package gov
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+)
+
+// WeightedVoteOption defines a vote option with the decimal weight of the vote.
+type WeightedVoteOption struct {
+	Option uint8
+	Weight string
+}
+
+// SubmitProposalInput is a struct to represent the arguments of the submitProposal transaction.
+type SubmitProposalInput struct {
+	Proposer     common.Address
+	JSONProposal []byte `abi:"jsonProposal"`
+	Deposit      []cmn.Coin
+}
+
+// VoteWeightedInput is a struct to represent the arguments of the voteWeighted transaction.
+// Needed to unpack the WeightedVoteOption tuples into native EVM types.
+type VoteWeightedInput struct {
+	Voter      common.Address
+	ProposalID uint64 `abi:"proposalId"`
+	Options    []WeightedVoteOption
+	Metadata   string
+}
+
+// DepositInput is a struct to represent the arguments of the deposit transaction.
+type DepositInput struct {
+	Depositor  common.Address
+	ProposalID uint64 `abi:"proposalId"`
+	Amount     []cmn.Coin
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance and does sanity checks
+// on the given arguments before populating the message.
+// The proposal messages, metadata, title, summary and expedited flag are decoded from
+// the JSON encoded proposal, while the proposer and deposit are taken from the arguments.
+func NewMsgSubmitProposal(method *abi.Method, args []interface{}, cdc codec.Codec) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input SubmitProposalInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SubmitProposalInput struct: %s", err)
+	}
+
+	if input.Proposer == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, input.Proposer)
+	}
+
+	msg := &govv1.MsgSubmitProposal{}
+	if err := cdc.UnmarshalJSON(input.JSONProposal, msg); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	deposit, err := newSDKCoins(input.Deposit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg.Proposer = sdk.AccAddress(input.Proposer.Bytes()).String()
+	msg.InitialDeposit = deposit
+
+	return msg, input.Proposer, nil
+}
+
+// NewMsgVote creates a new MsgVote instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voter, ok := args[0].(common.Address)
+	if !ok || voter == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVoter, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	option, ok := args[2].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOption, args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMetadata, args[3])
+	}
+
+	msg := &govv1.MsgVote{
+		ProposalId: proposalID,
+		Voter:      sdk.AccAddress(voter.Bytes()).String(),
+		Option:     govv1.VoteOption(option),
+		Metadata:   metadata,
+	}
+
+	return msg, voter, nil
+}
+
+// NewMsgVoteWeighted creates a new MsgVoteWeighted instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgVoteWeighted(method *abi.Method, args []interface{}) (*govv1.MsgVoteWeighted, common.Address, []WeightedVoteOption, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input VoteWeightedInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("error while unpacking args to VoteWeightedInput struct: %s", err)
+	}
+
+	if input.Voter == (common.Address{}) {
+		return nil, common.Address{}, nil, fmt.Errorf(ErrInvalidVoter, input.Voter)
+	}
+
+	options := make([]*govv1.WeightedVoteOption, len(input.Options))
+	for i, option := range input.Options {
+		options[i] = &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(option.Option),
+			Weight: option.Weight,
+		}
+	}
+
+	msg := &govv1.MsgVoteWeighted{
+		ProposalId: input.ProposalID,
+		Voter:      sdk.AccAddress(input.Voter.Bytes()).String(),
+		Options:    options,
+		Metadata:   input.Metadata,
+	}
+
+	return msg, input.Voter, input.Options, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input DepositInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to DepositInput struct: %s", err)
+	}
+
+	if input.Depositor == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, input.Depositor)
+	}
+
+	amount, err := newSDKCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: input.ProposalID,
+		Depositor:  sdk.AccAddress(input.Depositor.Bytes()).String(),
+		Amount:     amount,
+	}
+
+	return msg, input.Depositor, nil
+}
+
+// newSDKCoins converts the given coins into sorted and validated SDK coins.
+func newSDKCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	amount := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, coin.Amount)
+		}
+		amount[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	amount = amount.Sort()
+	if err := amount.Validate(); err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	return amount, nil
+}
+
+// NewProposalRequest creates a new QueryProposalRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewProposalRequest(args []interface{}) (*govv1.QueryProposalRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	return &govv1.QueryProposalRequest{ProposalId: proposalID}, nil
+}
+
+// ProposalsInput is a struct to represent the arguments of the getProposals query.
+// Needed to unpack arguments into the PageRequest struct.
+type ProposalsInput struct {
+	ProposalStatus uint32
+	Voter          common.Address
+	Depositor      common.Address
+	Pagination     query.PageRequest
+}
+
+// NewProposalsRequest creates a new QueryProposalsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+// The zero addresses don't filter the proposals by voter or depositor.
+func NewProposalsRequest(method *abi.Method, args []interface{}) (*govv1.QueryProposalsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input ProposalsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to ProposalsInput struct: %s", err)
+	}
+
+	if _, ok := govv1.ProposalStatus_name[int32(input.ProposalStatus)]; !ok { //nolint: gosec // G115 overflowing values are not valid statuses
+		return nil, fmt.Errorf(ErrInvalidStatus, input.ProposalStatus)
+	}
+
+	req := &govv1.QueryProposalsRequest{
+		ProposalStatus: govv1.ProposalStatus(input.ProposalStatus), //nolint: gosec // G115 validated above
+		Pagination:     normalizePageRequest(input.Pagination),
+	}
+	if input.Voter != (common.Address{}) {
+		req.Voter = sdk.AccAddress(input.Voter.Bytes()).String()
+	}
+	if input.Depositor != (common.Address{}) {
+		req.Depositor = sdk.AccAddress(input.Depositor.Bytes()).String()
+	}
+
+	return req, nil
+}
+
+// NewTallyResultRequest creates a new QueryTallyResultRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewTallyResultRequest(args []interface{}) (*govv1.QueryTallyResultRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	return &govv1.QueryTallyResultRequest{ProposalId: proposalID}, nil
+}
+
+// NewVoteRequest creates a new QueryVoteRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewVoteRequest(args []interface{}) (*govv1.QueryVoteRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	voter, ok := args[1].(common.Address)
+	if !ok || voter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidVoter, args[1])
+	}
+
+	return &govv1.QueryVoteRequest{
+		ProposalId: proposalID,
+		Voter:      sdk.AccAddress(voter.Bytes()).String(),
+	}, nil
+}
+
+// NewDepositRequest creates a new QueryDepositRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDepositRequest(args []interface{}) (*govv1.QueryDepositRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	depositor, ok := args[1].(common.Address)
+	if !ok || depositor == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidDepositor, args[1])
+	}
+
+	return &govv1.QueryDepositRequest{
+		ProposalId: proposalID,
+		Depositor:  sdk.AccAddress(depositor.Bytes()).String(),
+	}, nil
+}
+
+// PaginatedProposalInput is a struct to represent the arguments of the getVotes
+// and getDeposits queries. Needed to unpack arguments into the PageRequest struct.
+type PaginatedProposalInput struct {
+	ProposalID uint64 `abi:"proposalId"`
+	Pagination query.PageRequest
+}
+
+// NewVotesRequest creates a new QueryVotesRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewVotesRequest(method *abi.Method, args []interface{}) (*govv1.QueryVotesRequest, error) {
+	input, err := newPaginatedProposalInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &govv1.QueryVotesRequest{
+		ProposalId: input.ProposalID,
+		Pagination: normalizePageRequest(input.Pagination),
+	}, nil
+}
+
+// NewDepositsRequest creates a new QueryDepositsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDepositsRequest(method *abi.Method, args []interface{}) (*govv1.QueryDepositsRequest, error) {
+	input, err := newPaginatedProposalInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &govv1.QueryDepositsRequest{
+		ProposalId: input.ProposalID,
+		Pagination: normalizePageRequest(input.Pagination),
+	}, nil
+}
+
+// newPaginatedProposalInput unpacks the proposal ID and pagination arguments.
+func newPaginatedProposalInput(method *abi.Method, args []interface{}) (*PaginatedProposalInput, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input PaginatedProposalInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PaginatedProposalInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// normalizePageRequest returns the page request to be used by the gov queries.
+// A key of a single zero byte is treated as no key.
+func normalizePageRequest(pageRequest query.PageRequest) *query.PageRequest {
+	if bytes.Equal(pageRequest.Key, []byte{0}) {
+		pageRequest.Key = nil
+	}
+	return &pageRequest
+}
+
+// TallyResultData represents the tally of the votes of a proposal.
+type TallyResultData struct {
+	Yes        string
+	Abstain    string
+	No         string
+	NoWithVeto string
+}
+
+// NewTallyResultData creates a new TallyResultData from the gov tally result.
+func NewTallyResultData(tally *govv1.TallyResult) TallyResultData {
+	if tally == nil {
+		return TallyResultData{Yes: "0", Abstain: "0", No: "0", NoWithVeto: "0"}
+	}
+	return TallyResultData{
+		Yes:        tally.YesCount,
+		Abstain:    tally.AbstainCount,
+		No:         tally.NoCount,
+		NoWithVeto: tally.NoWithVetoCount,
+	}
+}
+
+// ProposalData represents a governance proposal.
+type ProposalData struct {
+	ID               uint64 `abi:"id"`
+	Messages         []string
+	Status           uint32
+	FinalTallyResult TallyResultData
+	SubmitTime       uint64
+	DepositEndTime   uint64
+	TotalDeposit     []cmn.Coin
+	VotingStartTime  uint64
+	VotingEndTime    uint64
+	Metadata         string
+	Title            string
+	Summary          string
+	Proposer         common.Address
+	Expedited        bool
+}
+
+// NewProposalData creates a new ProposalData from the gov proposal.
+func NewProposalData(proposal *govv1.Proposal) (ProposalData, error) {
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return ProposalData{}, err
+	}
+
+	messages := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		messages[i] = msg.TypeUrl
+	}
+
+	return ProposalData{
+		ID:               proposal.Id,
+		Messages:         messages,
+		Status:           uint32(proposal.Status), //nolint: gosec // G115 proposal statuses are non-negative
+		FinalTallyResult: NewTallyResultData(proposal.FinalTallyResult),
+		SubmitTime:       unixTime(proposal.SubmitTime),
+		DepositEndTime:   unixTime(proposal.DepositEndTime),
+		TotalDeposit:     cmn.NewCoinsResponse(proposal.TotalDeposit),
+		VotingStartTime:  unixTime(proposal.VotingStartTime),
+		VotingEndTime:    unixTime(proposal.VotingEndTime),
+		Metadata:         proposal.Metadata,
+		Title:            proposal.Title,
+		Summary:          proposal.Summary,
+		Proposer:         common.BytesToAddress(proposer.Bytes()),
+		Expedited:        proposal.Expedited,
+	}, nil
+}
+
+// ProposalsOutput is a struct to represent the output of the getProposals query.
+type ProposalsOutput struct {
+	Proposals    []ProposalData
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the ProposalsOutput from a QueryProposalsResponse.
+func (po *ProposalsOutput) FromResponse(res *govv1.QueryProposalsResponse) (*ProposalsOutput, error) {
+	po.Proposals = make([]ProposalData, len(res.Proposals))
+	for i, proposal := range res.Proposals {
+		data, err := NewProposalData(proposal)
+		if err != nil {
+			return nil, err
+		}
+		po.Proposals[i] = data
+	}
+
+	if res.Pagination != nil {
+		po.PageResponse.Total = res.Pagination.Total
+		po.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return po, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (po *ProposalsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(po.Proposals, po.PageResponse)
+}
+
+// WeightedVote represents the vote of an account on a proposal.
+type WeightedVote struct {
+	ProposalID uint64 `abi:"proposalId"`
+	Voter      common.Address
+	Options    []WeightedVoteOption
+	Metadata   string
+}
+
+// NewWeightedVote creates a new WeightedVote from the gov vote.
+func NewWeightedVote(vote *govv1.Vote) (WeightedVote, error) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return WeightedVote{}, err
+	}
+
+	options := make([]WeightedVoteOption, len(vote.Options))
+	for i, option := range vote.Options {
+		options[i] = WeightedVoteOption{
+			Option: uint8(option.Option), //nolint: gosec // G115 vote options fit in uint8
+			Weight: option.Weight,
+		}
+	}
+
+	return WeightedVote{
+		ProposalID: vote.ProposalId,
+		Voter:      common.BytesToAddress(voter.Bytes()),
+		Options:    options,
+		Metadata:   vote.Metadata,
+	}, nil
+}
+
+// VotesOutput is a struct to represent the output of the getVotes query.
+type VotesOutput struct {
+	Votes        []WeightedVote
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the VotesOutput from a QueryVotesResponse.
+func (vo *VotesOutput) FromResponse(res *govv1.QueryVotesResponse) (*VotesOutput, error) {
+	vo.Votes = make([]WeightedVote, len(res.Votes))
+	for i, vote := range res.Votes {
+		data, err := NewWeightedVote(vote)
+		if err != nil {
+			return nil, err
+		}
+		vo.Votes[i] = data
+	}
+
+	if res.Pagination != nil {
+		vo.PageResponse.Total = res.Pagination.Total
+		vo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return vo, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (vo *VotesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(vo.Votes, vo.PageResponse)
+}
+
+// DepositData represents the deposit of an account on a proposal.
+type DepositData struct {
+	ProposalID uint64 `abi:"proposalId"`
+	Depositor  common.Address
+	Amount     []cmn.Coin
+}
+
+// NewDepositData creates a new DepositData from the gov deposit.
+func NewDepositData(deposit *govv1.Deposit) (DepositData, error) {
+	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return DepositData{}, err
+	}
+
+	return DepositData{
+		ProposalID: deposit.ProposalId,
+		Depositor:  common.BytesToAddress(depositor.Bytes()),
+		Amount:     cmn.NewCoinsResponse(deposit.Amount),
+	}, nil
+}
+
+// DepositsOutput is a struct to represent the output of the getDeposits query.
+type DepositsOutput struct {
+	Deposits     []DepositData
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DepositsOutput from a QueryDepositsResponse.
+func (do *DepositsOutput) FromResponse(res *govv1.QueryDepositsResponse) (*DepositsOutput, error) {
+	do.Deposits = make([]DepositData, len(res.Deposits))
+	for i, deposit := range res.Deposits {
+		data, err := NewDepositData(deposit)
+		if err != nil {
+			return nil, err
+		}
+		do.Deposits[i] = data
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DepositsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Deposits, do.PageResponse)
+}
+
+// unixTime returns the unix time of the given time, or zero if it is not set.
+func unixTime(t *time.Time) uint64 {
+	if t == nil {
+		return 0
+	}
+	return uint64(t.Unix()) //nolint: gosec // G115 proposal times are after the unix epoch
+}
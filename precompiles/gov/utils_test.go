@@ -0,0 +1,11 @@
+package gov_test
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// voteKey returns the gov store key of the vote of the voter on the proposal.
+func voteKey(proposalID uint64, voter sdk.AccAddress) collections.Pair[uint64, sdk.AccAddress] {
+	return collections.Join(proposalID, voter)
+}
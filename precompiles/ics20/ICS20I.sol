@@ -54,7 +54,9 @@ interface ICS20I is IICS20Authorization {
     /// The timeout is disabled when set to 0
     /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
     /// The timeout is disabled when set to 0
-    /// @param memo optional memo
+    /// @param memo optional memo. A memo of the form {"src_callback": {"address": "<sender>"}}
+    /// calls back the sender contract through the IICS20Callbacks interface when the packet
+    /// is acknowledged or times out
     /// @return nextSequence sequence number of the transfer packet sent
     function transfer(
         string memory sourcePort,
@@ -90,4 +92,36 @@ interface ICS20I is IICS20Authorization {
         string memory trace
     ) external view returns (string memory hash);
 
+    /// @dev PacketCommitment defines a method for returning the commitment of an outgoing packet.
+    /// The commitment is empty once the packet is acknowledged or timed out, and the
+    /// sender contract is called back with the result if it requested it on transfer.
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence returned by the transfer
+    function packetCommitment(
+        string memory sourceChannel,
+        uint64 sequence
+    ) external view returns (bytes memory commitment);
+
+    /// @dev PacketAcknowledgement defines a method for returning whether an outgoing packet sent
+    /// through the precompile was acknowledged. The result of a settled packet is kept for
+    /// 100,000 blocks, after which the query reverts as for an unknown packet.
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence returned by the transfer
+    /// @return acknowledged true if the packet was acknowledged
+    /// @return success true if the acknowledgement is a success
+    function packetAcknowledgement(
+        string memory sourceChannel,
+        uint64 sequence
+    ) external view returns (bool acknowledged, bool success);
+
+    /// @dev PacketTimeout defines a method for returning whether an outgoing packet sent
+    /// through the precompile timed out. The result of a settled packet is kept for
+    /// 100,000 blocks, after which the query reverts as for an unknown packet.
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence returned by the transfer
+    function packetTimeout(
+        string memory sourceChannel,
+        uint64 sequence
+    ) external view returns (bool timedOut);
+
 }
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Haqq Team
+/// @title ICS20 Callbacks Interface
+/// @dev The interface implemented by the contracts that request to be called back when an
+/// ICS-20 packet they sent through the ICS20 precompile is acknowledged or times out.
+/// The callback is requested with a transfer memo of the form {"src_callback": {"address": "<sender>"}}.
+/// Callbacks are called by the erc20 module account with a gas limit of 200000. A failing
+/// callback is reverted without affecting the acknowledgement or timeout of the packet.
+interface IICS20Callbacks {
+    /// @dev Called when the packet is acknowledged.
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence of the packet
+    /// @param success true if the acknowledgement is a success, false if the tokens were refunded
+    function onPacketAcknowledgement(
+        string calldata sourceChannel,
+        uint64 sequence,
+        bool success
+    ) external;
+
+    /// @dev Called when the packet times out and the tokens are refunded.
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence of the packet
+    function onPacketTimeout(
+        string calldata sourceChannel,
+        uint64 sequence
+    ) external;
+}
@@ -24,4 +24,10 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
+	// ErrInvalidCallbackAddress is raised when the callback address requested in the memo is not the sender.
+	ErrInvalidCallbackAddress = "callback address %s is not the same as sender address %s"
+	// ErrInvalidSequence is raised when the packet sequence is invalid.
+	ErrInvalidSequence = "invalid packet sequence: %v"
+	// ErrOutgoingPacketNotFound is raised when the packet was not sent through the precompile.
+	ErrOutgoingPacketNotFound = "outgoing packet not found for source channel %s and sequence %d"
 )
@@ -14,6 +14,7 @@ import (
 
 	"github.com/haqq-network/haqq/precompiles/authorization"
 	cmn "github.com/haqq-network/haqq/precompiles/common"
+	erc20keeper "github.com/haqq-network/haqq/x/erc20/keeper"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 	evmtypes "github.com/haqq-network/haqq/x/evm/types"
 	transferkeeper "github.com/haqq-network/haqq/x/ibc/transfer/keeper"
@@ -32,6 +33,7 @@ type Precompile struct {
 	stakingKeeper  stakingkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
 	channelKeeper  channelkeeper.Keeper
+	erc20Keeper    erc20keeper.Keeper
 }
 
 // NewPrecompile creates a new ICS-20 Precompile instance as a
@@ -41,6 +43,7 @@ func NewPrecompile(
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
 ) (*Precompile, error) {
 	newAbi, err := cmn.LoadABI(f, "abi.json")
 	if err != nil {
@@ -58,6 +61,7 @@ func NewPrecompile(
 		transferKeeper: transferKeeper,
 		channelKeeper:  channelKeeper,
 		stakingKeeper:  stakingKeeper,
+		erc20Keeper:    erc20Keeper,
 	}
 
 	// SetAddress defines the address of the ICS-20 compile contract.
@@ -120,6 +124,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 				bz, err = p.DenomTraces(ctx, contract, method, args)
 			case DenomHashMethod:
 				bz, err = p.DenomHash(ctx, contract, method, args)
+			case PacketCommitmentMethod:
+				bz, err = p.PacketCommitment(ctx, contract, method, args)
+			case PacketAcknowledgementMethod:
+				bz, err = p.PacketAcknowledgement(ctx, contract, method, args)
+			case PacketTimeoutMethod:
+				bz, err = p.PacketTimeout(ctx, contract, method, args)
 			case authorization.AllowanceMethod:
 				bz, err = p.Allowance(ctx, method, args)
 			default:
@@ -13,6 +13,7 @@ import (
 
 	"github.com/haqq-network/haqq/precompiles/authorization"
 	cmn "github.com/haqq-network/haqq/precompiles/common"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 )
 
@@ -26,6 +27,15 @@ const (
 	// DenomHashMethod defines the ABI method name for the ICS20 DenomHash
 	// query.
 	DenomHashMethod = "denomHash"
+	// PacketCommitmentMethod defines the ABI method name for the ICS20 PacketCommitment
+	// query.
+	PacketCommitmentMethod = "packetCommitment"
+	// PacketAcknowledgementMethod defines the ABI method name for the ICS20 PacketAcknowledgement
+	// query.
+	PacketAcknowledgementMethod = "packetAcknowledgement"
+	// PacketTimeoutMethod defines the ABI method name for the ICS20 PacketTimeout
+	// query.
+	PacketTimeoutMethod = "packetTimeout"
 )
 
 // DenomTrace returns the requested denomination trace information.
@@ -147,3 +157,72 @@ func (p Precompile) Allowance(
 
 	return method.Outputs.Pack(allocs)
 }
+
+// PacketCommitment returns the commitment of the outgoing packet with the given source channel
+// and sequence. The commitment is empty once the packet is acknowledged or timed out.
+func (p Precompile) PacketCommitment(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sourceChannel, sequence, err := NewPacketRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := p.channelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, sourceChannel, sequence)
+
+	return method.Outputs.Pack(commitment)
+}
+
+// PacketAcknowledgement returns whether the outgoing packet sent through the precompile with
+// the given source channel and sequence was acknowledged, and whether the acknowledgement is a success.
+func (p Precompile) PacketAcknowledgement(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	packet, err := p.getOutgoingPacket(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	acknowledged := packet.Status == erc20types.PACKET_STATUS_ACKNOWLEDGED ||
+		packet.Status == erc20types.PACKET_STATUS_ACKNOWLEDGEMENT_ERROR
+
+	return method.Outputs.Pack(acknowledged, packet.Status == erc20types.PACKET_STATUS_ACKNOWLEDGED)
+}
+
+// PacketTimeout returns whether the outgoing packet sent through the precompile with
+// the given source channel and sequence timed out.
+func (p Precompile) PacketTimeout(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	packet, err := p.getOutgoingPacket(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(packet.Status == erc20types.PACKET_STATUS_TIMED_OUT)
+}
+
+// getOutgoingPacket returns the outgoing packet recorded by the Transfer method. The record
+// of a settled packet is pruned erc20types.SettledOutgoingPacketRetention blocks later.
+func (p Precompile) getOutgoingPacket(ctx sdk.Context, args []interface{}) (erc20types.OutgoingPacket, error) {
+	sourceChannel, sequence, err := NewPacketRequest(args)
+	if err != nil {
+		return erc20types.OutgoingPacket{}, err
+	}
+
+	packet, found := p.erc20Keeper.GetOutgoingPacket(ctx, sourceChannel, sequence)
+	if !found {
+		return erc20types.OutgoingPacket{}, fmt.Errorf(ErrOutgoingPacketNotFound, sourceChannel, sequence)
+	}
+
+	return packet, nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/haqq-network/haqq/precompiles/authorization"
 	cmn "github.com/haqq-network/haqq/precompiles/common"
 	"github.com/haqq-network/haqq/precompiles/ics20"
+	testutiltx "github.com/haqq-network/haqq/testutil/tx"
 	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
 )
 
 func (s *PrecompileTestSuite) TestDenomTrace() {
@@ -239,6 +241,90 @@ func (s *PrecompileTestSuite) TestDenomHash() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestPacketCommitment() {
+	method := s.precompile.Methods[ics20.PacketCommitmentMethod]
+	commitment := []byte("commitment")
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expCommit   []byte
+		errContains string
+	}{
+		{"fail - empty args", []interface{}{}, nil, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0)},
+		{"fail - invalid channel", []interface{}{"channel", uint64(1)}, nil, ics20.ErrInvalidSourceChannel},
+		{"fail - zero sequence", []interface{}{"channel-0", uint64(0)}, nil, "invalid packet sequence"},
+		{"success - no commitment", []interface{}{"channel-0", uint64(2)}, []byte{}, ""},
+		{"success - pending packet commitment", []interface{}{"channel-0", uint64(1)}, commitment, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.network.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, types.PortID, "channel-0", 1, commitment)
+
+			bz, err := s.precompile.PacketCommitment(ctx, nil, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out []byte
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, ics20.PacketCommitmentMethod, bz))
+			s.Require().Equal(tc.expCommit, out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPacketAcknowledgementAndTimeout() {
+	ackMethod := s.precompile.Methods[ics20.PacketAcknowledgementMethod]
+	timeoutMethod := s.precompile.Methods[ics20.PacketTimeoutMethod]
+
+	testCases := []struct {
+		name            string
+		status          erc20types.PacketStatus
+		expAcknowledged bool
+		expSuccess      bool
+		expTimedOut     bool
+	}{
+		{"pending", erc20types.PACKET_STATUS_PENDING, false, false, false},
+		{"acknowledged", erc20types.PACKET_STATUS_ACKNOWLEDGED, true, true, false},
+		{"acknowledgement error", erc20types.PACKET_STATUS_ACKNOWLEDGEMENT_ERROR, true, false, false},
+		{"timed out", erc20types.PACKET_STATUS_TIMED_OUT, false, false, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			args := []interface{}{"channel-0", uint64(1)}
+
+			_, err := s.precompile.PacketAcknowledgement(ctx, nil, &ackMethod, args)
+			s.Require().ErrorContains(err, "outgoing packet not found")
+
+			s.network.App.Erc20Keeper.RecordOutgoingPacket(ctx, "channel-0", 1, testutiltx.GenerateAddress(), false)
+			if tc.status != erc20types.PACKET_STATUS_PENDING {
+				s.network.App.Erc20Keeper.SettleOutgoingPacket(ctx, "channel-0", 1, tc.status)
+			}
+
+			bz, err := s.precompile.PacketAcknowledgement(ctx, nil, &ackMethod, args)
+			s.Require().NoError(err)
+			out, err := ackMethod.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAcknowledged, out[0])
+			s.Require().Equal(tc.expSuccess, out[1])
+
+			bz, err = s.precompile.PacketTimeout(ctx, nil, &timeoutMethod, args)
+			s.Require().NoError(err)
+			var timedOut bool
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&timedOut, ics20.PacketTimeoutMethod, bz))
+			s.Require().Equal(tc.expTimedOut, timedOut)
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestAllowance() {
 	var (
 		path   = haqqibctesting.NewTransferPath(s.chainA, s.chainB)
@@ -78,6 +78,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 		s.network.App.TransferKeeper,
 		s.network.App.IBCKeeper.ChannelKeeper,
 		s.network.App.AuthzKeeper,
+		s.network.App.Erc20Keeper,
 	); err != nil {
 		panic(err)
 	}
@@ -15,6 +15,7 @@ import (
 
 	cmn "github.com/haqq-network/haqq/precompiles/common"
 	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 )
 
@@ -51,6 +52,15 @@ func (p *Precompile) Transfer(
 		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
 	}
 
+	// the sender can request to be called back when the packet is acknowledged or times out
+	callbackAddr, callback, err := erc20types.ParseSourceCallbackAddress(msg.Memo)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMemo, err)
+	}
+	if callback && callbackAddr != sender {
+		return nil, fmt.Errorf(ErrInvalidCallbackAddress, callbackAddr, sender)
+	}
+
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
@@ -67,6 +77,8 @@ func (p *Precompile) Transfer(
 		return nil, err
 	}
 
+	p.erc20Keeper.RecordOutgoingPacket(ctx, msg.SourceChannel, res.Sequence, sender, callback)
+
 	if contract.CallerAddress != origin && msg.Token.Denom == utils.BaseDenom {
 		// escrow address is also changed on this tx, and it is not a module account
 		// so we need to account for this on the UpdateDirties
@@ -16,6 +16,7 @@ import (
 	evmosutil "github.com/haqq-network/haqq/testutil"
 	testutiltx "github.com/haqq-network/haqq/testutil/tx"
 	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 )
 
@@ -349,6 +350,57 @@ func (s *PrecompileTestSuite) TestTransfer() {
 			false,
 			"",
 		},
+		{
+			"fail - callback address is not the sender",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := s.coordinator.Setup(s.chainA.ChainID, s.chainB.ChainID)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					utils.BaseDenom,
+					big.NewInt(1e18),
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, differentAddress.Hex()),
+				}
+			},
+			func(sdk.AccAddress, sdk.AccAddress, []byte, []interface{}) {
+			},
+			200000,
+			true,
+			"is not the same as sender address",
+		},
+		{
+			"pass - transfer with a callback requested by the sender records the outgoing packet",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := s.coordinator.Setup(s.chainA.ChainID, s.chainB.ChainID)
+				err := s.NewTransferAuthorization(ctx, s.network.App, callingContractAddr, common.BytesToAddress(sender), path, defaultCoins, nil, []string{"*"})
+				s.Require().NoError(err)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					utils.BaseDenom,
+					big.NewInt(1e18),
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, common.BytesToAddress(sender.Bytes()).Hex()),
+				}
+			},
+			func(sender, _ sdk.AccAddress, _ []byte, inputArgs []interface{}) {
+				packet, found := s.network.App.Erc20Keeper.GetOutgoingPacket(ctx, inputArgs[1].(string), 1)
+				s.Require().True(found)
+				s.Require().Equal(common.BytesToAddress(sender.Bytes()).Hex(), packet.Sender)
+				s.Require().Equal(erc20types.PACKET_STATUS_PENDING, packet.Status)
+				s.Require().True(packet.Callback)
+			},
+			200000,
+			false,
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -17,6 +17,7 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -246,6 +247,26 @@ func NewDenomHashRequest(args []interface{}) (*transfertypes.QueryDenomHashReque
 	return req, nil
 }
 
+// NewPacketRequest returns the source channel and the sequence of the outgoing packet
+// from the given arguments.
+func NewPacketRequest(args []interface{}) (string, uint64, error) {
+	if len(args) != 2 {
+		return "", 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	sourceChannel, ok := args[0].(string)
+	if !ok || host.ChannelIdentifierValidator(sourceChannel) != nil {
+		return "", 0, errors.New(ErrInvalidSourceChannel)
+	}
+
+	sequence, ok := args[1].(uint64)
+	if !ok || sequence == 0 {
+		return "", 0, fmt.Errorf(ErrInvalidSequence, args[1])
+	}
+
+	return sourceChannel, sequence, nil
+}
+
 // checkRevokeArgs checks if the given arguments are valid for the Revoke tx.
 func checkRevokeArgs(args []interface{}) (common.Address, error) {
 	if len(args) != 1 {
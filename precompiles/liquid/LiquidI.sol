@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The Liquid contract's address.
+address constant LIQUID_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000902;
+
+/// @dev The Liquid contract's instance.
+LiquidI constant LIQUID_CONTRACT = LiquidI(LIQUID_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available methods.
+string constant MSG_LIQUIDATE = "/haqq.liquidvesting.v1.MsgLiquidate";
+string constant MSG_REDEEM = "/haqq.liquidvesting.v1.MsgRedeem";
+
+/// @dev Period represents a single period of a liquid token schedule.
+/// @param length the period length in seconds
+/// @param amount the coins unlocked at the end of the period
+struct Period {
+  int64 length;
+  Coin[] amount;
+}
+
+/// @dev LiquidDenom represents a liquid vesting token and the schedule of the locked coins behind it.
+/// Period amounts are denominated in the original denom.
+/// @param baseDenom the liquid token base denom (aLIQUIDn)
+/// @param displayDenom the liquid token display denom (LIQUIDn)
+/// @param originalDenom the denom of the locked coins behind the liquid token
+/// @param startTime the schedule start time as a unix timestamp in seconds
+/// @param endTime the schedule end time as a unix timestamp in seconds
+/// @param lockupPeriods the lockup periods of the locked coins
+/// @param vestingPeriods the vesting periods of the locked coins
+struct LiquidDenom {
+  string baseDenom;
+  string displayDenom;
+  string originalDenom;
+  int64 startTime;
+  int64 endTime;
+  Period[] lockupPeriods;
+  Period[] vestingPeriods;
+}
+
+/// @author Haqq Team
+/// @title Liquid Vesting Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the liquidvesting module
+/// @custom:address 0x0000000000000000000000000000000000000902
+interface LiquidI {
+  /// @dev Liquidate defines an Event emitted when locked coins are liquidated
+  /// @param sender The address whose locked aISLM coins were liquidated
+  /// @param receiver The address that received the liquid tokens
+  /// @param amount The amount of aISLM coins liquidated
+  /// @param erc20Contract The ERC20 contract of the minted liquid token
+  event Liquidate(
+    address indexed sender,
+    address indexed receiver,
+    uint256 amount,
+    address erc20Contract
+  );
+
+  /// @dev Redeem defines an Event emitted when liquid tokens are redeemed
+  /// @param sender The address that redeemed the liquid tokens
+  /// @param receiver The address that received the locked coins
+  /// @param denom The liquid token denom
+  /// @param amount The amount of liquid tokens redeemed
+  event Redeem(
+    address indexed sender,
+    address indexed receiver,
+    string denom,
+    uint256 amount
+  );
+
+  /// TRANSACTIONS
+
+  /// @dev Liquidate converts locked aISLM coins into a new liquid token.
+  /// @param liquidateFrom the hex address of the vesting account
+  /// @param liquidateTo the hex address receiving the liquid tokens
+  /// @param amount the amount of aISLM to liquidate
+  /// @return mintedAmount the amount of liquid tokens minted
+  /// @return erc20Contract the ERC20 contract of the minted liquid token
+  function liquidate(
+    address liquidateFrom,
+    address liquidateTo,
+    uint256 amount
+  ) external returns (uint256 mintedAmount, address erc20Contract);
+
+  /// @dev Redeem burns liquid tokens and returns the locked coins behind them with the remaining schedule.
+  /// @param redeemFrom the hex address holding the liquid tokens
+  /// @param redeemTo the hex address receiving the locked coins
+  /// @param denom the liquid token denom
+  /// @param amount the amount of liquid tokens to redeem
+  function redeem(
+    address redeemFrom,
+    address redeemTo,
+    string memory denom,
+    uint256 amount
+  ) external;
+
+  /// QUERIES
+
+  /// @dev Denom returns the liquid token by its base denom.
+  /// @param denom the liquid token base denom
+  /// @return liquidDenom the liquid token with its schedule
+  function denom(
+    string memory denom
+  ) external view returns (LiquidDenom memory liquidDenom);
+
+  /// @dev Denoms returns all liquid tokens.
+  /// @param pageRequest the pagination request
+  /// @return denoms the liquid tokens with their schedules
+  /// @return pageResponse the pagination response
+  function denoms(
+    PageRequest calldata pageRequest
+  ) external view returns (LiquidDenom[] memory denoms, PageResponse memory pageResponse);
+
+  /// @dev DenomByERC20 returns the liquid token by the ERC20 contract of its token pair.
+  /// @param erc20Address the ERC20 contract address
+  /// @return liquidDenom the liquid token with its schedule
+  function denomByERC20(
+    address erc20Address
+  ) external view returns (LiquidDenom memory liquidDenom);
+}
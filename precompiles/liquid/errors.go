@@ -7,6 +7,10 @@ const (
 	ErrInvalidReceiver = "invalid receiver address: %v"
 	// ErrInvalidDenom is raised when the provided denom cannot be unpacked.
 	ErrInvalidDenom = "invalid denom: %v"
+	// ErrInvalidERC20Address is raised when the ERC20 contract address cannot be unpacked.
+	ErrInvalidERC20Address = "invalid ERC20 contract address: %v"
+	// ErrDenomNotFoundForERC20 is raised when no liquid token is paired with the ERC20 contract.
+	ErrDenomNotFoundForERC20 = "liquid denom for ERC20 contract %s not found"
 	// ErrInvalidAmount is raised when the amount cannot be unpacked.
 	ErrInvalidAmount = "invalid amount: %v"
 	// ErrDifferentOriginFromSender is raised when the origin is different from the sender.
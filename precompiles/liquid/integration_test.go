@@ -61,7 +61,7 @@ func (s *liquidSafeSuite) setupNetwork(kr keyring.Keyring) {
 		network.WithCustomGenesis(customGenesis),
 	)
 	gh := grpc.NewIntegrationHandler(nw)
-	pc, err := liquid.NewPrecompile(nw.App.LiquidVestingKeeper, nw.App.AuthzKeeper)
+	pc, err := liquid.NewPrecompile(nw.App.LiquidVestingKeeper, nw.App.BankKeeper, nw.App.AuthzKeeper)
 	Expect(err).NotTo(HaveOccurred(), "failed to create liquid vesting precompile")
 
 	s.network = nw
@@ -867,7 +867,7 @@ var _ = Describe("Liquid Vesting precompile with Gnosis Safe (smart contract wal
 			//
 			// This explicitly exercises the cross-account redeem branch of
 			// the precompile mirror (where redeemFrom and redeemTo are two
-			// distinct addresses, both passed to mirrorBankBaseDeltasIntoStateDB):
+			// distinct addresses, both passed to MirrorBankBaseDeltas):
 			//   - Safe (redeemFrom): only aLIQUID0 moves; base aISLM is unchanged,
 			//     so the mirror snapshot resolves to a zero delta and is a no-op.
 			//   - owner2 (redeemTo): receives 100 aISLM from the module account,
@@ -22,12 +22,14 @@ var f embed.FS
 // Precompile defines the precompiled contract for the liquidvesting module.
 type Precompile struct {
 	cmn.Precompile
-	keeper liquidkeeper.Keeper
+	keeper     liquidkeeper.Keeper
+	bankKeeper cmn.BankKeeper
 }
 
 // NewPrecompile creates a new liquid Precompile instance as a PrecompiledContract.
 func NewPrecompile(
 	keeper liquidkeeper.Keeper,
+	bankKeeper cmn.BankKeeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	loadedABI, err := cmn.LoadABI(f, "abi.json")
@@ -43,7 +45,8 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration,
 		},
-		keeper: keeper,
+		keeper:     keeper,
+		bankKeeper: bankKeeper,
 	}
 
 	// SetAddress defines the address of the liquid precompiled contract.
@@ -90,6 +93,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 				bz, err = p.Liquidate(ctx, evm.Origin, contract, stateDB, method, args)
 			case RedeemMethod:
 				bz, err = p.Redeem(ctx, evm.Origin, contract, stateDB, method, args)
+			// Queries
+			case DenomMethod:
+				bz, err = p.Denom(ctx, contract, method, args)
+			case DenomsMethod:
+				bz, err = p.Denoms(ctx, contract, method, args)
+			case DenomByERC20Method:
+				bz, err = p.DenomByERC20(ctx, contract, method, args)
 			default:
 				return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 			}
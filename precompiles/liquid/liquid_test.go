@@ -12,6 +12,9 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	}{
 		{"liquidate is a transaction", liquid.LiquidateMethod, true},
 		{"redeem is a transaction", liquid.RedeemMethod, true},
+		{"denom is not a transaction", liquid.DenomMethod, false},
+		{"denoms is not a transaction", liquid.DenomsMethod, false},
+		{"denomByERC20 is not a transaction", liquid.DenomByERC20Method, false},
 		{"unknown method is not a transaction", "unknown", false},
 	}
 
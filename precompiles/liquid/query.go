@@ -0,0 +1,95 @@
+package liquid
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	liquidtypes "github.com/haqq-network/haqq/x/liquidvesting/types"
+)
+
+const (
+	// DenomMethod defines the ABI method name for the liquidvesting Denom query.
+	DenomMethod = "denom"
+	// DenomsMethod defines the ABI method name for the liquidvesting Denoms query.
+	DenomsMethod = "denoms"
+	// DenomByERC20Method defines the ABI method name for the liquid token query by its ERC20 contract.
+	DenomByERC20Method = "denomByERC20"
+)
+
+// Denom returns the liquid token by its base denom.
+func (p Precompile) Denom(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDenomRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.keeper.Denom(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewLiquidDenom(res.Denom))
+}
+
+// Denoms returns all liquid tokens with pagination.
+func (p Precompile) Denoms(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDenomsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.keeper.Denoms(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DenomsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// DenomByERC20 returns the liquid token by the ERC20 contract address of its token pair.
+func (p Precompile) DenomByERC20(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	erc20Address, err := ParseDenomByERC20Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, found := p.keeper.GetDenomByERC20(ctx, erc20Address)
+	if !found {
+		return nil, fmt.Errorf(ErrDenomNotFoundForERC20, erc20Address)
+	}
+
+	return method.Outputs.Pack(NewLiquidDenom(denom))
+}
+
+// NewLiquidDenom converts the liquid token to the ABI representation.
+func NewLiquidDenom(denom liquidtypes.Denom) LiquidDenom {
+	return LiquidDenom{
+		BaseDenom:      denom.BaseDenom,
+		DisplayDenom:   denom.DisplayDenom,
+		OriginalDenom:  denom.OriginalDenom,
+		StartTime:      denom.StartTime.Unix(),
+		EndTime:        denom.EndTime.Unix(),
+		LockupPeriods:  NewPeriods(denom.LockupPeriods),
+		VestingPeriods: NewPeriods(denom.VestingPeriods),
+	}
+}
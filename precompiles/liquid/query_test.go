@@ -0,0 +1,113 @@
+package liquid_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/liquid"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	"github.com/haqq-network/haqq/utils"
+	liquidtypes "github.com/haqq-network/haqq/x/liquidvesting/types"
+)
+
+// liquidateForQuery creates a liquid token and returns its denom and ERC20 contract address.
+func (s *PrecompileTestSuite) liquidateForQuery(ctx sdk.Context) (liquidtypes.Denom, common.Address) {
+	fromAccAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	s.createClawbackVestingAccount(ctx, fromAccAddr)
+
+	liquidCoin, erc20Address, err := s.network.App.LiquidVestingKeeper.Liquidate(
+		ctx, fromAccAddr, s.keyring.GetAccAddr(1), sdk.NewInt64Coin(utils.BaseDenom, 1_500_000),
+	)
+	s.Require().NoError(err)
+
+	denom, found := s.network.App.LiquidVestingKeeper.GetDenom(ctx, liquidCoin.Denom)
+	s.Require().True(found)
+
+	return denom, common.HexToAddress(erc20Address)
+}
+
+func (s *PrecompileTestSuite) TestDenom() {
+	method := s.precompile.Methods[liquid.DenomMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	denom, _ := s.liquidateForQuery(ctx)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	_, err := s.precompile.Denom(ctx, contract, &method, []any{})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+
+	_, err = s.precompile.Denom(ctx, contract, &method, []any{liquidtypes.DenomBaseNameFromID(999)})
+	s.Require().ErrorContains(err, "not found")
+
+	bz, err := s.precompile.Denom(ctx, contract, &method, []any{denom.BaseDenom})
+	s.Require().NoError(err)
+
+	var out struct{ LiquidDenom liquid.LiquidDenom }
+	err = s.precompile.UnpackIntoInterface(&out, liquid.DenomMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(liquid.NewLiquidDenom(denom), out.LiquidDenom)
+	s.Require().Equal(utils.BaseDenom, out.LiquidDenom.OriginalDenom)
+	s.Require().Equal(denom.StartTime.Unix(), out.LiquidDenom.StartTime)
+	s.Require().NotEmpty(out.LiquidDenom.LockupPeriods)
+}
+
+func (s *PrecompileTestSuite) TestDenoms() {
+	method := s.precompile.Methods[liquid.DenomsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	first, _ := s.liquidateForQuery(ctx)
+	second, _ := s.liquidateForQuery(ctx)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 500000)
+
+	bz, err := s.precompile.Denoms(ctx, contract, &method, []any{query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out liquid.DenomsOutput
+	err = s.precompile.UnpackIntoInterface(&out, liquid.DenomsMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]liquid.LiquidDenom{liquid.NewLiquidDenom(first)}, out.Denoms)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+
+	bz, err = s.precompile.Denoms(ctx, contract, &method, []any{query.PageRequest{Key: out.PageResponse.NextKey}})
+	s.Require().NoError(err)
+
+	var next liquid.DenomsOutput
+	err = s.precompile.UnpackIntoInterface(&next, liquid.DenomsMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]liquid.LiquidDenom{liquid.NewLiquidDenom(second)}, next.Denoms)
+	s.Require().Empty(next.PageResponse.NextKey)
+}
+
+func (s *PrecompileTestSuite) TestDenomByERC20() {
+	method := s.precompile.Methods[liquid.DenomByERC20Method]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	denom, erc20Address := s.liquidateForQuery(ctx)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	_, err := s.precompile.DenomByERC20(ctx, contract, &method, []any{"not-an-address"})
+	s.Require().ErrorContains(err, "invalid ERC20 contract address")
+
+	_, err = s.precompile.DenomByERC20(ctx, contract, &method, []any{utiltx.GenerateAddress()})
+	s.Require().ErrorContains(err, "not found")
+
+	bz, err := s.precompile.DenomByERC20(ctx, contract, &method, []any{erc20Address})
+	s.Require().NoError(err)
+
+	var out struct{ LiquidDenom liquid.LiquidDenom }
+	err = s.precompile.UnpackIntoInterface(&out, liquid.DenomByERC20Method, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(liquid.NewLiquidDenom(denom), out.LiquidDenom)
+}
@@ -52,6 +52,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 	var err error
 	s.precompile, err = liquid.NewPrecompile(
 		nw.App.LiquidVestingKeeper,
+		nw.App.BankKeeper,
 		nw.App.AuthzKeeper,
 	)
 	s.Require().NoError(err)
@@ -3,7 +3,6 @@ package liquid
 import (
 	"fmt"
 
-	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,73 +19,6 @@ const (
 	RedeemMethod = "redeem"
 )
 
-// bankBaseSnapshot pairs an account with its sampled base-denom (aISLM) bank
-// balance, captured immediately before a keeper call. It is the input unit
-// for mirrorBankBaseDeltasIntoStateDB.
-type bankBaseSnapshot struct {
-	addr       sdk.AccAddress
-	baseBefore sdkmath.Int
-}
-
-// mirrorBankBaseDeltasIntoStateDB mirrors per-account bank deltas of the EVM
-// gas denom (aISLM) into the EVM StateDB journal when the precompile is
-// invoked from another contract (caller != origin).
-//
-// Why this is needed:
-//   - Liquidate/Redeem move aISLM through bankKeeper.SendCoinsFromAccount/ModuleTo* directly.
-//     Those calls update the SDK bank state but do not touch the EVM journal.
-//   - On EVM tx commit, x/evm reconciles native EVM account balances against the
-//     SDK bank for accounts touched by the EVM journal. If the contract account that
-//     was actually debited/credited in bank is not in the journal, its EVM-side balance
-//     is left unchanged, while bank already reflects the move - leading to a state
-//     mismatch and effectively "phantom" coins on the EVM side.
-//   - When caller == origin (EOA), x/evm intentionally skips reconciling the origin's
-//     balance for these direct keeper movements, so no journal entry is required.
-//
-// Each snapshot.baseBefore must be sampled immediately before the keeper/msg work;
-// the resulting delta matches the actual bank movement (which may differ from the
-// nominal msg amount, e.g. for Redeem the credit on `to` equals the unlocked
-// principal that round-trips through the module account).
-//
-// IMPORTANT - duplicate accounts must be mirrored exactly once. A given account
-// can appear in multiple roles in the same call (typical case: redeemFrom ==
-// redeemTo when an account redeems back into itself). The function
-// deduplicates snapshots by address bech32, so a single account contributes a
-// single Sub/Add journal entry whose magnitude is its net post-keeper bank
-// delta. Without this, x/evm would over-credit/-debit the account at commit
-// (each repeated journal entry triggers another reconciliation pass), which is
-// exactly the phantom-coin failure mode this helper exists to prevent.
-func (p *Precompile) mirrorBankBaseDeltasIntoStateDB(
-	ctx sdk.Context,
-	isCallerOrigin bool,
-	snapshots ...bankBaseSnapshot,
-) {
-	if isCallerOrigin {
-		return
-	}
-	seen := make(map[string]struct{}, len(snapshots))
-	for _, s := range snapshots {
-		key := s.addr.String()
-		if _, ok := seen[key]; ok {
-			continue
-		}
-		seen[key] = struct{}{}
-
-		netBaseDelta := s.baseBefore.Sub(p.keeper.BaseDenomBankBalance(ctx, s.addr))
-		if netBaseDelta.IsZero() {
-			continue
-		}
-		hexAddr := common.BytesToAddress(s.addr.Bytes())
-		if netBaseDelta.IsNegative() {
-			// Bank balance grew: account was credited - mirror as Add.
-			p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(hexAddr, netBaseDelta.Neg().BigInt(), cmn.Add))
-			continue
-		}
-		// Bank balance shrank: account was debited - mirror as Sub.
-		p.AddBalanceChangeEntries(cmn.NewBalanceChangeEntry(hexAddr, netBaseDelta.BigInt(), cmn.Sub))
-	}
-}
-
 // Liquidate executes the liquidvesting Liquidate message.
 // It supports authorization when the caller is not the origin account.
 func (p *Precompile) Liquidate(
@@ -163,7 +95,7 @@ func (p *Precompile) Liquidate(
 	// only receives aLIQUID*, which is not the EVM gas denom, so there is no
 	// base-denom delta to mirror on it.
 	liquidateFromAccAddr := sdk.MustAccAddressFromBech32(msg.LiquidateFrom)
-	liquidateFromBaseBefore := p.keeper.BaseDenomBankBalance(ctx, liquidateFromAccAddr)
+	liquidateFromBaseBefore := cmn.BaseDenomBankBalance(ctx, p.bankKeeper, liquidateFromAccAddr)
 
 	// Execute the message using the message server.
 	msgSrv := liquidkeeper.NewMsgServerImpl(p.keeper)
@@ -175,8 +107,8 @@ func (p *Precompile) Liquidate(
 	// Mirror the bank delta on liquidateFrom into the EVM StateDB journal so
 	// that the EVM commit sees the contract caller's aISLM balance as actually
 	// debited.
-	p.mirrorBankBaseDeltasIntoStateDB(ctx, isCallerOrigin,
-		bankBaseSnapshot{addr: liquidateFromAccAddr, baseBefore: liquidateFromBaseBefore},
+	p.MirrorBankBaseDeltas(ctx, p.bankKeeper, isCallerOrigin,
+		cmn.BankBaseSnapshot{Addr: liquidateFromAccAddr, BaseBefore: liquidateFromBaseBefore},
 	)
 
 	minted := res.Minted.Amount.BigInt()
@@ -275,13 +207,13 @@ func (p *Precompile) Redeem(
 	//
 	// When redeemFrom == redeemTo (typical "self-redeem"), both snapshots
 	// resolve to the same account; the dedup logic in
-	// mirrorBankBaseDeltasIntoStateDB ensures the net delta is mirrored
+	// MirrorBankBaseDeltas ensures the net delta is mirrored
 	// exactly once. Without that dedup, x/evm's commit-time reconciliation
 	// would replay the credit twice and double the bank balance growth.
 	redeemFromAccAddr := sdk.MustAccAddressFromBech32(msg.RedeemFrom)
 	redeemToAccAddr := sdk.MustAccAddressFromBech32(msg.RedeemTo)
-	redeemFromBaseBefore := p.keeper.BaseDenomBankBalance(ctx, redeemFromAccAddr)
-	redeemToBaseBefore := p.keeper.BaseDenomBankBalance(ctx, redeemToAccAddr)
+	redeemFromBaseBefore := cmn.BaseDenomBankBalance(ctx, p.bankKeeper, redeemFromAccAddr)
+	redeemToBaseBefore := cmn.BaseDenomBankBalance(ctx, p.bankKeeper, redeemToAccAddr)
 
 	// Execute the message using the message server.
 	msgSrv := liquidkeeper.NewMsgServerImpl(p.keeper)
@@ -289,9 +221,9 @@ func (p *Precompile) Redeem(
 		return nil, err
 	}
 
-	p.mirrorBankBaseDeltasIntoStateDB(ctx, isCallerOrigin,
-		bankBaseSnapshot{addr: redeemFromAccAddr, baseBefore: redeemFromBaseBefore},
-		bankBaseSnapshot{addr: redeemToAccAddr, baseBefore: redeemToBaseBefore},
+	p.MirrorBankBaseDeltas(ctx, p.bankKeeper, isCallerOrigin,
+		cmn.BankBaseSnapshot{Addr: redeemFromAccAddr, BaseBefore: redeemFromBaseBefore},
+		cmn.BankBaseSnapshot{Addr: redeemToAccAddr, BaseBefore: redeemToBaseBefore},
 	)
 
 	if err := p.EmitRedeemEvent(ctx, stateDB, sender, common.HexToAddress(msg.RedeemTo), msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
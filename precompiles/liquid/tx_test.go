@@ -657,7 +657,7 @@ func (s *PrecompileTestSuite) TestLiquidateEOABankDeltas() {
 
 // TestRedeemEOASelfBankDeltas exercises the EOA self-redeem path
 // (caller == origin AND redeemFrom == redeemTo). It is the EOA-side regression
-// for the dedup logic in mirrorBankBaseDeltasIntoStateDB - on this path the
+// for the dedup logic in MirrorBankBaseDeltas - on this path the
 // mirror MUST be a no-op (because caller == origin), and the dedup never
 // matters; but if a future change accidentally enables the mirror for
 // caller==origin, this test would catch the resulting double-credit.
@@ -989,10 +989,10 @@ func (s *PrecompileTestSuite) TestLiquidateMultiDenomLockedVesting() {
 		"to-account must receive exactly 1_000_000 aLIQUID0")
 }
 
-// TestLiquidateVestingStillOngoing verifies the keeper rejects Liquidate
-// when the from-account still has non-vested coins (vestingPeriods has a
-// future-completing period). Without this guard, a user could pre-liquidate
-// not-yet-vested principal.
+// TestLiquidateVestingStillOngoing verifies the keeper liquidates coins
+// the from-account still has non-vested (vestingPeriods has a
+// future-completing period) and that the liquid denom carries the residual
+// vesting schedule along with the lockup one.
 func (s *PrecompileTestSuite) TestLiquidateVestingStillOngoing() {
 	s.SetupTest()
 	ctx := s.network.GetContext()
@@ -1025,9 +1025,24 @@ func (s *PrecompileTestSuite) TestLiquidateVestingStillOngoing() {
 	_, err := s.precompile.Liquidate(ctx, from, contract, s.network.GetStateDB(), &method, []any{
 		from, to, big.NewInt(1_000_000),
 	})
-	s.Require().Error(err)
-	s.Require().ErrorContains(err, "has vesting ongoing periods",
-		"keeper must reject Liquidate while vesting is still ongoing")
+	s.Require().NoError(err)
+
+	liquidDenom, found := s.network.App.LiquidVestingKeeper.GetDenom(ctx, liquidtypes.DenomBaseNameFromID(0))
+	s.Require().True(found, "liquid denom must be registered after Liquidate")
+	liquidated := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1_000_000))
+	s.Require().Equal(liquidated, liquidDenom.LockupPeriods.TotalAmount())
+	s.Require().Equal(liquidated, liquidDenom.VestingPeriods.TotalAmount())
+	// the residual period is shifted by the elapsed 10s of the schedule
+	s.Require().Equal(int64(100000-10), liquidDenom.VestingPeriods.TotalLength())
+
+	// the vesting account keeps the rest of both schedules
+	acc := s.network.App.AccountKeeper.GetAccount(ctx, fromAccAddr)
+	va, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	rest := vestingAmount.Sub(liquidated...)
+	s.Require().Equal(rest, va.OriginalVesting)
+	s.Require().Equal(rest, va.LockupPeriods.TotalAmount())
+	s.Require().Equal(rest, va.VestingPeriods.TotalAmount())
 }
 
 // TestLiquidateLockupExpired verifies the keeper rejects Liquidate when the
@@ -6,6 +6,9 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/haqq-network/haqq/precompiles/common"
@@ -99,3 +102,108 @@ func NewRedeemMsg(args []interface{}) (*liquidtypes.MsgRedeem, common.Address, c
 
 	return msg, from, to, nil
 }
+
+// Period defines a single period of a liquid token schedule in types native to the EVM.
+type Period struct {
+	Length int64
+	Amount []cmn.Coin
+}
+
+// LiquidDenom defines a liquid token with its schedule in types native to the EVM.
+type LiquidDenom struct {
+	BaseDenom      string
+	DisplayDenom   string
+	OriginalDenom  string
+	StartTime      int64
+	EndTime        int64
+	LockupPeriods  []Period
+	VestingPeriods []Period
+}
+
+// NewPeriods converts the vesting periods to the ABI representation.
+func NewPeriods(periods sdkvesting.Periods) []Period {
+	outputs := make([]Period, len(periods))
+	for i, period := range periods {
+		outputs[i] = Period{
+			Length: period.Length,
+			Amount: cmn.NewCoinsResponse(period.Amount),
+		}
+	}
+	return outputs
+}
+
+// PageRequest defines the data for the page request.
+type PageRequest struct {
+	PageRequest query.PageRequest
+}
+
+// DenomsOutput defines the data for the denoms query response.
+type DenomsOutput struct {
+	Denoms       []LiquidDenom
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DenomsOutput from a QueryDenomsResponse.
+func (do *DenomsOutput) FromResponse(res *liquidtypes.QueryDenomsResponse) *DenomsOutput {
+	do.Denoms = make([]LiquidDenom, len(res.Denoms))
+	for i, denom := range res.Denoms {
+		do.Denoms[i] = NewLiquidDenom(denom)
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DenomsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Denoms, do.PageResponse)
+}
+
+// NewDenomRequest builds a QueryDenomRequest from ABI arguments.
+// Expected args: [denom string].
+func NewDenomRequest(args []interface{}) (*liquidtypes.QueryDenomRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidDenom, args[0])
+	}
+
+	return &liquidtypes.QueryDenomRequest{Denom: denom}, nil
+}
+
+// NewDenomsRequest builds a QueryDenomsRequest from ABI arguments.
+// Expected args: [pageRequest PageRequest].
+func NewDenomsRequest(method *abi.Method, args []interface{}) (*liquidtypes.QueryDenomsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var pageRequest PageRequest
+	if err := method.Inputs.Copy(&pageRequest, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PageRequest: %w", err)
+	}
+
+	return &liquidtypes.QueryDenomsRequest{Pagination: &pageRequest.PageRequest}, nil
+}
+
+// ParseDenomByERC20Args parses the ERC20 contract address from ABI arguments.
+// Expected args: [erc20Address common.Address].
+func ParseDenomByERC20Args(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	erc20Address, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(ErrInvalidERC20Address, args[0])
+	}
+
+	return erc20Address, nil
+}
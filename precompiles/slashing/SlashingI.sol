@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The Slashing contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The Slashing contract's instance.
+SlashingI constant SLASHING_CONTRACT = SlashingI(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev SigningInfo defines the liveness information of a validator.
+/// @param validatorAddress the validator consensus address
+/// @param startHeight the height at which the validator was first a candidate or was unjailed
+/// @param indexOffset the index offset into the signed blocks window
+/// @param jailedUntil the unix timestamp in seconds until which the validator is jailed
+/// @param tombstoned whether the validator has been tombstoned for a double sign
+/// @param missedBlocksCounter the number of blocks missed in the current window
+struct SigningInfo {
+  address validatorAddress;
+  int64 startHeight;
+  int64 indexOffset;
+  int64 jailedUntil;
+  bool tombstoned;
+  int64 missedBlocksCounter;
+}
+
+/// @dev Params defines the parameters of the slashing module.
+/// @param signedBlocksWindow the number of blocks over which liveness is tracked
+/// @param minSignedPerWindow the minimum fraction of blocks to sign per window
+/// @param downtimeJailDuration the jail duration for downtime in seconds
+/// @param slashFractionDoubleSign the fraction of stake slashed for a double sign
+/// @param slashFractionDowntime the fraction of stake slashed for downtime
+struct Params {
+  int64 signedBlocksWindow;
+  Dec minSignedPerWindow;
+  int64 downtimeJailDuration;
+  Dec slashFractionDoubleSign;
+  Dec slashFractionDowntime;
+}
+
+/// @author Haqq Team
+/// @title Slashing Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the slashing module
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface SlashingI {
+  /// @dev ValidatorUnjailed defines an Event emitted when a validator is unjailed
+  /// @param validator The validator operator address
+  event ValidatorUnjailed(address indexed validator);
+
+  /// @dev Unjails a validator jailed for downtime.
+  /// @param validatorAddress The validator operator address
+  /// @return success Whether the transaction was successful or not
+  function unjail(address validatorAddress) external returns (bool success);
+
+  /// @dev Returns the signing info of a validator.
+  /// @param consAddress The validator consensus address
+  /// @return signingInfo The signing info of the validator
+  function getSigningInfo(
+    address consAddress
+  ) external view returns (SigningInfo memory signingInfo);
+
+  /// @dev Returns the signing info of all validators.
+  /// @param pagination The pagination options
+  /// @return signingInfos The signing infos of the validators
+  /// @return pageResponse The pagination response
+  function getSigningInfos(
+    PageRequest calldata pagination
+  )
+    external
+    view
+    returns (
+      SigningInfo[] memory signingInfos,
+      PageResponse memory pageResponse
+    );
+
+  /// @dev Returns the parameters of the slashing module.
+  /// @return params The slashing parameters
+  function getParams() external view returns (Params memory params);
+}
@@ -0,0 +1,10 @@
+package slashing
+
+const (
+	// ErrDifferentOrigin is raised when a contract acts as a validator that is not the origin.
+	ErrDifferentOrigin = "tx origin address %s does not match the validator address %s"
+	// ErrInvalidValidatorAddress is raised when the validator address is invalid.
+	ErrInvalidValidatorAddress = "invalid validator address: %v"
+	// ErrInvalidConsAddress is raised when the consensus address is invalid.
+	ErrInvalidConsAddress = "invalid consensus address: %v"
+)
@@ -0,0 +1,84 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// GetSigningInfoMethod defines the ABI method name for the slashing SigningInfo query.
+	GetSigningInfoMethod = "getSigningInfo"
+	// GetSigningInfosMethod defines the ABI method name for the slashing SigningInfos query.
+	GetSigningInfosMethod = "getSigningInfos"
+	// GetParamsMethod defines the ABI method name for the slashing Params query.
+	GetParamsMethod = "getParams"
+)
+
+// GetSigningInfo returns the signing info of the validator with the given consensus address.
+func (p *Precompile) GetSigningInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfoRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := slashingkeeper.NewQuerier(p.slashingKeeper).SigningInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewSigningInfo(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetSigningInfos returns the signing info of all validators.
+func (p *Precompile) GetSigningInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfosRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := slashingkeeper.NewQuerier(p.slashingKeeper).SigningInfos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(SigningInfosOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GetParams returns the parameters of the slashing module.
+func (p *Precompile) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := slashingkeeper.NewQuerier(p.slashingKeeper).Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewParams(res.Params))
+}
@@ -0,0 +1,101 @@
+package slashing_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/slashing"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestGetSigningInfo() {
+	method := s.precompile.Methods[slashing.GetSigningInfoMethod]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSigningInfo(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+	})
+
+	s.Run("fail - unknown consensus address", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSigningInfo(s.network.GetContext(), &method, nil, []any{utiltx.GenerateAddress()})
+		s.Require().ErrorContains(err, "SigningInfo not found")
+	})
+
+	s.Run("success - jailed validator", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		_, consAddr := s.jailValidator(ctx)
+		jailedUntil := ctx.BlockTime().Add(time.Hour)
+		s.Require().NoError(s.network.App.SlashingKeeper.JailUntil(ctx, consAddr, jailedUntil))
+
+		bz, err := s.precompile.GetSigningInfo(ctx, &method, nil, []any{common.BytesToAddress(consAddr)})
+		s.Require().NoError(err)
+
+		var out struct{ SigningInfo slashing.SigningInfo }
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Equal(common.BytesToAddress(consAddr), out.SigningInfo.ValidatorAddress)
+		s.Require().Equal(jailedUntil.Unix(), out.SigningInfo.JailedUntil)
+		s.Require().False(out.SigningInfo.Tombstoned)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetSigningInfos() {
+	method := s.precompile.Methods[slashing.GetSigningInfosMethod]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.GetSigningInfos(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+	})
+
+	s.Run("success - paginated signing infos", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+
+		bz, err := s.precompile.GetSigningInfos(ctx, &method, nil, []any{query.PageRequest{Limit: 2, CountTotal: true}})
+		s.Require().NoError(err)
+
+		var out slashing.SigningInfosOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Len(out.SigningInfos, 2)
+		s.Require().Equal(uint64(len(s.network.GetValidators())), out.PageResponse.Total)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetParams() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[slashing.GetParamsMethod]
+
+	params, err := s.network.App.SlashingKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+
+	bz, err := s.precompile.GetParams(ctx, &method, nil, nil)
+	s.Require().NoError(err)
+
+	var out struct{ Params slashing.Params }
+	s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+	s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+	s.Require().Equal(int64(params.DowntimeJailDuration.Seconds()), out.Params.DowntimeJailDuration)
+	s.Require().Equal(params.MinSignedPerWindow.BigInt(), out.Params.MinSignedPerWindow.Value)
+	s.Require().Equal(uint8(math.LegacyPrecision), out.Params.SlashFractionDowntime.Precision)
+	s.Require().Equal(
+		params.SlashFractionDoubleSign,
+		math.LegacyNewDecFromBigIntWithPrec(out.Params.SlashFractionDoubleSign.Value, math.LegacyPrecision),
+	)
+}
+
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
@@ -0,0 +1,41 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/haqq-network/haqq/precompiles/slashing"
+	testkeyring "github.com/haqq-network/haqq/testutil/integration/haqq/keyring"
+	"github.com/haqq-network/haqq/testutil/integration/haqq/network"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network    *network.UnitTestNetwork
+	keyring    testkeyring.Keyring
+	precompile *slashing.Precompile
+}
+
+func TestPrecompileUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	// The first account operates and self-delegates the first validator.
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithValidatorOperators(keyring.GetAllAccAddrs()),
+	)
+
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	s.precompile, err = slashing.NewPrecompile(s.network.App.SlashingKeeper, s.network.App.AuthzKeeper)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,40 @@
+package slashing_test
+
+import (
+	"github.com/haqq-network/haqq/precompiles/slashing"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method string
+		isTx   bool
+	}{
+		{slashing.UnjailMethod, s.precompile.Methods[slashing.UnjailMethod].Name, true},
+		{slashing.GetSigningInfoMethod, s.precompile.Methods[slashing.GetSigningInfoMethod].Name, false},
+		{slashing.GetSigningInfosMethod, s.precompile.Methods[slashing.GetSigningInfosMethod].Name, false},
+		{slashing.GetParamsMethod, s.precompile.Methods[slashing.GetParamsMethod].Name, false},
+		{"invalid", "invalid", false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(tc.method))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	for _, name := range []string{slashing.UnjailMethod, slashing.GetSigningInfoMethod} {
+		s.Run(name, func() {
+			method := s.precompile.Methods[name]
+			// Use methodID + padding to test RequiredGas
+			input := append(method.ID, make([]byte, 32)...) //nolint: gocritic
+			s.Require().Positive(s.precompile.RequiredGas(input))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas_ShortInput() {
+	s.Require().Equal(uint64(0), s.precompile.RequiredGas([]byte{0x01}))
+}
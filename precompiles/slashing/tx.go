@@ -0,0 +1,63 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// UnjailMethod defines the ABI method name for the slashing Unjail transaction.
+	UnjailMethod = "unjail"
+)
+
+// Unjail unjails a validator jailed for downtime. The validator operator must either
+// be the caller of the precompile, or originate the transaction and grant an
+// authorization for MsgUnjail to the contract calling the precompile.
+func (p *Precompile) Unjail(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgUnjail(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf("{ validator_address: %s }", msg.ValidatorAddr),
+	)
+
+	if contract.CallerAddress != validatorHexAddr {
+		if origin != validatorHexAddr {
+			return nil, fmt.Errorf(ErrDifferentOrigin, origin.String(), validatorHexAddr.String())
+		}
+		if err := authorization.CheckAndAcceptAuthz(ctx, p.AuthzKeeper, contract.CallerAddress, validatorHexAddr, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	msgSrv := slashingkeeper.NewMsgServerImpl(p.slashingKeeper)
+	if _, err = msgSrv.Unjail(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitValidatorUnjailedEvent(ctx, stateDB, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
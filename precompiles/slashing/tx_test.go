@@ -0,0 +1,168 @@
+package slashing_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/slashing"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	var (
+		ctx       sdk.Context
+		validator common.Address
+		consAddr  sdk.ConsAddress
+		origin    common.Address
+	)
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func() []any {
+				return []any{"validator"}
+			},
+			"invalid validator address",
+		},
+		{
+			"fail - different origin from validator",
+			func() []any {
+				origin = s.keyring.GetAddr(1)
+				return []any{validator}
+			},
+			"does not match the validator address",
+		},
+		{
+			"fail - not a validator",
+			func() []any {
+				origin = utiltx.GenerateAddress()
+				return []any{origin}
+			},
+			"validator does not exist",
+		},
+		{
+			"fail - jail period not over",
+			func() []any {
+				return []any{validator}
+			},
+			"validator still jailed",
+		},
+		{
+			"success - unjail after the jail period",
+			func() []any {
+				s.Require().NoError(s.network.App.SlashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime()))
+				return []any{validator}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			validator, consAddr = s.jailValidator(ctx)
+			s.Require().NoError(s.network.App.SlashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime().Add(time.Hour)))
+			origin = validator
+			stDB := s.network.GetStateDB()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, origin, s.precompile, 200000)
+			_, err := s.precompile.Unjail(ctx, origin, contract, stDB, &method, args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			val, err := s.network.App.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+			s.Require().NoError(err)
+			s.Require().False(val.IsJailed())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[slashing.EventTypeValidatorUnjailed].ID, logs[len(logs)-1].Topics[0])
+			s.Require().Equal(common.BytesToHash(validator.Bytes()), logs[len(logs)-1].Topics[1])
+		})
+	}
+}
+
+// TestUnjailFromContract checks that a contract unjailing the validator of the origin
+// needs an authorization for MsgUnjail granted by the validator operator.
+func (s *PrecompileTestSuite) TestUnjailFromContract() {
+	method := s.precompile.Methods[slashing.UnjailMethod]
+	msgURL := sdk.MsgTypeURL(&slashingtypes.MsgUnjail{})
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context, validator, caller common.Address)
+		errContains string
+	}{
+		{
+			"fail - no authorization",
+			func(sdk.Context, common.Address, common.Address) {},
+			fmt.Sprintf("authorization to %s", msgURL),
+		},
+		{
+			"fail - authorization for another msg",
+			func(ctx sdk.Context, validator, caller common.Address) {
+				expiration := ctx.BlockTime().Add(time.Hour)
+				err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), validator.Bytes(), authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), &expiration)
+				s.Require().NoError(err)
+			},
+			fmt.Sprintf("authorization to %s", msgURL),
+		},
+		{
+			"success - authorization granted by the validator",
+			func(ctx sdk.Context, validator, caller common.Address) {
+				expiration := ctx.BlockTime().Add(time.Hour)
+				err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), validator.Bytes(), authz.NewGenericAuthorization(msgURL), &expiration)
+				s.Require().NoError(err)
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			validator, consAddr := s.jailValidator(ctx)
+			s.Require().NoError(s.network.App.SlashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime()))
+			caller := s.keyring.GetAddr(2)
+			tc.malleate(ctx, validator, caller)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, 200000)
+			_, err := s.precompile.Unjail(ctx, validator, contract, s.network.GetStateDB(), &method, []any{validator})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			val, err := s.network.App.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+			s.Require().NoError(err)
+			s.Require().False(val.IsJailed())
+		})
+	}
+}
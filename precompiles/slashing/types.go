@@ -0,0 +1,162 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+)
+
+// SigningInfo represents the liveness information of a validator.
+type SigningInfo struct {
+	ValidatorAddress    common.Address
+	StartHeight         int64
+	IndexOffset         int64
+	JailedUntil         int64
+	Tombstoned          bool
+	MissedBlocksCounter int64
+}
+
+// NewSigningInfo converts the signing info of a validator to its EVM representation.
+func NewSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, fmt.Errorf(ErrInvalidConsAddress, err)
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddr),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// Params represents the parameters of the slashing module.
+type Params struct {
+	SignedBlocksWindow      int64
+	MinSignedPerWindow      cmn.Dec
+	DowntimeJailDuration    int64
+	SlashFractionDoubleSign cmn.Dec
+	SlashFractionDowntime   cmn.Dec
+}
+
+// NewParams converts the slashing parameters to their EVM representation.
+func NewParams(params slashingtypes.Params) Params {
+	return Params{
+		SignedBlocksWindow:      params.SignedBlocksWindow,
+		MinSignedPerWindow:      newDec(params.MinSignedPerWindow),
+		DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: newDec(params.SlashFractionDoubleSign),
+		SlashFractionDowntime:   newDec(params.SlashFractionDowntime),
+	}
+}
+
+// newDec converts the decimal to its EVM representation.
+func newDec(dec math.LegacyDec) cmn.Dec {
+	return cmn.Dec{
+		Value:     dec.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance and does sanity checks
+// on the given arguments before populating the message.
+func NewMsgUnjail(args []interface{}) (*slashingtypes.MsgUnjail, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorHexAddr, ok := args[0].(common.Address)
+	if !ok || validatorHexAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidValidatorAddress, args[0])
+	}
+
+	msg := &slashingtypes.MsgUnjail{
+		ValidatorAddr: sdk.ValAddress(validatorHexAddr.Bytes()).String(),
+	}
+
+	return msg, validatorHexAddr, nil
+}
+
+// NewSigningInfoRequest creates a new QuerySigningInfoRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfoRequest(args []interface{}) (*slashingtypes.QuerySigningInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consHexAddr, ok := args[0].(common.Address)
+	if !ok || consHexAddr == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consHexAddr.Bytes()).String(),
+	}, nil
+}
+
+// SigningInfosInput is a struct to represent the input of the getSigningInfos query.
+// Needed to unpack arguments into the PageRequest struct.
+type SigningInfosInput struct {
+	Pagination query.PageRequest
+}
+
+// NewSigningInfosRequest creates a new QuerySigningInfosRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewSigningInfosRequest(method *abi.Method, args []interface{}) (*slashingtypes.QuerySigningInfosRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input SigningInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SigningInfosInput: %w", err)
+	}
+
+	// A key of a single zero byte is treated as no key.
+	if bytes.Equal(input.Pagination.Key, []byte{0}) {
+		input.Pagination.Key = nil
+	}
+
+	return &slashingtypes.QuerySigningInfosRequest{Pagination: &input.Pagination}, nil
+}
+
+// SigningInfosOutput is a struct to represent the output of the getSigningInfos query.
+type SigningInfosOutput struct {
+	SigningInfos []SigningInfo
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the SigningInfosOutput from a QuerySigningInfosResponse.
+func (so *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfosResponse) (*SigningInfosOutput, error) {
+	so.SigningInfos = make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfo, err := NewSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		so.SigningInfos[i] = signingInfo
+	}
+
+	if res.Pagination != nil {
+		so.PageResponse.Total = res.Pagination.Total
+		so.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return so, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (so *SigningInfosOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(so.SigningInfos, so.PageResponse)
+}
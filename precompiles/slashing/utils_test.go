@@ -0,0 +1,20 @@
+package slashing_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// jailValidator jails the validator operated by the first account for downtime and
+// returns its operator and consensus addresses.
+func (s *PrecompileTestSuite) jailValidator(ctx sdk.Context) (common.Address, sdk.ConsAddress) {
+	operator := s.keyring.GetAddr(0)
+	validator, err := s.network.App.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operator.Bytes()))
+	s.Require().NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.App.SlashingKeeper.Jail(ctx, consAddr))
+
+	return operator, consAddr
+}
@@ -2,6 +2,7 @@
 pragma solidity >=0.8.18;
 
 import "../authorization/AuthorizationI.sol";
+import "../common/Types.sol";
 
 /// @dev The UCDAO contract's address.
 address constant UCDAO_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000901;
@@ -12,6 +13,27 @@ UcdaoI constant UCDAO_CONTRACT = UcdaoI(UCDAO_PRECOMPILE_ADDRESS);
 /// @dev Define all the available methods.
 string constant MSG_CONVERT_TO_HAQQ = "/haqq.ucdao.v1.MsgConvertToHaqq";
 string constant MSG_TRANSFER_OWNERSHIP = "/haqq.ucdao.v1.MsgTransferOwnershipWithAmount";
+string constant MSG_TRANSFER_OWNERSHIP_WITH_RATIO = "/haqq.ucdao.v1.MsgTransferOwnershipWithRatio";
+string constant MSG_FUND = "/haqq.ucdao.v1.MsgFund";
+
+/// @dev Holder represents the DAO balances of a single holder.
+struct Holder {
+  address account;
+  Coin[] coins;
+}
+
+/// @dev AllowedCollateral represents a collateral accepted by the DAO.
+/// valueType is 1 for strict denom matching and 2 for denom mask matching.
+struct AllowedCollateral {
+  string value;
+  uint8 valueType;
+}
+
+/// @dev Params defines the parameters of the ucdao module.
+struct Params {
+  bool enableDao;
+  AllowedCollateral[] allowedCollaterals;
+}
 
 /// @author Haqq Team
 /// @title UCDAO Precompile Contract
@@ -30,8 +52,36 @@ interface UcdaoI is AuthorizationI {
     uint256 haqqAmount
   );
 
+  /// @dev Fund defines an Event emitted when a depositor funds the DAO
+  /// @param depositor The address that funded the DAO
+  /// @param amount The coins deposited into the DAO
+  event Fund(
+    address indexed depositor,
+    Coin[] amount
+  );
+
+  /// @dev TransferOwnership defines an Event emitted when DAO balances change their owner
+  /// @param owner The previous owner of the DAO balances
+  /// @param newOwner The new owner of the DAO balances
+  /// @param amount The coins transferred to the new owner
+  event TransferOwnership(
+    address indexed owner,
+    address indexed newOwner,
+    Coin[] amount
+  );
+
   /// TRANSACTIONS
 
+  /// @dev Fund deposits the given coins into the DAO on behalf of the depositor.
+  /// Only aISLM and aLIQUID coins are accepted.
+  /// @param depositor the hex address of the depositor
+  /// @param amount the coins to deposit
+  /// @return success true if the deposit succeeded
+  function fund(
+    address depositor,
+    Coin[] memory amount
+  ) external returns (bool success);
+
   /// @dev ConvertToHaqq allows a holder to convert aISLM tokens to aHAQQ tokens.
   /// @param sender the hex address of the sender (ucdao holder)
   /// @param receiver the bech32-mapped recipient address as hex
@@ -63,5 +113,62 @@ interface UcdaoI is AuthorizationI {
     string[] memory denoms,
     uint256[] memory amounts
   ) external;
+
+  /// @dev TransferOwnershipWithRatio transfers the given share of every DAO balance from the owner
+  /// to the new owner.
+  /// @param owner the current owner (ucdao holder)
+  /// @param newOwner the new owner
+  /// @param ratio the share of balances to transfer, as a fixed point number with 18 decimals (1e18 = 100%)
+  /// @return amount the coins transferred to the new owner
+  function transferOwnershipWithRatio(
+    address owner,
+    address newOwner,
+    uint256 ratio
+  ) external returns (Coin[] memory amount);
+
+  /// QUERIES
+
+  /// @dev Balance returns the DAO balance of the account in the given denom.
+  /// @param account the holder address
+  /// @param denom the Cosmos denom
+  /// @return balance the DAO balance
+  function balance(
+    address account,
+    string memory denom
+  ) external view returns (Coin memory balance);
+
+  /// @dev AllBalances returns all DAO balances of the account.
+  /// @param account the holder address
+  /// @return balances the DAO balances
+  function allBalances(
+    address account
+  ) external view returns (Coin[] memory balances);
+
+  /// @dev TotalBalance returns the total balance held by the DAO.
+  /// @param pageRequest the pagination request
+  /// @return totalBalance the total DAO balance
+  /// @return pageResponse the pagination response
+  function totalBalance(
+    PageRequest calldata pageRequest
+  ) external view returns (Coin[] memory totalBalance, PageResponse memory pageResponse);
+
+  /// @dev Holders returns the DAO holders with their balances.
+  /// @param pageRequest the pagination request
+  /// @return holders the DAO holders
+  /// @return pageResponse the pagination response
+  function holders(
+    PageRequest calldata pageRequest
+  ) external view returns (Holder[] memory holders, PageResponse memory pageResponse);
+
+  /// @dev EscrowAddress returns the address holding the DAO funds of the account.
+  /// @param account the holder address
+  /// @return escrow the escrow address
+  function escrowAddress(
+    address account
+  ) external view returns (address escrow);
+
+  /// @dev Params returns the ucdao module parameters.
+  /// @return params the module parameters
+  function params() external view returns (Params memory params);
 }
 
@@ -18,6 +18,10 @@ import (
 const (
 	// EventTypeMintHaqq defines the event type for the ucdao ConvertToHaqq transaction.
 	EventTypeMintHaqq = "MintHaqq"
+	// EventTypeFund defines the event type for the ucdao Fund transaction.
+	EventTypeFund = "Fund"
+	// EventTypeTransferOwnership defines the event type for the ucdao TransferOwnership transactions.
+	EventTypeTransferOwnership = "TransferOwnership"
 )
 
 // EmitApprovalEvent creates a new approval event emitted on an Approve, IncreaseAllowance and DecreaseAllowance transactions.
@@ -160,3 +164,73 @@ func EmitMintHaqqEventWithAmount(
 
 	return nil
 }
+
+// EmitFundEvent creates a new fund event emitted on a Fund transaction.
+func (p Precompile) EmitFundEvent(ctx sdk.Context, stateDB vm.StateDB, depositor common.Address, amount sdk.Coins) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeFund]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(depositor)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint: gosec // G115 blockHeight is positive int64 and can't overflow uint64
+	})
+
+	return nil
+}
+
+// EmitTransferOwnershipEvent creates a new transfer ownership event emitted on TransferOwnership,
+// TransferOwnershipWithAmount and TransferOwnershipWithRatio transactions.
+func (p Precompile) EmitTransferOwnershipEvent(ctx sdk.Context, stateDB vm.StateDB, owner, newOwner common.Address, amount sdk.Coins) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeTransferOwnership]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(newOwner)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint: gosec // G115 blockHeight is positive int64 and can't overflow uint64
+	})
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/haqq-network/haqq/precompiles/authorization"
 	cmn "github.com/haqq-network/haqq/precompiles/common"
@@ -13,6 +14,21 @@ import (
 	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
 )
 
+const (
+	// BalanceMethod defines the ABI method name for the ucdao Balance query.
+	BalanceMethod = "balance"
+	// AllBalancesMethod defines the ABI method name for the ucdao AllBalances query.
+	AllBalancesMethod = "allBalances"
+	// TotalBalanceMethod defines the ABI method name for the ucdao TotalBalance query.
+	TotalBalanceMethod = "totalBalance"
+	// HoldersMethod defines the ABI method name for the ucdao Holders query.
+	HoldersMethod = "holders"
+	// EscrowAddressMethod defines the ABI method name for the ucdao EscrowAddress query.
+	EscrowAddressMethod = "escrowAddress"
+	// ParamsMethod defines the ABI method name for the ucdao Params query.
+	ParamsMethod = "params"
+)
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
@@ -43,3 +59,133 @@ func (p Precompile) Allowance(
 
 	return method.Outputs.Pack(convAuthz.SpendLimit.Amount.BigInt())
 }
+
+// Balance returns the DAO balance of the account in the given denom.
+func (p Precompile) Balance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewBalanceRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.daoKeeper.Balance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.Coin{
+		Denom:  res.Balance.Denom,
+		Amount: res.Balance.Amount.BigInt(),
+	})
+}
+
+// AllBalances returns all DAO balances of the account.
+func (p Precompile) AllBalances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewAllBalancesRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.daoKeeper.AllBalances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Balances))
+}
+
+// TotalBalance returns the total balance held by the DAO.
+func (p Precompile) TotalBalance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTotalBalanceRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.daoKeeper.TotalBalance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(TotalBalanceOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// Holders returns the DAO holders with their balances.
+func (p Precompile) Holders(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewHoldersRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.daoKeeper.Holders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(HoldersOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// EscrowAddress returns the address holding the DAO funds of the account.
+func (p Precompile) EscrowAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewEscrowAddressRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.daoKeeper.EscrowAddress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow, err := sdk.AccAddressFromBech32(res.EscrowAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(common.BytesToAddress(escrow))
+}
+
+// Params returns the ucdao module parameters.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.daoKeeper.Params(ctx, &ucdaotypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewParamsOutput(res.Params))
+}
@@ -0,0 +1,219 @@
+package ucdao_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	"github.com/haqq-network/haqq/precompiles/ucdao"
+	"github.com/haqq-network/haqq/utils"
+	ucdaokeeper "github.com/haqq-network/haqq/x/ucdao/keeper"
+	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
+)
+
+// fundDao deposits the given aISLM amount into the DAO on behalf of the account
+func (s *PrecompileTestSuite) fundDao(ctx sdk.Context, account sdk.AccAddress, amount int64) {
+	err := s.network.App.DaoKeeper.Fund(ctx, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(amount))), account)
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestBalance() {
+	method := s.precompile.Methods[ucdao.BalanceMethod]
+
+	testCases := []struct {
+		name        string
+		args        func() []any
+		expBalance  *big.Int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid account",
+			func() []any {
+				return []any{"not-an-address", utils.BaseDenom}
+			},
+			nil,
+			true,
+			"invalid account address",
+		},
+		{
+			"fail - invalid denom",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), "1"}
+			},
+			nil,
+			true,
+			"invalid denom",
+		},
+		{
+			"success - funded account",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), utils.BaseDenom}
+			},
+			big.NewInt(1e18),
+			false,
+			"",
+		},
+		{
+			"success - not a holder",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), utils.BaseDenom}
+			},
+			big.NewInt(0),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.fundDao(ctx, s.keyring.GetAccAddr(0), 1e18)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			bz, err := s.precompile.Balance(ctx, &method, contract, tc.args())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ Balance cmn.Coin }
+			err = s.precompile.UnpackIntoInterface(&out, ucdao.BalanceMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(utils.BaseDenom, out.Balance.Denom)
+			s.Require().Zero(tc.expBalance.Cmp(out.Balance.Amount))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllBalances() {
+	method := s.precompile.Methods[ucdao.AllBalancesMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.fundDao(ctx, s.keyring.GetAccAddr(0), 1e18)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	_, err := s.precompile.AllBalances(ctx, &method, contract, []any{})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	bz, err := s.precompile.AllBalances(ctx, &method, contract, []any{s.keyring.GetAddr(0)})
+	s.Require().NoError(err)
+
+	var balances []cmn.Coin
+	err = s.precompile.UnpackIntoInterface(&balances, ucdao.AllBalancesMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}}, balances)
+}
+
+func (s *PrecompileTestSuite) TestTotalBalance() {
+	method := s.precompile.Methods[ucdao.TotalBalanceMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.fundDao(ctx, s.keyring.GetAccAddr(0), 1e18)
+	s.fundDao(ctx, s.keyring.GetAccAddr(1), 2e18)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+	bz, err := s.precompile.TotalBalance(ctx, &method, contract, []any{query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out ucdao.TotalBalanceOutput
+	err = s.precompile.UnpackIntoInterface(&out, ucdao.TotalBalanceMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(3e18)}}, out.TotalBalance)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+}
+
+func (s *PrecompileTestSuite) TestHolders() {
+	method := s.precompile.Methods[ucdao.HoldersMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	s.fundDao(ctx, s.keyring.GetAccAddr(0), 1e18)
+	s.fundDao(ctx, s.keyring.GetAccAddr(1), 2e18)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+
+	// first page holds a single holder
+	bz, err := s.precompile.Holders(ctx, &method, contract, []any{query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out ucdao.HoldersOutput
+	err = s.precompile.UnpackIntoInterface(&out, ucdao.HoldersMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Len(out.Holders, 1)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+
+	// the rest is on the next page
+	bz, err = s.precompile.Holders(ctx, &method, contract, []any{query.PageRequest{Key: out.PageResponse.NextKey}})
+	s.Require().NoError(err)
+
+	var next ucdao.HoldersOutput
+	err = s.precompile.UnpackIntoInterface(&next, ucdao.HoldersMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Len(next.Holders, 1)
+	s.Require().Empty(next.PageResponse.NextKey)
+
+	holders := map[common.Address][]cmn.Coin{
+		out.Holders[0].Account:  out.Holders[0].Coins,
+		next.Holders[0].Account: next.Holders[0].Coins,
+	}
+	s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}}, holders[s.keyring.GetAddr(0)])
+	s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(2e18)}}, holders[s.keyring.GetAddr(1)])
+}
+
+func (s *PrecompileTestSuite) TestEscrowAddress() {
+	method := s.precompile.Methods[ucdao.EscrowAddressMethod]
+
+	s.SetupTest()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200000)
+
+	bz, err := s.precompile.EscrowAddress(ctx, &method, contract, []any{s.keyring.GetAddr(0)})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(common.BytesToAddress(ucdaotypes.GetEscrowAddress(s.keyring.GetAccAddr(0))), out[0])
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[ucdao.ParamsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+
+	params := ucdaotypes.DefaultParams()
+	params.AllowedCollaterals = []*ucdaotypes.AllowedCollateral{
+		{Value: utils.BaseDenom, Type: ucdaotypes.CollateralValueType_COLLATERAL_VALUE_TYPE_STRICT},
+		{Value: "aLIQUID*", Type: ucdaotypes.CollateralValueType_COLLATERAL_VALUE_TYPE_MASK},
+	}
+	daoKeeper, ok := s.network.App.DaoKeeper.(ucdaokeeper.BaseKeeper)
+	s.Require().True(ok)
+	s.Require().NoError(daoKeeper.SetParams(ctx, params))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+	bz, err := s.precompile.Params(ctx, &method, contract, []any{})
+	s.Require().NoError(err)
+
+	var out struct{ Params ucdao.ParamsOutput }
+	err = s.precompile.UnpackIntoInterface(&out, ucdao.ParamsMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(ucdao.ParamsOutput{
+		EnableDao: true,
+		AllowedCollaterals: []ucdao.AllowedCollateral{
+			{Value: utils.BaseDenom, ValueType: 1},
+			{Value: "aLIQUID*", ValueType: 2},
+		},
+	}, out.Params)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/utils"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 	ucdaokeeper "github.com/haqq-network/haqq/x/ucdao/keeper"
 	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
@@ -19,6 +21,10 @@ const (
 	TransferOwnershipMethod = "transferOwnership"
 	// TransferOwnershipWithAmountMethod defines the ABI method name for TransferOwnershipWithAmount transaction.
 	TransferOwnershipWithAmountMethod = "transferOwnershipWithAmount"
+	// TransferOwnershipWithRatioMethod defines the ABI method name for TransferOwnershipWithRatio transaction.
+	TransferOwnershipWithRatioMethod = "transferOwnershipWithRatio"
+	// FundMethod defines the ABI method name for Fund transaction.
+	FundMethod = "fund"
 )
 
 // ConvertToHaqqMsgURL defines the authorization type for MsgConvertToHaqq
@@ -27,6 +33,9 @@ var ConvertToHaqqMsgURL = sdk.MsgTypeURL(&ucdaotypes.MsgConvertToHaqq{})
 // TransferOwnershipMsgURL defines the authorization type for MsgTransferOwnership
 var TransferOwnershipMsgURL = sdk.MsgTypeURL(&ucdaotypes.MsgTransferOwnership{})
 
+// FundMsgURL defines the authorization type for MsgFund
+var FundMsgURL = sdk.MsgTypeURL(&ucdaotypes.MsgFund{})
+
 func (p *Precompile) ConvertToHaqq(
 	ctx sdk.Context,
 	origin common.Address,
@@ -95,11 +104,11 @@ func (p *Precompile) TransferOwnership(
 	ctx sdk.Context,
 	origin common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
-	msg, owner, _, err := NewTransferOwnershipMsg(args)
+	msg, owner, newOwner, err := NewTransferOwnershipMsg(args)
 	if err != nil {
 		return nil, err
 	}
@@ -137,12 +146,19 @@ func (p *Precompile) TransferOwnership(
 		return nil, fmt.Errorf("origin (%s) must be the owner (%s)", origin.String(), owner.String())
 	}
 
+	// All balances are transferred
+	balances := p.daoKeeper.GetAccountBalances(ctx, sdk.AccAddress(owner.Bytes()))
+
 	msgSrv := ucdaokeeper.NewMsgServerImpl(p.daoKeeper)
 	_, err = msgSrv.TransferOwnership(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = p.EmitTransferOwnershipEvent(ctx, stateDB, owner, newOwner, balances); err != nil {
+		return nil, err
+	}
+
 	return []byte{}, nil
 }
 
@@ -150,11 +166,11 @@ func (p *Precompile) TransferOwnershipWithAmount(
 	ctx sdk.Context,
 	origin common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
-	msg, owner, _, err := NewTransferOwnershipWithAmountMsg(args)
+	msg, owner, newOwner, err := NewTransferOwnershipWithAmountMsg(args)
 	if err != nil {
 		return nil, err
 	}
@@ -198,5 +214,128 @@ func (p *Precompile) TransferOwnershipWithAmount(
 		return nil, err
 	}
 
+	if err = p.EmitTransferOwnershipEvent(ctx, stateDB, owner, newOwner, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	return []byte{}, nil
 }
+
+// TransferOwnershipWithRatio transfers the given ratio of every owner's balance
+// in the DAO to the new owner and returns the transferred coins.
+func (p *Precompile) TransferOwnershipWithRatio(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, newOwner, err := NewTransferOwnershipWithRatioMsg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, newOwner: %s, ratio: %s }",
+			msg.Owner,
+			msg.NewOwner,
+			msg.Ratio.String(),
+		),
+	)
+
+	// isCallerSender is true when the contract caller is the same as the sender
+	isCallerSender := contract.CallerAddress == owner
+
+	// If the contract caller is not the same as the sender, the sender must be the origin
+	if isCallerSender {
+		owner = origin
+	} else if origin != owner {
+		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), owner.String())
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, owner, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	// Ensure origin is the owner
+	if origin != owner {
+		return nil, fmt.Errorf("origin (%s) must be the owner (%s)", origin.String(), owner.String())
+	}
+
+	msgSrv := ucdaokeeper.NewMsgServerImpl(p.daoKeeper)
+	res, err := msgSrv.TransferOwnershipWithRatio(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitTransferOwnershipEvent(ctx, stateDB, owner, newOwner, res.Coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Coins))
+}
+
+// Fund deposits the given coins from the depositor into the DAO. A contract
+// funding on behalf of the depositor needs the depositor's authorization.
+func (p *Precompile) Fund(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositor, err := NewFundMsg(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ depositor: %s, amount: %s }",
+			msg.Depositor,
+			msg.Amount.String(),
+		),
+	)
+
+	// If the contract caller is not the same as the depositor, the depositor must be the origin
+	// and must have authorized the caller
+	if contract.CallerAddress != depositor {
+		if origin != depositor {
+			return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), depositor.String())
+		}
+
+		if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, depositor, p.AuthzKeeper, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	msgSrv := ucdaokeeper.NewMsgServerImpl(p.daoKeeper)
+	if _, err = msgSrv.Fund(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, msg.Amount.AmountOf(utils.BaseDenom).BigInt(), cmn.Sub))
+	}
+
+	if err = p.EmitFundEvent(ctx, stateDB, depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
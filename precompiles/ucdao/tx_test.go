@@ -3,13 +3,20 @@ package ucdao_test
 import (
 	"fmt"
 	"math/big"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/haqq-network/haqq/precompiles/common"
 	"github.com/haqq-network/haqq/precompiles/testutil"
 	"github.com/haqq-network/haqq/precompiles/ucdao"
 	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/evm/statedb"
+	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
 )
 
 func (s *PrecompileTestSuite) TestConvertToHaqq() {
@@ -242,3 +249,298 @@ func (s *PrecompileTestSuite) TestTransferOwnershipWithAmount() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferOwnershipWithRatio() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[ucdao.TransferOwnershipWithRatioMethod]
+	fundAmount := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1e18)))
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		gas         uint64
+		postCheck   func(bz []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid ratio",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					"half",
+				}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			"invalid ratio",
+		},
+		{
+			"fail - different origin from owner",
+			func() []any {
+				return []any{
+					utiltx.GenerateAddress(),
+					s.keyring.GetAddr(1),
+					big.NewInt(5e17),
+				}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			"origin",
+		},
+		{
+			"fail - owner is not a holder",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(5e17),
+				}
+			},
+			200000,
+			func([]byte) {},
+			true,
+			ucdaotypes.ErrNotEligible.Error(),
+		},
+		{
+			"success - half of balances transferred",
+			func() []any {
+				err := s.network.App.DaoKeeper.Fund(ctx, fundAmount, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+
+				return []any{
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(5e17),
+				}
+			},
+			200000,
+			func(bz []byte) {
+				var transferred []cmn.Coin
+				err := s.precompile.UnpackIntoInterface(&transferred, ucdao.TransferOwnershipWithRatioMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Len(transferred, 1)
+				s.Require().Equal(utils.BaseDenom, transferred[0].Denom)
+				s.Require().Equal(big.NewInt(5e17), transferred[0].Amount)
+
+				newOwnerBalance := s.network.App.DaoKeeper.GetBalance(ctx, s.keyring.GetAccAddr(1), utils.BaseDenom)
+				s.Require().Equal(math.NewInt(5e17), newOwnerBalance.Amount)
+
+				logs := stDB.Logs()
+				s.Require().NotEmpty(logs)
+				s.Require().Equal(s.precompile.Events[ucdao.EventTypeTransferOwnership].ID, logs[len(logs)-1].Topics[0])
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			stDB = s.network.GetStateDB()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+			bz, err := s.precompile.TransferOwnershipWithRatio(ctx, s.keyring.GetAddr(0), contract, stDB, &method, args)
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFund() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[ucdao.FundMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - different origin from depositor",
+			func() []any {
+				return []any{
+					utiltx.GenerateAddress(),
+					[]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			200000,
+			true,
+			"origin",
+		},
+		{
+			"fail - denom is not allowed",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					[]cmn.Coin{{Denom: "aHAQQ", Amount: big.NewInt(1e18)}},
+				}
+			},
+			200000,
+			true,
+			"is not allowed",
+		},
+		{
+			"success - funded",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					[]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}},
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			stDB = s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+			bz, err := s.precompile.Fund(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			success, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			balance := s.network.App.DaoKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), utils.BaseDenom)
+			s.Require().Equal(math.NewInt(1e18), balance.Amount)
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[ucdao.EventTypeFund].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFundFromContract() {
+	var depositor, caller common.Address
+	method := s.precompile.Methods[ucdao.FundMethod]
+
+	testCases := []struct {
+		name        string
+		grant       func(ctx sdk.Context)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - no authorization",
+			func(sdk.Context) {},
+			true,
+			"authorization",
+		},
+		{
+			"fail - generic authorization for another message",
+			func(ctx sdk.Context) {
+				// the grant is looked up by the fund message type, so a generic
+				// grant for any other message doesn't authorize the funding
+				expiration := time.Now().Add(time.Hour).UTC()
+				err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), depositor.Bytes(), authz.NewGenericAuthorization(ucdao.ConvertToHaqqMsgURL), &expiration)
+				s.Require().NoError(err)
+			},
+			true,
+			"authorization",
+		},
+		{
+			"success - generic authorization for fund",
+			func(ctx sdk.Context) {
+				expiration := time.Now().Add(time.Hour).UTC()
+				err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), depositor.Bytes(), authz.NewGenericAuthorization(ucdao.FundMsgURL), &expiration)
+				s.Require().NoError(err)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			depositor = s.keyring.GetAddr(0)
+			caller = utiltx.GenerateAddress()
+			tc.grant(ctx)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, 200000)
+			_, err := s.precompile.Fund(ctx, depositor, contract, s.network.GetStateDB(), &method, []any{
+				depositor,
+				[]cmn.Coin{{Denom: utils.BaseDenom, Amount: big.NewInt(1e18)}},
+			})
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().True(s.network.App.DaoKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), utils.BaseDenom).IsZero())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(math.NewInt(1e18), s.network.App.DaoKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), utils.BaseDenom).Amount)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGenericAuthorizationOnlyForFund() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	owner := s.keyring.GetAddr(0)
+	caller := utiltx.GenerateAddress()
+
+	msg := ucdaotypes.NewMsgTransferOwnershipWithRatio(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), math.LegacyNewDecWithPrec(5, 1))
+	expiration := time.Now().Add(time.Hour).UTC()
+	err := s.network.App.AuthzKeeper.SaveGrant(ctx, caller.Bytes(), owner.Bytes(), authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), &expiration)
+	s.Require().NoError(err)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, 200000)
+	err = ucdao.CheckAndAcceptAuthorizationIfNeeded(ctx, contract, owner, s.network.App.AuthzKeeper, msg)
+	s.Require().ErrorContains(err, "GenericAuthorization is only allowed for")
+}
@@ -6,7 +6,10 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/haqq-network/haqq/precompiles/authorization"
@@ -135,11 +138,15 @@ func CheckAndAcceptAuthorizationIfNeeded(
 		return fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, contract.CallerAddress, origin)
 	}
 
-	// Accept the grant with the actual message
+	// Accept the grant with the actual message, the generic grant is only allowed for funding
 	_, isMintAuth := auth.(*ucdaotypes.ConvertToHaqqAuthorization)
 	_, isTransferAuth := auth.(*ucdaotypes.TransferOwnershipAuthorization)
-	if !isMintAuth && !isTransferAuth {
-		return fmt.Errorf("expected ConvertToHaqqAuthorization or TransferOwnershipAuthorization, got %T", auth)
+	_, isGenericAuth := auth.(*authz.GenericAuthorization)
+	if isGenericAuth && sdk.MsgTypeURL(msg) != FundMsgURL {
+		return fmt.Errorf("GenericAuthorization is only allowed for %s, got %s", FundMsgURL, sdk.MsgTypeURL(msg))
+	}
+	if !isMintAuth && !isTransferAuth && !isGenericAuth {
+		return fmt.Errorf("expected ConvertToHaqqAuthorization, TransferOwnershipAuthorization or GenericAuthorization, got %T", auth)
 	}
 
 	resp, err := auth.Accept(ctx, msg)
@@ -164,3 +171,257 @@ func CheckAndAcceptAuthorizationIfNeeded(
 
 	return nil
 }
+
+// FundInput is a struct to represent the arguments of the fund transaction.
+// Needed to unpack the Coin tuples into native EVM types.
+type FundInput struct {
+	Depositor common.Address
+	Amount    []cmn.Coin
+}
+
+// NewFundMsg builds MsgFund from ABI arguments.
+func NewFundMsg(method *abi.Method, args []interface{}) (*ucdaotypes.MsgFund, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input FundInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to FundInput struct: %s", err)
+	}
+
+	coins := sdk.Coins{}
+	for i, coin := range input.Amount {
+		if coin.Amount == nil {
+			return nil, common.Address{}, fmt.Errorf("nil amount at index %d", i)
+		}
+		coins = coins.Add(coin.ToSDKType())
+	}
+
+	msg := &ucdaotypes.MsgFund{
+		Amount:    coins,
+		Depositor: sdk.AccAddress(input.Depositor.Bytes()).String(),
+	}
+
+	return msg, input.Depositor, nil
+}
+
+// NewTransferOwnershipWithRatioMsg builds MsgTransferOwnershipWithRatio from ABI arguments.
+// The ratio is a fixed point number with 18 decimals.
+func NewTransferOwnershipWithRatioMsg(args []interface{}) (*ucdaotypes.MsgTransferOwnershipWithRatio, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	newOwner, ok := args[1].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid new owner address: %v", args[1])
+	}
+
+	ratio, ok := args[2].(*big.Int)
+	if !ok || ratio == nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid ratio: %v", args[2])
+	}
+
+	msg := &ucdaotypes.MsgTransferOwnershipWithRatio{
+		Owner:    sdk.AccAddress(owner.Bytes()).String(),
+		NewOwner: sdk.AccAddress(newOwner.Bytes()).String(),
+		Ratio:    sdkmath.LegacyNewDecFromBigIntWithPrec(ratio, sdkmath.LegacyPrecision),
+	}
+
+	return msg, owner, newOwner, nil
+}
+
+// NewBalanceRequest creates a new QueryBalanceRequest instance from ABI arguments.
+func NewBalanceRequest(args []interface{}) (*ucdaotypes.QueryBalanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	return &ucdaotypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(account.Bytes()).String(),
+		Denom:   denom,
+	}, nil
+}
+
+// NewAllBalancesRequest creates a new QueryAllBalancesRequest instance from ABI arguments.
+func NewAllBalancesRequest(args []interface{}) (*ucdaotypes.QueryAllBalancesRequest, error) {
+	account, err := accountFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ucdaotypes.QueryAllBalancesRequest{
+		Address: sdk.AccAddress(account.Bytes()).String(),
+	}, nil
+}
+
+// NewEscrowAddressRequest creates a new QueryEscrowAddressRequest instance from ABI arguments.
+func NewEscrowAddressRequest(args []interface{}) (*ucdaotypes.QueryEscrowAddressRequest, error) {
+	account, err := accountFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ucdaotypes.QueryEscrowAddressRequest{
+		Address: sdk.AccAddress(account.Bytes()).String(),
+	}, nil
+}
+
+// PageRequest defines the data for the page request.
+type PageRequest struct {
+	PageRequest query.PageRequest
+}
+
+// NewTotalBalanceRequest creates a new QueryTotalBalanceRequest instance from ABI arguments.
+func NewTotalBalanceRequest(method *abi.Method, args []interface{}) (*ucdaotypes.QueryTotalBalanceRequest, error) {
+	pageRequest, err := pageRequestFromArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ucdaotypes.QueryTotalBalanceRequest{Pagination: pageRequest}, nil
+}
+
+// NewHoldersRequest creates a new QueryHoldersRequest instance from ABI arguments.
+func NewHoldersRequest(method *abi.Method, args []interface{}) (*ucdaotypes.QueryHoldersRequest, error) {
+	pageRequest, err := pageRequestFromArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ucdaotypes.QueryHoldersRequest{Pagination: pageRequest}, nil
+}
+
+// TotalBalanceOutput is a struct to represent the key information from a TotalBalance response.
+type TotalBalanceOutput struct {
+	TotalBalance []cmn.Coin
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the TotalBalanceOutput from a QueryTotalBalanceResponse.
+func (to *TotalBalanceOutput) FromResponse(res *ucdaotypes.QueryTotalBalanceResponse) *TotalBalanceOutput {
+	to.TotalBalance = cmn.NewCoinsResponse(res.TotalBalance)
+	if res.Pagination != nil {
+		to.PageResponse.Total = res.Pagination.Total
+		to.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return to
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (to *TotalBalanceOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(to.TotalBalance, to.PageResponse)
+}
+
+// Holder represents the DAO balances of a single holder.
+type Holder struct {
+	Account common.Address
+	Coins   []cmn.Coin
+}
+
+// HoldersOutput is a struct to represent the key information from a Holders response.
+type HoldersOutput struct {
+	Holders      []Holder
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the HoldersOutput from a QueryHoldersResponse.
+func (ho *HoldersOutput) FromResponse(res *ucdaotypes.QueryHoldersResponse) (*HoldersOutput, error) {
+	ho.Holders = make([]Holder, len(res.Balances))
+	for i, balance := range res.Balances {
+		account, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		ho.Holders[i] = Holder{
+			Account: common.BytesToAddress(account),
+			Coins:   cmn.NewCoinsResponse(balance.Coins),
+		}
+	}
+
+	if res.Pagination != nil {
+		ho.PageResponse.Total = res.Pagination.Total
+		ho.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return ho, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (ho *HoldersOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(ho.Holders, ho.PageResponse)
+}
+
+// AllowedCollateral represents a collateral accepted by the DAO.
+type AllowedCollateral struct {
+	Value     string
+	ValueType uint8
+}
+
+// ParamsOutput is a struct to represent the ucdao module parameters.
+type ParamsOutput struct {
+	EnableDao          bool
+	AllowedCollaterals []AllowedCollateral
+}
+
+// NewParamsOutput converts the ucdao module parameters to the ABI representation.
+func NewParamsOutput(params ucdaotypes.Params) ParamsOutput {
+	collaterals := make([]AllowedCollateral, len(params.AllowedCollaterals))
+	for i, collateral := range params.AllowedCollaterals {
+		collaterals[i] = AllowedCollateral{
+			Value:     collateral.Value,
+			ValueType: uint8(collateral.Type), //nolint: gosec // G115 -- enum values are small and non-negative
+		}
+	}
+
+	return ParamsOutput{
+		EnableDao:          params.EnableDao,
+		AllowedCollaterals: collaterals,
+	}
+}
+
+// accountFromArgs returns the single account address argument.
+func accountFromArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	return account, nil
+}
+
+// pageRequestFromArgs unpacks the single page request argument.
+func pageRequestFromArgs(method *abi.Method, args []interface{}) (*query.PageRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var pageRequest PageRequest
+	if err := method.Inputs.Copy(&pageRequest, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PageRequest: %w", err)
+	}
+
+	return &pageRequest.PageRequest, nil
+}
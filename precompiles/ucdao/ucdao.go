@@ -70,7 +70,6 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return 0
 	}
 
-	// all exposed methods are transactions
 	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
 }
 
@@ -106,9 +105,25 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 				bz, err = p.TransferOwnership(ctx, evm.Origin, contract, stateDB, method, args)
 			case TransferOwnershipWithAmountMethod:
 				bz, err = p.TransferOwnershipWithAmount(ctx, evm.Origin, contract, stateDB, method, args)
+			case TransferOwnershipWithRatioMethod:
+				bz, err = p.TransferOwnershipWithRatio(ctx, evm.Origin, contract, stateDB, method, args)
+			case FundMethod:
+				bz, err = p.Fund(ctx, evm.Origin, contract, stateDB, method, args)
 			// Queries
 			case authorization.AllowanceMethod:
 				bz, err = p.Allowance(ctx, method, contract, args)
+			case BalanceMethod:
+				bz, err = p.Balance(ctx, method, contract, args)
+			case AllBalancesMethod:
+				bz, err = p.AllBalances(ctx, method, contract, args)
+			case TotalBalanceMethod:
+				bz, err = p.TotalBalance(ctx, method, contract, args)
+			case HoldersMethod:
+				bz, err = p.Holders(ctx, method, contract, args)
+			case EscrowAddressMethod:
+				bz, err = p.EscrowAddress(ctx, method, contract, args)
+			case ParamsMethod:
+				bz, err = p.Params(ctx, method, contract, args)
 			default:
 				return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 			}
@@ -133,12 +148,13 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// All exposed ucdao methods are transactions.
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case ConvertToHaqqMethod,
 		TransferOwnershipMethod,
-		TransferOwnershipWithAmountMethod:
+		TransferOwnershipWithAmountMethod,
+		TransferOwnershipWithRatioMethod,
+		FundMethod:
 		return true
 	default:
 		return false
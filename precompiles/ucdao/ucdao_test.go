@@ -33,6 +33,21 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[ucdao.TransferOwnershipWithAmountMethod].Name,
 			true,
 		},
+		{
+			ucdao.TransferOwnershipWithRatioMethod,
+			s.precompile.Methods[ucdao.TransferOwnershipWithRatioMethod].Name,
+			true,
+		},
+		{
+			ucdao.FundMethod,
+			s.precompile.Methods[ucdao.FundMethod].Name,
+			true,
+		},
+		{
+			ucdao.HoldersMethod,
+			s.precompile.Methods[ucdao.HoldersMethod].Name,
+			false,
+		},
 		{
 			"invalid",
 			"invalid",
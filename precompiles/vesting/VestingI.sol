@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../authorization/AuthorizationI.sol";
+import "../common/Types.sol";
+
+/// @dev The Vesting contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The Vesting contract's instance.
+VestingI constant VESTING_CONTRACT = VestingI(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available vesting methods.
+string constant MSG_CREATE_CLAWBACK_VESTING_ACCOUNT = "/haqq.vesting.v1.MsgCreateClawbackVestingAccount";
+string constant MSG_CLAWBACK = "/haqq.vesting.v1.MsgClawback";
+string constant MSG_UPDATE_VESTING_FUNDER = "/haqq.vesting.v1.MsgUpdateVestingFunder";
+string constant MSG_CONVERT_VESTING_ACCOUNT = "/haqq.vesting.v1.MsgConvertVestingAccount";
+string constant MSG_CONVERT_INTO_VESTING_ACCOUNT = "/haqq.vesting.v1.MsgConvertIntoVestingAccount";
+
+/// @dev Period defines a single period of a lockup or vesting schedule.
+/// @param length The period length in seconds
+/// @param amount The coins unlocked or vested at the end of the period
+struct Period {
+  int64 length;
+  Coin[] amount;
+}
+
+/// @author Haqq Team
+/// @title Vesting Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the vesting module
+/// @custom:address 0x0000000000000000000000000000000000000803
+interface VestingI is AuthorizationI {
+  /// @dev CreateClawbackVestingAccount defines an Event emitted when a clawback vesting account is created or funded
+  /// @param funder The address of the account funding the vesting schedule
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @param amount The coins transferred to the vesting account
+  /// @param startTime The unix time at which the schedule begins
+  /// @param merge True if the grant was merged into an existing vesting account
+  event CreateClawbackVestingAccount(
+    address indexed funder,
+    address indexed vestingAddress,
+    Coin[] amount,
+    int64 startTime,
+    bool merge
+  );
+
+  /// @dev Clawback defines an Event emitted when unvested coins are clawed back
+  /// @param funder The address of the account funding the vesting schedule
+  /// @param accountAddress The address of the clawback vesting account
+  /// @param destAddress The address that received the clawed back coins
+  /// @param amount The coins clawed back
+  event Clawback(
+    address indexed funder,
+    address indexed accountAddress,
+    address destAddress,
+    Coin[] amount
+  );
+
+  /// @dev UpdateVestingFunder defines an Event emitted when the funder of a vesting account changes
+  /// @param funder The address of the previous funder
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @param newFunder The address of the new funder
+  event UpdateVestingFunder(
+    address indexed funder,
+    address indexed vestingAddress,
+    address indexed newFunder
+  );
+
+  /// @dev ConvertVestingAccount defines an Event emitted when a vesting account is converted back
+  /// into a regular account
+  /// @param vestingAddress The address of the converted account
+  event ConvertVestingAccount(
+    address indexed vestingAddress
+  );
+
+  /// @dev ConvertIntoVestingAccount defines an Event emitted when an account is converted into
+  /// a clawback vesting account
+  /// @param funder The address of the account funding the vesting schedule
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @param amount The coins transferred to the vesting account
+  /// @param startTime The unix time at which the schedule begins
+  /// @param merge True if the grant was merged into an existing vesting account
+  /// @param stake True if the vested coins were delegated to a validator
+  event ConvertIntoVestingAccount(
+    address indexed funder,
+    address indexed vestingAddress,
+    Coin[] amount,
+    int64 startTime,
+    bool merge,
+    bool stake
+  );
+
+  /// TRANSACTIONS
+
+  /// @dev Creates a new clawback vesting account, or merges a new grant into an existing one.
+  /// The lockup and vesting schedules must describe the same total amount. An empty schedule
+  /// defaults to an instant unlock or vesting of the other schedule's amount.
+  /// @param funderAddress The address of the account funding the vesting schedule
+  /// @param vestingAddress The address of the account to receive the funds
+  /// @param startTime The unix time at which the schedule begins
+  /// @param lockupPeriods The unlocking schedule relative to the start time
+  /// @param vestingPeriods The vesting schedule relative to the start time
+  /// @param merge If true, merge the grant into an existing clawback vesting account
+  /// @return success True if the transaction succeeded
+  function createClawbackVestingAccount(
+    address funderAddress,
+    address vestingAddress,
+    int64 startTime,
+    Period[] calldata lockupPeriods,
+    Period[] calldata vestingPeriods,
+    bool merge
+  ) external returns (bool success);
+
+  /// @dev Claws back the unvested coins of a clawback vesting account.
+  /// Can only be requested by the funder of the vesting account.
+  /// @param funderAddress The address of the account funding the vesting schedule
+  /// @param accountAddress The address of the clawback vesting account
+  /// @param destAddress The address to receive the coins. The zero address defaults to the funder
+  /// @return amount The coins clawed back
+  function clawback(
+    address funderAddress,
+    address accountAddress,
+    address destAddress
+  ) external returns (Coin[] memory amount);
+
+  /// @dev Updates the funder of a clawback vesting account.
+  /// Can only be requested by the current funder of the vesting account.
+  /// @param funderAddress The address of the current funder
+  /// @param newFunderAddress The address of the new funder
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @return success True if the transaction succeeded
+  function updateVestingFunder(
+    address funderAddress,
+    address newFunderAddress,
+    address vestingAddress
+  ) external returns (bool success);
+
+  /// @dev Converts a clawback vesting account into a regular account once its lockup
+  /// and vesting schedules have concluded.
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @return success True if the transaction succeeded
+  function convertVestingAccount(
+    address vestingAddress
+  ) external returns (bool success);
+
+  /// @dev Converts an existing account into a clawback vesting account, or merges a new grant
+  /// into an existing one. Optionally delegates the transferred coins to a validator.
+  /// @param funderAddress The address of the account funding the vesting schedule
+  /// @param vestingAddress The address of the account to convert
+  /// @param startTime The unix time at which the schedule begins
+  /// @param lockupPeriods The unlocking schedule relative to the start time
+  /// @param vestingPeriods The vesting schedule relative to the start time
+  /// @param merge If true, merge the grant into an existing clawback vesting account
+  /// @param stake If true, delegate the transferred coins to the given validator
+  /// @param validatorAddress The bech32 address of the validator to delegate to
+  /// @return success True if the transaction succeeded
+  function convertIntoVestingAccount(
+    address funderAddress,
+    address vestingAddress,
+    int64 startTime,
+    Period[] calldata lockupPeriods,
+    Period[] calldata vestingPeriods,
+    bool merge,
+    bool stake,
+    string calldata validatorAddress
+  ) external returns (bool success);
+
+  /// QUERIES
+
+  /// @dev Returns the locked, unvested and vested coins of a clawback vesting account.
+  /// @param vestingAddress The address of the clawback vesting account
+  /// @return locked The coins still locked up
+  /// @return unvested The coins not yet vested
+  /// @return vested The coins already vested
+  function balances(
+    address vestingAddress
+  ) external view returns (
+    Coin[] memory locked,
+    Coin[] memory unvested,
+    Coin[] memory vested
+  );
+
+  /// @dev Returns the locked, unvested and vested coins of all clawback vesting accounts.
+  /// The locked amount includes the aISLM escrowed by the liquidvesting module.
+  /// NOTE: the query is only available on nodes with vesting stats enabled.
+  /// @return locked The coins still locked up
+  /// @return unvested The coins not yet vested
+  /// @return vested The coins already vested
+  function totalLocked() external view returns (
+    Coin[] memory locked,
+    Coin[] memory unvested,
+    Coin[] memory vested
+  );
+}
@@ -0,0 +1,190 @@
+package vesting
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+// vestingMsgURLs defines the message types that can be authorized through the vesting precompile.
+var vestingMsgURLs = []string{
+	CreateClawbackVestingAccountMsgURL,
+	ClawbackMsgURL,
+	UpdateVestingFunderMsgURL,
+	ConvertVestingAccountMsgURL,
+	ConvertIntoVestingAccountMsgURL,
+}
+
+// Approve grants a generic authorization for the given vesting messages to the grantee.
+// Vesting messages carry no spend limit, so any non-zero amount grants an unlimited
+// authorization, while a zero amount deletes the existing one.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, coin, typeURLs, err := authorization.CheckApprovalArgs(args, utils.BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		if !slices.Contains(vestingMsgURLs, typeURL) {
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "vesting", typeURL)
+		}
+		if err = p.grantOrDeleteAuthz(ctx, grantee, origin, coin, typeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, origin, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke deletes the authorizations for the given vesting messages of the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		if !slices.Contains(vestingMsgURLs, typeURL) {
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "vesting", typeURL)
+		}
+		if err = p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), typeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  origin,
+			Grantee:  grantee,
+			TypeUrls: typeURLs,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// IncreaseAllowance implements the vesting increase allowance transactions.
+// Generic authorizations have no limit, so only the existence of the grant is checked.
+func (p Precompile) IncreaseAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.changeAllowance(ctx, origin, stateDB, method, args)
+}
+
+// DecreaseAllowance implements the vesting decrease allowance transactions.
+// Generic authorizations have no limit, so only the existence of the grant is checked.
+func (p Precompile) DecreaseAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.changeAllowance(ctx, origin, stateDB, method, args)
+}
+
+// changeAllowance checks that a generic authorization exists for each of the given
+// vesting messages and emits the allowance change event. It is a no-op otherwise.
+func (p Precompile) changeAllowance(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, _, typeURLs, err := authorization.CheckApprovalArgs(args, utils.BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		if !slices.Contains(vestingMsgURLs, typeURL) {
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "vesting", typeURL)
+		}
+
+		existingAuthz, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, origin, typeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := existingAuthz.(*authz.GenericAuthorization); !ok {
+			return nil, errorsmod.Wrapf(authz.ErrUnknownAuthorizationType, "expected: *authz.GenericAuthorization, received: %T", existingAuthz)
+		}
+
+		p.Logger(ctx).Debug("allowance change called on generic authorization with no limit: no-op")
+	}
+
+	if err := p.EmitAllowanceChangeEvent(ctx, stateDB, grantee, origin, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantOrDeleteAuthz grants a generic vesting authorization to the grantee.
+// If the amount is zero, it deletes the authorization if it exists.
+func (p Precompile) grantOrDeleteAuthz(
+	ctx sdk.Context,
+	grantee, granter common.Address,
+	coin *sdk.Coin,
+	msgURL string,
+) error {
+	if coin != nil && !coin.IsNil() && !coin.Amount.IsPositive() {
+		p.Logger(ctx).Debug(
+			"deleting authorization",
+			"grantee", grantee.String(),
+			"granter", granter.String(),
+		)
+		return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	}
+
+	p.Logger(ctx).Debug(
+		"setting authorization without limit",
+		"grantee", grantee.String(),
+		"granter", granter.String(),
+	)
+
+	genericAuthz := authz.NewGenericAuthorization(msgURL)
+	if err := genericAuthz.ValidateBasic(); err != nil {
+		return err
+	}
+
+	expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+	return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), genericAuthz, &expiration)
+}
@@ -0,0 +1,168 @@
+package vesting_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	"github.com/haqq-network/haqq/precompiles/vesting"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestApprove() {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any { return []any{} },
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid message type URL",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), abi.MaxUint256, []string{"invalid/url"}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidMsgType, "vesting", "invalid/url"),
+		},
+		{
+			"success - grants generic authorizations",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), abi.MaxUint256, []string{vesting.ClawbackMsgURL, vesting.UpdateVestingFunderMsgURL}}
+			},
+			func() {
+				ctx := s.network.GetContext()
+				for _, msgURL := range []string{vesting.ClawbackMsgURL, vesting.UpdateVestingFunderMsgURL} {
+					grant, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgURL)
+					s.Require().NotNil(grant)
+					s.Require().Equal(msgURL, grant.MsgTypeURL())
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.Approve(ctx, s.keyring.GetAddr(0), stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(true, mustUnpack(s, method, bz)[0])
+			tc.postCheck()
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[authorization.EventTypeApproval].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	approveMethod := s.precompile.Methods[authorization.ApproveMethod]
+	revokeMethod := s.precompile.Methods[authorization.RevokeMethod]
+
+	_, err := s.precompile.Approve(ctx, s.keyring.GetAddr(0), s.network.GetStateDB(), &approveMethod, []any{
+		s.keyring.GetAddr(1), abi.MaxUint256, []string{vesting.ClawbackMsgURL},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.precompile.Revoke(ctx, s.keyring.GetAddr(0), s.network.GetStateDB(), &revokeMethod, []any{
+		s.keyring.GetAddr(1), []string{vesting.ClawbackMsgURL},
+	})
+	s.Require().NoError(err)
+
+	grant, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), vesting.ClawbackMsgURL)
+	s.Require().Nil(grant)
+}
+
+// TestContractCallerAuthorization checks that a contract calling the precompile on behalf
+// of the origin needs a grant from the origin for the executed message.
+func (s *PrecompileTestSuite) TestContractCallerAuthorization() {
+	var vestingAddr common.Address
+	method := s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod]
+	approveMethod := s.precompile.Methods[authorization.ApproveMethod]
+
+	args := func() []any {
+		return []any{
+			s.keyring.GetAddr(0),
+			vestingAddr,
+			s.network.GetContext().BlockTime().Unix(),
+			newPeriods(1000, big.NewInt(1e18)),
+			newPeriods(1000, big.NewInt(1e18)),
+			false,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		approve     bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - no authorization from the origin",
+			false,
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"success - authorized contract caller",
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			origin := s.keyring.GetAddr(0)
+			caller := s.keyring.GetAddr(2)
+			vestingAddr = utiltx.GenerateAddress()
+
+			if tc.approve {
+				_, err := s.precompile.Approve(ctx, origin, s.network.GetStateDB(), &approveMethod, []any{
+					caller, abi.MaxUint256, []string{vesting.CreateClawbackVestingAccountMsgURL},
+				})
+				s.Require().NoError(err)
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile, 200000)
+			_, err := s.precompile.CreateClawbackVestingAccount(ctx, origin, contract, s.network.GetStateDB(), &method, args())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.network.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr.Bytes())
+			s.Require().NoError(err)
+		})
+	}
+}
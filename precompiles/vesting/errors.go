@@ -0,0 +1,18 @@
+package vesting
+
+const (
+	// ErrDifferentOriginFromSigner is raised when the origin address is not the same as the message signer address.
+	ErrDifferentOriginFromSigner = "origin address %s is not the same as signer address %s"
+	// ErrInvalidFunder is raised when the funder address is invalid.
+	ErrInvalidFunder = "invalid funder address: %v"
+	// ErrInvalidVestingAddress is raised when the vesting account address is invalid.
+	ErrInvalidVestingAddress = "invalid vesting address: %v"
+	// ErrInvalidDestination is raised when the clawback destination address is invalid.
+	ErrInvalidDestination = "invalid destination address: %v"
+	// ErrInvalidStartTime is raised when the vesting start time is invalid.
+	ErrInvalidStartTime = "invalid start time: %v"
+	// ErrInvalidFlag is raised when a boolean flag argument is invalid.
+	ErrInvalidFlag = "invalid %s flag: %v"
+	// ErrInvalidValidator is raised when the validator address is invalid.
+	ErrInvalidValidator = "invalid validator address: %v"
+)
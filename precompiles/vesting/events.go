@@ -0,0 +1,209 @@
+package vesting
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+)
+
+const (
+	// EventTypeCreateClawbackVestingAccount defines the event type for the vesting CreateClawbackVestingAccount transaction.
+	EventTypeCreateClawbackVestingAccount = "CreateClawbackVestingAccount"
+	// EventTypeClawback defines the event type for the vesting Clawback transaction.
+	EventTypeClawback = "Clawback"
+	// EventTypeUpdateVestingFunder defines the event type for the vesting UpdateVestingFunder transaction.
+	EventTypeUpdateVestingFunder = "UpdateVestingFunder"
+	// EventTypeConvertVestingAccount defines the event type for the vesting ConvertVestingAccount transaction.
+	EventTypeConvertVestingAccount = "ConvertVestingAccount"
+	// EventTypeConvertIntoVestingAccount defines the event type for the vesting ConvertIntoVestingAccount transaction.
+	EventTypeConvertIntoVestingAccount = "ConvertIntoVestingAccount"
+)
+
+// EmitCreateClawbackVestingAccountEvent creates a new event emitted on a CreateClawbackVestingAccount transaction.
+func (p Precompile) EmitCreateClawbackVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddr common.Address,
+	amount sdk.Coins,
+	startTime int64,
+	merge bool,
+) error {
+	event := p.ABI.Events[EventTypeCreateClawbackVestingAccount]
+
+	topics, err := makeTopics(event, funder, vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount), startTime, merge)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitClawbackEvent creates a new event emitted on a Clawback transaction.
+func (p Precompile) EmitClawbackEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, account, dest common.Address,
+	amount sdk.Coins,
+) error {
+	event := p.ABI.Events[EventTypeClawback]
+
+	topics, err := makeTopics(event, funder, account)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(dest, cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitUpdateVestingFunderEvent creates a new event emitted on an UpdateVestingFunder transaction.
+func (p Precompile) EmitUpdateVestingFunderEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddr, newFunder common.Address,
+) error {
+	event := p.ABI.Events[EventTypeUpdateVestingFunder]
+
+	topics, err := makeTopics(event, funder, vestingAddr, newFunder)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, nil)
+	return nil
+}
+
+// EmitConvertVestingAccountEvent creates a new event emitted on a ConvertVestingAccount transaction.
+func (p Precompile) EmitConvertVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	vestingAddr common.Address,
+) error {
+	event := p.ABI.Events[EventTypeConvertVestingAccount]
+
+	topics, err := makeTopics(event, vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, nil)
+	return nil
+}
+
+// EmitConvertIntoVestingAccountEvent creates a new event emitted on a ConvertIntoVestingAccount transaction.
+func (p Precompile) EmitConvertIntoVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddr common.Address,
+	amount sdk.Coins,
+	startTime int64,
+	merge, stake bool,
+) error {
+	event := p.ABI.Events[EventTypeConvertIntoVestingAccount]
+
+	topics, err := makeTopics(event, funder, vestingAddr)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(cmn.NewCoinsResponse(amount), startTime, merge, stake)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitApprovalEvent creates a new approval event emitted on an Approve transaction.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, grantee, granter common.Address, typeUrls []string) error {
+	event := p.ABI.Events[authorization.EventTypeApproval]
+
+	topics, err := makeTopics(event, grantee, granter)
+	if err != nil {
+		return err
+	}
+
+	// Vesting authorizations are generic, so the allowance is always infinite
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(typeUrls, abi.MaxUint256)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitAllowanceChangeEvent creates a new allowance change event emitted on an IncreaseAllowance and DecreaseAllowance transactions.
+func (p Precompile) EmitAllowanceChangeEvent(ctx sdk.Context, stateDB vm.StateDB, grantee, granter common.Address, typeUrls []string) error {
+	event := p.ABI.Events[authorization.EventTypeAllowanceChange]
+
+	topics, err := makeTopics(event, grantee, granter)
+	if err != nil {
+		return err
+	}
+
+	newValues := make([]*big.Int, len(typeUrls))
+	for i := range typeUrls {
+		newValues[i] = abi.MaxUint256
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(typeUrls, newValues)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// makeTopics returns the event topics: the event signature followed by the indexed addresses.
+func makeTopics(event abi.Event, addrs ...common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(addrs)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, addr := range addrs {
+		topics[i+1], err = cmn.MakeTopic(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return topics, nil
+}
+
+// addLog adds a precompile log with the given topics and data to the stateDB.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint: gosec // G115 blockHeight is positive int64 and can't overflow uint64
+	})
+}
@@ -0,0 +1,87 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the vesting Balances query.
+	BalancesMethod = "balances"
+	// TotalLockedMethod defines the ABI method name for the vesting TotalLocked query.
+	TotalLockedMethod = "totalLocked"
+)
+
+// Balances returns the locked, unvested and vested coins of a clawback vesting account.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewBalancesRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.vestingKeeper.Balances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBalancesOutput(res.Locked, res.Unvested, res.Vested).Pack(method.Outputs)
+}
+
+// TotalLocked returns the locked, unvested and vested coins of all clawback vesting accounts.
+func (p Precompile) TotalLocked(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.vestingKeeper.TotalLocked(ctx, &vestingtypes.QueryTotalLockedRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBalancesOutput(res.Locked, res.Unvested, res.Vested).Pack(method.Outputs)
+}
+
+// Allowance returns the remaining allowance of a grantee to the contract.
+// Vesting authorizations are generic, so an existing grant has an infinite allowance.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	grantee, granter, msg, err := authorization.CheckAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgAuthz, _ := p.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), msg)
+
+	if msgAuthz == nil {
+		return method.Outputs.Pack(big.NewInt(0))
+	}
+
+	if _, ok := msgAuthz.(*authz.GenericAuthorization); !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "vesting authorization", &authz.GenericAuthorization{}, msgAuthz)
+	}
+
+	return method.Outputs.Pack(abi.MaxUint256)
+}
@@ -0,0 +1,107 @@
+package vesting_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/vesting"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	"github.com/haqq-network/haqq/utils"
+)
+
+func (s *PrecompileTestSuite) TestBalances() {
+	method := s.precompile.Methods[vesting.BalancesMethod]
+
+	s.Run("fail - empty input args", func() {
+		s.SetupTest()
+		_, err := s.precompile.Balances(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+	})
+
+	s.Run("fail - not a vesting account", func() {
+		s.SetupTest()
+		_, err := s.precompile.Balances(s.network.GetContext(), &method, nil, []any{s.keyring.GetAddr(1)})
+		s.Require().ErrorContains(err, "either does not exist or is not a vesting account")
+	})
+
+	s.Run("success - locked and unvested schedule", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		vestingAddr := utiltx.GenerateAddress()
+		s.createVestingAccount(ctx, vestingAddr, ctx.BlockTime().Unix(), 1000)
+
+		bz, err := s.precompile.Balances(ctx, &method, nil, []any{vestingAddr})
+		s.Require().NoError(err)
+
+		var out vesting.BalancesOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: vestingAmount}}, out.Locked)
+		s.Require().Equal([]cmn.Coin{{Denom: utils.BaseDenom, Amount: vestingAmount}}, out.Unvested)
+		s.Require().Empty(out.Vested)
+	})
+}
+
+func (s *PrecompileTestSuite) TestTotalLocked() {
+	method := s.precompile.Methods[vesting.TotalLockedMethod]
+
+	s.Run("fail - vesting stats disabled", func() {
+		s.SetupTest()
+		s.T().Setenv("HAQQ_ENABLE_VESTING_STATS", "false")
+		_, err := s.precompile.TotalLocked(s.network.GetContext(), &method, nil, []any{})
+		s.Require().ErrorContains(err, "vesting stats is disabled")
+	})
+
+	s.Run("success - includes the created vesting account", func() {
+		s.SetupTest()
+		s.T().Setenv("HAQQ_ENABLE_VESTING_STATS", "true")
+		ctx := s.network.GetContext()
+		s.createVestingAccount(ctx, utiltx.GenerateAddress(), ctx.BlockTime().Unix(), 1000)
+
+		bz, err := s.precompile.TotalLocked(ctx, &method, nil, []any{})
+		s.Require().NoError(err)
+
+		var out vesting.BalancesOutput
+		s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+		s.Require().NotEmpty(out.Unvested)
+		s.Require().GreaterOrEqual(out.Unvested[0].Amount.Cmp(vestingAmount), 0)
+	})
+}
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[authorization.AllowanceMethod]
+
+	s.Run("no grant returns zero", func() {
+		s.SetupTest()
+		bz, err := s.precompile.Allowance(s.network.GetContext(), &method, nil, []any{
+			s.keyring.GetAddr(1), s.keyring.GetAddr(0), vesting.ClawbackMsgURL,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(0, mustUnpack(s, method, bz)[0].(*big.Int).Sign())
+	})
+
+	s.Run("generic grant returns max uint256", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		expiration := ctx.BlockTime().Add(cmn.DefaultExpirationDuration)
+		err := s.network.App.AuthzKeeper.SaveGrant(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), authz.NewGenericAuthorization(vesting.ClawbackMsgURL), &expiration)
+		s.Require().NoError(err)
+
+		bz, err := s.precompile.Allowance(ctx, &method, nil, []any{
+			s.keyring.GetAddr(1), s.keyring.GetAddr(0), vesting.ClawbackMsgURL,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(abi.MaxUint256, mustUnpack(s, method, bz)[0])
+	})
+}
+
+// mustUnpack unpacks the ABI-encoded output of the method and fails the test on error.
+func mustUnpack(s *PrecompileTestSuite, method abi.Method, bz []byte) []any {
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out
+}
@@ -0,0 +1,309 @@
+package vesting
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
+)
+
+const (
+	// CreateClawbackVestingAccountMethod defines the ABI method name for the vesting
+	// CreateClawbackVestingAccount transaction.
+	CreateClawbackVestingAccountMethod = "createClawbackVestingAccount"
+	// ClawbackMethod defines the ABI method name for the vesting Clawback transaction.
+	ClawbackMethod = "clawback"
+	// UpdateVestingFunderMethod defines the ABI method name for the vesting UpdateVestingFunder transaction.
+	UpdateVestingFunderMethod = "updateVestingFunder"
+	// ConvertVestingAccountMethod defines the ABI method name for the vesting ConvertVestingAccount transaction.
+	ConvertVestingAccountMethod = "convertVestingAccount"
+	// ConvertIntoVestingAccountMethod defines the ABI method name for the vesting
+	// ConvertIntoVestingAccount transaction.
+	ConvertIntoVestingAccountMethod = "convertIntoVestingAccount"
+)
+
+var (
+	// CreateClawbackVestingAccountMsgURL defines the authorization type for MsgCreateClawbackVestingAccount
+	CreateClawbackVestingAccountMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgCreateClawbackVestingAccount{})
+	// ClawbackMsgURL defines the authorization type for MsgClawback
+	ClawbackMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgClawback{})
+	// UpdateVestingFunderMsgURL defines the authorization type for MsgUpdateVestingFunder
+	UpdateVestingFunderMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgUpdateVestingFunder{})
+	// ConvertVestingAccountMsgURL defines the authorization type for MsgConvertVestingAccount
+	ConvertVestingAccountMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgConvertVestingAccount{})
+	// ConvertIntoVestingAccountMsgURL defines the authorization type for MsgConvertIntoVestingAccount
+	ConvertIntoVestingAccountMsgURL = sdk.MsgTypeURL(&vestingtypes.MsgConvertIntoVestingAccount{})
+)
+
+// checkSigner ensures the message signer either calls the precompile directly or
+// is the origin of the transaction, in which case the calling contract must be
+// authorized by the signer.
+func checkSigner(origin common.Address, contract *vm.Contract, signer common.Address) error {
+	if contract.CallerAddress != signer && origin != signer {
+		return fmt.Errorf(ErrDifferentOriginFromSigner, origin.String(), signer.String())
+	}
+	return nil
+}
+
+// CreateClawbackVestingAccount creates a new clawback vesting account, or merges
+// a new grant into an existing one.
+func (p *Precompile) CreateClawbackVestingAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, vestingAddr, err := NewCreateClawbackVestingAccountMsg(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, start_time: %s, merge: %t }",
+			msg.FromAddress,
+			msg.ToAddress,
+			msg.StartTime,
+			msg.Merge,
+		),
+	)
+
+	if err := checkSigner(origin, contract, funder); err != nil {
+		return nil, err
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, funder, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	snapshots := cmn.SnapshotBaseBalances(ctx, p.bankKeeper, funder, vestingAddr)
+
+	if _, err = p.vestingKeeper.CreateClawbackVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.MirrorBankBaseDeltas(ctx, p.bankKeeper, contract.CallerAddress == origin, snapshots...)
+
+	// The msg server fills in the default schedules, so the amount is read after execution
+	if err = p.EmitCreateClawbackVestingAccountEvent(ctx, stateDB, funder, vestingAddr, msg.VestingPeriods.TotalAmount(), msg.StartTime.Unix(), msg.Merge); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Clawback claws back the unvested coins of a clawback vesting account and
+// returns the transferred amount.
+func (p *Precompile) Clawback(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, account, dest, err := NewClawbackMsg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, account: %s, dest: %s }",
+			msg.FunderAddress,
+			msg.AccountAddress,
+			msg.DestAddress,
+		),
+	)
+
+	if err := checkSigner(origin, contract, funder); err != nil {
+		return nil, err
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, funder, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	va, err := p.vestingKeeper.GetClawbackVestingAccount(ctx, account.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	_, clawedBack := va.ComputeClawback(ctx.BlockTime().Unix())
+
+	snapshots := cmn.SnapshotBaseBalances(ctx, p.bankKeeper, account, dest)
+
+	if _, err = p.vestingKeeper.Clawback(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.MirrorBankBaseDeltas(ctx, p.bankKeeper, contract.CallerAddress == origin, snapshots...)
+
+	if err = p.EmitClawbackEvent(ctx, stateDB, funder, account, dest, clawedBack); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(clawedBack))
+}
+
+// UpdateVestingFunder updates the funder of a clawback vesting account.
+func (p *Precompile) UpdateVestingFunder(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, newFunder, vestingAddr, err := NewUpdateVestingFunderMsg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, new_funder: %s, vesting_address: %s }",
+			msg.FunderAddress,
+			msg.NewFunderAddress,
+			msg.VestingAddress,
+		),
+	)
+
+	if err := checkSigner(origin, contract, funder); err != nil {
+		return nil, err
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, funder, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err = p.vestingKeeper.UpdateVestingFunder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitUpdateVestingFunderEvent(ctx, stateDB, funder, vestingAddr, newFunder); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ConvertVestingAccount converts a clawback vesting account into a regular
+// account once its lockup and vesting schedules have concluded.
+func (p *Precompile) ConvertVestingAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, vestingAddr, err := NewConvertVestingAccountMsg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf("{ vesting_address: %s }", msg.VestingAddress),
+	)
+
+	if err := checkSigner(origin, contract, vestingAddr); err != nil {
+		return nil, err
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, vestingAddr, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err = p.vestingKeeper.ConvertVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitConvertVestingAccountEvent(ctx, stateDB, vestingAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ConvertIntoVestingAccount converts an account into a clawback vesting account,
+// or merges a new grant into an existing one.
+func (p *Precompile) ConvertIntoVestingAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, vestingAddr, err := NewConvertIntoVestingAccountMsg(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"origin", origin.String(),
+		"caller", contract.CallerAddress.String(),
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ funder: %s, vesting_address: %s, start_time: %s, merge: %t, stake: %t, validator: %s }",
+			msg.FromAddress,
+			msg.ToAddress,
+			msg.StartTime,
+			msg.Merge,
+			msg.Stake,
+			msg.ValidatorAddress,
+		),
+	)
+
+	if err := checkSigner(origin, contract, funder); err != nil {
+		return nil, err
+	}
+
+	// Check and accept authorization if needed
+	if err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, funder, p.AuthzKeeper, msg); err != nil {
+		return nil, err
+	}
+
+	snapshots := cmn.SnapshotBaseBalances(ctx, p.bankKeeper, funder, vestingAddr)
+
+	if _, err = p.vestingKeeper.ConvertIntoVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	p.MirrorBankBaseDeltas(ctx, p.bankKeeper, contract.CallerAddress == origin, snapshots...)
+
+	// The msg server fills in the default schedules, so the amount is read after execution
+	if err = p.EmitConvertIntoVestingAccountEvent(ctx, stateDB, funder, vestingAddr, msg.VestingPeriods.TotalAmount(), msg.StartTime.Unix(), msg.Merge, msg.Stake); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,369 @@
+package vesting_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/precompiles/testutil"
+	"github.com/haqq-network/haqq/precompiles/vesting"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	ethtypes "github.com/haqq-network/haqq/types"
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/evm/statedb"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
+)
+
+var vestingAmount = big.NewInt(1e18)
+
+// newPeriods returns a schedule with a single period of the given length unlocking the given aISLM amount.
+func newPeriods(length int64, amount *big.Int) []vesting.Period {
+	return []vesting.Period{{
+		Length: length,
+		Amount: []cmn.Coin{{Denom: utils.BaseDenom, Amount: amount}},
+	}}
+}
+
+// createVestingAccount creates a clawback vesting account funded by the first keyring account
+// through the precompile, with a single lockup and vesting period of the given length.
+func (s *PrecompileTestSuite) createVestingAccount(ctx sdk.Context, vestingAddr common.Address, startTime, length int64) {
+	method := s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod]
+	funder := s.keyring.GetAddr(0)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, funder, s.precompile, 200000)
+	_, err := s.precompile.CreateClawbackVestingAccount(ctx, funder, contract, s.network.GetStateDB(), &method, []any{
+		funder,
+		vestingAddr,
+		startTime,
+		newPeriods(length, vestingAmount),
+		newPeriods(length, vestingAmount),
+		false,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestCreateClawbackVestingAccount() {
+	var (
+		ctx         sdk.Context
+		stDB        *statedb.StateDB
+		vestingAddr common.Address
+	)
+	method := s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - different origin from funder",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(1),
+					vestingAddr,
+					ctx.BlockTime().Unix(),
+					newPeriods(1000, vestingAmount),
+					newPeriods(1000, vestingAmount),
+					false,
+				}
+			},
+			true,
+			"is not the same as signer address",
+		},
+		{
+			"fail - lockup and vesting schedules differ",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					vestingAddr,
+					ctx.BlockTime().Unix(),
+					newPeriods(1000, vestingAmount),
+					newPeriods(1000, big.NewInt(1)),
+					false,
+				}
+			},
+			true,
+			"vesting and lockup schedules must have same total coins",
+		},
+		{
+			"success - create clawback vesting account",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					vestingAddr,
+					ctx.BlockTime().Unix(),
+					newPeriods(1000, vestingAmount),
+					newPeriods(1000, vestingAmount),
+					false,
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - empty lockup defaults to instant unlock",
+			func() []any {
+				return []any{
+					s.keyring.GetAddr(0),
+					vestingAddr,
+					ctx.BlockTime().Unix(),
+					[]vesting.Period{},
+					newPeriods(1000, vestingAmount),
+					false,
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+			vestingAddr = utiltx.GenerateAddress()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			bz, err := s.precompile.CreateClawbackVestingAccount(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			success, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			va, err := s.network.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(), va.FunderAddress)
+			s.Require().Equal(vestingAmount, va.OriginalVesting.AmountOf(utils.BaseDenom).BigInt())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[vesting.EventTypeCreateClawbackVestingAccount].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClawback() {
+	var (
+		ctx         sdk.Context
+		stDB        *statedb.StateDB
+		vestingAddr common.Address
+	)
+	method := s.precompile.Methods[vesting.ClawbackMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - not a vesting account",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Address{}}
+			},
+			true,
+			vestingtypes.ErrNotSubjectToClawback.Error(),
+		},
+		{
+			"fail - different origin from funder",
+			func() []any {
+				return []any{s.keyring.GetAddr(1), vestingAddr, common.Address{}}
+			},
+			true,
+			"is not the same as signer address",
+		},
+		{
+			"success - claw back to the funder",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), vestingAddr, common.Address{}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			vestingAddr = utiltx.GenerateAddress()
+			s.createVestingAccount(ctx, vestingAddr, ctx.BlockTime().Unix(), 1000)
+			stDB = s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			bz, err := s.precompile.Clawback(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out struct{ Amount []cmn.Coin }
+			s.Require().NoError(method.Outputs.Copy(&out, mustUnpack(s, method, bz)))
+			s.Require().Len(out.Amount, 1)
+			s.Require().Equal(utils.BaseDenom, out.Amount[0].Denom)
+			s.Require().Equal(0, vestingAmount.Cmp(out.Amount[0].Amount))
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, vestingAddr.Bytes(), utils.BaseDenom)
+			s.Require().True(balance.IsZero())
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[vesting.EventTypeClawback].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateVestingFunder() {
+	var (
+		ctx         sdk.Context
+		vestingAddr common.Address
+	)
+	method := s.precompile.Methods[vesting.UpdateVestingFunderMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []any
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []any {
+				return []any{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - new funder is the current funder",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), s.keyring.GetAddr(0), vestingAddr}
+			},
+			true,
+			"new funder address is equal to current funder address",
+		},
+		{
+			"success - update funder",
+			func() []any {
+				return []any{s.keyring.GetAddr(0), s.keyring.GetAddr(1), vestingAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			vestingAddr = utiltx.GenerateAddress()
+			s.createVestingAccount(ctx, vestingAddr, ctx.BlockTime().Unix(), 1000)
+			stDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200000)
+			_, err := s.precompile.UpdateVestingFunder(ctx, s.keyring.GetAddr(0), contract, stDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			va, err := s.network.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal(s.keyring.GetAccAddr(1).String(), va.FunderAddress)
+
+			logs := stDB.Logs()
+			s.Require().NotEmpty(logs)
+			s.Require().Equal(s.precompile.Events[vesting.EventTypeUpdateVestingFunder].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConvertIntoAndConvertVestingAccount() {
+	convertIntoMethod := s.precompile.Methods[vesting.ConvertIntoVestingAccountMethod]
+	convertMethod := s.precompile.Methods[vesting.ConvertVestingAccountMethod]
+
+	testCases := []struct {
+		name        string
+		startOffset int64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - convert while coins are still locked",
+			0,
+			true,
+			"vesting coins still left in account",
+		},
+		{
+			"success - convert after the schedule concluded",
+			-10,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			funder := s.keyring.GetAddr(0)
+			vestingAddr := s.keyring.GetAddr(1)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, funder, s.precompile, 200000)
+			_, err := s.precompile.ConvertIntoVestingAccount(ctx, funder, contract, s.network.GetStateDB(), &convertIntoMethod, []any{
+				funder,
+				vestingAddr,
+				ctx.BlockTime().Unix() + tc.startOffset,
+				newPeriods(1, vestingAmount),
+				newPeriods(1, vestingAmount),
+				false,
+				false,
+				"",
+			})
+			s.Require().NoError(err)
+
+			_, err = s.network.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr.Bytes())
+			s.Require().NoError(err)
+
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, vestingAddr, s.precompile, 200000)
+			_, err = s.precompile.ConvertVestingAccount(ctx, vestingAddr, contract, s.network.GetStateDB(), &convertMethod, []any{vestingAddr})
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			acc := s.network.App.AccountKeeper.GetAccount(ctx, vestingAddr.Bytes())
+			_, ok := acc.(*ethtypes.EthAccount)
+			s.Require().True(ok, "expected the vesting account to be converted into an eth account")
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package vesting
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	cmn "github.com/haqq-network/haqq/precompiles/common"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
+)
+
+// Period defines a single period of a lockup or vesting schedule in types native to the EVM.
+type Period struct {
+	Length int64
+	Amount []cmn.Coin
+}
+
+// NewSDKPeriods converts the ABI representation of the periods to the vesting periods.
+// Coin amounts are not validated here, since that is done by the ValidateBasic of the message.
+func NewSDKPeriods(periods []Period) sdkvesting.Periods {
+	outputs := make(sdkvesting.Periods, len(periods))
+	for i, period := range periods {
+		amount := make(sdk.Coins, len(period.Amount))
+		for j, coin := range period.Amount {
+			amount[j] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+		}
+		outputs[i] = sdkvesting.Period{
+			Length: period.Length,
+			Amount: amount.Sort(),
+		}
+	}
+	return outputs
+}
+
+// CreateClawbackVestingAccountInput is a struct to represent the arguments of the
+// createClawbackVestingAccount transaction.
+// Needed to unpack the Period tuples into native EVM types.
+type CreateClawbackVestingAccountInput struct {
+	FunderAddress  common.Address
+	VestingAddress common.Address
+	StartTime      int64
+	LockupPeriods  []Period
+	VestingPeriods []Period
+	Merge          bool
+}
+
+// ConvertIntoVestingAccountInput is a struct to represent the arguments of the
+// convertIntoVestingAccount transaction.
+// Needed to unpack the Period tuples into native EVM types.
+type ConvertIntoVestingAccountInput struct {
+	FunderAddress    common.Address
+	VestingAddress   common.Address
+	StartTime        int64
+	LockupPeriods    []Period
+	VestingPeriods   []Period
+	Merge            bool
+	Stake            bool
+	ValidatorAddress string
+}
+
+// NewCreateClawbackVestingAccountMsg builds a MsgCreateClawbackVestingAccount from ABI arguments.
+func NewCreateClawbackVestingAccountMsg(method *abi.Method, args []interface{}) (*vestingtypes.MsgCreateClawbackVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input CreateClawbackVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to CreateClawbackVestingAccountInput struct: %s", err)
+	}
+
+	msg := vestingtypes.NewMsgCreateClawbackVestingAccount(
+		input.FunderAddress.Bytes(),
+		input.VestingAddress.Bytes(),
+		time.Unix(input.StartTime, 0).UTC(),
+		NewSDKPeriods(input.LockupPeriods),
+		NewSDKPeriods(input.VestingPeriods),
+		input.Merge,
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, input.FunderAddress, input.VestingAddress, nil
+}
+
+// NewClawbackMsg builds a MsgClawback from ABI arguments.
+// The zero destination address defaults to the funder of the vesting account.
+func NewClawbackMsg(args []interface{}) (*vestingtypes.MsgClawback, common.Address, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funder, ok := args[0].(common.Address)
+	if !ok || funder == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidFunder, args[0])
+	}
+
+	account, ok := args[1].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[1])
+	}
+
+	dest, ok := args[2].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidDestination, args[2])
+	}
+
+	var destAccAddr sdk.AccAddress
+	if dest != (common.Address{}) {
+		destAccAddr = dest.Bytes()
+	} else {
+		dest = funder
+	}
+
+	msg := vestingtypes.NewMsgClawback(funder.Bytes(), account.Bytes(), destAccAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	return msg, funder, account, dest, nil
+}
+
+// NewUpdateVestingFunderMsg builds a MsgUpdateVestingFunder from ABI arguments.
+func NewUpdateVestingFunderMsg(args []interface{}) (*vestingtypes.MsgUpdateVestingFunder, common.Address, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funder, ok := args[0].(common.Address)
+	if !ok || funder == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidFunder, args[0])
+	}
+
+	newFunder, ok := args[1].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidFunder, args[1])
+	}
+
+	vestingAddr, ok := args[2].(common.Address)
+	if !ok || vestingAddr == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[2])
+	}
+
+	msg := vestingtypes.NewMsgUpdateVestingFunder(funder.Bytes(), newFunder.Bytes(), vestingAddr.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, common.Address{}, err
+	}
+
+	return msg, funder, newFunder, vestingAddr, nil
+}
+
+// NewConvertVestingAccountMsg builds a MsgConvertVestingAccount from ABI arguments.
+func NewConvertVestingAccountMsg(args []interface{}) (*vestingtypes.MsgConvertVestingAccount, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	vestingAddr, ok := args[0].(common.Address)
+	if !ok || vestingAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, args[0])
+	}
+
+	msg := vestingtypes.NewMsgConvertVestingAccount(vestingAddr.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, vestingAddr, nil
+}
+
+// NewConvertIntoVestingAccountMsg builds a MsgConvertIntoVestingAccount from ABI arguments.
+func NewConvertIntoVestingAccountMsg(method *abi.Method, args []interface{}) (*vestingtypes.MsgConvertIntoVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 8 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 8, len(args))
+	}
+
+	var input ConvertIntoVestingAccountInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to ConvertIntoVestingAccountInput struct: %s", err)
+	}
+
+	msg := &vestingtypes.MsgConvertIntoVestingAccount{
+		FromAddress:    sdk.AccAddress(input.FunderAddress.Bytes()).String(),
+		ToAddress:      sdk.AccAddress(input.VestingAddress.Bytes()).String(),
+		StartTime:      time.Unix(input.StartTime, 0).UTC(),
+		LockupPeriods:  NewSDKPeriods(input.LockupPeriods),
+		VestingPeriods: NewSDKPeriods(input.VestingPeriods),
+		Merge:          input.Merge,
+		Stake:          input.Stake,
+	}
+
+	if input.Stake {
+		if _, err := sdk.ValAddressFromBech32(input.ValidatorAddress); err != nil {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidValidator, input.ValidatorAddress)
+		}
+		msg.ValidatorAddress = input.ValidatorAddress
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, input.FunderAddress, input.VestingAddress, nil
+}
+
+// NewBalancesRequest builds a QueryBalancesRequest from ABI arguments.
+func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	vestingAddr, ok := args[0].(common.Address)
+	if !ok || vestingAddr == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidVestingAddress, args[0])
+	}
+
+	return &vestingtypes.QueryBalancesRequest{
+		Address: sdk.AccAddress(vestingAddr.Bytes()).String(),
+	}, nil
+}
+
+// BalancesOutput defines the locked, unvested and vested coins of the balances
+// and totalLocked queries in types native to the EVM.
+type BalancesOutput struct {
+	Locked   []cmn.Coin
+	Unvested []cmn.Coin
+	Vested   []cmn.Coin
+}
+
+// NewBalancesOutput converts the locked, unvested and vested coins to the ABI representation.
+func NewBalancesOutput(locked, unvested, vested sdk.Coins) *BalancesOutput {
+	return &BalancesOutput{
+		Locked:   cmn.NewCoinsResponse(locked),
+		Unvested: cmn.NewCoinsResponse(unvested),
+		Vested:   cmn.NewCoinsResponse(vested),
+	}
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (bo *BalancesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(bo.Locked, bo.Unvested, bo.Vested)
+}
+
+// CheckAndAcceptAuthorizationIfNeeded checks if authorization exists and accepts the grant.
+// In case the signer is the caller of the precompile, no authorization is required.
+// Vesting messages carry no spend limit, so only generic authorizations are accepted.
+func CheckAndAcceptAuthorizationIfNeeded(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	signer common.Address,
+	authzKeeper authzkeeper.Keeper,
+	msg sdk.Msg,
+) error {
+	if contract.CallerAddress == signer {
+		return nil
+	}
+
+	auth, expiration, err := authorization.CheckAuthzExists(ctx, authzKeeper, contract.CallerAddress, signer, sdk.MsgTypeURL(msg))
+	if err != nil {
+		return fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, contract.CallerAddress, signer)
+	}
+
+	if _, ok := auth.(*authz.GenericAuthorization); !ok {
+		return fmt.Errorf("expected GenericAuthorization, got %T", auth)
+	}
+
+	resp, err := auth.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return fmt.Errorf("authorization not accepted")
+	}
+
+	if resp.Delete {
+		if err = authzKeeper.DeleteGrant(ctx, contract.CallerAddress.Bytes(), signer.Bytes(), sdk.MsgTypeURL(msg)); err != nil {
+			return err
+		}
+	} else if resp.Updated != nil {
+		if err = authzKeeper.SaveGrant(ctx, contract.CallerAddress.Bytes(), signer.Bytes(), resp.Updated, expiration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
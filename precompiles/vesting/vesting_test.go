@@ -0,0 +1,91 @@
+package vesting_test
+
+import (
+	"github.com/haqq-network/haqq/precompiles/authorization"
+	"github.com/haqq-network/haqq/precompiles/vesting"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method string
+		isTx   bool
+	}{
+		{
+			vesting.CreateClawbackVestingAccountMethod,
+			s.precompile.Methods[vesting.CreateClawbackVestingAccountMethod].Name,
+			true,
+		},
+		{
+			vesting.ClawbackMethod,
+			s.precompile.Methods[vesting.ClawbackMethod].Name,
+			true,
+		},
+		{
+			vesting.UpdateVestingFunderMethod,
+			s.precompile.Methods[vesting.UpdateVestingFunderMethod].Name,
+			true,
+		},
+		{
+			vesting.ConvertVestingAccountMethod,
+			s.precompile.Methods[vesting.ConvertVestingAccountMethod].Name,
+			true,
+		},
+		{
+			vesting.ConvertIntoVestingAccountMethod,
+			s.precompile.Methods[vesting.ConvertIntoVestingAccountMethod].Name,
+			true,
+		},
+		{
+			authorization.ApproveMethod,
+			s.precompile.Methods[authorization.ApproveMethod].Name,
+			true,
+		},
+		{
+			authorization.RevokeMethod,
+			s.precompile.Methods[authorization.RevokeMethod].Name,
+			true,
+		},
+		{
+			vesting.BalancesMethod,
+			s.precompile.Methods[vesting.BalancesMethod].Name,
+			false,
+		},
+		{
+			vesting.TotalLockedMethod,
+			s.precompile.Methods[vesting.TotalLockedMethod].Name,
+			false,
+		},
+		{
+			authorization.AllowanceMethod,
+			s.precompile.Methods[authorization.AllowanceMethod].Name,
+			false,
+		},
+		{
+			"invalid",
+			"invalid",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(tc.method))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas() {
+	for _, name := range []string{vesting.ClawbackMethod, vesting.BalancesMethod} {
+		s.Run(name, func() {
+			method := s.precompile.Methods[name]
+			// Use methodID + padding to test RequiredGas
+			input := append(method.ID, make([]byte, 32)...) //nolint: gocritic
+			s.Require().Positive(s.precompile.RequiredGas(input))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGas_ShortInput() {
+	s.Require().Equal(uint64(0), s.precompile.RequiredGas([]byte{0x01}))
+}
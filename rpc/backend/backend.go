@@ -107,6 +107,7 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*haqqtypes.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
@@ -115,18 +116,35 @@ type EVMBackend interface {
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(
+		args evmtypes.TransactionArgs,
+		blockNrOptional *rpctypes.BlockNumber,
+		overrides *rpctypes.StateOverride,
+		blockOverrides *rpctypes.BlockOverrides,
+	) (hexutil.Uint64, error)
+	DoCall(
+		args evmtypes.TransactionArgs,
+		blockNr rpctypes.BlockNumber,
+		overrides *rpctypes.StateOverride,
+		blockOverrides *rpctypes.BlockOverrides,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, int64, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *rpctypes.TraceCallConfig) (interface{}, error)
+
+	// TxPool API
+	TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -221,7 +221,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client, pendingTxsLimitPtr())
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -251,7 +251,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterUnconfirmedTxsEmpty(client, pendingTxsLimitPtr())
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -406,12 +406,15 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
+	nonce := hexutil.Uint64(1)
+	overrides := rpctypes.StateOverride{common.Address{}: {Nonce: &nonce}}
 
 	testCases := []struct {
 		name         string
 		registerMock func()
 		blockNum     rpctypes.BlockNumber
 		callArgs     evmtypes.TransactionArgs
+		overrides    *rpctypes.StateOverride
 		expEthTx     *evmtypes.MsgEthereumTxResponse
 		expPass      bool
 	}{
@@ -426,6 +429,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -440,6 +444,24 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Returned transaction response with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				overridesBz, err := json.Marshal(overrides)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64(), Overrides: overridesBz})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -450,7 +472,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -461,6 +483,163 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	blockNum := rpctypes.BlockNumber(1)
+	slot := common.BigToHash(big.NewInt(1))
+	accessList := ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{slot}}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned access list and gas used",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(
+					queryClient,
+					&evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()},
+					&evmtypes.QueryCreateAccessListResponse{AccessList: evmtypes.NewAccessList(&accessList), GasUsed: 23400},
+				)
+			},
+			&rpctypes.AccessListResult{AccessList: &accessList, GasUsed: 23400},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{To: &toAddr}}},
+		},
+		TraceTransfers: true,
+	}
+	optsBz, err := json.Marshal(&opts)
+	suite.Require().NoError(err)
+	blockNum := rpctypes.BlockNumber(1)
+	request := &evmtypes.QuerySimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()}
+
+	blocks := []*rpctypes.SimBlockResult{
+		{
+			Number:       2,
+			Transactions: []common.Hash{common.HexToHash("0x01")},
+			Calls: []evmtypes.SimCallResult{
+				{ReturnValue: hexutil.Bytes{}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1},
+			},
+		},
+	}
+	blocksBz, err := json.Marshal(blocks)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expBlocks    []*rpctypes.SimBlockResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1Error(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returned simulated blocks",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1(queryClient, request, &evmtypes.QuerySimulateV1Response{Data: blocksBz})
+			},
+			blocks,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBlocks, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
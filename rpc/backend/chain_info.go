@@ -98,6 +98,11 @@ func (b *Backend) CurrentHeader() (*ethtypes.Header, error) {
 	return b.HeaderByNumber(rpctypes.EthLatestBlockNumber)
 }
 
+// pendingTxsLimit is the number of mempool txs requested by PendingTransactions.
+// It is the largest page served by CometBFT, which doesn't support paging through
+// the unconfirmed txs, so only the first txs of larger mempools are returned.
+const pendingTxsLimit = 100
+
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
 func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
@@ -106,7 +111,9 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 		return nil, errors.New("invalid rpc client")
 	}
 
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	// request the maximum page explicitly, the default one only holds 30 txs
+	limit := pendingTxsLimit
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
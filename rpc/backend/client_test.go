@@ -61,6 +61,12 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 }
 
 // Unconfirmed Transactions
+// pendingTxsLimitPtr returns the limit PendingTransactions requests the mempool txs with
+func pendingTxsLimitPtr() *int {
+	limit := pendingTxsLimit
+	return &limit
+}
+
 func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
 	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
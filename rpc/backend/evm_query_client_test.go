@@ -65,6 +65,16 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, height int64, args, overrides []byte) {
+	data := []byte(`{"test": "hello"}`)
+	queryClient.On("TraceCall", rpc.ContextWithHeight(height),
+		mock.MatchedBy(func(req *evmtypes.QueryTraceCallRequest) bool {
+			return string(req.Args) == string(args) && string(req.Overrides) == string(overrides) && req.BlockNumber == height
+		})).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -152,6 +162,38 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Create Access List
+func RegisterCreateAccessList(
+	queryClient *mocks.EVMQueryClient,
+	request *evmtypes.EthCallRequest,
+	response *evmtypes.QueryCreateAccessListResponse,
+) {
+	// the call context is wrapped with the RPC EVM timeout
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// Simulate V1
+func RegisterSimulateV1(
+	queryClient *mocks.EVMQueryClient,
+	request *evmtypes.QuerySimulateV1Request,
+	response *evmtypes.QuerySimulateV1Response,
+) {
+	// the call context is wrapped with the RPC EVM timeout
+	queryClient.On("SimulateV1", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.QuerySimulateV1Request) {
+	queryClient.On("SimulateV1", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
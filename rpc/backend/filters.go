@@ -29,6 +29,20 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetLogHeights returns the heights within [from, to] of the blocks holding logs that may match the
+// given addresses and topics, along with the last height served by the custom indexer log index.
+// It returns from-1 as the last height when the custom indexer is disabled.
+func (b *Backend) GetLogHeights(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, int64, error) {
+	if b.indexer == nil {
+		return nil, from - 1, nil
+	}
+	return b.indexer.GetLogHeights(from, to, addresses, topics)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
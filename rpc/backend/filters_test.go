@@ -9,6 +9,7 @@ import (
 
 	"github.com/haqq-network/haqq/rpc/backend/mocks"
 	ethrpc "github.com/haqq-network/haqq/rpc/types"
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
 	evmtypes "github.com/haqq-network/haqq/x/evm/types"
 )
 
@@ -93,6 +94,22 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetLogHeights() {
+	suite.SetupTest()
+
+	// the log index of the suite indexer is empty, so the whole range needs to be scanned
+	heights, last, err := suite.backend.GetLogHeights(1, 10, []common.Address{utiltx.GenerateAddress()}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(heights)
+	suite.Require().Equal(int64(0), last)
+
+	suite.backend.indexer = nil
+	heights, last, err = suite.backend.GetLogHeights(1, 10, []common.Address{utiltx.GenerateAddress()}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(heights)
+	suite.Require().Equal(int64(0), last)
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
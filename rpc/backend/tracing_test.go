@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/log"
@@ -10,11 +11,13 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/haqq-network/haqq/crypto/ethsecp256k1"
 	"github.com/haqq-network/haqq/indexer"
 	"github.com/haqq-network/haqq/rpc/backend/mocks"
+	rpctypes "github.com/haqq-network/haqq/rpc/types"
 	evmtypes "github.com/haqq-network/haqq/x/evm/types"
 )
 
@@ -263,3 +266,64 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	callArgs := evmtypes.TransactionArgs{From: &from, To: &from}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(1)
+	overrides := rpctypes.StateOverride{from: {Nonce: &nonce}}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace call with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, 1, argsBz, overridesBz)
+			},
+			&rpctypes.TraceCallConfig{StateOverrides: &overrides},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, 1, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
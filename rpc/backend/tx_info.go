@@ -153,6 +153,66 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, nil
 	}
 
+	blockRes, err := b.rpcClient.BlockResults(b.ctx, &res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	return b.formatTxReceipt(hash, res, resBlock, blockRes)
+}
+
+// GetBlockReceipts returns the receipts of all the Ethereum transactions included
+// in the block identified by number or hash.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	b.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err)
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		hash := common.HexToHash(msg.Hash)
+		res, err := b.GetTxByEthHash(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get tx %s from indexer", msg.Hash)
+		}
+
+		receipt, err := b.formatTxReceipt(hash, res, resBlock, blockRes)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt builds the receipt of the indexed Ethereum transaction included
+// in the given block.
+func (b *Backend) formatTxReceipt(
+	hash common.Hash,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (map[string]interface{}, error) {
+	hexTx := hash.Hex()
+
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
 	if err != nil {
 		b.logger.Debug("decoding failed", "error", err.Error())
@@ -168,12 +228,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
-
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) //nolint: gosec // G115 -- already checked for int overflow
 	}
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/haqq-network/haqq/rpc/types"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions contained in the mempool, grouped
+// by sender and nonce. Transactions are split into pending (executable with the
+// sender's current nonce) and queued (separated from the current nonce by a gap).
+func (b *Backend) TxPoolContent() (
+	pending map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySender := make(map[common.Address][]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			bySender[rpcTx.From] = append(bySender[rpcTx.From], rpcTx)
+		}
+	}
+
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+
+	for sender, senderTxs := range bySender {
+		res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{Address: sender.Hex()})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce < senderTxs[j].Nonce
+		})
+
+		// transactions are pending as long as their nonces follow the account
+		// nonce without gaps, everything after the first gap is queued
+		next := res.Nonce
+		for _, tx := range senderTxs {
+			nonce := uint64(tx.Nonce)
+			switch {
+			case nonce < res.Nonce:
+				// already committed, waiting to be evicted on recheck
+				continue
+			case nonce == next:
+				if pending[sender] == nil {
+					pending[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				pending[sender][nonce] = tx
+				next++
+			case nonce > next:
+				if queued[sender] == nil {
+					queued[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				if _, found := queued[sender][nonce]; !found {
+					queued[sender][nonce] = tx
+				}
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
@@ -0,0 +1,103 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/rpc/backend/mocks"
+	rpctypes "github.com/haqq-network/haqq/rpc/types"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	// unsigned transactions resolve to the zero address as sender
+	sender := common.Address{}
+
+	buildTx := func(nonce uint64) (*rpctypes.RPCTransaction, []byte) {
+		msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			Nonce:    nonce,
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+		msgEthereumTx.From = suite.from.Hex()
+
+		txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(txBuilder.SetMsgs(msgEthereumTx))
+		bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+
+		rpcTx, err := rpctypes.NewTransactionFromMsg(msgEthereumTx, common.Hash{}, 0, 0, nil, suite.backend.chainID)
+		suite.Require().NoError(err)
+		return rpcTx, bz
+	}
+
+	tx0, bz0 := buildTx(0)
+	tx1, bz1 := buildTx(1)
+	tx3, bz3 := buildTx(3)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   map[common.Address]map[uint64]*rpctypes.RPCTransaction
+		expQueued    map[common.Address]map[uint64]*rpctypes.RPCTransaction
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions returns error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, pendingTxsLimitPtr())
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, pendingTxsLimitPtr(), nil)
+			},
+			map[common.Address]map[uint64]*rpctypes.RPCTransaction{},
+			map[common.Address]map[uint64]*rpctypes.RPCTransaction{},
+			true,
+		},
+		{
+			"pass - transactions split into pending and queued",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, pendingTxsLimitPtr(), types.Txs{bz3, bz1, bz0})
+				RegisterAccount(queryClient, sender, 1)
+			},
+			map[common.Address]map[uint64]*rpctypes.RPCTransaction{
+				sender: {0: tx0, 1: tx1},
+			},
+			map[common.Address]map[uint64]*rpctypes.RPCTransaction{
+				sender: {3: tx3},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPending, pending)
+				suite.Require().Equal(tc.expQueued, queued)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
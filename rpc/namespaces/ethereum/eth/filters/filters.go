@@ -139,7 +139,16 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// the blocks served by the indexer log index are narrowed down to the ones holding matching logs,
+	// the remaining ones are scanned. Both count towards the block limit.
+	heights, indexedTo, err := f.backend.GetLogHeights(
+		f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64(), f.criteria.Addresses, f.criteria.Topics,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch log heights from the indexer: %w", err)
+	}
+
+	if f.criteria.ToBlock.Int64()-(indexedTo+1) > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
@@ -150,10 +159,18 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
 	}
 
-	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
+	for len(heights) > 0 && heights[len(heights)-1] > to {
+		heights = heights[:len(heights)-1]
+	}
+	for height := indexedTo + 1; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	if int64(len(heights)) > blockLimit {
+		return nil, fmt.Errorf("maximum number of candidate blocks: %d", blockLimit)
+	}
 
-	for height := from; height <= to; height++ {
+	for _, height := range heights {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
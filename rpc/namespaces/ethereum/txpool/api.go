@@ -1,22 +1,28 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/haqq-network/haqq/rpc/backend"
 	"github.com/haqq-network/haqq/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The content is read from the CometBFT mempool, so only transactions that passed CheckTx are reported.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -27,24 +33,115 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatTxs(txs)
+	}
+	return content, nil
+}
+
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent from the given address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	content := map[string]map[string]*types.RPCTransaction{
+		"pending": make(map[string]*types.RPCTransaction),
+		"queued":  make(map[string]*types.RPCTransaction),
+	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	if txs, ok := pending[address]; ok {
+		content["pending"] = formatTxs(txs)
+	}
+	if txs, ok := queued[address]; ok {
+		content["queued"] = formatTxs(txs)
+	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	var numPending, numQueued int
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, txs := range pending {
+		numPending += len(txs)
+	}
+	for _, txs := range queued {
+		numQueued += len(txs)
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(numPending), //nolint: gosec // G115 -- length is never negative
+		"queued":  hexutil.Uint(numQueued),  //nolint: gosec // G115 -- length is never negative
+	}, nil
+}
+
+// formatTxs keys the given transactions by their decimal nonce.
+func formatTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	dump := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		dump[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return dump
+}
+
+// inspectTxs returns a human-readable summary of the given transactions keyed
+// by their decimal nonce.
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	dump := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		if tx.To != nil {
+			dump[fmt.Sprintf("%d", nonce)] = fmt.Sprintf(
+				"%s: %v wei + %v gas × %v wei",
+				tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+			)
+			continue
+		}
+		dump[fmt.Sprintf("%d", nonce)] = fmt.Sprintf(
+			"contract creation: %v wei + %v gas × %v wei",
+			tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+		)
 	}
+	return dump
 }
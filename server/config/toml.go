@@ -74,6 +74,7 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
+# It also indexes the log addresses and topics, so that eth_getLogs only fetches the matching blocks.
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
@@ -22,6 +22,8 @@ func NewIndexTxCmd() *cobra.Command {
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
+		The indexed blocks are tracked by the log index, so both directions also backfill the log index of the blocks indexed before it was introduced.
+
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
 		`,
@@ -81,7 +83,7 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := idxer.FirstLogIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -95,7 +97,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := idxer.LastLogIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -16,4 +16,7 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetLogHeights returns the heights within the range of the blocks holding logs that may match
+	// the addresses and topics, and the last height served by the log index.
+	GetLogHeights(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, int64, error)
 }
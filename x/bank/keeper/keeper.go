@@ -57,3 +57,8 @@ func (k Keeper) BurnCoins(ctx context.Context, moduleName string, amounts sdk.Co
 
 	return k.BaseKeeper.BurnCoins(ctx, moduleName, amounts)
 }
+
+// DeleteDenomMetaData removes the metadata of the given denomination.
+func (k Keeper) DeleteDenomMetaData(ctx context.Context, denom string) error {
+	return k.BaseViewKeeper.DenomMetadata.Remove(ctx, denom)
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/haqq-network/haqq/x/coinomics/types"
 )
 
+// FlagEpochIdentifier defines the epoch identifier flag of the mint history query.
+const FlagEpochIdentifier = "epoch-identifier"
+
 // GetQueryCmd returns the cli query commands for the coinomics module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetMaxSupply(),
 		GetRewardCoefficient(),
+		GetMintHistory(),
+		GetProjectedInflation(),
 	)
 
 	return cmd
@@ -111,3 +117,81 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+func GetMintHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-history [start-epoch] [end-epoch]",
+		Short: "Query the coins minted over the settled epochs",
+		Long:  "Query the coins minted over the settled epochs within the range. Without the end epoch all the epochs from the start epoch are returned.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startEpoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start epoch: %w", err)
+			}
+
+			var endEpoch int64
+			if len(args) == 2 {
+				endEpoch, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid end epoch: %w", err)
+				}
+			}
+
+			epochIdentifier, err := cmd.Flags().GetString(FlagEpochIdentifier)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMintHistoryRequest{
+				EpochIdentifier: epochIdentifier,
+				StartEpoch:      startEpoch,
+				EndEpoch:        endEpoch,
+			}
+			res, err := queryClient.MintHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagEpochIdentifier, "", "epoch identifier, defaults to the current mint epoch identifier")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetProjectedInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-inflation",
+		Short: "Query the projected annual provisions and inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProjectedInflationRequest{}
+			res, err := queryClient.ProjectedInflation(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,6 +28,14 @@ func InitGenesis(
 	// Set genesis state
 	maxSupply := data.MaxSupply
 	k.SetMaxSupply(ctx, maxSupply)
+
+	if !data.AccruedMint.IsNil() {
+		k.SetAccruedMint(ctx, data.AccruedMint)
+	}
+
+	for _, record := range data.MintHistory {
+		k.SetMintRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -36,5 +44,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:      k.GetParams(ctx),
 		PrevBlockTs: k.GetPrevBlockTS(ctx),
 		MaxSupply:   k.GetMaxSupply(ctx),
+		AccruedMint: k.GetAccruedMint(ctx),
+		MintHistory: k.GetAllMintRecords(ctx),
 	}
 }
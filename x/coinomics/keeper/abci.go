@@ -19,9 +19,15 @@ func (k Keeper) EndBlocker(ctx sdk.Context) error {
 		return nil
 	}
 
-	if err := k.MintAndAllocate(ctx); err != nil {
+	// NOTE: mint on a cached context so a failed allocation does not leave
+	// minted coins in the module account
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.MintAndAllocate(cacheCtx); err != nil {
 		ctx.Logger().Error("Failed MintAndAllocateInflation: ", err.Error())
+		return nil
 	}
 
+	writeCache()
+
 	return nil
 }
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/haqq-network/haqq/x/coinomics/types"
+)
+
+func (suite *KeeperTestSuite) TestMintAllocation() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.CoinomicsKeeper
+
+	account := suite.keyring.GetAccAddr(0)
+	owner := suite.keyring.GetAccAddr(1)
+
+	params := k.GetParams(ctx)
+	params.Allocations = []types.MintAllocation{
+		{Type: types.RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Type: types.RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Weight: math.LegacyNewDecWithPrec(2, 1)},
+		{Type: types.RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: account.String(), Weight: math.LegacyNewDecWithPrec(2, 1)},
+		{Type: types.RecipientType_RECIPIENT_TYPE_UCDAO, Recipient: owner.String(), Weight: math.LegacyNewDecWithPrec(1, 1)},
+	}
+	k.SetParams(ctx, params)
+
+	feeCollector := suite.network.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBefore := suite.network.App.BankKeeper.GetBalance(ctx, feeCollector, denomMint)
+	accountBefore := suite.network.App.BankKeeper.GetBalance(ctx, account, denomMint)
+	ownerBefore := suite.network.App.BankKeeper.GetBalance(ctx, owner, denomMint)
+	daoBefore := suite.network.App.DaoKeeper.GetBalance(ctx, owner, denomMint)
+	poolBefore, err := suite.network.App.DistrKeeper.FeePool.Get(ctx)
+	suite.Require().NoError(err)
+	supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, denomMint)
+
+	// mint a second worth of rewards
+	k.SetPrevBlockTS(ctx, math.NewInt(ctx.BlockTime().UnixMilli()-1000))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(k.MintAndAllocate(ctx))
+
+	minted := suite.network.App.BankKeeper.GetSupply(ctx, denomMint).Amount.Sub(supplyBefore.Amount)
+	suite.Require().True(minted.IsPositive())
+	amounts := types.MintAllocations(params.Allocations).Split(minted)
+
+	feeCollectorAfter := suite.network.App.BankKeeper.GetBalance(ctx, feeCollector, denomMint)
+	suite.Require().Equal(amounts[0].String(), feeCollectorAfter.Amount.Sub(feeCollectorBefore.Amount).String())
+
+	poolAfter, err := suite.network.App.DistrKeeper.FeePool.Get(ctx)
+	suite.Require().NoError(err)
+	poolDiff := poolAfter.CommunityPool.AmountOf(denomMint).Sub(poolBefore.CommunityPool.AmountOf(denomMint))
+	suite.Require().Equal(math.LegacyNewDecFromInt(amounts[1]).String(), poolDiff.String())
+
+	accountAfter := suite.network.App.BankKeeper.GetBalance(ctx, account, denomMint)
+	suite.Require().Equal(amounts[2].String(), accountAfter.Amount.Sub(accountBefore.Amount).String())
+
+	// the UC DAO share is escrowed on behalf of the owner
+	suite.Require().Equal(ownerBefore, suite.network.App.BankKeeper.GetBalance(ctx, owner, denomMint))
+	daoAfter := suite.network.App.DaoKeeper.GetBalance(ctx, owner, denomMint)
+	suite.Require().Equal(amounts[3].String(), daoAfter.Amount.Sub(daoBefore.Amount).String())
+
+	allocationEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintAllocation {
+			allocationEvents++
+		}
+	}
+	suite.Require().Equal(len(params.Allocations), allocationEvents)
+}
+
+func (suite *KeeperTestSuite) TestMintAllocationMissingModule() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.CoinomicsKeeper
+
+	params := k.GetParams(ctx)
+	params.Allocations = []types.MintAllocation{
+		{Type: types.RecipientType_RECIPIENT_TYPE_MODULE, Recipient: "unknown", Weight: math.LegacyOneDec()},
+	}
+	k.SetParams(ctx, params)
+
+	supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, denomMint)
+	prevBlockTS := k.GetPrevBlockTS(ctx)
+
+	// the failed allocation does not mint anything
+	suite.Require().NoError(suite.network.NextBlock())
+	ctx = suite.network.GetContext()
+	suite.Require().Equal(supplyBefore, suite.network.App.BankKeeper.GetSupply(ctx, denomMint))
+	suite.Require().Equal(prevBlockTS, k.GetPrevBlockTS(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMintAllocationBlockedRecipient() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	k := suite.network.App.CoinomicsKeeper
+
+	// module accounts are blocked from receiving funds
+	blocked := suite.network.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	allocations := []types.MintAllocation{
+		{Type: types.RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: blocked.String(), Weight: math.LegacyOneDec()},
+	}
+
+	// the governance params update rejects the blocked recipient
+	subspace, found := suite.network.App.ParamsKeeper.GetSubspace(types.ModuleName)
+	suite.Require().True(found)
+	suite.Require().ErrorContains(subspace.Validate(ctx, types.ParamStoreKeyAllocations, allocations), "not allowed to receive funds")
+
+	// the allocation rejects a blocked recipient set without validation
+	params := k.GetParams(ctx)
+	params.Allocations = allocations
+	k.SetParams(ctx, params)
+
+	supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, denomMint)
+	k.SetPrevBlockTS(ctx, math.NewInt(ctx.BlockTime().UnixMilli()-1000))
+	suite.Require().ErrorContains(k.MintAndAllocate(ctx), "not allowed to receive funds")
+	suite.Require().Equal(supplyBefore, suite.network.App.BankKeeper.GetSupply(ctx, denomMint))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/haqq-network/haqq/x/coinomics/types"
 )
@@ -39,3 +41,56 @@ func (k Keeper) Params(
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// MintHistory returns the mint records settled within the requested epoch range.
+func (k Keeper) MintHistory(
+	c context.Context,
+	req *types.QueryMintHistoryRequest,
+) (*types.QueryMintHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartEpoch < 0 || req.EndEpoch < 0 {
+		return nil, status.Error(codes.InvalidArgument, "epoch numbers cannot be negative")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "end epoch %d is lower than start epoch %d", req.EndEpoch, req.StartEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochIdentifier := req.EpochIdentifier
+	if epochIdentifier == "" {
+		epochIdentifier = k.GetParams(ctx).MintEpochIdentifier
+	}
+	if epochIdentifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "epoch identifier is required when minting is settled every block")
+	}
+
+	records := k.GetMintHistory(ctx, epochIdentifier, req.StartEpoch, req.EndEpoch)
+
+	return &types.QueryMintHistoryResponse{Records: records}, nil
+}
+
+// ProjectedInflation returns the annual provisions and inflation rate
+// projected from the current bonded tokens and reward coefficient.
+func (k Keeper) ProjectedInflation(
+	c context.Context,
+	_ *types.QueryProjectedInflationRequest,
+) (*types.QueryProjectedInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	annualProvisions, inflationRate, err := k.ProjectInflation(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	pendingMint := sdk.NewCoin(params.MintDenom, k.GetAccruedMint(ctx).TruncateInt())
+
+	return &types.QueryProjectedInflationResponse{
+		AnnualProvisions: annualProvisions,
+		InflationRate:    inflationRate,
+		PendingMint:      pendingMint,
+	}, nil
+}
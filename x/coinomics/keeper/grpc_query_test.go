@@ -118,3 +118,167 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	// due to mainnet chain id in tests setup
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestMintHistory() {
+	var (
+		ctx         sdk.Context
+		req         *types.QueryMintHistoryRequest
+		expResponse *types.QueryMintHistoryResponse
+	)
+
+	newRecord := func(identifier string, epochNumber int64) types.MintRecord {
+		return types.MintRecord{
+			EpochIdentifier:   identifier,
+			EpochNumber:       epochNumber,
+			Height:            epochNumber,
+			Minted:            sdk.NewCoin(denomMint, math.NewInt(epochNumber)),
+			BondedBase:        math.NewInt(1000),
+			RewardCoefficient: math.LegacyNewDecWithPrec(78, 1),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - per block minting without identifier",
+			func() {
+				req = &types.QueryMintHistoryRequest{}
+			},
+			false,
+		},
+		{
+			"fail - end epoch lower than start epoch",
+			func() {
+				req = &types.QueryMintHistoryRequest{EpochIdentifier: "day", StartEpoch: 3, EndEpoch: 2}
+			},
+			false,
+		},
+		{
+			"fail - negative start epoch",
+			func() {
+				req = &types.QueryMintHistoryRequest{EpochIdentifier: "day", StartEpoch: -1}
+			},
+			false,
+		},
+		{
+			"no records",
+			func() {
+				req = &types.QueryMintHistoryRequest{EpochIdentifier: "day"}
+				expResponse = &types.QueryMintHistoryResponse{Records: []types.MintRecord{}}
+			},
+			true,
+		},
+		{
+			"records of the current mint epoch identifier",
+			func() {
+				params := suite.network.App.CoinomicsKeeper.GetParams(ctx)
+				params.MintEpochIdentifier = "day"
+				suite.network.App.CoinomicsKeeper.SetParams(ctx, params)
+
+				for i := int64(1); i <= 3; i++ {
+					suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("day", i))
+				}
+				suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("week", 2))
+
+				req = &types.QueryMintHistoryRequest{StartEpoch: 2, EndEpoch: 3}
+				expResponse = &types.QueryMintHistoryResponse{Records: []types.MintRecord{newRecord("day", 2), newRecord("day", 3)}}
+			},
+			true,
+		},
+		{
+			"records of the requested epoch identifier",
+			func() {
+				suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("day", 2))
+				suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("week", 2))
+
+				req = &types.QueryMintHistoryRequest{EpochIdentifier: "week"}
+				expResponse = &types.QueryMintHistoryResponse{Records: []types.MintRecord{newRecord("week", 2)}}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			res, err := suite.network.App.CoinomicsKeeper.MintHistory(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProjectedInflation() {
+	var (
+		ctx                 sdk.Context
+		expAnnualProvisions math.Int
+		expPendingMint      math.Int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"default params",
+			func() {
+				totalBonded, err := suite.network.App.StakingKeeper.TotalBondedTokens(ctx)
+				suite.Require().NoError(err)
+
+				// 7.8% of the bonded tokens
+				expAnnualProvisions = totalBonded.MulRaw(78).QuoRaw(1000)
+				expPendingMint = math.ZeroInt()
+			},
+		},
+		{
+			"coinomics disabled",
+			func() {
+				params := suite.network.App.CoinomicsKeeper.GetParams(ctx)
+				params.EnableCoinomics = false
+				suite.network.App.CoinomicsKeeper.SetParams(ctx, params)
+
+				expAnnualProvisions = math.ZeroInt()
+				expPendingMint = math.ZeroInt()
+			},
+		},
+		{
+			"capped by max supply including the pending mint",
+			func() {
+				supply := suite.network.App.BankKeeper.GetSupply(ctx, denomMint)
+				suite.network.App.CoinomicsKeeper.SetMaxSupply(ctx, supply.AddAmount(math.NewInt(1000)))
+				suite.network.App.CoinomicsKeeper.SetAccruedMint(ctx, math.LegacyMustNewDecFromStr("100.5"))
+
+				expAnnualProvisions = math.NewInt(900)
+				expPendingMint = math.NewInt(100)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			res, err := suite.network.GetCoinomicsClient().ProjectedInflation(ctx, &types.QueryProjectedInflationRequest{})
+			suite.Require().NoError(err)
+
+			supply := suite.network.App.BankKeeper.GetSupply(ctx, denomMint)
+			expInflationRate := math.LegacyNewDecFromInt(expAnnualProvisions).QuoInt(supply.Amount)
+
+			suite.Require().Equal(sdk.NewCoin(denomMint, expAnnualProvisions), res.AnnualProvisions)
+			suite.Require().Equal(expInflationRate.String(), res.InflationRate.String())
+			suite.Require().Equal(sdk.NewCoin(denomMint, expPendingMint), res.PendingMint)
+		})
+	}
+}
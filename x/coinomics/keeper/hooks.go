@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the coinomics keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for the epoch hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd settles the mint accrued over the epoch if the ended epoch
+// matches the mint epoch identifier.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.k.GetParams(ctx)
+	if params.MintEpochIdentifier != epochIdentifier {
+		return
+	}
+
+	// NOTE: the settlement is applied on a cached context so a failure does
+	// not leave the accrued amount partially settled
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := h.k.SettleEpochMint(cacheCtx, epochIdentifier, epochNumber); err != nil {
+		h.k.Logger(ctx).Error("failed to settle epoch mint", "epoch", epochIdentifier, "number", epochNumber, "error", err.Error())
+		return
+	}
+
+	writeCache()
+}
+
+// BeforeEpochStart is a no-op for the coinomics module.
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestEpochMintSettlement() {
+	suite.SetupTest()
+
+	params := suite.network.App.CoinomicsKeeper.GetParams(suite.network.GetContext())
+	params.MintEpochIdentifier = epochstypes.DayEpochID
+	suite.network.App.CoinomicsKeeper.SetParams(suite.network.GetContext(), params)
+
+	supplyBefore := suite.network.App.BankKeeper.GetSupply(suite.network.GetContext(), denomMint)
+
+	// mint accrues without changing the supply
+	suite.Require().NoError(suite.network.NextNBlocks(10))
+
+	ctx := suite.network.GetContext()
+	accruedMint := suite.network.App.CoinomicsKeeper.GetAccruedMint(ctx)
+	suite.Require().True(accruedMint.IsPositive())
+	suite.Require().Equal(supplyBefore, suite.network.App.BankKeeper.GetSupply(ctx, denomMint))
+
+	// epochs with other identifiers are ignored
+	suite.network.App.CoinomicsKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().Equal(accruedMint, suite.network.App.CoinomicsKeeper.GetAccruedMint(ctx))
+	_, found := suite.network.App.CoinomicsKeeper.GetMintRecord(ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().False(found)
+
+	totalBonded, err := suite.network.App.StakingKeeper.TotalBondedTokens(ctx)
+	suite.Require().NoError(err)
+
+	suite.network.App.CoinomicsKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+
+	expMinted := sdk.NewCoin(denomMint, accruedMint.TruncateInt())
+	suite.Require().Equal(supplyBefore.Add(expMinted), suite.network.App.BankKeeper.GetSupply(ctx, denomMint))
+
+	// the fractional part is carried over to the next epoch
+	remainder := suite.network.App.CoinomicsKeeper.GetAccruedMint(ctx)
+	suite.Require().Equal(accruedMint.Sub(math.LegacyNewDecFromInt(expMinted.Amount)), remainder)
+	suite.Require().True(remainder.LT(math.LegacyOneDec()))
+
+	record, found := suite.network.App.CoinomicsKeeper.GetMintRecord(ctx, epochstypes.DayEpochID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expMinted, record.Minted)
+	suite.Require().Equal(totalBonded, record.BondedBase)
+	suite.Require().Equal(params.RewardCoefficient, record.RewardCoefficient)
+	suite.Require().Equal(ctx.BlockHeight(), record.Height)
+
+	// switching back to per block minting settles the remainder with the block mint
+	params.MintEpochIdentifier = ""
+	suite.network.App.CoinomicsKeeper.SetParams(ctx, params)
+	suite.Require().NoError(suite.network.NextBlock())
+	suite.Require().True(suite.network.App.CoinomicsKeeper.GetAccruedMint(suite.network.GetContext()).IsZero())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,10 +48,11 @@ func (k Keeper) MintAndAllocate(ctx sdk.Context) error {
 
 	bankTotalSupply, _ := math.LegacyNewDecFromStr(k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount.String())
 	maxSupply, _ := math.LegacyNewDecFromStr(k.GetMaxSupply(ctx).Amount.String())
+	accruedMint := k.GetAccruedMint(ctx)
 
-	// Ensure minting does not exceed the maximum supply
-	if bankTotalSupply.Add(blockMint).GT(maxSupply) {
-		blockMint = maxSupply.Sub(bankTotalSupply)
+	// Ensure minting, including the amount accrued and not yet settled, does not exceed the maximum supply
+	if bankTotalSupply.Add(accruedMint).Add(blockMint).GT(maxSupply) {
+		blockMint = maxSupply.Sub(bankTotalSupply).Sub(accruedMint)
 		params.EnableCoinomics = false
 		k.SetParams(ctx, params)
 	}
@@ -64,20 +66,24 @@ func (k Keeper) MintAndAllocate(ctx sdk.Context) error {
 		return nil
 	}
 
-	// Mint and allocate the calculated coin amount
-	totalMintOnBlockCoin := sdk.NewCoin(params.MintDenom, blockMint.RoundInt())
-	if err := k.MintCoins(ctx, totalMintOnBlockCoin); err != nil {
-		return errors.Wrap(err, "failed mint coins")
+	// Accrue the block mint until the end of the epoch if minting is settled per epoch
+	if params.MintEpochIdentifier != "" {
+		k.SetAccruedMint(ctx, accruedMint.Add(blockMint))
+		k.SetPrevBlockTS(ctx, currentBlockTS.RoundInt())
+
+		return nil
+	}
+
+	// Settle the amount accrued before switching back to per block minting together with the block mint
+	if accruedMint.IsPositive() {
+		blockMint = blockMint.Add(accruedMint)
+		k.SetAccruedMint(ctx, math.LegacyZeroDec())
 	}
 
-	// Allocate remaining coinomics module balance to distribution
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		sdk.NewCoins(totalMintOnBlockCoin),
-	); err != nil {
-		return errors.Wrap(err, "failed send coins from coinomics to distribution")
+	// Mint and allocate the calculated coin amount
+	totalMintOnBlockCoin := sdk.NewCoin(params.MintDenom, blockMint.RoundInt())
+	if err := k.allocate(ctx, totalMintOnBlockCoin); err != nil {
+		return err
 	}
 
 	// Update the previous block timestamp for the next cycle.
@@ -86,6 +92,110 @@ func (k Keeper) MintAndAllocate(ctx sdk.Context) error {
 	return nil
 }
 
+// SettleEpochMint mints and allocates the amount accrued over the ended epoch
+// and stores its mint record. The fractional part of the accrued amount is
+// carried over to the next epoch.
+func (k Keeper) SettleEpochMint(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	params := k.GetParams(ctx)
+	accruedMint := k.GetAccruedMint(ctx)
+
+	minted := sdk.NewCoin(params.MintDenom, accruedMint.TruncateInt())
+	if err := k.allocate(ctx, minted); err != nil {
+		return err
+	}
+
+	k.SetAccruedMint(ctx, accruedMint.Sub(math.LegacyNewDecFromInt(minted.Amount)))
+
+	totalBondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get total bonded tokens")
+	}
+
+	k.SetMintRecord(ctx, types.MintRecord{
+		EpochIdentifier:   epochIdentifier,
+		EpochNumber:       epochNumber,
+		Height:            ctx.BlockHeight(),
+		Minted:            minted,
+		BondedBase:        totalBondedTokens,
+		RewardCoefficient: params.RewardCoefficient,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, minted.String()),
+		),
+	)
+
+	return nil
+}
+
+// allocate mints the given coin and splits it between the recipients of the
+// allocation table.
+func (k Keeper) allocate(ctx sdk.Context, coin sdk.Coin) error {
+	allocations := types.MintAllocations(k.GetParams(ctx).Allocations)
+
+	// Ensure the module recipients exist and no recipient is blocked before minting
+	for _, allocation := range allocations {
+		if allocation.Type == types.RecipientType_RECIPIENT_TYPE_MODULE && k.accountKeeper.GetModuleAddress(allocation.Recipient) == nil {
+			return fmt.Errorf("module account %s does not exist", allocation.Recipient)
+		}
+	}
+	if err := allocations.ValidateRecipients(k.bankKeeper.BlockedAddr); err != nil {
+		return err
+	}
+
+	if err := k.MintCoins(ctx, coin); err != nil {
+		return errors.Wrap(err, "failed mint coins")
+	}
+
+	for i, amount := range allocations.Split(coin.Amount) {
+		if !amount.IsPositive() {
+			continue
+		}
+
+		allocation := allocations[i]
+		coins := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+		if err := k.allocateTo(ctx, allocation, coins); err != nil {
+			return errors.Wrapf(err, "failed to allocate %s to %s %s", coins, allocation.Type, allocation.Recipient)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintAllocation,
+				sdk.NewAttribute(types.AttributeRecipientType, allocation.Type.String()),
+				sdk.NewAttribute(types.AttributeRecipient, allocation.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// allocateTo sends the coins from the coinomics module to the allocation recipient.
+func (k Keeper) allocateTo(ctx sdk.Context, allocation types.MintAllocation, coins sdk.Coins) error {
+	switch allocation.Type {
+	case types.RecipientType_RECIPIENT_TYPE_MODULE:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, allocation.Recipient, coins)
+	case types.RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	case types.RecipientType_RECIPIENT_TYPE_UCDAO:
+		owner := sdk.MustAccAddressFromBech32(allocation.Recipient)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, coins); err != nil {
+			return err
+		}
+
+		return k.ucdaoKeeper.Fund(ctx, coins, owner)
+	case types.RecipientType_RECIPIENT_TYPE_ACCOUNT:
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(allocation.Recipient), coins)
+	default:
+		return fmt.Errorf("invalid recipient type: %s", allocation.Type)
+	}
+}
+
 func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	coins := sdk.NewCoins(coin)
 
@@ -97,3 +207,36 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	// Perform the minting action
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
+
+// ProjectInflation returns the coins projected to be minted over a year with
+// the current total bonded tokens and reward coefficient, capped by the max
+// supply, and its ratio to the current total supply.
+func (k Keeper) ProjectInflation(ctx sdk.Context) (sdk.Coin, math.LegacyDec, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableCoinomics {
+		return sdk.NewCoin(params.MintDenom, math.ZeroInt()), math.LegacyZeroDec(), nil
+	}
+
+	totalBondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return sdk.Coin{}, math.LegacyDec{}, errors.Wrap(err, "failed to get total bonded tokens")
+	}
+
+	// totalBonded * rewardCoefficient
+	rewardCoefficient := params.RewardCoefficient.Quo(math.LegacyNewDec(100))
+	annualProvisions := math.LegacyNewDecFromInt(totalBondedTokens).Mul(rewardCoefficient).TruncateInt()
+
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	remainingSupply := k.GetMaxSupply(ctx).Amount.Sub(totalSupply).Sub(k.GetAccruedMint(ctx).TruncateInt())
+	if remainingSupply.IsNegative() {
+		remainingSupply = math.ZeroInt()
+	}
+	annualProvisions = math.MinInt(annualProvisions, remainingSupply)
+
+	inflationRate := math.LegacyZeroDec()
+	if totalSupply.IsPositive() {
+		inflationRate = math.LegacyNewDecFromInt(annualProvisions).QuoInt(totalSupply)
+	}
+
+	return sdk.NewCoin(params.MintDenom, annualProvisions), inflationRate, nil
+}
@@ -17,11 +17,11 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramstore paramtypes.Subspace
 
-	accountKeeper    types.AccountKeeper
-	bankKeeper       types.BankKeeper
-	distrKeeper      types.DistrKeeper
-	stakingKeeper    types.StakingKeeper
-	feeCollectorName string
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	stakingKeeper types.StakingKeeper
+	ucdaoKeeper   types.UcdaoKeeper
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -33,7 +33,7 @@ func NewKeeper(
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	sk types.StakingKeeper,
-	feeCollectorName string,
+	uk types.UcdaoKeeper,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -42,18 +42,18 @@ func NewKeeper(
 
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
+		ps = ps.WithKeyTable(types.NewParamKeyTable(bk.BlockedAddr))
 	}
 
 	return Keeper{
-		storeKey:         storeKey,
-		cdc:              cdc,
-		paramstore:       ps,
-		accountKeeper:    ak,
-		bankKeeper:       bk,
-		distrKeeper:      dk,
-		stakingKeeper:    sk,
-		feeCollectorName: feeCollectorName,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramstore:    ps,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+		stakingKeeper: sk,
+		ucdaoKeeper:   uk,
 	}
 }
 
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/haqq-network/haqq/x/coinomics/types"
@@ -62,3 +65,105 @@ func (k Keeper) SetMaxSupply(ctx sdk.Context, maxSupply sdk.Coin) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyPrefixMaxSupply, binaryMaxSupply)
 }
+
+// GetRemainingMintableSupply returns the amount that can still be minted
+// before the max supply is reached. The amount accrued and not yet settled is
+// treated as already minted.
+func (k Keeper) GetRemainingMintableSupply(ctx sdk.Context) sdk.Coin {
+	maxSupply := k.GetMaxSupply(ctx)
+	totalSupply := k.TokenSupply(ctx, maxSupply.Denom)
+	accruedMint := k.GetAccruedMint(ctx).TruncateInt()
+
+	remaining := maxSupply.Amount.Sub(totalSupply).Sub(accruedMint)
+	if remaining.IsNegative() {
+		remaining = sdkmath.ZeroInt()
+	}
+
+	return sdk.NewCoin(maxSupply.Denom, remaining)
+}
+
+func (k Keeper) GetAccruedMint(ctx sdk.Context) sdkmath.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixAccruedMint)
+	if len(bz) == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	var accruedMint sdkmath.LegacyDec
+	err := accruedMint.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal accruedMint value: %w", err))
+	}
+
+	return accruedMint
+}
+
+func (k Keeper) SetAccruedMint(ctx sdk.Context, accruedMint sdkmath.LegacyDec) {
+	binaryAccruedMint, err := accruedMint.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value: %w", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixAccruedMint, binaryAccruedMint)
+}
+
+// GetMintRecord returns the mint record settled on the given epoch.
+func (k Keeper) GetMintRecord(ctx sdk.Context, epochIdentifier string, epochNumber int64) (types.MintRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintRecordKey(epochIdentifier, epochNumber))
+	if len(bz) == 0 {
+		return types.MintRecord{}, false
+	}
+
+	var record types.MintRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetMintRecord stores the mint record of a settled epoch.
+func (k Keeper) SetMintRecord(ctx sdk.Context, record types.MintRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintRecordKey(record.EpochIdentifier, record.EpochNumber), k.cdc.MustMarshal(&record))
+}
+
+// GetMintHistory returns the mint records settled on the given epoch
+// identifier from startEpoch to endEpoch inclusive. An endEpoch of zero
+// returns all the records from startEpoch onwards.
+func (k Keeper) GetMintHistory(ctx sdk.Context, epochIdentifier string, startEpoch, endEpoch int64) []types.MintRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintHistoryPrefix(epochIdentifier))
+
+	start := sdk.Uint64ToBigEndian(uint64(startEpoch)) //nolint: gosec // G115 -- epoch number is never negative
+	var end []byte
+	if endEpoch > 0 && endEpoch < math.MaxInt64 {
+		end = sdk.Uint64ToBigEndian(uint64(endEpoch + 1)) //nolint: gosec // G115 -- epoch number is never negative
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	records := []types.MintRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllMintRecords returns the mint records settled on every epoch identifier.
+func (k Keeper) GetAllMintRecords(ctx sdk.Context) []types.MintRecord {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixMintHistory)
+	defer iterator.Close()
+
+	records := []types.MintRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/haqq-network/haqq/x/coinomics/types"
 )
 
 func (suite *KeeperTestSuite) TestSetGetPrevBlockTs() {
@@ -89,3 +91,96 @@ func (suite *KeeperTestSuite) TestSetGetMaxSupply() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetGetAccruedMint() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	accruedMint := suite.network.App.CoinomicsKeeper.GetAccruedMint(ctx)
+	suite.Require().True(accruedMint.IsZero())
+
+	expAccruedMint := math.LegacyMustNewDecFromStr("1337.5")
+	suite.network.App.CoinomicsKeeper.SetAccruedMint(ctx, expAccruedMint)
+
+	accruedMint = suite.network.App.CoinomicsKeeper.GetAccruedMint(ctx)
+	suite.Require().Equal(expAccruedMint.String(), accruedMint.String())
+}
+
+func (suite *KeeperTestSuite) TestGetRemainingMintableSupply() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.CoinomicsKeeper
+
+	totalSupply := keeper.TokenSupply(ctx, denomMint)
+	maxSupply := sdk.NewCoin(denomMint, totalSupply.Add(math.NewInt(1000)))
+	keeper.SetMaxSupply(ctx, maxSupply)
+
+	remaining := keeper.GetRemainingMintableSupply(ctx)
+	suite.Require().Equal(sdk.NewCoin(denomMint, math.NewInt(1000)).String(), remaining.String())
+
+	// the accrued amount is treated as already minted
+	keeper.SetAccruedMint(ctx, math.LegacyMustNewDecFromStr("400.7"))
+	remaining = keeper.GetRemainingMintableSupply(ctx)
+	suite.Require().Equal(sdk.NewCoin(denomMint, math.NewInt(600)).String(), remaining.String())
+
+	// the remaining supply never goes negative
+	keeper.SetMaxSupply(ctx, sdk.NewCoin(denomMint, totalSupply.Sub(math.NewInt(1))))
+	remaining = keeper.GetRemainingMintableSupply(ctx)
+	suite.Require().True(remaining.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSetGetMintHistory() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	newRecord := func(identifier string, epochNumber int64) types.MintRecord {
+		return types.MintRecord{
+			EpochIdentifier:   identifier,
+			EpochNumber:       epochNumber,
+			Height:            epochNumber * 10,
+			Minted:            sdk.NewCoin(denomMint, math.NewInt(epochNumber*100)),
+			BondedBase:        math.NewInt(1000),
+			RewardCoefficient: math.LegacyNewDecWithPrec(78, 1),
+		}
+	}
+
+	for i := int64(1); i <= 5; i++ {
+		suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("day", i))
+	}
+	suite.network.App.CoinomicsKeeper.SetMintRecord(ctx, newRecord("week", 1))
+
+	record, found := suite.network.App.CoinomicsKeeper.GetMintRecord(ctx, "day", 3)
+	suite.Require().True(found)
+	suite.Require().Equal(newRecord("day", 3), record)
+
+	_, found = suite.network.App.CoinomicsKeeper.GetMintRecord(ctx, "day", 6)
+	suite.Require().False(found)
+
+	testCases := []struct {
+		name        string
+		identifier  string
+		start       int64
+		end         int64
+		expEpochNrs []int64
+	}{
+		{"full range", "day", 0, 0, []int64{1, 2, 3, 4, 5}},
+		{"bounded range", "day", 2, 4, []int64{2, 3, 4}},
+		{"open end", "day", 4, 0, []int64{4, 5}},
+		{"single epoch", "day", 3, 3, []int64{3}},
+		{"out of range", "day", 6, 10, []int64{}},
+		{"other identifier", "week", 0, 0, []int64{1}},
+		{"unknown identifier", "month", 0, 0, []int64{}},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			records := suite.network.App.CoinomicsKeeper.GetMintHistory(ctx, tc.identifier, tc.start, tc.end)
+			suite.Require().Len(records, len(tc.expEpochNrs))
+			for i, record := range records {
+				suite.Require().Equal(tc.identifier, record.EpochIdentifier)
+				suite.Require().Equal(tc.expEpochNrs[i], record.EpochNumber)
+			}
+		})
+	}
+
+	suite.Require().Len(suite.network.App.CoinomicsKeeper.GetAllMintRecords(ctx), 6)
+}
@@ -0,0 +1,20 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/haqq-network/haqq/x/coinomics/types"
+)
+
+// MigrateStore migrates the x/coinomics module state from the consensus version 1 to
+// version 2. Specifically, it sets the mint epoch identifier parameter to an empty
+// value, keeping the mint settled every block.
+func MigrateStore(
+	ctx sdk.Context,
+	ps paramtypes.Subspace,
+) error {
+	ps.Set(ctx, types.ParamStoreKeyMintEpochIdentifier, "")
+
+	return nil
+}
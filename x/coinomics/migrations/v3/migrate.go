@@ -0,0 +1,20 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/haqq-network/haqq/x/coinomics/types"
+)
+
+// MigrateStore migrates the x/coinomics module state from the consensus version 2 to
+// version 3. Specifically, it sets the allocation table parameter to the default one,
+// keeping all the minted coins allocated to the fee collector.
+func MigrateStore(
+	ctx sdk.Context,
+	ps paramtypes.Subspace,
+) error {
+	ps.Set(ctx, types.ParamStoreKeyAllocations, []types.MintAllocation(types.DefaultAllocations()))
+
+	return nil
+}
@@ -24,7 +24,7 @@ import (
 )
 
 // consensusVersion defines the current x/coinomics module consensus version.
-const consensusVersion = 1
+const consensusVersion = 3
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -125,7 +125,13 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	_ = keeper.NewMigrator(am.keeper)
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
 }
 
 // EndBlock returns the end blocker for the coinomics module.
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MintAllocations defines the allocation table of the minted coins.
+type MintAllocations []MintAllocation
+
+// DefaultAllocations returns the default allocation table, which sends all
+// the minted coins to the fee collector.
+func DefaultAllocations() MintAllocations {
+	return MintAllocations{
+		{
+			Type:      RecipientType_RECIPIENT_TYPE_MODULE,
+			Recipient: authtypes.FeeCollectorName,
+			Weight:    sdkmath.LegacyOneDec(),
+		},
+	}
+}
+
+// Validate checks that the allocation table is not empty, has no duplicated
+// recipients and that the weights sum up to one.
+func (mas MintAllocations) Validate() error {
+	if len(mas) == 0 {
+		return fmt.Errorf("allocations cannot be empty")
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	seen := make(map[string]bool)
+	for i, ma := range mas {
+		if err := ma.Validate(); err != nil {
+			return fmt.Errorf("allocation %d: %w", i, err)
+		}
+
+		key := ma.Type.String() + "/" + ma.Recipient
+		if seen[key] {
+			return fmt.Errorf("allocation %d: duplicated recipient %s", i, key)
+		}
+		seen[key] = true
+
+		total = total.Add(ma.Weight)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("allocation weights must sum up to one, got %s", total)
+	}
+
+	return nil
+}
+
+// ValidateRecipients checks that none of the account and UC DAO recipients of
+// the allocation table is a blocked address.
+func (mas MintAllocations) ValidateRecipients(blockedAddr func(sdk.AccAddress) bool) error {
+	for i, ma := range mas {
+		if ma.Type != RecipientType_RECIPIENT_TYPE_ACCOUNT && ma.Type != RecipientType_RECIPIENT_TYPE_UCDAO {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(ma.Recipient)
+		if err != nil {
+			return fmt.Errorf("allocation %d: invalid recipient address %s: %w", i, ma.Recipient, err)
+		}
+		if blockedAddr(recipient) {
+			return fmt.Errorf("allocation %d: %s is not allowed to receive funds", i, ma.Recipient)
+		}
+	}
+
+	return nil
+}
+
+// Split splits the given amount between the allocations proportionally to
+// their weights. The rounding remainder is allocated to the last recipient so
+// the split amounts always sum up to the given amount.
+func (mas MintAllocations) Split(amount sdkmath.Int) []sdkmath.Int {
+	amounts := make([]sdkmath.Int, len(mas))
+	if len(mas) == 0 {
+		return amounts
+	}
+
+	remaining := amount
+	for i, ma := range mas[:len(mas)-1] {
+		amounts[i] = ma.Weight.MulInt(amount).TruncateInt()
+		remaining = remaining.Sub(amounts[i])
+	}
+	amounts[len(mas)-1] = remaining
+
+	return amounts
+}
+
+// Validate performs a stateless validation of a single allocation.
+func (ma MintAllocation) Validate() error {
+	if ma.Weight.IsNil() || !ma.Weight.IsPositive() {
+		return fmt.Errorf("weight must be positive: %s", ma.Weight)
+	}
+
+	switch ma.Type {
+	case RecipientType_RECIPIENT_TYPE_MODULE:
+		if strings.TrimSpace(ma.Recipient) == "" {
+			return fmt.Errorf("module name cannot be blank")
+		}
+		if ma.Recipient == ModuleName {
+			return fmt.Errorf("cannot allocate to the %s module", ModuleName)
+		}
+	case RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL:
+		if ma.Recipient != "" {
+			return fmt.Errorf("community pool recipient must be empty, got %s", ma.Recipient)
+		}
+	case RecipientType_RECIPIENT_TYPE_UCDAO, RecipientType_RECIPIENT_TYPE_ACCOUNT:
+		if _, err := sdk.AccAddressFromBech32(ma.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient address %s: %w", ma.Recipient, err)
+		}
+	default:
+		return fmt.Errorf("invalid recipient type: %s", ma.Type)
+	}
+
+	return nil
+}
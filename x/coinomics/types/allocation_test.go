@@ -0,0 +1,200 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *ParamsTestSuite) TestValidateAllocations() {
+	addr := sdk.AccAddress([]byte("recipient_address___")).String()
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name        string
+		allocations MintAllocations
+		expectError bool
+	}{
+		{"default allocations", DefaultAllocations(), false},
+		{
+			"valid multi recipient allocations",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: sdkmath.LegacyNewDecWithPrec(4, 1)},
+				{Type: RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Weight: sdkmath.LegacyNewDecWithPrec(3, 1)},
+				{Type: RecipientType_RECIPIENT_TYPE_UCDAO, Recipient: addr, Weight: sdkmath.LegacyNewDecWithPrec(2, 1)},
+				{Type: RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: addr, Weight: sdkmath.LegacyNewDecWithPrec(1, 1)},
+			},
+			false,
+		},
+		{"empty allocations", MintAllocations{}, true},
+		{
+			"weights sum below one",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: half},
+			},
+			true,
+		},
+		{
+			"weights sum above one",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: sdkmath.LegacyOneDec()},
+				{Type: RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Weight: half},
+			},
+			true,
+		},
+		{
+			"duplicated recipient",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: half},
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: half},
+			},
+			true,
+		},
+		{
+			"zero weight",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: sdkmath.LegacyOneDec()},
+				{Type: RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Weight: sdkmath.LegacyZeroDec()},
+			},
+			true,
+		},
+		{
+			"unspecified recipient type",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_UNSPECIFIED, Recipient: authtypes.FeeCollectorName, Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"blank module name",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: " ", Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"coinomics module recipient",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: ModuleName, Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"community pool with recipient",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Recipient: addr, Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"invalid account address",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: "invalid", Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"invalid ucdao owner address",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_UCDAO, Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.allocations.Validate()
+			if tc.expectError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			// the allocations are validated with the params
+			params := DefaultParams()
+			params.Allocations = tc.allocations
+			suite.Require().Equal(err != nil, params.Validate() != nil)
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestValidateAllocationRecipients() {
+	addr := sdk.AccAddress([]byte("recipient_address___"))
+	blocked := sdk.AccAddress([]byte("blocked_address_____"))
+	blockedAddr := func(a sdk.AccAddress) bool { return a.Equals(blocked) }
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name        string
+		allocations MintAllocations
+		expectError bool
+	}{
+		{"default allocations", DefaultAllocations(), false},
+		{
+			"allowed recipients",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: addr.String(), Weight: half},
+				{Type: RecipientType_RECIPIENT_TYPE_UCDAO, Recipient: addr.String(), Weight: half},
+			},
+			false,
+		},
+		{
+			"blocked account recipient",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_ACCOUNT, Recipient: blocked.String(), Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"blocked ucdao owner",
+			MintAllocations{
+				{Type: RecipientType_RECIPIENT_TYPE_UCDAO, Recipient: blocked.String(), Weight: sdkmath.LegacyOneDec()},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.allocations.ValidateRecipients(blockedAddr)
+			if tc.expectError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestSplitAllocations() {
+	allocations := MintAllocations{
+		{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: authtypes.FeeCollectorName, Weight: sdkmath.LegacyMustNewDecFromStr("0.333333333333333333")},
+		{Type: RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL, Weight: sdkmath.LegacyMustNewDecFromStr("0.333333333333333333")},
+		{Type: RecipientType_RECIPIENT_TYPE_MODULE, Recipient: "ucdao", Weight: sdkmath.LegacyMustNewDecFromStr("0.333333333333333334")},
+	}
+
+	testCases := []struct {
+		name       string
+		amount     sdkmath.Int
+		expAmounts []sdkmath.Int
+	}{
+		{"zero amount", sdkmath.ZeroInt(), []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()}},
+		{"remainder to the last recipient", sdkmath.NewInt(100), []sdkmath.Int{sdkmath.NewInt(33), sdkmath.NewInt(33), sdkmath.NewInt(34)}},
+		{"single unit", sdkmath.NewInt(1), []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(1)}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			amounts := allocations.Split(tc.amount)
+			suite.Require().Len(amounts, len(tc.expAmounts))
+			for i, amount := range amounts {
+				suite.Require().Equal(tc.expAmounts[i].String(), amount.String())
+			}
+		})
+	}
+
+	amounts := DefaultAllocations().Split(sdkmath.NewInt(7))
+	suite.Require().Len(amounts, 1)
+	suite.Require().Equal(sdkmath.NewInt(7).String(), amounts[0].String())
+}
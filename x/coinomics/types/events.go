@@ -2,7 +2,12 @@ package types
 
 // Module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint           = ModuleName
+	EventTypeMintAllocation = "mint_allocation"
 
-	AttributeEraNumber = "era_number"
+	AttributeEraNumber       = "era_number"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeRecipientType   = "recipient_type"
+	AttributeRecipient       = "recipient"
 )
@@ -5,6 +5,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
 )
 
 func NewGenesisState(
@@ -15,6 +17,7 @@ func NewGenesisState(
 		Params:      params,
 		PrevBlockTs: math.NewInt(0),
 		MaxSupply:   maxSupply,
+		AccruedMint: math.LegacyZeroDec(),
 	}
 }
 
@@ -27,6 +30,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:      params,
 		PrevBlockTs: math.NewInt(0),
 		MaxSupply:   sdk.NewCoin(params.MintDenom, maxSupply),
+		AccruedMint: math.LegacyZeroDec(),
 	}
 }
 
@@ -36,9 +40,47 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if !gs.AccruedMint.IsNil() && gs.AccruedMint.IsNegative() {
+		return fmt.Errorf("accrued mint: can't be negative: %s", gs.AccruedMint)
+	}
+
+	seenRecords := make(map[string]bool)
+	for _, record := range gs.MintHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		key := string(MintRecordKey(record.EpochIdentifier, record.EpochNumber))
+		if seenRecords[key] {
+			return fmt.Errorf("mint history: duplicated record for epoch %s #%d", record.EpochIdentifier, record.EpochNumber)
+		}
+		seenRecords[key] = true
+	}
+
 	return gs.Params.Validate()
 }
 
+// Validate performs a stateless validation of the mint record.
+func (r MintRecord) Validate() error {
+	if err := epochstypes.ValidateEpochIdentifierString(r.EpochIdentifier); err != nil {
+		return fmt.Errorf("mint record: %w", err)
+	}
+	if r.EpochNumber < 0 {
+		return fmt.Errorf("mint record: epoch number can't be negative: %d", r.EpochNumber)
+	}
+	if err := r.Minted.Validate(); err != nil {
+		return fmt.Errorf("mint record: %w", err)
+	}
+	if r.BondedBase.IsNil() || r.BondedBase.IsNegative() {
+		return fmt.Errorf("mint record: invalid bonded base: %s", r.BondedBase)
+	}
+	if r.RewardCoefficient.IsNil() {
+		return fmt.Errorf("mint record: reward coefficient can't be nil")
+	}
+
+	return nil
+}
+
 func validateMaxSupply(i interface{}) error {
 	totalTargetSupply, ok := i.(sdk.Coin)
 
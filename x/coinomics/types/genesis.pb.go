@@ -27,6 +27,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecipientType defines the type of a mint allocation recipient.
+type RecipientType int32
+
+const (
+	// RECIPIENT_TYPE_UNSPECIFIED is the unspecified recipient type.
+	RecipientType_RECIPIENT_TYPE_UNSPECIFIED RecipientType = 0
+	// RECIPIENT_TYPE_MODULE allocates to a module account by its name.
+	RecipientType_RECIPIENT_TYPE_MODULE RecipientType = 1
+	// RECIPIENT_TYPE_COMMUNITY_POOL allocates to the distribution community pool.
+	RecipientType_RECIPIENT_TYPE_COMMUNITY_POOL RecipientType = 2
+	// RECIPIENT_TYPE_UCDAO funds the UC DAO on behalf of a share owner address.
+	RecipientType_RECIPIENT_TYPE_UCDAO RecipientType = 3
+	// RECIPIENT_TYPE_ACCOUNT allocates to an account address.
+	RecipientType_RECIPIENT_TYPE_ACCOUNT RecipientType = 4
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_UNSPECIFIED",
+	1: "RECIPIENT_TYPE_MODULE",
+	2: "RECIPIENT_TYPE_COMMUNITY_POOL",
+	3: "RECIPIENT_TYPE_UCDAO",
+	4: "RECIPIENT_TYPE_ACCOUNT",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_UNSPECIFIED":    0,
+	"RECIPIENT_TYPE_MODULE":         1,
+	"RECIPIENT_TYPE_COMMUNITY_POOL": 2,
+	"RECIPIENT_TYPE_UCDAO":          3,
+	"RECIPIENT_TYPE_ACCOUNT":        4,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_228a4e7fa0b804ae, []int{0}
+}
+
 // GenesisState defines the coinomics module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -35,6 +75,10 @@ type GenesisState struct {
 	PrevBlockTs cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=prev_block_ts,json=prevBlockTs,proto3,customtype=cosmossdk.io/math.Int" json:"prev_block_ts"`
 	// max supply
 	MaxSupply github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"max_supply" yaml:"max_supply"`
+	// mint accrued since the last settlement when minting is settled per epoch
+	AccruedMint cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=accrued_mint,json=accruedMint,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"accrued_mint"`
+	// settled mint records
+	MintHistory []MintRecord `protobuf:"bytes,5,rep,name=mint_history,json=mintHistory,proto3" json:"mint_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +121,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintHistory() []MintRecord {
+	if m != nil {
+		return m.MintHistory
+	}
+	return nil
+}
+
 // Params holds parameters for the coinomics module.
 type Params struct {
 	// type of coin to mint
@@ -85,6 +136,12 @@ type Params struct {
 	EnableCoinomics bool `protobuf:"varint,2,opt,name=enable_coinomics,json=enableCoinomics,proto3" json:"enable_coinomics,omitempty"`
 	// current staking reward coefficient
 	RewardCoefficient cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=reward_coefficient,json=rewardCoefficient,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_coefficient"`
+	// epoch identifier on which the minted coins are settled, minting is settled
+	// every block if empty
+	MintEpochIdentifier string `protobuf:"bytes,4,opt,name=mint_epoch_identifier,json=mintEpochIdentifier,proto3" json:"mint_epoch_identifier,omitempty"`
+	// allocation of the minted coins between the recipients, the weights must
+	// sum up to one
+	Allocations []MintAllocation `protobuf:"bytes,5,rep,name=allocations,proto3" json:"allocations"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,45 +191,213 @@ func (m *Params) GetEnableCoinomics() bool {
 	return false
 }
 
+func (m *Params) GetMintEpochIdentifier() string {
+	if m != nil {
+		return m.MintEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAllocations() []MintAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// MintAllocation defines a recipient of the minted coins and its share.
+type MintAllocation struct {
+	// type of the recipient
+	Type RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=haqq.coinomics.v1.RecipientType" json:"type,omitempty"`
+	// module name for the module type, bech32 address for the account type,
+	// bech32 share owner address for the UC DAO type and empty for the
+	// community pool type
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share of the minted coins
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *MintAllocation) Reset()         { *m = MintAllocation{} }
+func (m *MintAllocation) String() string { return proto.CompactTextString(m) }
+func (*MintAllocation) ProtoMessage()    {}
+func (*MintAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228a4e7fa0b804ae, []int{2}
+}
+func (m *MintAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllocation.Merge(m, src)
+}
+func (m *MintAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAllocation proto.InternalMessageInfo
+
+func (m *MintAllocation) GetType() RecipientType {
+	if m != nil {
+		return m.Type
+	}
+	return RecipientType_RECIPIENT_TYPE_UNSPECIFIED
+}
+
+func (m *MintAllocation) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MintRecord defines the coins minted over a single epoch.
+type MintRecord struct {
+	// identifier of the epoch the mint has been settled on
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// number of the settled epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// block height of the settlement
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// coins minted over the epoch
+	Minted github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"minted"`
+	// total bonded tokens at the settlement
+	BondedBase cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=bonded_base,json=bondedBase,proto3,customtype=cosmossdk.io/math.Int" json:"bonded_base"`
+	// staking reward coefficient at the settlement
+	RewardCoefficient cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=reward_coefficient,json=rewardCoefficient,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_coefficient"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228a4e7fa0b804ae, []int{3}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MintRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("haqq.coinomics.v1.RecipientType", RecipientType_name, RecipientType_value)
 	proto.RegisterType((*GenesisState)(nil), "haqq.coinomics.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "haqq.coinomics.v1.Params")
+	proto.RegisterType((*MintAllocation)(nil), "haqq.coinomics.v1.MintAllocation")
+	proto.RegisterType((*MintRecord)(nil), "haqq.coinomics.v1.MintRecord")
 }
 
 func init() { proto.RegisterFile("haqq/coinomics/v1/genesis.proto", fileDescriptor_228a4e7fa0b804ae) }
 
 var fileDescriptor_228a4e7fa0b804ae = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x3f, 0x6f, 0x13, 0x31,
-	0x1c, 0x8d, 0x8b, 0x14, 0x71, 0x2e, 0x08, 0x72, 0x02, 0x11, 0x0a, 0x5c, 0xaa, 0x2c, 0x84, 0x4a,
-	0xb5, 0x15, 0xd8, 0x2a, 0xa6, 0x4b, 0x05, 0x54, 0x62, 0x40, 0x29, 0x12, 0x12, 0xcb, 0xc9, 0xe7,
-	0xb8, 0x17, 0x2b, 0xb1, 0x7d, 0x3d, 0xbb, 0x69, 0x6e, 0x66, 0x63, 0x62, 0xe6, 0x13, 0x30, 0xf6,
-	0x63, 0x94, 0xad, 0x23, 0x62, 0x88, 0x50, 0x32, 0x74, 0xe7, 0x13, 0x20, 0xff, 0xa1, 0xad, 0x94,
-	0xe5, 0xce, 0x7a, 0xf7, 0xde, 0xef, 0xfd, 0xde, 0x3b, 0xc3, 0xce, 0x98, 0x1c, 0x1f, 0x63, 0xaa,
-	0xb8, 0x54, 0x82, 0x53, 0x8d, 0x67, 0x7d, 0x5c, 0x30, 0xc9, 0x34, 0xd7, 0xa8, 0xac, 0x94, 0x51,
-	0x71, 0xcb, 0x12, 0xd0, 0x15, 0x01, 0xcd, 0xfa, 0x5b, 0x2d, 0x22, 0xb8, 0x54, 0xd8, 0x3d, 0x3d,
-	0x6b, 0xeb, 0x41, 0xa1, 0x0a, 0xe5, 0x8e, 0xd8, 0x9e, 0x02, 0x9a, 0x50, 0xa5, 0x85, 0xd2, 0x38,
-	0x27, 0x9a, 0xe1, 0x59, 0x3f, 0x67, 0x86, 0xf4, 0x9d, 0x97, 0xff, 0xde, 0xfd, 0xbe, 0x01, 0xef,
-	0xbc, 0xf5, 0x6e, 0x87, 0x86, 0x18, 0x16, 0xbf, 0x86, 0xcd, 0x92, 0x54, 0x44, 0xe8, 0x36, 0xd8,
-	0x06, 0xbd, 0xcd, 0x97, 0x8f, 0xd1, 0x9a, 0x3b, 0xfa, 0xe0, 0x08, 0x69, 0x74, 0xbe, 0xe8, 0x34,
-	0x7e, 0x5c, 0x9e, 0xed, 0x80, 0x61, 0xd0, 0xc4, 0x6f, 0xe0, 0xdd, 0xb2, 0x62, 0xb3, 0x2c, 0x9f,
-	0x2a, 0x3a, 0xc9, 0x8c, 0x6e, 0x6f, 0x6c, 0x83, 0x5e, 0x94, 0x76, 0x2d, 0xf3, 0xf7, 0xa2, 0xf3,
-	0xd0, 0x6f, 0xa3, 0x47, 0x13, 0xc4, 0x15, 0x16, 0xc4, 0x8c, 0xd1, 0x81, 0x34, 0x7e, 0xc4, 0xa6,
-	0x15, 0xa6, 0x56, 0xf7, 0x51, 0xc7, 0x5f, 0x00, 0x84, 0x82, 0xcc, 0x33, 0x7d, 0x52, 0x96, 0xd3,
-	0xba, 0x7d, 0x2b, 0xac, 0xe2, 0xe5, 0xc8, 0x86, 0x41, 0x21, 0x0c, 0x1a, 0x28, 0x2e, 0xd3, 0x83,
-	0x60, 0xf0, 0xbc, 0xe0, 0x66, 0x7c, 0x92, 0x23, 0xaa, 0x04, 0x0e, 0xc9, 0xfd, 0x6b, 0x57, 0x8f,
-	0x26, 0xd8, 0xd4, 0x25, 0xd3, 0x4e, 0xf0, 0x77, 0xd1, 0x69, 0xd5, 0x44, 0x4c, 0xf7, 0xba, 0xd7,
-	0x2e, 0x5d, 0xbf, 0x47, 0x24, 0xc8, 0xfc, 0xd0, 0x03, 0x3f, 0x01, 0x6c, 0xfa, 0xac, 0xf1, 0x33,
-	0x08, 0x05, 0x97, 0x26, 0x1b, 0x31, 0xa9, 0x84, 0xab, 0x26, 0x1a, 0x46, 0x16, 0xd9, 0xb7, 0x40,
-	0xfc, 0x02, 0xde, 0x67, 0x92, 0xe4, 0x53, 0x96, 0x5d, 0x15, 0xe5, 0xa2, 0xdf, 0x1e, 0xde, 0xf3,
-	0xf8, 0xe0, 0x3f, 0x1c, 0x7f, 0x82, 0x71, 0xc5, 0x4e, 0x49, 0x35, 0xca, 0xa8, 0x62, 0x47, 0x47,
-	0x9c, 0x72, 0x26, 0x8d, 0x4b, 0x18, 0xa5, 0xbd, 0x10, 0xe3, 0xc9, 0x7a, 0x4f, 0xef, 0x59, 0x41,
-	0x68, 0xbd, 0xcf, 0xa8, 0xdf, 0xb2, 0xe5, 0x67, 0x0c, 0xae, 0x47, 0xec, 0x3d, 0xfd, 0x7a, 0x79,
-	0xb6, 0xf3, 0xc8, 0x5d, 0xa6, 0xf9, 0x8d, 0xeb, 0x14, 0x7e, 0xd6, 0xbb, 0xf3, 0x65, 0x02, 0x2e,
-	0x96, 0x09, 0xf8, 0xb3, 0x4c, 0xc0, 0xb7, 0x55, 0xd2, 0xb8, 0x58, 0x25, 0x8d, 0x5f, 0xab, 0xa4,
-	0xf1, 0x19, 0xdd, 0xe8, 0xcc, 0xaa, 0x77, 0x25, 0x33, 0xa7, 0xaa, 0x9a, 0xe0, 0xb5, 0x51, 0xae,
-	0xbf, 0xbc, 0xe9, 0x6e, 0xce, 0xab, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x55, 0xcd, 0xef, 0x3b,
-	0xb8, 0x02, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x47, 0xa8, 0x4e, 0x4e, 0x22, 0x5f, 0xe3, 0x94, 0x71, 0x63, 0xda, 0xd6, 0x52,
+	0xd7, 0x40, 0x48, 0x58, 0xcd, 0x14, 0x74, 0xa8, 0x45, 0x29, 0x0d, 0x51, 0xeb, 0x07, 0x68, 0x09,
+	0x45, 0xba, 0x10, 0x47, 0xf2, 0x2c, 0x1d, 0x24, 0xf2, 0x18, 0xde, 0x49, 0xb6, 0xe6, 0x6e, 0x9d,
+	0xfa, 0x3f, 0x14, 0x28, 0x3a, 0x66, 0xe8, 0x1f, 0x91, 0xa5, 0x40, 0xc6, 0xa2, 0x83, 0x51, 0xd8,
+	0x43, 0xf6, 0x6e, 0xdd, 0x8a, 0xbb, 0x63, 0x24, 0x59, 0x8e, 0xd1, 0x1a, 0x5d, 0x24, 0xea, 0xbd,
+	0xf7, 0x7d, 0xef, 0xbd, 0xef, 0xbd, 0x27, 0x82, 0xed, 0x01, 0x7a, 0xf5, 0xca, 0x0a, 0x28, 0x89,
+	0x69, 0x44, 0x02, 0x66, 0x4d, 0x0e, 0xac, 0x3e, 0x8e, 0x31, 0x23, 0xcc, 0x4c, 0x52, 0xca, 0x29,
+	0x5c, 0x17, 0x01, 0xe6, 0x2c, 0xc0, 0x9c, 0x1c, 0x6c, 0xae, 0xa3, 0x88, 0xc4, 0xd4, 0x92, 0x9f,
+	0x2a, 0x6a, 0xf3, 0x41, 0x9f, 0xf6, 0xa9, 0x7c, 0xb4, 0xc4, 0x53, 0x66, 0x35, 0x02, 0xca, 0x22,
+	0xca, 0x2c, 0x1f, 0x31, 0x6c, 0x4d, 0x0e, 0x7c, 0xcc, 0xd1, 0x81, 0xcc, 0xa5, 0xfc, 0x95, 0x5f,
+	0xf3, 0x60, 0xed, 0x6b, 0x95, 0xed, 0x98, 0x23, 0x8e, 0xe1, 0x97, 0xa0, 0x90, 0xa0, 0x14, 0x45,
+	0x4c, 0xd7, 0x76, 0xb4, 0xbd, 0x52, 0xf5, 0x91, 0x79, 0x2d, 0xbb, 0xd9, 0x91, 0x01, 0xb5, 0xe2,
+	0x9b, 0xf3, 0xed, 0xdc, 0x2f, 0xef, 0x5e, 0xef, 0x6b, 0x6e, 0x86, 0x81, 0xcf, 0xc1, 0xdd, 0x24,
+	0xc5, 0x13, 0xcf, 0x1f, 0xd1, 0x60, 0xe8, 0x71, 0xa6, 0xaf, 0xec, 0x68, 0x7b, 0xc5, 0x5a, 0x45,
+	0x44, 0xfe, 0x71, 0xbe, 0xbd, 0xa1, 0xaa, 0x61, 0xe1, 0xd0, 0x24, 0xd4, 0x8a, 0x10, 0x1f, 0x98,
+	0x4e, 0xcc, 0x15, 0x45, 0x49, 0x00, 0x6b, 0x02, 0xd7, 0x65, 0xf0, 0x7b, 0x0d, 0x80, 0x08, 0x9d,
+	0x79, 0x6c, 0x9c, 0x24, 0xa3, 0xa9, 0x9e, 0xcf, 0x4a, 0x51, 0x70, 0x53, 0x34, 0x63, 0x66, 0xcd,
+	0x98, 0x36, 0x25, 0x71, 0xcd, 0xc9, 0x12, 0x7c, 0xd6, 0x27, 0x7c, 0x30, 0xf6, 0xcd, 0x80, 0x46,
+	0x56, 0xd6, 0xb9, 0xfa, 0x7a, 0xc2, 0xc2, 0xa1, 0xc5, 0xa7, 0x09, 0x66, 0x12, 0xf0, 0xd7, 0xf9,
+	0xf6, 0xfa, 0x14, 0x45, 0xa3, 0x67, 0x95, 0x79, 0x96, 0x8a, 0xaa, 0xa3, 0x18, 0xa1, 0xb3, 0x63,
+	0x69, 0x80, 0xdf, 0x80, 0x35, 0x14, 0x04, 0xe9, 0x18, 0x87, 0x5e, 0x44, 0x62, 0xae, 0xaf, 0xca,
+	0x66, 0xf6, 0xb2, 0x5c, 0x9f, 0x5e, 0x6f, 0xe6, 0x08, 0xf7, 0x51, 0x30, 0xad, 0xe3, 0x20, 0x6b,
+	0x29, 0x43, 0x37, 0x49, 0xcc, 0x05, 0x99, 0x20, 0xf1, 0x06, 0x84, 0x71, 0x9a, 0x4e, 0xf5, 0x3b,
+	0x3b, 0xf9, 0xbd, 0x52, 0x75, 0xeb, 0x03, 0xf2, 0x8a, 0x70, 0x17, 0x07, 0x34, 0x0d, 0x17, 0x25,
+	0x2e, 0x09, 0xf4, 0x0b, 0x05, 0xae, 0xfc, 0xb6, 0x02, 0x0a, 0x6a, 0x0a, 0x70, 0x0b, 0x00, 0xc9,
+	0x1b, 0xe2, 0x98, 0x46, 0x72, 0x68, 0x45, 0xb7, 0x28, 0x2c, 0x75, 0x61, 0x80, 0x9f, 0x83, 0x32,
+	0x8e, 0x91, 0x3f, 0xc2, 0xde, 0x2c, 0x87, 0x1c, 0xca, 0x47, 0xee, 0x7d, 0x65, 0xb7, 0xdf, 0x9b,
+	0xe1, 0xb7, 0x00, 0xa6, 0xf8, 0x14, 0xa5, 0xa1, 0x17, 0x50, 0x7c, 0x72, 0x42, 0x02, 0x82, 0x63,
+	0xae, 0xe7, 0x6f, 0xd9, 0xf4, 0xba, 0xe2, 0xb0, 0xe7, 0x14, 0xb0, 0x0a, 0x36, 0x64, 0x89, 0x38,
+	0xa1, 0xc1, 0xc0, 0x23, 0x21, 0x8e, 0x39, 0x39, 0x21, 0x38, 0x55, 0x82, 0xba, 0x1f, 0x0b, 0x67,
+	0x43, 0xf8, 0x9c, 0x99, 0x0b, 0xb6, 0x40, 0x09, 0x8d, 0x46, 0x34, 0x40, 0x9c, 0xd0, 0x98, 0x65,
+	0x6a, 0xed, 0xde, 0xa0, 0xd6, 0xe1, 0x2c, 0xf2, 0x8a, 0x62, 0x0b, 0x04, 0xcf, 0x1e, 0xff, 0xf0,
+	0xee, 0xf5, 0xfe, 0x27, 0xf2, 0xd4, 0xce, 0x16, 0x8e, 0x4d, 0x89, 0x58, 0xf9, 0x59, 0x03, 0xf7,
+	0xae, 0x12, 0xc1, 0xa7, 0x60, 0x55, 0xec, 0x8b, 0x54, 0xf4, 0x5e, 0x75, 0xe7, 0x03, 0x99, 0x5d,
+	0x1c, 0x90, 0x44, 0x34, 0xd8, 0x9d, 0x26, 0xd8, 0x95, 0xd1, 0xf0, 0x31, 0x28, 0xa6, 0xef, 0xcd,
+	0x6a, 0xf9, 0xdd, 0xb9, 0x01, 0x7e, 0x05, 0x0a, 0xa7, 0x98, 0xf4, 0x07, 0xb7, 0x57, 0x35, 0xc3,
+	0x55, 0xfe, 0x5e, 0x01, 0x60, 0xbe, 0x1f, 0x72, 0xba, 0xcb, 0xa2, 0xaa, 0x15, 0xb8, 0x8f, 0x97,
+	0x04, 0xdd, 0x05, 0x6b, 0x2a, 0x34, 0x1e, 0x47, 0x3e, 0x4e, 0x65, 0x71, 0x79, 0xb7, 0x24, 0x6d,
+	0x2d, 0x69, 0x82, 0x0f, 0x41, 0x61, 0x30, 0x2f, 0x2f, 0xef, 0x66, 0xbf, 0x60, 0x1f, 0x14, 0xc4,
+	0x88, 0x70, 0xa8, 0xaf, 0xfe, 0xdb, 0x21, 0x3e, 0xbd, 0xe5, 0x21, 0x66, 0xdd, 0x29, 0x7a, 0x68,
+	0x83, 0x92, 0x4f, 0xe3, 0x10, 0x87, 0x9e, 0x60, 0xd6, 0xef, 0xfc, 0xe7, 0x3f, 0x0f, 0xa0, 0x60,
+	0x35, 0xc4, 0xf0, 0x0d, 0x6b, 0x5c, 0xf8, 0xdf, 0x6b, 0xbc, 0xff, 0x93, 0x06, 0xee, 0x5e, 0x99,
+	0x39, 0x34, 0xc0, 0xa6, 0xdb, 0xb0, 0x9d, 0x8e, 0xd3, 0x68, 0x75, 0xbd, 0xee, 0xcb, 0x4e, 0xc3,
+	0xeb, 0xb5, 0x8e, 0x3b, 0x0d, 0xdb, 0x79, 0xee, 0x34, 0xea, 0xe5, 0x1c, 0x7c, 0x04, 0x36, 0x96,
+	0xfc, 0xcd, 0x76, 0xbd, 0x77, 0xd4, 0x28, 0x6b, 0x70, 0x17, 0x6c, 0x2d, 0xb9, 0xec, 0x76, 0xb3,
+	0xd9, 0x6b, 0x39, 0xdd, 0x97, 0x5e, 0xa7, 0xdd, 0x3e, 0x2a, 0xaf, 0x40, 0x1d, 0x3c, 0x58, 0x66,
+	0xb7, 0xeb, 0x87, 0xed, 0x72, 0x1e, 0x6e, 0x82, 0x87, 0x4b, 0x9e, 0x43, 0xdb, 0x6e, 0xf7, 0x5a,
+	0xdd, 0xf2, 0x6a, 0xed, 0xc5, 0x9b, 0x0b, 0x43, 0x7b, 0x7b, 0x61, 0x68, 0x7f, 0x5e, 0x18, 0xda,
+	0x8f, 0x97, 0x46, 0xee, 0xed, 0xa5, 0x91, 0xfb, 0xfd, 0xd2, 0xc8, 0x7d, 0x67, 0x2e, 0xcc, 0x44,
+	0x6c, 0xf3, 0x93, 0x18, 0xf3, 0x53, 0x9a, 0x0e, 0xad, 0x6b, 0x57, 0x21, 0xe7, 0xe3, 0x17, 0xe4,
+	0x2b, 0xe2, 0x8b, 0x7f, 0x06, 0x00, 0x38, 0xee, 0x8c, 0x17, 0xa1, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +420,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintHistory) > 0 {
+		for iNdEx := len(m.MintHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.AccruedMint.Size()
+		i -= size
+		if _, err := m.AccruedMint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -248,6 +497,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MintEpochIdentifier) > 0 {
+		i -= len(m.MintEpochIdentifier)
+		copy(dAtA[i:], m.MintEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MintEpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.RewardCoefficient.Size()
 		i -= size
@@ -278,6 +548,121 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardCoefficient.Size()
+		i -= size
+		if _, err := m.RewardCoefficient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BondedBase.Size()
+		i -= size
+		if _, err := m.BondedBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -301,6 +686,14 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AccruedMint.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintHistory) > 0 {
+		for _, e := range m.MintHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +712,59 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RewardCoefficient.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.MintEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovGenesis(uint64(m.Type))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BondedBase.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RewardCoefficient.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -457,17 +903,85 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedMint", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintHistory = append(m.MintHistory, MintRecord{})
+			if err := m.MintHistory[len(m.MintHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -593,6 +1107,428 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, MintAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCoefficient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
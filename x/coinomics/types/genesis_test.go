@@ -24,6 +24,15 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 	// Team Address needs to be set manually at Genesis
 	validParams := DefaultParams()
 
+	validRecord := MintRecord{
+		EpochIdentifier:   "day",
+		EpochNumber:       1,
+		Height:            10,
+		Minted:            sdk.NewCoin("aISLM", sdkmath.NewInt(100)),
+		BondedBase:        sdkmath.NewInt(1000),
+		RewardCoefficient: sdkmath.LegacyNewDecWithPrec(78, 1),
+	}
+
 	newGen := NewGenesisState(
 		validParams,
 		sdk.NewCoin("aISLM", sdkmath.NewInt(10_000_000_000)),
@@ -72,6 +81,42 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"invalid genesis - negative accrued mint",
+			&GenesisState{
+				Params:      validParams,
+				MaxSupply:   sdk.NewCoin("aISLM", sdkmath.NewInt(10_000_000_000)),
+				AccruedMint: sdkmath.LegacyNewDec(-1),
+			},
+			false,
+		},
+		{
+			"valid genesis - mint history",
+			&GenesisState{
+				Params:      validParams,
+				MaxSupply:   sdk.NewCoin("aISLM", sdkmath.NewInt(10_000_000_000)),
+				MintHistory: []MintRecord{validRecord, {EpochIdentifier: "day", EpochNumber: 2, Minted: validRecord.Minted, BondedBase: validRecord.BondedBase, RewardCoefficient: validRecord.RewardCoefficient}},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated mint record",
+			&GenesisState{
+				Params:      validParams,
+				MaxSupply:   sdk.NewCoin("aISLM", sdkmath.NewInt(10_000_000_000)),
+				MintHistory: []MintRecord{validRecord, validRecord},
+			},
+			false,
+		},
+		{
+			"invalid genesis - mint record without identifier",
+			&GenesisState{
+				Params:      validParams,
+				MaxSupply:   sdk.NewCoin("aISLM", sdkmath.NewInt(10_000_000_000)),
+				MintHistory: []MintRecord{{EpochNumber: 1, Minted: validRecord.Minted, BondedBase: validRecord.BondedBase, RewardCoefficient: validRecord.RewardCoefficient}},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -26,6 +26,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -40,3 +41,8 @@ type StakingKeeper interface {
 	StakingTokenSupply(ctx context.Context) (sdkmath.Int, error)
 	TotalBondedTokens(ctx context.Context) (sdkmath.Int, error)
 }
+
+// UcdaoKeeper defines the contract needed to be fulfilled for the UC DAO keeper
+type UcdaoKeeper interface {
+	Fund(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var ParamsKey = []byte{0x00}
 
 // constants
@@ -18,10 +20,25 @@ const (
 const (
 	prefixPrevBlockTS = iota + 1
 	prefixMaxSupply
+	prefixAccruedMint
+	prefixMintHistory
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixPrevBlockTS = []byte{prefixPrevBlockTS}
 	KeyPrefixMaxSupply   = []byte{prefixMaxSupply}
+	KeyPrefixAccruedMint = []byte{prefixAccruedMint}
+	KeyPrefixMintHistory = []byte{prefixMintHistory}
 )
+
+// MintHistoryPrefix returns the prefix of the mint records settled on the
+// given epoch identifier.
+func MintHistoryPrefix(epochIdentifier string) []byte {
+	return append(append(KeyPrefixMintHistory, []byte(epochIdentifier)...), 0x00)
+}
+
+// MintRecordKey returns the key of the mint record settled on the given epoch.
+func MintRecordKey(epochIdentifier string, epochNumber int64) []byte {
+	return append(MintHistoryPrefix(epochIdentifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...) //nolint: gosec // G115 -- epoch number is never negative
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,21 +9,43 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/haqq-network/haqq/x/epochs/types"
 )
 
 var DefaultMintDenom = "aISLM"
 
 // Parameter store keys
 var (
-	ParamStoreKeyMintDenom         = []byte("ParamStoreKeyMintDenom")
-	ParamStoreKeyEnableCoinomics   = []byte("ParamStoreKeyEnableCoinomics")
-	ParamStoreKeyRewardCoefficient = []byte("ParamStoreKeyRewardCoefficient")
+	ParamStoreKeyMintDenom           = []byte("ParamStoreKeyMintDenom")
+	ParamStoreKeyEnableCoinomics     = []byte("ParamStoreKeyEnableCoinomics")
+	ParamStoreKeyRewardCoefficient   = []byte("ParamStoreKeyRewardCoefficient")
+	ParamStoreKeyMintEpochIdentifier = []byte("ParamStoreKeyMintEpochIdentifier")
+	ParamStoreKeyAllocations         = []byte("ParamStoreKeyAllocations")
 )
 
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParamKeyTable returns the key table of the module params, whose allocation
+// table validation also rejects the recipients reported as blocked.
+func NewParamKeyTable(blockedAddr func(sdk.AccAddress) bool) paramtypes.KeyTable {
+	keyTable := paramtypes.NewKeyTable()
+	for _, psp := range (&Params{}).ParamSetPairs() {
+		if bytes.Equal(psp.Key, ParamStoreKeyAllocations) {
+			psp.ValidatorFn = func(i interface{}) error {
+				if err := validateAllocations(i); err != nil {
+					return err
+				}
+				return MintAllocations(i.([]MintAllocation)).ValidateRecipients(blockedAddr)
+			}
+		}
+		keyTable = keyTable.RegisterType(psp)
+	}
+	return keyTable
+}
+
 func NewParams(
 	mintDenom string,
 	rewardsCoefficient sdkmath.LegacyDec,
@@ -32,6 +55,7 @@ func NewParams(
 		MintDenom:         mintDenom,
 		RewardCoefficient: rewardsCoefficient,
 		EnableCoinomics:   enableCoinomics,
+		Allocations:       DefaultAllocations(),
 	}
 }
 
@@ -40,6 +64,7 @@ func DefaultParams() Params {
 		MintDenom:         DefaultMintDenom,
 		RewardCoefficient: sdkmath.LegacyNewDecWithPrec(78, 1),
 		EnableCoinomics:   true,
+		Allocations:       DefaultAllocations(),
 	}
 }
 
@@ -49,6 +74,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMintDenom, &p.MintDenom, validateMintDenom),
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardCoefficient, &p.RewardCoefficient, validateRewardCoefficient),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableCoinomics, &p.EnableCoinomics, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMintEpochIdentifier, &p.MintEpochIdentifier, validateMintEpochIdentifier),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllocations, &p.Allocations, validateAllocations),
 	}
 }
 
@@ -75,6 +102,29 @@ func validateRewardCoefficient(i interface{}) error {
 	return nil
 }
 
+func validateMintEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty identifier settles the mint every block
+	if v == "" {
+		return nil
+	}
+
+	return epochstypes.ValidateEpochIdentifierString(v)
+}
+
+func validateAllocations(i interface{}) error {
+	v, ok := i.([]MintAllocation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return MintAllocations(v).Validate()
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -92,5 +142,12 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMintEpochIdentifier(p.MintEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateAllocations(p.Allocations); err != nil {
+		return err
+	}
+
 	return validateBool(p.EnableCoinomics)
 }
@@ -44,7 +44,7 @@ func (suite *ParamsTestSuite) TestParamKeyTable() {
 func (suite *ParamsTestSuite) TestParamSetPairs() {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	suite.Require().Len(pairs, 3)
+	suite.Require().Len(pairs, 5)
 }
 
 func (suite *ParamsTestSuite) TestValidateMintDenom() {
@@ -96,6 +96,30 @@ func (suite *ParamsTestSuite) TestValidateRewardCoefficient() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestValidateMintEpochIdentifier() {
+	testCases := []struct {
+		name        string
+		value       any
+		expectError bool
+	}{
+		{"empty identifier", "", false},
+		{"day identifier", "day", false},
+		{"whitespace only", "   ", true},
+		{"wrong type int", 1, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateMintEpochIdentifier(tc.value)
+			if tc.expectError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *ParamsTestSuite) TestValidateBool() {
 	testCases := []struct {
 		name        string
@@ -261,6 +261,192 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type QueryMintHistoryRequest struct {
+	// epoch identifier, defaults to the current mint epoch identifier
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// first epoch of the range, inclusive
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last epoch of the range, inclusive; zero means no upper bound
+	EndEpoch int64 `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+}
+
+func (m *QueryMintHistoryRequest) Reset()         { *m = QueryMintHistoryRequest{} }
+func (m *QueryMintHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryRequest) ProtoMessage()    {}
+func (*QueryMintHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bccdbe1913fce8f0, []int{6}
+}
+func (m *QueryMintHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryRequest.Merge(m, src)
+}
+func (m *QueryMintHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMintHistoryRequest) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *QueryMintHistoryRequest) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *QueryMintHistoryRequest) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type QueryMintHistoryResponse struct {
+	Records []MintRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryMintHistoryResponse) Reset()         { *m = QueryMintHistoryResponse{} }
+func (m *QueryMintHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryResponse) ProtoMessage()    {}
+func (*QueryMintHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bccdbe1913fce8f0, []int{7}
+}
+func (m *QueryMintHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryResponse.Merge(m, src)
+}
+func (m *QueryMintHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMintHistoryResponse) GetRecords() []MintRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type QueryProjectedInflationRequest struct {
+}
+
+func (m *QueryProjectedInflationRequest) Reset()         { *m = QueryProjectedInflationRequest{} }
+func (m *QueryProjectedInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedInflationRequest) ProtoMessage()    {}
+func (*QueryProjectedInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bccdbe1913fce8f0, []int{8}
+}
+func (m *QueryProjectedInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedInflationRequest.Merge(m, src)
+}
+func (m *QueryProjectedInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedInflationRequest proto.InternalMessageInfo
+
+type QueryProjectedInflationResponse struct {
+	// coins projected to be minted over a year with the current bonded tokens
+	// and reward coefficient, capped by the max supply
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"annual_provisions"`
+	// annual provisions relative to the current total supply
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// coins accrued and not yet settled
+	PendingMint github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=pending_mint,json=pendingMint,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"pending_mint"`
+}
+
+func (m *QueryProjectedInflationResponse) Reset()         { *m = QueryProjectedInflationResponse{} }
+func (m *QueryProjectedInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedInflationResponse) ProtoMessage()    {}
+func (*QueryProjectedInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bccdbe1913fce8f0, []int{9}
+}
+func (m *QueryProjectedInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedInflationResponse.Merge(m, src)
+}
+func (m *QueryProjectedInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedInflationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryMaxSupplyRequest)(nil), "haqq.coinomics.v1.QueryMaxSupplyRequest")
 	proto.RegisterType((*QueryMaxSupplyResponse)(nil), "haqq.coinomics.v1.QueryMaxSupplyResponse")
@@ -268,46 +454,67 @@ func init() {
 	proto.RegisterType((*QueryRewardCoefficientResponse)(nil), "haqq.coinomics.v1.QueryRewardCoefficientResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "haqq.coinomics.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "haqq.coinomics.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMintHistoryRequest)(nil), "haqq.coinomics.v1.QueryMintHistoryRequest")
+	proto.RegisterType((*QueryMintHistoryResponse)(nil), "haqq.coinomics.v1.QueryMintHistoryResponse")
+	proto.RegisterType((*QueryProjectedInflationRequest)(nil), "haqq.coinomics.v1.QueryProjectedInflationRequest")
+	proto.RegisterType((*QueryProjectedInflationResponse)(nil), "haqq.coinomics.v1.QueryProjectedInflationResponse")
 }
 
 func init() { proto.RegisterFile("haqq/coinomics/v1/query.proto", fileDescriptor_bccdbe1913fce8f0) }
 
 var fileDescriptor_bccdbe1913fce8f0 = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3d, 0x6f, 0x13, 0x31,
-	0x18, 0xc7, 0x73, 0x20, 0x22, 0xc5, 0x4c, 0x31, 0xe5, 0xa5, 0x57, 0x7a, 0x29, 0x27, 0xb5, 0x0d,
-	0x48, 0xb1, 0xb9, 0xc2, 0xc8, 0x94, 0x32, 0x30, 0x80, 0x04, 0xe9, 0x80, 0xc4, 0x52, 0x39, 0x17,
-	0xf7, 0x62, 0xa5, 0x67, 0x5f, 0xce, 0x4e, 0x9a, 0x63, 0x64, 0xea, 0x88, 0xc4, 0x87, 0x80, 0x91,
-	0x91, 0x8f, 0xd0, 0xb1, 0x12, 0x0b, 0x62, 0xa8, 0x50, 0x82, 0xc4, 0xd7, 0x40, 0x67, 0x3b, 0x69,
-	0xd3, 0x4b, 0x04, 0x5d, 0xee, 0xac, 0xe7, 0xff, 0xbc, 0xfc, 0xe4, 0xbf, 0x1f, 0xb0, 0xde, 0x25,
-	0xfd, 0x3e, 0x0e, 0x05, 0xe3, 0x22, 0x66, 0xa1, 0xc4, 0xc3, 0x00, 0xf7, 0x07, 0x34, 0xcd, 0x50,
-	0x92, 0x0a, 0x25, 0x60, 0x35, 0x97, 0xd1, 0x4c, 0x46, 0xc3, 0xc0, 0xad, 0x92, 0x98, 0x71, 0x81,
-	0xf5, 0xd7, 0x64, 0xb9, 0x2b, 0x91, 0x88, 0x84, 0x3e, 0xe2, 0xfc, 0x64, 0xa3, 0xf7, 0x23, 0x21,
-	0xa2, 0x43, 0x8a, 0x49, 0xc2, 0x30, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xd2, 0xaa, 0x5e, 0x28,
-	0x64, 0x2c, 0x24, 0x6e, 0x13, 0x49, 0xf1, 0x30, 0x68, 0x53, 0x45, 0x02, 0xcd, 0x61, 0xf5, 0x5a,
-	0x11, 0x2c, 0xa2, 0x9c, 0x4a, 0x66, 0x1b, 0xf8, 0x77, 0xc1, 0xed, 0x37, 0x39, 0xe9, 0x2b, 0x32,
-	0xda, 0x1b, 0x24, 0xc9, 0x61, 0xd6, 0xa2, 0xfd, 0x01, 0x95, 0xca, 0x3f, 0x76, 0xc0, 0x9d, 0xcb,
-	0x8a, 0x4c, 0x04, 0x97, 0x14, 0x72, 0x00, 0x62, 0x32, 0xda, 0x97, 0x3a, 0x7a, 0xcf, 0xd9, 0x70,
-	0xea, 0x37, 0x77, 0x56, 0x91, 0x21, 0x41, 0x39, 0x09, 0xb2, 0x24, 0x68, 0x57, 0x30, 0xde, 0x7c,
-	0x7a, 0x72, 0x56, 0x2b, 0xfd, 0x3c, 0xab, 0x6d, 0x47, 0x4c, 0x75, 0x07, 0x6d, 0x14, 0x8a, 0x18,
-	0x5b, 0x6c, 0xf3, 0x6b, 0xc8, 0x4e, 0x0f, 0xab, 0x2c, 0xa1, 0x52, 0x17, 0x7c, 0xf9, 0xf3, 0xf5,
-	0x91, 0xd3, 0xaa, 0xc4, 0xd3, 0xb9, 0x7e, 0x0d, 0xac, 0x6b, 0x92, 0x16, 0x3d, 0x22, 0x69, 0x67,
-	0x57, 0xd0, 0x83, 0x03, 0x16, 0x32, 0xca, 0xd5, 0x94, 0x35, 0x03, 0xde, 0xb2, 0x04, 0x8b, 0xfc,
-	0x16, 0xc0, 0x54, 0x8b, 0xfb, 0xe1, 0xb9, 0xaa, 0xd1, 0x2b, 0xcd, 0xba, 0xe5, 0x5b, 0x33, 0x34,
-	0xb2, 0xd3, 0x43, 0x4c, 0xe0, 0x98, 0xa8, 0x2e, 0x7a, 0x49, 0x23, 0x12, 0x66, 0xcf, 0x69, 0x68,
-	0x98, 0xaa, 0xe9, 0xe5, 0x01, 0xfe, 0x0a, 0x80, 0x7a, 0xf4, 0x6b, 0x92, 0x92, 0x58, 0x4e, 0x81,
-	0xf6, 0xc0, 0xad, 0xb9, 0xa8, 0xa5, 0x78, 0x06, 0xca, 0x89, 0x8e, 0xcc, 0x2e, 0xad, 0xf0, 0x30,
-	0x90, 0x29, 0x69, 0x56, 0x72, 0x28, 0x33, 0xd5, 0xd6, 0xec, 0x7c, 0xbb, 0x0e, 0x6e, 0xe8, 0xae,
-	0xf0, 0xd8, 0x01, 0x95, 0x99, 0x2d, 0xb0, 0xbe, 0xa0, 0xcb, 0x42, 0x4f, 0xdd, 0x87, 0xff, 0x91,
-	0x69, 0x50, 0xfd, 0xad, 0x0f, 0xdf, 0x7f, 0x7f, 0xba, 0xb6, 0x01, 0x3d, 0x9c, 0x97, 0x74, 0xe6,
-	0x9f, 0xd0, 0xb9, 0xfb, 0xf0, 0xb3, 0x03, 0xaa, 0x85, 0x6b, 0x87, 0x8f, 0x97, 0x0d, 0x5a, 0x66,
-	0xa1, 0x1b, 0x5c, 0xa1, 0xc2, 0x22, 0x36, 0x34, 0xe2, 0x36, 0xdc, 0xc4, 0xc5, 0x47, 0x5e, 0x34,
-	0x1b, 0xbe, 0x07, 0x65, 0x73, 0xb7, 0x70, 0x73, 0xd9, 0xac, 0x39, 0x13, 0xdd, 0xad, 0x7f, 0xa5,
-	0x59, 0x8e, 0x07, 0x9a, 0x63, 0x0d, 0xae, 0x2e, 0xe0, 0x30, 0xd6, 0x35, 0x5f, 0x9c, 0x8c, 0x3d,
-	0xe7, 0x74, 0xec, 0x39, 0xbf, 0xc6, 0x9e, 0xf3, 0x71, 0xe2, 0x95, 0x4e, 0x27, 0x5e, 0xe9, 0xc7,
-	0xc4, 0x2b, 0xbd, 0x43, 0x17, 0x96, 0x22, 0x2f, 0x6f, 0x70, 0xaa, 0x8e, 0x44, 0xda, 0x33, 0xbd,
-	0x46, 0x17, 0xba, 0xe9, 0x05, 0x69, 0x97, 0xf5, 0xda, 0x3e, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0x33, 0x7a, 0xb1, 0x72, 0x04, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x6f, 0xeb, 0x44,
+	0x10, 0xc7, 0xe3, 0x44, 0xaf, 0x90, 0x0d, 0x3f, 0x5e, 0x96, 0x07, 0x2f, 0x2f, 0xa5, 0x4e, 0x9e,
+	0xa5, 0xd7, 0xa6, 0x45, 0xb5, 0x49, 0xe0, 0xc8, 0x29, 0x05, 0xa9, 0x95, 0x40, 0x94, 0xf4, 0x80,
+	0xc4, 0x01, 0x6b, 0x63, 0x6f, 0x9c, 0xa5, 0xf1, 0xae, 0xb3, 0xbb, 0x49, 0x1b, 0xc4, 0xa9, 0xa7,
+	0xde, 0x40, 0xe2, 0x8f, 0x00, 0x89, 0x0b, 0x7f, 0x46, 0x8f, 0x95, 0xb8, 0x20, 0x0e, 0x15, 0x6a,
+	0x2b, 0xf1, 0x2f, 0x70, 0x44, 0x5e, 0xaf, 0xd3, 0xb4, 0x8e, 0x45, 0x7b, 0xe8, 0xa5, 0xb5, 0xe6,
+	0x3b, 0xb3, 0xf3, 0x99, 0x9d, 0x9d, 0x09, 0x58, 0x1b, 0xa2, 0xf1, 0xd8, 0xf1, 0x18, 0xa1, 0x2c,
+	0x24, 0x9e, 0x70, 0xa6, 0x6d, 0x67, 0x3c, 0xc1, 0x7c, 0x66, 0x47, 0x9c, 0x49, 0x06, 0xab, 0xb1,
+	0x6c, 0xcf, 0x65, 0x7b, 0xda, 0xae, 0x57, 0x51, 0x48, 0x28, 0x73, 0xd4, 0xdf, 0xc4, 0xab, 0xfe,
+	0x2c, 0x60, 0x01, 0x53, 0x9f, 0x4e, 0xfc, 0xa5, 0xad, 0xef, 0x07, 0x8c, 0x05, 0x23, 0xec, 0xa0,
+	0x88, 0x38, 0x88, 0x52, 0x26, 0x91, 0x24, 0x8c, 0x0a, 0xad, 0x9a, 0x1e, 0x13, 0x21, 0x13, 0x4e,
+	0x1f, 0x09, 0xec, 0x4c, 0xdb, 0x7d, 0x2c, 0x51, 0x5b, 0x71, 0x68, 0xbd, 0x91, 0x05, 0x0b, 0x30,
+	0xc5, 0x82, 0xe8, 0x03, 0xac, 0xe7, 0xe0, 0xdd, 0xaf, 0x62, 0xd2, 0x2f, 0xd0, 0xf1, 0xc1, 0x24,
+	0x8a, 0x46, 0xb3, 0x1e, 0x1e, 0x4f, 0xb0, 0x90, 0xd6, 0xa9, 0x01, 0xde, 0xbb, 0xab, 0x88, 0x88,
+	0x51, 0x81, 0x21, 0x05, 0x20, 0x44, 0xc7, 0xae, 0x50, 0xd6, 0x9a, 0xd1, 0x34, 0x5a, 0x95, 0xce,
+	0x0b, 0x3b, 0x21, 0xb1, 0x63, 0x12, 0x5b, 0x93, 0xd8, 0x3b, 0x8c, 0xd0, 0xee, 0xc7, 0x67, 0x17,
+	0x8d, 0xc2, 0x5f, 0x17, 0x8d, 0x8d, 0x80, 0xc8, 0xe1, 0xa4, 0x6f, 0x7b, 0x2c, 0x74, 0x34, 0x76,
+	0xf2, 0x6f, 0x5b, 0xf8, 0x87, 0x8e, 0x9c, 0x45, 0x58, 0xa8, 0x80, 0x5f, 0xff, 0xf9, 0x7d, 0xcb,
+	0xe8, 0x95, 0xc3, 0x34, 0xaf, 0xd5, 0x00, 0x6b, 0x8a, 0xa4, 0x87, 0x8f, 0x10, 0xf7, 0x77, 0x18,
+	0x1e, 0x0c, 0x88, 0x47, 0x30, 0x95, 0x29, 0xeb, 0x0c, 0x98, 0x79, 0x0e, 0x1a, 0xf9, 0x6b, 0x00,
+	0xb9, 0x12, 0x5d, 0xef, 0x46, 0x55, 0xe8, 0xe5, 0x6e, 0x4b, 0xf3, 0xad, 0x26, 0x34, 0xc2, 0x3f,
+	0xb4, 0x09, 0x73, 0x42, 0x24, 0x87, 0xf6, 0xe7, 0x38, 0x40, 0xde, 0xec, 0x53, 0xec, 0x25, 0x4c,
+	0x55, 0x7e, 0x37, 0x81, 0xf5, 0x0c, 0x40, 0x95, 0x7a, 0x1f, 0x71, 0x14, 0x8a, 0x14, 0xe8, 0x00,
+	0xbc, 0x73, 0xcb, 0xaa, 0x29, 0x3e, 0x01, 0x2b, 0x91, 0xb2, 0xcc, 0x2f, 0x2d, 0xf3, 0x30, 0xec,
+	0x24, 0xa4, 0x5b, 0x8e, 0xa1, 0x92, 0xac, 0x3a, 0xc6, 0x3a, 0x31, 0xc0, 0xf3, 0xa4, 0x23, 0x84,
+	0xca, 0x5d, 0x22, 0x24, 0xe3, 0x69, 0xb7, 0xe0, 0x26, 0x78, 0x8a, 0x23, 0xe6, 0x0d, 0x5d, 0xe2,
+	0x63, 0x2a, 0xc9, 0x80, 0x60, 0x9e, 0x54, 0xd7, 0x7b, 0x5b, 0xd9, 0xf7, 0xe6, 0x66, 0xd8, 0x00,
+	0x15, 0x21, 0x11, 0x97, 0xae, 0x12, 0x6a, 0xc5, 0xa6, 0xd1, 0x2a, 0xf5, 0x80, 0x32, 0x7d, 0x16,
+	0x5b, 0xe0, 0x2a, 0x28, 0x63, 0xea, 0x6b, 0xb9, 0xa4, 0xe4, 0xd7, 0x31, 0xf5, 0x95, 0x68, 0x7d,
+	0x0b, 0x6a, 0x59, 0x06, 0x5d, 0x5e, 0x17, 0xbc, 0xc6, 0xb1, 0xc7, 0xb8, 0x1f, 0xd7, 0x57, 0x6a,
+	0x55, 0x3a, 0x6b, 0x4b, 0xea, 0x8b, 0x03, 0x7b, 0xca, 0x6b, 0xb1, 0xc6, 0x34, 0xd0, 0x6a, 0xea,
+	0x56, 0xee, 0x73, 0xf6, 0x1d, 0xf6, 0x24, 0xf6, 0xf7, 0xe8, 0x60, 0xa4, 0x9e, 0x7c, 0x7a, 0xb7,
+	0xd7, 0x45, 0xd0, 0xc8, 0x75, 0xd1, 0x24, 0x3f, 0x80, 0x2a, 0xa2, 0x74, 0x82, 0x46, 0x6e, 0xc4,
+	0xd9, 0x94, 0x88, 0x78, 0x62, 0x1e, 0xeb, 0xa1, 0x3e, 0x4d, 0x32, 0xed, 0xcf, 0x13, 0xc1, 0x2f,
+	0xc1, 0x5b, 0x24, 0x45, 0x72, 0x39, 0x92, 0xb8, 0x56, 0x7c, 0xe0, 0x43, 0x7b, 0x73, 0x1e, 0xdf,
+	0x43, 0x12, 0x43, 0x0e, 0xde, 0x88, 0x30, 0xf5, 0x09, 0x0d, 0xdc, 0x90, 0x50, 0x59, 0x2b, 0x3d,
+	0x4e, 0x25, 0x15, 0x9d, 0x24, 0x6e, 0x51, 0xe7, 0xdf, 0x27, 0xe0, 0x89, 0xba, 0x66, 0x78, 0x6a,
+	0x80, 0xf2, 0x7c, 0x09, 0xc0, 0xd6, 0x92, 0x9e, 0x2e, 0xdd, 0x20, 0xf5, 0xcd, 0x7b, 0x78, 0x26,
+	0xfd, 0xb2, 0xd6, 0x4f, 0xfe, 0xb8, 0xfe, 0xb9, 0xd8, 0x84, 0xa6, 0x13, 0x87, 0xf8, 0xb7, 0x17,
+	0xd6, 0xcd, 0xae, 0x81, 0xbf, 0x18, 0xa0, 0x9a, 0x19, 0x72, 0xf8, 0x61, 0x5e, 0xa2, 0xbc, 0x85,
+	0x51, 0x6f, 0x3f, 0x20, 0x42, 0x23, 0x6e, 0x2b, 0xc4, 0x0d, 0xf8, 0xca, 0xc9, 0xae, 0xd4, 0xec,
+	0x6a, 0x81, 0xdf, 0x83, 0x95, 0x64, 0x92, 0xe1, 0xab, 0xbc, 0x5c, 0xb7, 0x56, 0x46, 0x7d, 0xfd,
+	0xff, 0xdc, 0x34, 0xc7, 0x4b, 0xc5, 0xb1, 0x0a, 0x5f, 0x2c, 0xe1, 0x48, 0x16, 0x05, 0xfc, 0xd1,
+	0x00, 0x95, 0x85, 0xf9, 0x84, 0x5b, 0xb9, 0x8d, 0xc8, 0x2c, 0x92, 0xfa, 0x07, 0xf7, 0xf2, 0xd5,
+	0x2c, 0x1b, 0x8a, 0xe5, 0x25, 0x6c, 0x2c, 0x61, 0x89, 0x1f, 0xaa, 0x3b, 0xd4, 0x04, 0xbf, 0x19,
+	0x00, 0x66, 0xc7, 0x15, 0xe6, 0xb6, 0x21, 0x77, 0xfa, 0xeb, 0x9d, 0x87, 0x84, 0x68, 0x4c, 0x5b,
+	0x61, 0xb6, 0xe0, 0xfa, 0xb2, 0x2b, 0x4b, 0xc3, 0xdc, 0xf9, 0xc8, 0x75, 0x77, 0xcf, 0x2e, 0x4d,
+	0xe3, 0xfc, 0xd2, 0x34, 0xfe, 0xbe, 0x34, 0x8d, 0x9f, 0xae, 0xcc, 0xc2, 0xf9, 0x95, 0x59, 0xf8,
+	0xf3, 0xca, 0x2c, 0x7c, 0x63, 0x2f, 0xcc, 0x53, 0x7c, 0xd6, 0x36, 0xc5, 0xf2, 0x88, 0xf1, 0xc3,
+	0xe4, 0xe0, 0xe3, 0x85, 0xa3, 0xd5, 0x6c, 0xf5, 0x57, 0xd4, 0x8f, 0xec, 0x47, 0xff, 0x0d, 0x00,
+	0x53, 0xef, 0x05, 0xa7, 0x20, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -328,6 +535,11 @@ type QueryClient interface {
 	RewardCoefficient(ctx context.Context, in *QueryRewardCoefficientRequest, opts ...grpc.CallOption) (*QueryRewardCoefficientResponse, error)
 	// Params retrieves coinomics moudle params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MintHistory retrieves the mint records settled within an epoch range.
+	MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error)
+	// ProjectedInflation retrieves the annual provisions and inflation rate
+	// projected from the current state.
+	ProjectedInflation(ctx context.Context, in *QueryProjectedInflationRequest, opts ...grpc.CallOption) (*QueryProjectedInflationResponse, error)
 }
 
 type queryClient struct {
@@ -365,6 +577,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error) {
+	out := new(QueryMintHistoryResponse)
+	err := c.cc.Invoke(ctx, "/haqq.coinomics.v1.Query/MintHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedInflation(ctx context.Context, in *QueryProjectedInflationRequest, opts ...grpc.CallOption) (*QueryProjectedInflationResponse, error) {
+	out := new(QueryProjectedInflationResponse)
+	err := c.cc.Invoke(ctx, "/haqq.coinomics.v1.Query/ProjectedInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// MaxSupply retrieves total coins of all eras and when mint ended.
@@ -373,6 +603,11 @@ type QueryServer interface {
 	RewardCoefficient(context.Context, *QueryRewardCoefficientRequest) (*QueryRewardCoefficientResponse, error)
 	// Params retrieves coinomics moudle params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MintHistory retrieves the mint records settled within an epoch range.
+	MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error)
+	// ProjectedInflation retrieves the annual provisions and inflation rate
+	// projected from the current state.
+	ProjectedInflation(context.Context, *QueryProjectedInflationRequest) (*QueryProjectedInflationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -388,6 +623,12 @@ func (*UnimplementedQueryServer) RewardCoefficient(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) MintHistory(ctx context.Context, req *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedInflation(ctx context.Context, req *QueryProjectedInflationRequest) (*QueryProjectedInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedInflation not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -447,6 +688,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MintHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.coinomics.v1.Query/MintHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintHistory(ctx, req.(*QueryMintHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.coinomics.v1.Query/ProjectedInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedInflation(ctx, req.(*QueryProjectedInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "haqq.coinomics.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -463,6 +740,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MintHistory",
+			Handler:    _Query_MintHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedInflation",
+			Handler:    _Query_ProjectedInflation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/coinomics/v1/query.proto",
@@ -636,6 +921,159 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMintHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PendingMint.Size()
+		i -= size
+		if _, err := m.PendingMint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -707,11 +1145,69 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryMintHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryMintHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectedInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProjectedInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryMaxSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1113,6 +1609,410 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMintHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, MintRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
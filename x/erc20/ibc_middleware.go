@@ -14,6 +14,7 @@ import (
 
 	"github.com/haqq-network/haqq/ibc"
 	"github.com/haqq-network/haqq/x/erc20/keeper"
+	"github.com/haqq-network/haqq/x/erc20/types"
 )
 
 var _ porttypes.IBCModule = &IBCMiddleware{}
@@ -64,7 +65,8 @@ func (im IBCMiddleware) OnRecvPacket(
 
 // OnAcknowledgementPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. Finally, it settles the
+// packet if it was sent through the ICS-20 precompile.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -85,12 +87,23 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
-	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	status := types.PACKET_STATUS_ACKNOWLEDGED
+	if !ack.Success() {
+		status = types.PACKET_STATUS_ACKNOWLEDGEMENT_ERROR
+	}
+	im.keeper.SettleOutgoingPacket(ctx, packet.SourceChannel, packet.Sequence, status)
+
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// Cosmos Coin to their ERC20 token representation. Finally, it settles the
+// packet if it was sent through the ICS-20 precompile.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -105,5 +118,11 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
-	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	im.keeper.SettleOutgoingPacket(ctx, packet.SourceChannel, packet.Sequence, types.PACKET_STATUS_TIMED_OUT)
+
+	return nil
 }
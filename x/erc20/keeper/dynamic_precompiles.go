@@ -130,3 +130,29 @@ func appendPrecompiles(existingPrecompiles []string, addresses ...common.Address
 	utils.SortSlice(updatedPrecompiles)
 	return updatedPrecompiles, nil
 }
+
+// DisableDynamicPrecompiles removes the addresses of the given Precompiles from the list
+// of active dynamic precompiles. Addresses which are not active are ignored.
+func (k Keeper) DisableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error {
+	// Get the current params and remove the precompiles
+	params := k.GetParams(ctx)
+	params.DynamicPrecompiles = removePrecompiles(params.DynamicPrecompiles, addresses...)
+
+	// Update params, the codehash of removed precompiles is unregistered
+	k.Logger(ctx).Info("Removed precompiles", "addresses", addresses)
+	return k.SetParams(ctx, params)
+}
+
+// removePrecompiles returns a copy of existingPrecompiles without the given addresses.
+func removePrecompiles(existingPrecompiles []string, addresses ...common.Address) []string {
+	updatedPrecompiles := make([]string, 0, len(existingPrecompiles))
+	for _, precompile := range existingPrecompiles {
+		if !slices.ContainsFunc(addresses, func(address common.Address) bool {
+			return address.Hex() == precompile
+		}) {
+			updatedPrecompiles = append(updatedPrecompiles, precompile)
+		}
+	}
+
+	return updatedPrecompiles
+}
@@ -86,3 +86,59 @@ func (suite *KeeperTestSuite) TestRegisterERC20CodeHash() {
 
 	}
 }
+
+func (suite *KeeperTestSuite) TestDisableDynamicPrecompiles() {
+	var (
+		ctx           sdk.Context
+		emptyCodeHash = crypto.Keccak256(nil)
+	)
+
+	enabled := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		disable      []common.Address
+		expRemaining []common.Address
+	}{
+		{
+			"ok - disable one of precompiles",
+			[]common.Address{enabled},
+			[]common.Address{other},
+		},
+		{
+			"ok - disable all precompiles",
+			[]common.Address{enabled, other},
+			[]common.Address{},
+		},
+		{
+			"ok - disable not active precompile is ignored",
+			[]common.Address{utiltx.GenerateAddress()},
+			[]common.Address{enabled, other},
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // reset
+		ctx = suite.network.GetContext()
+
+		initial := suite.network.App.Erc20Keeper.GetParams(ctx).DynamicPrecompiles
+		err := suite.network.App.Erc20Keeper.EnableDynamicPrecompiles(ctx, enabled, other)
+		suite.Require().NoError(err)
+
+		err = suite.network.App.Erc20Keeper.DisableDynamicPrecompiles(ctx, tc.disable...)
+		suite.Require().NoError(err, tc.name)
+
+		params := suite.network.App.Erc20Keeper.GetParams(ctx)
+		suite.Require().Len(params.DynamicPrecompiles, len(initial)+len(tc.expRemaining), tc.name)
+		for _, address := range tc.expRemaining {
+			suite.Require().True(params.IsDynamicPrecompile(address), tc.name)
+		}
+		for _, address := range tc.disable {
+			suite.Require().False(params.IsDynamicPrecompile(address), tc.name)
+			acc := suite.network.App.EvmKeeper.GetAccount(ctx, address)
+			if acc != nil {
+				suite.Require().Equal(emptyCodeHash, acc.CodeHash, tc.name)
+			}
+		}
+	}
+}
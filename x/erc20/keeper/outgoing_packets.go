@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/haqq-network/haqq/x/erc20/types"
+	evmtypes "github.com/haqq-network/haqq/x/evm/types"
+)
+
+// SetOutgoingPacket stores the outgoing ICS-20 packet with the given source channel and sequence.
+func (k Keeper) SetOutgoingPacket(ctx sdk.Context, sourceChannel string, sequence uint64, packet types.OutgoingPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOutgoingPacket)
+	store.Set(types.OutgoingPacketKey(sourceChannel, sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetOutgoingPacket returns the outgoing ICS-20 packet with the given source channel and sequence.
+func (k Keeper) GetOutgoingPacket(ctx sdk.Context, sourceChannel string, sequence uint64) (types.OutgoingPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOutgoingPacket)
+	bz := store.Get(types.OutgoingPacketKey(sourceChannel, sequence))
+	if len(bz) == 0 {
+		return types.OutgoingPacket{}, false
+	}
+
+	var packet types.OutgoingPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// RecordOutgoingPacket records a pending ICS-20 packet sent by the given sender. If callback
+// is set, the sender is called back when the packet is acknowledged or times out.
+func (k Keeper) RecordOutgoingPacket(ctx sdk.Context, sourceChannel string, sequence uint64, sender common.Address, callback bool) {
+	k.SetOutgoingPacket(ctx, sourceChannel, sequence, types.OutgoingPacket{
+		Sender:   sender.Hex(),
+		Status:   types.PACKET_STATUS_PENDING,
+		Callback: callback,
+	})
+}
+
+// SettleOutgoingPacket records the status of an outgoing packet once it is acknowledged or
+// timed out, and calls back the sender contract with it if requested. Packets that weren't
+// recorded are ignored. The settled packet only keeps its status, which is pruned
+// SettledOutgoingPacketRetention blocks later.
+//
+// A failed callback doesn't fail the acknowledgement or timeout, as the packet
+// is settled on the IBC side regardless of the sender contract. The gas used by
+// the callback is charged to the relayer either way.
+func (k Keeper) SettleOutgoingPacket(ctx sdk.Context, sourceChannel string, sequence uint64, status types.PacketStatus) {
+	packet, found := k.GetOutgoingPacket(ctx, sourceChannel, sequence)
+	if !found || packet.Status != types.PACKET_STATUS_PENDING {
+		return
+	}
+
+	k.pruneSettledOutgoingPackets(ctx)
+	k.SetOutgoingPacket(ctx, sourceChannel, sequence, types.OutgoingPacket{Status: status})
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSettledOutgoingPacket)
+	store.Set(types.SettledOutgoingPacketKey(ctx.BlockHeight(), sourceChannel, sequence), []byte{})
+
+	if !packet.Callback {
+		return
+	}
+
+	var (
+		method string
+		args   []interface{}
+	)
+	switch status {
+	case types.PACKET_STATUS_TIMED_OUT:
+		method, args = types.OnPacketTimeoutMethod, []interface{}{sourceChannel, sequence}
+	default:
+		method, args = types.OnPacketAcknowledgementMethod, []interface{}{sourceChannel, sequence, status == types.PACKET_STATUS_ACKNOWLEDGED}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, packet.Sender),
+		sdk.NewAttribute(types.AttributeCoinSourceChannel, sourceChannel),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallbackMethod, method),
+	}
+	gasUsed, err := k.callOutgoingPacketSender(ctx, common.HexToAddress(packet.Sender), method, args...)
+	if err != nil {
+		k.Logger(ctx).Error("ics20 callback failed", "sender", packet.Sender, "method", method, "error", err.Error())
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+	ctx.GasMeter().ConsumeGas(gasUsed, "ics20 callback")
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeICS20Callback, attrs...))
+}
+
+// pruneSettledOutgoingPackets deletes up to MaxPrunedOutgoingPackets outgoing packets
+// settled at least SettledOutgoingPacketRetention blocks ago. As every settlement prunes
+// more than one packet, the expired packets don't pile up.
+func (k Keeper) pruneSettledOutgoingPackets(ctx sdk.Context) {
+	expiry := ctx.BlockHeight() - types.SettledOutgoingPacketRetention
+	if expiry < 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSettledOutgoingPacket)
+	packets := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOutgoingPacket)
+
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(expiry)+1)) //nolint: gosec // G115 expiry is not negative
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedOutgoingPackets; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// the key is the settlement height followed by the outgoing packet key
+		packets.Delete(key[8:])
+		store.Delete(key)
+	}
+}
+
+// callOutgoingPacketSender calls the given callback method of the sender contract with
+// the ICS20CallbackGasLimit and returns the EVM gas used by the call. The state changes
+// are only committed if the call succeeds.
+func (k Keeper) callOutgoingPacketSender(ctx sdk.Context, sender common.Address, method string, args ...interface{}) (gasUsed uint64, err error) {
+	data, err := types.ICS20CallbacksABI.Pack(method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&sender,
+		nonce,
+		big.NewInt(0),               // amount
+		types.ICS20CallbackGasLimit, // gasLimit
+		big.NewInt(0),               // gasFeeCap
+		big.NewInt(0),               // gasTipCap
+		big.NewInt(0),               // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// the callback runs arbitrary contract code, so it must not panic the packet handling,
+	// in which case the whole gas limit is charged
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed, err = types.ICS20CallbackGasLimit, fmt.Errorf("callback panicked: %v", r)
+		}
+	}()
+
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return 0, err
+	}
+	if res.Failed() {
+		return res.GasUsed, fmt.Errorf("%s: %s", evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	writeCache()
+	return res.GasUsed, nil
+}
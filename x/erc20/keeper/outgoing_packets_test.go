@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
+	"github.com/haqq-network/haqq/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSettleOutgoingPacket() {
+	var sender common.Address
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		record        bool
+		callback      bool
+		status        types.PacketStatus
+		expCallback   bool
+		expCallbackOK bool
+		expGasCharged bool
+	}{
+		{
+			"packet not sent through the precompile",
+			func() {},
+			false, false, types.PACKET_STATUS_ACKNOWLEDGED, false, false, false,
+		},
+		{
+			"acknowledged without callback",
+			func() {},
+			true, false, types.PACKET_STATUS_ACKNOWLEDGED, false, false, false,
+		},
+		{
+			"acknowledgement error with callback to an account without code",
+			func() {},
+			true, true, types.PACKET_STATUS_ACKNOWLEDGEMENT_ERROR, true, true, true,
+		},
+		{
+			"timed out with callback to a contract that reverts",
+			func() {
+				var err error
+				// the ERC20 contract doesn't implement the callbacks, so the call reverts
+				sender, err = suite.DeployContract("coin", "token", 18)
+				suite.Require().NoError(err)
+			},
+			true, true, types.PACKET_STATUS_TIMED_OUT, true, false, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender = utiltx.GenerateAddress()
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			if tc.record {
+				suite.network.App.Erc20Keeper.RecordOutgoingPacket(ctx, "channel-0", 1, sender, tc.callback)
+			}
+
+			gasMeter := storetypes.NewGasMeter(1_000_000)
+			ctx = ctx.WithGasMeter(gasMeter)
+			suite.network.App.Erc20Keeper.SettleOutgoingPacket(ctx, "channel-0", 1, tc.status)
+			gasConsumed := gasMeter.GasConsumed()
+
+			// the settled packet only keeps its status
+			packet, found := suite.network.App.Erc20Keeper.GetOutgoingPacket(ctx, "channel-0", 1)
+			suite.Require().Equal(tc.record, found)
+			if tc.record {
+				suite.Require().Equal(types.OutgoingPacket{Status: tc.status}, packet)
+			}
+
+			var callbackEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeICS20Callback {
+					continue
+				}
+				callbackEvents++
+				_, failed := event.GetAttribute(types.AttributeKeyCallbackError)
+				suite.Require().Equal(!tc.expCallbackOK, failed)
+			}
+			if tc.expCallback {
+				suite.Require().Equal(1, callbackEvents)
+			} else {
+				suite.Require().Zero(callbackEvents)
+			}
+
+			// the EVM gas used by the callback is charged on top of the store gas
+			if tc.expGasCharged {
+				suite.Require().GreaterOrEqual(gasConsumed, params.TxGas)
+			} else {
+				suite.Require().Less(gasConsumed, params.TxGas)
+			}
+
+			// a settled packet is not settled again
+			suite.network.App.Erc20Keeper.SettleOutgoingPacket(ctx, "channel-0", 1, types.PACKET_STATUS_ACKNOWLEDGED)
+			var events int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeICS20Callback {
+					events++
+				}
+			}
+			suite.Require().Equal(callbackEvents, events)
+			packet, _ = suite.network.App.Erc20Keeper.GetOutgoingPacket(ctx, "channel-0", 1)
+			if tc.record {
+				suite.Require().Equal(tc.status, packet.Status)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneSettledOutgoingPackets() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.Erc20Keeper
+	sender := utiltx.GenerateAddress()
+
+	// settle three packets at the same height
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		keeper.RecordOutgoingPacket(ctx, "channel-0", sequence, sender, false)
+		keeper.SettleOutgoingPacket(ctx, "channel-0", sequence, types.PACKET_STATUS_ACKNOWLEDGED)
+	}
+
+	// the settled packets are kept during the retention period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.SettledOutgoingPacketRetention - 1)
+	keeper.RecordOutgoingPacket(ctx, "channel-0", 4, sender, false)
+	keeper.SettleOutgoingPacket(ctx, "channel-0", 4, types.PACKET_STATUS_TIMED_OUT)
+	for sequence := uint64(1); sequence <= 4; sequence++ {
+		_, found := keeper.GetOutgoingPacket(ctx, "channel-0", sequence)
+		suite.Require().True(found, "sequence %d", sequence)
+	}
+
+	// every settlement prunes up to two expired packets, pending packets are kept
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.RecordOutgoingPacket(ctx, "channel-0", 5, sender, false)
+	keeper.RecordOutgoingPacket(ctx, "channel-0", 6, sender, false)
+	keeper.SettleOutgoingPacket(ctx, "channel-0", 5, types.PACKET_STATUS_ACKNOWLEDGED)
+	for sequence, expFound := range map[uint64]bool{1: false, 2: false, 3: true, 4: true, 5: true, 6: true} {
+		_, found := keeper.GetOutgoingPacket(ctx, "channel-0", sequence)
+		suite.Require().Equal(expFound, found, "sequence %d", sequence)
+	}
+
+	keeper.SettleOutgoingPacket(ctx, "channel-0", 6, types.PACKET_STATUS_ACKNOWLEDGED)
+	_, found := keeper.GetOutgoingPacket(ctx, "channel-0", 3)
+	suite.Require().False(found)
+	packet, found := keeper.GetOutgoingPacket(ctx, "channel-0", 4)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PACKET_STATUS_TIMED_OUT, packet.Status)
+}
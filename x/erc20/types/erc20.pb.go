@@ -56,6 +56,46 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// PacketStatus enumerates the status of an outgoing ICS-20 packet.
+type PacketStatus int32
+
+const (
+	// PACKET_STATUS_UNSPECIFIED defines an unknown packet.
+	PACKET_STATUS_UNSPECIFIED PacketStatus = 0
+	// PACKET_STATUS_PENDING - the packet is neither acknowledged nor timed out.
+	PACKET_STATUS_PENDING PacketStatus = 1
+	// PACKET_STATUS_ACKNOWLEDGED - the packet is acknowledged with a success.
+	PACKET_STATUS_ACKNOWLEDGED PacketStatus = 2
+	// PACKET_STATUS_ACKNOWLEDGEMENT_ERROR - the packet is acknowledged with an error.
+	PACKET_STATUS_ACKNOWLEDGEMENT_ERROR PacketStatus = 3
+	// PACKET_STATUS_TIMED_OUT - the packet timed out.
+	PACKET_STATUS_TIMED_OUT PacketStatus = 4
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_ACKNOWLEDGED",
+	3: "PACKET_STATUS_ACKNOWLEDGEMENT_ERROR",
+	4: "PACKET_STATUS_TIMED_OUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNSPECIFIED":           0,
+	"PACKET_STATUS_PENDING":               1,
+	"PACKET_STATUS_ACKNOWLEDGED":          2,
+	"PACKET_STATUS_ACKNOWLEDGEMENT_ERROR": 3,
+	"PACKET_STATUS_TIMED_OUT":             4,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 // Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
@@ -130,6 +170,73 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// OutgoingPacket records an ICS-20 packet sent through the ICS-20 precompile,
+// so the sender can be called back and query its status once the packet is
+// relayed. A settled packet only keeps its status, which is pruned after a
+// retention period.
+type OutgoingPacket struct {
+	// sender is the hex address of the packet sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// status of the packet
+	Status PacketStatus `protobuf:"varint,2,opt,name=status,proto3,enum=evmos.erc20.v1.PacketStatus" json:"status,omitempty"`
+	// callback defines if the sender contract is called back on acknowledgement or timeout
+	Callback bool `protobuf:"varint,3,opt,name=callback,proto3" json:"callback,omitempty"`
+}
+
+func (m *OutgoingPacket) Reset()         { *m = OutgoingPacket{} }
+func (m *OutgoingPacket) String() string { return proto.CompactTextString(m) }
+func (*OutgoingPacket) ProtoMessage()    {}
+func (*OutgoingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *OutgoingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingPacket.Merge(m, src)
+}
+func (m *OutgoingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingPacket proto.InternalMessageInfo
+
+func (m *OutgoingPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *OutgoingPacket) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PACKET_STATUS_UNSPECIFIED
+}
+
+func (m *OutgoingPacket) GetCallback() bool {
+	if m != nil {
+		return m.Callback
+	}
+	return false
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -147,7 +254,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +316,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +368,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +435,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +487,9 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*OutgoingPacket)(nil), "evmos.erc20.v1.OutgoingPacket")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -390,39 +499,49 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0x53, 0x6d, 0xa6, 0x6d, 0x58, 0x87, 0x04, 0x96, 0x40, 0xb7, 0x4b, 0x04, 0x09,
-	0x05, 0x77, 0x9b, 0x78, 0x13, 0x41, 0xda, 0x64, 0x85, 0x4a, 0x9b, 0x84, 0x6d, 0x8a, 0xe2, 0x25,
-	0xcc, 0xee, 0x0e, 0xdb, 0x25, 0xc9, 0x4c, 0x3a, 0x33, 0x6e, 0xf5, 0xe0, 0xdd, 0xa3, 0x17, 0xef,
-	0x82, 0xfe, 0x31, 0x3d, 0xf6, 0xe8, 0x49, 0x24, 0xb9, 0xf8, 0x67, 0xc8, 0xce, 0xec, 0x8a, 0xf5,
-	0x68, 0x6f, 0xef, 0xfb, 0xde, 0x0f, 0xde, 0xf7, 0xbd, 0x19, 0xd8, 0x24, 0xd9, 0x9c, 0x09, 0x8f,
-	0xf0, 0xa8, 0x7b, 0xe0, 0x65, 0x1d, 0x1d, 0xb8, 0x0b, 0xce, 0x24, 0x43, 0x35, 0x95, 0x73, 0x35,
-	0x95, 0x75, 0x9a, 0x76, 0xc4, 0x44, 0x5e, 0x1c, 0x62, 0x3a, 0xf5, 0xb2, 0x4e, 0x48, 0x24, 0xee,
-	0x28, 0xa0, 0xeb, 0x9b, 0xf5, 0x84, 0x25, 0x4c, 0x85, 0x5e, 0x1e, 0x69, 0xb6, 0xf5, 0x0d, 0xc0,
-	0xea, 0x98, 0x4d, 0x09, 0x1d, 0xe1, 0x94, 0xa3, 0x87, 0x70, 0x47, 0xcd, 0x9b, 0xe0, 0x38, 0xe6,
-	0x44, 0x08, 0x0b, 0x38, 0xa0, 0x5d, 0x0d, 0xb6, 0x15, 0x79, 0xa8, 0x39, 0x54, 0x87, 0x1b, 0x31,
-	0xa1, 0x6c, 0x6e, 0xad, 0xa9, 0xa4, 0x06, 0xc8, 0x82, 0xf7, 0x09, 0xc5, 0xe1, 0x8c, 0xc4, 0xd6,
-	0xba, 0x03, 0xda, 0x9b, 0x41, 0x09, 0xd1, 0x33, 0x58, 0x8b, 0x18, 0x95, 0x1c, 0x47, 0x72, 0xc2,
-	0xae, 0x28, 0xe1, 0x56, 0xc5, 0x01, 0xed, 0x5a, 0xb7, 0xe1, 0xde, 0x56, 0xe0, 0x0e, 0xf3, 0x64,
-	0xb0, 0x53, 0x16, 0x2b, 0xf8, 0xb4, 0xf2, 0xeb, 0xcb, 0x1e, 0x68, 0x7d, 0x06, 0xb0, 0x1e, 0x90,
-	0x24, 0x15, 0x92, 0xf0, 0x1e, 0x4b, 0xe9, 0x88, 0xb3, 0x05, 0x13, 0x78, 0x96, 0x2f, 0x23, 0x53,
-	0x39, 0x23, 0xc5, 0xa6, 0x1a, 0x20, 0x07, 0x6e, 0xc5, 0x44, 0x44, 0x3c, 0x5d, 0xc8, 0x94, 0xd1,
-	0x62, 0xd1, 0xbf, 0x29, 0xf4, 0x1c, 0x6e, 0xce, 0x89, 0xc4, 0x31, 0x96, 0xd8, 0x5a, 0x77, 0xd6,
-	0xdb, 0x5b, 0xdd, 0x5d, 0x57, 0x1b, 0xe8, 0x2a, 0xcf, 0x0a, 0x03, 0xdd, 0xd3, 0xa2, 0xe8, 0xa8,
-	0x72, 0xfd, 0x63, 0xcf, 0x08, 0xfe, 0x34, 0xa9, 0xbd, 0x8c, 0xd6, 0x19, 0x34, 0xcb, 0x55, 0xca,
-	0xca, 0x5b, 0xa3, 0xc1, 0x7f, 0x8c, 0x6e, 0x7d, 0x80, 0x8d, 0x52, 0xab, 0x1f, 0xf4, 0xba, 0x07,
-	0x77, 0x16, 0xfb, 0x08, 0xd6, 0x94, 0xc9, 0xc5, 0x55, 0x89, 0x50, 0x92, 0xab, 0xc1, 0x3f, 0x6c,
-	0xa1, 0x49, 0xc0, 0xdd, 0x31, 0x4b, 0x92, 0x19, 0x51, 0xef, 0xa2, 0xc7, 0x68, 0x46, 0xb8, 0x48,
-	0xd9, 0xdd, 0x3d, 0xcf, 0xfb, 0xf2, 0x91, 0xea, 0x81, 0xe4, 0x7d, 0x39, 0xd0, 0x07, 0xde, 0x7f,
-	0x09, 0x37, 0xd4, 0xbd, 0x51, 0x03, 0x3e, 0x18, 0xbe, 0x1a, 0xf8, 0xc1, 0xe4, 0x7c, 0x70, 0x36,
-	0xf2, 0x7b, 0xc7, 0x2f, 0x8e, 0xfd, 0xbe, 0x69, 0x20, 0x13, 0x6e, 0x6b, 0xfa, 0x74, 0xd8, 0x3f,
-	0x3f, 0xf1, 0x4d, 0x80, 0x10, 0xac, 0x69, 0xc6, 0x7f, 0x3d, 0xf6, 0x83, 0xc1, 0xe1, 0x89, 0xb9,
-	0xd6, 0xac, 0x7c, 0xfc, 0x6a, 0x1b, 0x47, 0xfd, 0xeb, 0xa5, 0x0d, 0x6e, 0x96, 0x36, 0xf8, 0xb9,
-	0xb4, 0xc1, 0xa7, 0x95, 0x6d, 0xdc, 0xac, 0x6c, 0xe3, 0xfb, 0xca, 0x36, 0xde, 0xec, 0x27, 0xa9,
-	0xbc, 0x78, 0x1b, 0xba, 0x11, 0x9b, 0x7b, 0x17, 0xf8, 0xf2, 0xf2, 0x31, 0x25, 0xf2, 0x8a, 0xf1,
-	0xa9, 0x02, 0xde, 0xbb, 0xe2, 0xa3, 0xc9, 0xf7, 0x0b, 0x22, 0xc2, 0x7b, 0xea, 0x83, 0x3c, 0xf9,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xab, 0x51, 0xd3, 0x84, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xd0, 0x82, 0x30, 0x40, 0xb3, 0x4e, 0xa8, 0x96, 0x2a, 0x4b, 0x53, 0x12, 0x6d, 0x48,
+	0xdc, 0xd2, 0xea, 0xc9, 0x98, 0x98, 0xd2, 0x1d, 0x49, 0x85, 0xee, 0x36, 0xdb, 0x6d, 0x30, 0x5e,
+	0x9a, 0xe9, 0xee, 0x64, 0xd9, 0xb4, 0xdd, 0x29, 0x3b, 0x43, 0x51, 0x13, 0xef, 0x1e, 0xbd, 0x78,
+	0x37, 0xd1, 0x3f, 0xc1, 0x3f, 0x82, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0xc5, 0x3f, 0xc3, 0xec, 0xec,
+	0x96, 0x50, 0x12, 0x2f, 0x72, 0x9b, 0xef, 0x7b, 0xdf, 0x7b, 0xfb, 0xbd, 0x1f, 0x59, 0x58, 0xa0,
+	0x93, 0x11, 0xe3, 0x15, 0x1a, 0x3a, 0xb5, 0x9d, 0xca, 0xa4, 0x1a, 0x3f, 0xb4, 0x71, 0xc8, 0x04,
+	0x43, 0x59, 0x19, 0xd3, 0x62, 0x6a, 0x52, 0x2d, 0xa8, 0x0e, 0xe3, 0x91, 0xb8, 0x4f, 0x82, 0x41,
+	0x65, 0x52, 0xed, 0x53, 0x41, 0xaa, 0x12, 0xc4, 0xfa, 0xc2, 0x9a, 0xc7, 0x3c, 0x26, 0x9f, 0x95,
+	0xe8, 0x15, 0xb3, 0xa5, 0xef, 0x00, 0x2e, 0xd9, 0x6c, 0x40, 0x83, 0x36, 0xf1, 0x43, 0xb4, 0x05,
+	0x57, 0x65, 0xbd, 0x1e, 0x71, 0xdd, 0x90, 0x72, 0x9e, 0x07, 0x45, 0x50, 0x5e, 0xb2, 0x56, 0x24,
+	0x59, 0x8f, 0x39, 0xb4, 0x06, 0xe7, 0x5d, 0x1a, 0xb0, 0x51, 0x7e, 0x4e, 0x06, 0x63, 0x80, 0xf2,
+	0xf0, 0x0e, 0x0d, 0x48, 0x7f, 0x48, 0xdd, 0x7c, 0xba, 0x08, 0xca, 0x8b, 0xd6, 0x14, 0xa2, 0x17,
+	0x30, 0xeb, 0xb0, 0x40, 0x84, 0xc4, 0x11, 0x3d, 0x76, 0x1a, 0xd0, 0x30, 0x9f, 0x29, 0x82, 0x72,
+	0xb6, 0x96, 0xd3, 0x66, 0x3b, 0xd0, 0xcc, 0x28, 0x68, 0xad, 0x4e, 0xc5, 0x12, 0x3e, 0xcf, 0xfc,
+	0xf9, 0xba, 0x09, 0x4a, 0x1f, 0x60, 0xd6, 0x3c, 0x11, 0x1e, 0xf3, 0x03, 0xaf, 0x4d, 0x9c, 0x01,
+	0x15, 0xe8, 0x1e, 0x5c, 0xe0, 0x34, 0x70, 0x69, 0x98, 0x78, 0x4c, 0x10, 0x7a, 0x06, 0x17, 0xb8,
+	0x20, 0xe2, 0x84, 0x4b, 0x7b, 0xd9, 0xda, 0xc3, 0x9b, 0x5f, 0x89, 0xf3, 0x3b, 0x52, 0x63, 0x25,
+	0x5a, 0x54, 0x80, 0x8b, 0x0e, 0x19, 0x0e, 0xfb, 0xc4, 0x19, 0x24, 0xf6, 0xaf, 0x70, 0xe9, 0x0b,
+	0x80, 0x6b, 0x16, 0xf5, 0x7c, 0x2e, 0x68, 0xd8, 0x60, 0x7e, 0xd0, 0x0e, 0xd9, 0x98, 0x71, 0x32,
+	0x8c, 0x06, 0x21, 0x7c, 0x31, 0xa4, 0x89, 0x83, 0x18, 0xa0, 0x22, 0x5c, 0x76, 0x29, 0x77, 0x42,
+	0x7f, 0x2c, 0x7c, 0x16, 0x24, 0x43, 0xba, 0x4e, 0xa1, 0x97, 0x70, 0x71, 0x44, 0x05, 0x71, 0x89,
+	0x20, 0xf9, 0x74, 0x31, 0x5d, 0x5e, 0xae, 0x6d, 0x68, 0xf1, 0xf2, 0x34, 0xb9, 0xaf, 0x64, 0x79,
+	0x5a, 0x2b, 0x11, 0xed, 0x66, 0xce, 0x7e, 0x6d, 0xa6, 0xac, 0xab, 0x24, 0x39, 0x93, 0x54, 0xa9,
+	0x03, 0x95, 0xa9, 0x95, 0xa9, 0x72, 0xa6, 0x34, 0xf8, 0x8f, 0xd2, 0xa5, 0x8f, 0x30, 0x37, 0xed,
+	0x15, 0x5b, 0x8d, 0xda, 0xce, 0xad, 0x9b, 0x7d, 0x04, 0xb3, 0x72, 0xf4, 0xc9, 0x45, 0x51, 0x2e,
+	0x5b, 0x5e, 0xb2, 0x6e, 0xb0, 0x49, 0x4f, 0x1c, 0x6e, 0xd8, 0xcc, 0xf3, 0x86, 0x54, 0xde, 0x64,
+	0x83, 0x05, 0x13, 0x1a, 0x72, 0x9f, 0xdd, 0x7e, 0xe6, 0x51, 0x5e, 0x54, 0x32, 0x9f, 0x4e, 0xf2,
+	0x22, 0x10, 0x1f, 0xd7, 0xf6, 0x6b, 0x38, 0x2f, 0x6f, 0x0d, 0xe5, 0xe0, 0x5d, 0xf3, 0xd0, 0xc0,
+	0x56, 0xaf, 0x6b, 0x74, 0xda, 0xb8, 0xd1, 0x7c, 0xd5, 0xc4, 0xba, 0x92, 0x42, 0x0a, 0x5c, 0x89,
+	0xe9, 0x96, 0xa9, 0x77, 0x0f, 0xb0, 0x02, 0x10, 0x82, 0xd9, 0x98, 0xc1, 0x6f, 0x6c, 0x6c, 0x19,
+	0xf5, 0x03, 0x65, 0xae, 0x90, 0xf9, 0xf4, 0x4d, 0x4d, 0x6d, 0xff, 0x00, 0x70, 0xe5, 0xfa, 0x85,
+	0xa1, 0x0d, 0xb8, 0xde, 0xae, 0x37, 0xf6, 0xb1, 0xdd, 0xeb, 0xd8, 0x75, 0xbb, 0xdb, 0xb9, 0x51,
+	0x7b, 0x1d, 0xe6, 0x66, 0xc3, 0x6d, 0x6c, 0xe8, 0x4d, 0x63, 0x4f, 0x01, 0x48, 0x85, 0x85, 0xd9,
+	0x50, 0xbd, 0xb1, 0x6f, 0x98, 0x87, 0x07, 0x58, 0xdf, 0xc3, 0xba, 0x32, 0x87, 0x1e, 0xc3, 0xad,
+	0x7f, 0xc6, 0x5b, 0xd8, 0xb0, 0x7b, 0xd8, 0xb2, 0x4c, 0x4b, 0x49, 0xa3, 0x07, 0xf0, 0xfe, 0xac,
+	0xd0, 0x6e, 0xb6, 0xb0, 0xde, 0x33, 0xbb, 0xb6, 0x92, 0x89, 0x6d, 0xef, 0xea, 0x67, 0x17, 0x2a,
+	0x38, 0xbf, 0x50, 0xc1, 0xef, 0x0b, 0x15, 0x7c, 0xbe, 0x54, 0x53, 0xe7, 0x97, 0x6a, 0xea, 0xe7,
+	0xa5, 0x9a, 0x7a, 0xbb, 0xed, 0xf9, 0xe2, 0xe8, 0xa4, 0xaf, 0x39, 0x6c, 0x54, 0x39, 0x22, 0xc7,
+	0xc7, 0x4f, 0x02, 0x2a, 0x4e, 0x59, 0x38, 0x90, 0xa0, 0xf2, 0x2e, 0xf9, 0x37, 0x89, 0xf7, 0x63,
+	0xca, 0xfb, 0x0b, 0xf2, 0x9f, 0xf2, 0xf4, 0xef, 0x00, 0xfd, 0x29, 0xd3, 0x12, 0xb7, 0x04, 0x00,
+	0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -540,6 +659,51 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutgoingPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Callback {
+		i--
+		if m.Callback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -752,6 +916,25 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *OutgoingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovErc20(uint64(m.Status))
+	}
+	if m.Callback {
+		n += 2
+	}
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -993,6 +1176,127 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OutgoingPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Callback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,11 +16,15 @@ const (
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
 	EventTypeUpdateCoinMetadata     = "update_coin_metadata" // #nosec
+	EventTypeICS20Callback          = "ics20_callback"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyPacketSequence = "packet_sequence"
+	AttributeKeyCallbackMethod = "callback_method"
+	AttributeKeyCallbackError  = "callback_error"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
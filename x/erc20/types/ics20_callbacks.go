@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// SourceCallbackMemoKey is the ICS-20 memo key under which the sender requests
+	// to be called back on the acknowledgement or timeout of its packet, e.g.
+	// {"src_callback": {"address": "0x..."}}.
+	SourceCallbackMemoKey = "src_callback"
+
+	// OnPacketAcknowledgementMethod defines the callback method called on the packet acknowledgement.
+	OnPacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// OnPacketTimeoutMethod defines the callback method called on the packet timeout.
+	OnPacketTimeoutMethod = "onPacketTimeout"
+
+	// ICS20CallbackGasLimit is the EVM gas limit of a callback into the sender contract.
+	ICS20CallbackGasLimit uint64 = 200_000
+
+	// SettledOutgoingPacketRetention is the number of blocks the status of a settled
+	// outgoing packet is kept for, so the sender can still query it.
+	SettledOutgoingPacketRetention int64 = 100_000
+	// MaxPrunedOutgoingPackets is the maximum number of expired settled outgoing packets
+	// pruned when a packet settles.
+	MaxPrunedOutgoingPackets = 2
+)
+
+// ics20CallbacksJSON is the ABI of the IICS20Callbacks interface implemented by
+// the contracts that request ICS-20 callbacks.
+const ics20CallbacksJSON = `[
+  {
+    "inputs": [
+      {"internalType": "string", "name": "sourceChannel", "type": "string"},
+      {"internalType": "uint64", "name": "sequence", "type": "uint64"},
+      {"internalType": "bool", "name": "success", "type": "bool"}
+    ],
+    "name": "onPacketAcknowledgement",
+    "outputs": [],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {"internalType": "string", "name": "sourceChannel", "type": "string"},
+      {"internalType": "uint64", "name": "sequence", "type": "uint64"}
+    ],
+    "name": "onPacketTimeout",
+    "outputs": [],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  }
+]`
+
+// ICS20CallbacksABI is the parsed ABI of the IICS20Callbacks interface.
+var ICS20CallbacksABI abi.ABI
+
+func init() {
+	var err error
+	if ICS20CallbacksABI, err = abi.JSON(strings.NewReader(ics20CallbacksJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// ParseSourceCallbackAddress returns the callback address requested in the memo
+// of an ICS-20 transfer. The boolean is false when the memo doesn't request a callback.
+func ParseSourceCallbackAddress(memo string) (common.Address, bool, error) {
+	data := transfertypes.FungibleTokenPacketData{Memo: memo}.GetCustomPacketData(SourceCallbackMemoKey)
+	if data == nil {
+		return common.Address{}, false, nil
+	}
+
+	callback, ok := data.(map[string]interface{})
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid %s memo: expected an object", SourceCallbackMemoKey)
+	}
+
+	address, ok := callback["address"].(string)
+	if !ok || !common.IsHexAddress(address) {
+		return common.Address{}, false, fmt.Errorf("invalid %s memo: invalid callback address %v", SourceCallbackMemoKey, callback["address"])
+	}
+
+	return common.HexToAddress(address), true, nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/haqq-network/haqq/x/erc20/types"
+)
+
+func TestParseSourceCallbackAddress(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expAddr     common.Address
+		expCallback bool
+		expPass     bool
+	}{
+		{"empty memo", "", common.Address{}, false, true},
+		{"plain text memo", "memo", common.Address{}, false, true},
+		{"memo without callback", `{"forward": {"port": "transfer"}}`, common.Address{}, false, true},
+		{"callback", `{"src_callback": {"address": "` + addr.Hex() + `"}}`, addr, true, true},
+		{"callback with other keys", `{"forward": {}, "src_callback": {"address": "` + addr.Hex() + `", "gas_limit": "1"}}`, addr, true, true},
+		{"callback is not an object", `{"src_callback": "` + addr.Hex() + `"}`, common.Address{}, false, false},
+		{"callback without address", `{"src_callback": {}}`, common.Address{}, false, false},
+		{"callback with bech32 address", `{"src_callback": {"address": "haqq1"}}`, common.Address{}, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			callbackAddr, callback, err := types.ParseSourceCallbackAddress(tc.memo)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCallback, callback)
+			require.Equal(t, tc.expAddr, callbackAddr)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -33,12 +34,29 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixOutgoingPacket
+	prefixSettledOutgoingPacket
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
-	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixTokenPair             = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20      = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom      = []byte{prefixTokenPairByDenom}
+	KeyPrefixSTRv2Addresses        = []byte{prefixSTRv2Addresses}
+	KeyPrefixOutgoingPacket        = []byte{prefixOutgoingPacket}
+	KeyPrefixSettledOutgoingPacket = []byte{prefixSettledOutgoingPacket}
 )
+
+// OutgoingPacketKey returns the store key of the outgoing packet with the given
+// source channel and sequence, relative to KeyPrefixOutgoingPacket.
+func OutgoingPacketKey(sourceChannel string, sequence uint64) []byte {
+	return append([]byte(sourceChannel+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SettledOutgoingPacketKey returns the store key of the settled outgoing packet with the given
+// source channel and sequence, relative to KeyPrefixSettledOutgoingPacket. The keys are ordered
+// by the settlement height, so the expired packets are pruned first.
+func SettledOutgoingPacketKey(height int64, sourceChannel string, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), OutgoingPacketKey(sourceChannel, sequence)...) //nolint: gosec // G115 block height is never negative
+}
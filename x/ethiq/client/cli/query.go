@@ -27,8 +27,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryTotalBurned(),
 		GetCmdQueryCalculate(),
+		GetCmdQueryCalculateBreakdown(),
 		GetCmdQueryCalculateForApplication(),
 		GetCmdQueryGetApplications(),
+		GetCmdQueryPriceLevels(),
 		GetCmdQueryParams(),
 	)
 
@@ -116,6 +118,51 @@ Example:
 	return cmd
 }
 
+func GetCmdQueryCalculateBreakdown() *cobra.Command { //nolint: dupl // similar, but not the duplicate
+	cmd := &cobra.Command{
+		Use:   "calculate-breakdown [islm-amount]",
+		Short: "Calculate the estimated aHAQQ amount to be minted for a given aISLM amount split by price levels",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Calculate the estimated aHAQQ amount to be minted in exchange for the given amount of aISLM coins,
+split by the price levels the burn spreads over.
+
+Example:
+  $ %s query %s calculate-breakdown 1000000000000000000
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			islmAmount, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid islm_amount: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.CalculateBreakdown(ctx, &types.QueryCalculateBreakdownRequest{
+				IslmAmount: islmAmount.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryCalculateForApplication() *cobra.Command { //nolint: dupl // similar, but not the duplicate
 	cmd := &cobra.Command{
 		Use:   "calculate-for-app [app_id]",
@@ -200,6 +247,43 @@ Example:
 	return cmd
 }
 
+func GetCmdQueryPriceLevels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-levels",
+		Short: "Query the aISLM to aHAQQ burn price curve",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the price levels of the aISLM to aHAQQ burn price curve.
+
+Example:
+  $ %s query %s price-levels
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.PriceLevels(ctx, &types.QueryPriceLevelsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
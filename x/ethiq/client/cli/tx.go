@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,8 @@ func NewTxCmd() *cobra.Command {
 	ethiqTxCmd.AddCommand(
 		NewMintHaqqCmd(),
 		NewMintHaqqByApplicationCmd(),
+		NewSubmitBurnApplicationCmd(),
+		NewCancelBurnApplicationCmd(),
 	)
 
 	return ethiqTxCmd
@@ -123,3 +126,112 @@ $ %s tx %s mint-by-app 1 --from mykey
 
 	return cmd
 }
+
+// NewSubmitBurnApplicationCmd returns a CLI command handler for creating a MsgSubmitBurnApplication transaction.
+func NewSubmitBurnApplicationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-application [burn_amount] [to_address] [source]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a burn application of the given aISLM coins, waiting for governance approval",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a burn application of the given aISLM coins, waiting for governance approval.
+The source of funds is either BANK or UCDAO.
+
+Example:
+$ %s tx %s submit-application 1000000000000000000aISLM haqq1tjdjfavsy956d25hvhs3p0nw9a7pfghqm0up92 BANK --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			burnAmount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid burn_amount: %v", err)
+			}
+
+			toAddress, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid to_address: %v", err)
+			}
+
+			source, err := parseSourceOfFunds(args[2])
+			if err != nil {
+				return err
+			}
+
+			fromAddress := clientCtx.GetFromAddress()
+
+			msg := &types.MsgSubmitBurnApplication{
+				FromAddress: fromAddress.String(),
+				ToAddress:   toAddress.String(),
+				Source:      source,
+				BurnAmount:  burnAmount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelBurnApplicationCmd returns a CLI command handler for creating a MsgCancelBurnApplication transaction.
+func NewCancelBurnApplicationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-application [app_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the submitted burn application which is not executed yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the submitted burn application which is not executed yet
+
+Example:
+$ %s tx %s cancel-application 1 --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid app_id: %s", args[0])
+			}
+
+			fromAddress := clientCtx.GetFromAddress()
+
+			msg := &types.MsgCancelBurnApplication{
+				FromAddress:   fromAddress.String(),
+				ApplicationId: appID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseSourceOfFunds parses the source of funds by its short (BANK, UCDAO) or full enum name
+func parseSourceOfFunds(arg string) (types.SourceOfFunds, error) {
+	name := strings.ToUpper(arg)
+	for source, short := range types.FundSources {
+		if name == short || name == source.String() {
+			return source, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid source: %s", arg)
+}
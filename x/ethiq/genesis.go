@@ -14,22 +14,32 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set params
 	k.SetParams(ctx, genState.Params)
 
+	// Set price levels
+	k.SetPriceLevels(ctx, genState.PriceLevels)
+
 	// Set total burned amount
 	if !genState.TotalBurnedAmount.IsZero() {
 		k.SetTotalBurnedAmount(ctx, genState.TotalBurnedAmount)
 	}
 
+	// Set burn applications
+	var nextApplicationID uint64
+	for _, application := range genState.Applications {
+		k.SetApplication(ctx, application)
+		if application.Id >= nextApplicationID {
+			nextApplicationID = application.Id + 1
+		}
+	}
+	k.SetNextApplicationID(ctx, nextApplicationID)
+	k.SetSumOfAllApplications(ctx, types.ApprovedApplicationsAmount(genState.Applications))
+
 	// Set total burned from applications amount
 	for _, appID := range genState.ExecutedApplications {
-		if !types.IsApplicationExists(appID) {
+		application, found := k.GetApplication(ctx, appID)
+		if !found {
 			panic(fmt.Sprintf("invalid executed application id, id %d not found", appID))
 		}
 
-		application, err := types.GetApplicationByID(appID)
-		if err != nil {
-			panic(err)
-		}
-
 		k.SetApplicationAsExecuted(ctx, appID)
 		k.AddToTotalBurnedFromApplicationsAmount(ctx, application.BurnAmount.Amount)
 	}
@@ -51,5 +61,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:               k.GetParams(ctx),
 		TotalBurnedAmount:    k.GetTotalBurnedAmount(ctx),
 		ExecutedApplications: k.GetAllExecutedApplicationsIDs(ctx),
+		PriceLevels:          k.GetPriceLevels(ctx),
+		Applications:         k.GetAllApplications(ctx),
 	}
 }
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/haqq-network/haqq/utils"
+	"github.com/haqq-network/haqq/x/ethiq/types"
+	ucdaotypes "github.com/haqq-network/haqq/x/ucdao/types"
+)
+
+// GetApplication returns the burn application by its ID.
+// The execution status is filled from the executed applications index.
+func (k Keeper) GetApplication(ctx sdk.Context, appID uint64) (types.BurnApplication, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ApplicationsPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(appID))
+	if bz == nil {
+		return types.BurnApplication{}, false
+	}
+
+	var application types.BurnApplication
+	k.cdc.MustUnmarshal(bz, &application)
+	application.IsExecuted = k.IsApplicationExecuted(ctx, appID)
+
+	return application, true
+}
+
+// SetApplication stores the burn application and indexes it by sender.
+// The execution status is tracked by the executed applications index and is not stored.
+func (k Keeper) SetApplication(ctx sdk.Context, application types.BurnApplication) {
+	application.IsExecuted = false
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ApplicationsPrefix)
+	store.Set(sdk.Uint64ToBigEndian(application.Id), k.cdc.MustMarshal(&application))
+
+	// the sender index doesn't depend on the configured prefix,
+	// as the seeded waitlist applications use the mainnet one.
+	_, sender, err := bech32.DecodeAndConvert(application.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetApplicationsBySenderPrefix(sender))
+	senderStore.Set(sdk.Uint64ToBigEndian(application.Id), []byte{0})
+}
+
+// GetNextApplicationID returns the ID to be assigned to the next submitted burn application
+func (k Keeper) GetNextApplicationID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextApplicationIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextApplicationID sets the ID to be assigned to the next submitted burn application
+func (k Keeper) SetNextApplicationID(ctx sdk.Context, appID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextApplicationIDKey, sdk.Uint64ToBigEndian(appID))
+}
+
+// IterateApplications iterates over all burn applications in the ID order
+func (k Keeper) IterateApplications(ctx sdk.Context, cb func(application types.BurnApplication) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ApplicationsPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var application types.BurnApplication
+		k.cdc.MustUnmarshal(iterator.Value(), &application)
+		application.IsExecuted = k.IsApplicationExecuted(ctx, application.Id)
+
+		if cb(application) {
+			break
+		}
+	}
+}
+
+// GetAllApplications returns all burn applications in the ID order
+func (k Keeper) GetAllApplications(ctx sdk.Context) []types.BurnApplication {
+	applications := make([]types.BurnApplication, 0)
+	k.IterateApplications(ctx, func(application types.BurnApplication) bool {
+		applications = append(applications, application)
+		return false
+	})
+
+	return applications
+}
+
+// GetSumOfAllApplications returns the amount of the price curve taken by approved applications.
+// Canceled applications keep their range unless it is the last one on the curve.
+func (k Keeper) GetSumOfAllApplications(ctx sdk.Context) sdkmath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.ApprovedApplicationsAmountKey)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// SetSumOfAllApplications sets the amount of the price curve taken by approved applications
+func (k Keeper) SetSumOfAllApplications(ctx sdk.Context, amount sdkmath.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.ApprovedApplicationsAmountKey, bz)
+}
+
+// SubmitApplication registers a new burn application waiting for governance approval.
+// The application takes no range of the price curve until it is approved, so its
+// burned before amount is assigned on approval.
+func (k Keeper) SubmitApplication(
+	ctx sdk.Context,
+	fromAddress, toAddress sdk.AccAddress,
+	source types.SourceOfFunds,
+	burnAmount sdk.Coin,
+) (types.BurnApplication, error) {
+	// Short no-op circuit if module is disabled
+	if !k.IsModuleEnabled(ctx) {
+		return types.BurnApplication{}, types.ErrModuleDisabled
+	}
+
+	if err := k.checkSourceOfFunds(ctx, fromAddress, source, burnAmount); err != nil {
+		return types.BurnApplication{}, err
+	}
+
+	// the application must fit the price curve if it was approved right away
+	if _, err := k.CalculateHaqqAmount(ctx, k.GetCurvePosition(ctx), burnAmount.Amount); err != nil {
+		return types.BurnApplication{}, err
+	}
+
+	application := types.BurnApplication{
+		Id:                 k.GetNextApplicationID(ctx),
+		FromAddress:        fromAddress.String(),
+		ToAddress:          toAddress.String(),
+		Source:             source,
+		BurnAmount:         burnAmount,
+		BurnedBeforeAmount: sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()),
+	}
+	if err := application.ValidateBasic(); err != nil {
+		return types.BurnApplication{}, err
+	}
+
+	k.SetApplication(ctx, application)
+	k.SetNextApplicationID(ctx, application.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitBurnApplication,
+			sdk.NewAttribute(types.AttributeKeyApplicationID, strconv.FormatUint(application.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, application.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyReceiver, application.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyApplicationFundsSource, types.FundSources[source]),
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, burnAmount.Amount.String()),
+		),
+	)
+
+	return application, nil
+}
+
+// CancelApplication cancels the burn application which is not executed yet on behalf of its sender
+func (k Keeper) CancelApplication(ctx sdk.Context, fromAddress sdk.AccAddress, appID uint64) error {
+	application, err := k.getPendingApplication(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(sdk.MustAccAddressFromBech32(application.FromAddress), fromAddress) {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "application ID %d can be canceled by %s; got %s", appID, application.FromAddress, fromAddress.String())
+	}
+
+	// the approved application which range is the last one on the price curve releases it,
+	// which withdraws its approval. Otherwise the range stays taken, as it is followed by
+	// other burns.
+	rangeEnd := application.BurnedBeforeAmount.Amount.Add(application.BurnAmount.Amount)
+	if application.IsApproved && rangeEnd.Equal(k.GetCurvePosition(ctx)) {
+		k.SetSumOfAllApplications(ctx, k.GetSumOfAllApplications(ctx).Sub(application.BurnAmount.Amount))
+		application.IsApproved = false
+	}
+
+	application.IsCanceled = true
+	k.SetApplication(ctx, application)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelBurnApplication,
+			sdk.NewAttribute(types.AttributeKeyApplicationID, strconv.FormatUint(appID, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, application.FromAddress),
+		),
+	)
+
+	return nil
+}
+
+// ApproveApplication approves the submitted burn application, so it can be executed.
+// The application takes the range of the price curve at its current position, so the
+// following burns are priced after it.
+func (k Keeper) ApproveApplication(ctx sdk.Context, appID uint64) error {
+	application, err := k.getPendingApplication(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	if application.IsApproved {
+		return errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is already approved", appID)
+	}
+
+	burnedBefore := k.GetCurvePosition(ctx)
+	if _, err := k.CalculateHaqqAmount(ctx, burnedBefore, application.BurnAmount.Amount); err != nil {
+		return err
+	}
+
+	application.IsApproved = true
+	application.BurnedBeforeAmount = sdk.NewCoin(utils.BaseDenom, burnedBefore)
+	k.SetApplication(ctx, application)
+	k.SetSumOfAllApplications(ctx, k.GetSumOfAllApplications(ctx).Add(application.BurnAmount.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveBurnApplication,
+			sdk.NewAttribute(types.AttributeKeyApplicationID, strconv.FormatUint(appID, 10)),
+			sdk.NewAttribute(types.AttributeKeyBurnedBeforeAmount, burnedBefore.String()),
+		),
+	)
+
+	return nil
+}
+
+// getPendingApplication returns the burn application which is neither executed nor canceled
+func (k Keeper) getPendingApplication(ctx sdk.Context, appID uint64) (types.BurnApplication, error) {
+	application, found := k.GetApplication(ctx, appID)
+	if !found {
+		return types.BurnApplication{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application not found: id = %d", appID)
+	}
+
+	if application.IsExecuted {
+		return types.BurnApplication{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is already executed", appID)
+	}
+
+	if application.IsCanceled {
+		return types.BurnApplication{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is canceled", appID)
+	}
+
+	return application, nil
+}
+
+// checkSourceOfFunds checks the source of funds holds enough aISLM to execute the application.
+// aLIQUID tokens are counted as they are redeemed on execution.
+func (k Keeper) checkSourceOfFunds(ctx sdk.Context, fromAddress sdk.AccAddress, source types.SourceOfFunds, burnAmount sdk.Coin) error {
+	fundsAddress := fromAddress
+	switch source {
+	case types.SourceOfFunds_SOURCE_OF_FUNDS_BANK:
+	case types.SourceOfFunds_SOURCE_OF_FUNDS_UCDAO:
+		fundsAddress = ucdaotypes.GetEscrowAddress(fromAddress)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidFundsSource, "invalid source_of_funds: %d", source)
+	}
+
+	available := sdkmath.ZeroInt()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, fundsAddress) {
+		if balance.Denom == utils.BaseDenom || utils.IsLiquidToken(balance.Denom) {
+			available = available.Add(balance.Amount)
+		}
+	}
+
+	if available.LT(burnAmount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "%s source of funds holds %s%s, application requires %s", types.FundSources[source], available, utils.BaseDenom, burnAmount)
+	}
+
+	return nil
+}
@@ -99,12 +99,12 @@ func (k Keeper) BurnIslmForHaqqByApplicationID(ctx sdk.Context, fromAddress sdk.
 		return sdkmath.ZeroInt(), sdk.AccAddress{}, types.ErrModuleDisabled
 	}
 
-	application, err := types.GetApplicationByID(appID)
-	if err != nil {
+	application, found := k.GetApplication(ctx, appID)
+	if !found {
 		return sdkmath.ZeroInt(), sdk.AccAddress{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application %d not found", appID)
 	}
 
-	if k.IsApplicationExecuted(ctx, application.Id) {
+	if application.IsExecuted {
 		return sdkmath.ZeroInt(), sdk.AccAddress{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is already executed", application.Id)
 	}
 
@@ -112,6 +112,10 @@ func (k Keeper) BurnIslmForHaqqByApplicationID(ctx sdk.Context, fromAddress sdk.
 		return sdkmath.ZeroInt(), sdk.AccAddress{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is canceled", application.Id)
 	}
 
+	if !application.IsApproved {
+		return sdkmath.ZeroInt(), sdk.AccAddress{}, errorsmod.Wrapf(types.ErrInvalidApplicationID, "application ID %d is not approved", application.Id)
+	}
+
 	isUcdao := application.Source == types.SourceOfFunds_SOURCE_OF_FUNDS_UCDAO
 	applicationFromAddress := sdk.MustAccAddressFromBech32(application.FromAddress)
 	toAddress := sdk.MustAccAddressFromBech32(application.ToAddress)
@@ -128,7 +132,7 @@ func (k Keeper) BurnIslmForHaqqByApplicationID(ctx sdk.Context, fromAddress sdk.
 	}
 
 	// Calculate aHAQQ amount to be minted
-	haqqAmount, err := CalculateHaqqAmount(islmPreBurntByApplications.Amount, islmCoinsToBurn.Amount)
+	haqqAmount, err := k.CalculateHaqqAmount(ctx, islmPreBurntByApplications.Amount, islmCoinsToBurn.Amount)
 	if err != nil {
 		return sdkmath.ZeroInt(), sdk.AccAddress{}, errorsmod.Wrap(err, "failed to calculate aHAQQ amount to be minted")
 	}
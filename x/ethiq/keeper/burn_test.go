@@ -232,11 +232,8 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 	var ucdaoAppID uint64
 	var ucdaoAppFrom string
 
-	totalApps := ethiqtypes.TotalNumberOfApplications()
-	for i := uint64(0); i < totalApps; i++ {
-		app, err := ethiqtypes.GetApplicationByID(i)
-		suite.Require().NoError(err)
-
+	for i, app := range ethiqtypes.WaitlistApplications() {
+		i := uint64(i) //nolint: gosec
 		if app.IsCanceled && canceledAppFrom == "" {
 			canceledAppID = i
 			canceledAppFrom = app.FromAddress
@@ -356,7 +353,7 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 		{
 			name: "success - bank as source of funds, no liquid vesting coins",
 			malleate: func(_ sdk.Context) {
-				application, err := ethiqtypes.GetApplicationByID(29)
+				application, err := s.getApplication(29)
 				suite.Require().NoError(err)
 
 				// Create and fund account
@@ -377,7 +374,7 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 			malleate: func(ctx sdk.Context) {
 				// Create and fund account
 				fromAddr := sdk.MustAccAddressFromBech32("haqq1jt70r5w5q56fers0a4z2x95l92v360pwtey60k")
-				application, err := ethiqtypes.GetApplicationByID(29)
+				application, err := s.getApplication(29)
 				suite.Require().NoError(err)
 				suite.Require().NoError(s.network.FundAccount(fromAddr, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, application.BurnAmount.Amount.MulRaw(2)))))
 
@@ -403,7 +400,7 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 		{
 			name: "success - UCDAO as source of funds, no liquid vesting coins",
 			malleate: func(ctx sdk.Context) {
-				application, err := ethiqtypes.GetApplicationByID(ucdaoAppID)
+				application, err := s.getApplication(ucdaoAppID)
 				suite.Require().NoError(err)
 				fromAddr := sdk.MustAccAddressFromBech32(application.FromAddress)
 				fundAmt := application.BurnAmount.Amount.MulRaw(2)
@@ -420,7 +417,7 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 			name: "success - UCDAO as source of funds, with liquid vesting coins",
 			malleate: func(ctx sdk.Context) {
 				// fund funder account
-				application, err := ethiqtypes.GetApplicationByID(ucdaoAppID)
+				application, err := s.getApplication(ucdaoAppID)
 				suite.Require().NoError(err)
 				fromAddr := sdk.MustAccAddressFromBech32(application.FromAddress)
 				fundAmt := application.BurnAmount.Amount.MulRaw(2)
@@ -465,7 +462,7 @@ func (suite *KeeperTestSuite) TestBurnIslmForHaqqByApplicationID() {
 				suite.Require().NotNil(resAmt)
 				suite.Require().NotNil(toAddr)
 				if tc.calcExpected {
-					app, appErr := ethiqtypes.GetApplicationByID(tc.appID)
+					app, appErr := s.getApplication(tc.appID)
 					suite.Require().NoError(appErr)
 					calcRes, calcErr := s.network.App.EthiqKeeper.CalculateForApplication(ctx, &ethiqtypes.QueryCalculateForApplicationRequest{
 						ApplicationId: tc.appID,
@@ -4,13 +4,40 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/haqq-network/haqq/utils"
 
 	"github.com/haqq-network/haqq/x/ethiq/types"
 )
 
 // CalculateHaqqCoinsToMint calculates the amount of aHAQQ to be minted in exchange for the given aISLM coins.
 func (k Keeper) CalculateHaqqCoinsToMint(ctx sdk.Context, islmAmountToBurn sdkmath.Int) (sdkmath.Int, error) {
+	islmTotalBurnedBefore, err := k.getIslmTotalBurnedBefore(ctx, islmAmountToBurn)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	return k.CalculateHaqqAmount(ctx, islmTotalBurnedBefore, islmAmountToBurn)
+}
+
+// CalculateHaqqBreakdown calculates the amount of aHAQQ to be minted in exchange for the given aISLM coins
+// split by the price levels the burn spreads over. It also returns the cumulative burned aISLM amount
+// on the price curve after the burn.
+func (k Keeper) CalculateHaqqBreakdown(ctx sdk.Context, islmAmountToBurn sdkmath.Int) ([]types.PriceLevelBreakdown, sdkmath.Int, error) {
+	islmTotalBurnedBefore, err := k.getIslmTotalBurnedBefore(ctx, islmAmountToBurn)
+	if err != nil {
+		return nil, sdkmath.ZeroInt(), err
+	}
+
+	breakdown, err := CalculateHaqqBreakdownWithLevels(k.GetPriceLevels(ctx), islmTotalBurnedBefore, islmAmountToBurn)
+	if err != nil {
+		return nil, sdkmath.ZeroInt(), err
+	}
+
+	return breakdown, islmTotalBurnedBefore.Add(islmAmountToBurn), nil
+}
+
+// getIslmTotalBurnedBefore validates the amount to burn and returns the current position on the price curve,
+// which accounts for all approved applications whether they are executed or not.
+func (k Keeper) getIslmTotalBurnedBefore(ctx sdk.Context, islmAmountToBurn sdkmath.Int) (sdkmath.Int, error) {
 	// Short no-op circuit if module is disabled
 	if !k.IsModuleEnabled(ctx) {
 		return sdkmath.ZeroInt(), types.ErrModuleDisabled
@@ -21,33 +48,57 @@ func (k Keeper) CalculateHaqqCoinsToMint(ctx sdk.Context, islmAmountToBurn sdkma
 		return sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAmount, "islm_amount must be positive and greater than zero, got %s", islmAmountToBurn.String())
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.GetCurvePosition(ctx), nil
+}
+
+// GetCurvePosition returns the current position on the price curve. It is made of the public burns
+// and the ranges taken by approved applications, whether they are executed or not.
+func (k Keeper) GetCurvePosition(ctx sdk.Context) sdkmath.Int {
+	sumOfAllApplications := k.GetSumOfAllApplications(ctx)
+	totalBurnedAmount := k.GetTotalBurnedAmount(ctx)
+	totalBurnedFromApplicationsAmount := k.GetTotalBurnedFromApplicationsAmount(ctx)
+
+	return totalBurnedAmount.Amount.Add(sumOfAllApplications).Sub(totalBurnedFromApplicationsAmount.Amount)
+}
+
+// CalculateHaqqAmount calculates the amount of aHAQQ to be minted in exchange for the given aISLM coins
+// using the price levels stored in the module state.
+// Final result depends on currentIslmTotalBurned amount.
+func (k Keeper) CalculateHaqqAmount(ctx sdk.Context, currentIslmTotalBurned, restAmountToBeBurned sdkmath.Int) (sdkmath.Int, error) {
+	return CalculateHaqqAmountWithLevels(k.GetPriceLevels(ctx), currentIslmTotalBurned, restAmountToBeBurned)
+}
 
-	sumOfAllApplications, err := types.GetSumOfAllApplications()
+// CalculateHaqqAmountWithLevels calculates the amount of aHAQQ to be minted in exchange for the given aISLM coins
+// using the given price levels.
+// Final result depends on currentIslmTotalBurned amount.
+func CalculateHaqqAmountWithLevels(priceLevels types.PriceLevels, currentIslmTotalBurned, restAmountToBeBurned sdkmath.Int) (sdkmath.Int, error) {
+	breakdown, err := CalculateHaqqBreakdownWithLevels(priceLevels, currentIslmTotalBurned, restAmountToBeBurned)
 	if err != nil {
-		return sdkmath.ZeroInt(), errorsmod.Wrap(types.ErrCalculationFailed, err.Error())
+		return sdkmath.Int{}, err
 	}
-	totalBurnedAmount := k.GetTotalBurnedAmount(sdkCtx)
-	totalBurnedFromApplicationsAmount := k.GetTotalBurnedFromApplicationsAmount(sdkCtx)
 
-	islmTotalBurnedBefore := totalBurnedAmount.Add(sdk.NewCoin(utils.BaseDenom, sumOfAllApplications)).Sub(totalBurnedFromApplicationsAmount)
+	totalHaqqToBeMinted := sdkmath.ZeroInt()
+	for _, b := range breakdown {
+		totalHaqqToBeMinted = totalHaqqToBeMinted.Add(b.HaqqAmount)
+	}
 
-	return CalculateHaqqAmount(islmTotalBurnedBefore.Amount, islmAmountToBurn)
+	return totalHaqqToBeMinted, nil
 }
 
-// CalculateHaqqAmount calculates the amount of aHAQQ to be minted in exchange for the given aISLM coins.
+// CalculateHaqqBreakdownWithLevels splits the burn of the given aISLM coins by the given price levels
+// and calculates the amount of aHAQQ to be minted on each of them.
 // Final result depends on currentIslmTotalBurned amount.
-func CalculateHaqqAmount(currentIslmTotalBurned, restAmountToBeBurned sdkmath.Int) (sdkmath.Int, error) {
-	totalHaqqToBeMinted := sdkmath.ZeroInt()
+func CalculateHaqqBreakdownWithLevels(priceLevels types.PriceLevels, currentIslmTotalBurned, restAmountToBeBurned sdkmath.Int) ([]types.PriceLevelBreakdown, error) {
+	breakdown := make([]types.PriceLevelBreakdown, 0)
 
-	for _, pl := range types.Prices {
+	for i, pl := range priceLevels {
 		if restAmountToBeBurned.IsZero() {
 			// already burnt everything
 			break
 		}
 
-		levelMaxAmount := pl.ToAmount()
-		levelMinAmount := pl.FromAmount()
+		levelMaxAmount := pl.To
+		levelMinAmount := pl.From
 
 		// Price levels use inclusive upper bounds: the valid burn range is [from, to-1].
 		// The value at "to" itself is the "from" of the next level, so it is excluded here.
@@ -63,10 +114,10 @@ func CalculateHaqqAmount(currentIslmTotalBurned, restAmountToBeBurned sdkmath.In
 		// from the previous level (since from[N] == to[N-1]).
 		if !(currentIslmTotalBurned.GTE(levelMinAmount.Sub(sdkmath.OneInt())) && currentIslmTotalBurned.LT(levelMaxAmount)) {
 			// should never happen
-			return sdkmath.Int{}, errorsmod.Wrap(types.ErrCalculationFailed, "failed to find price level")
+			return nil, errorsmod.Wrap(types.ErrCalculationFailed, "failed to find price level")
 		}
 
-		unitPrice := pl.UnitPrice()
+		unitPrice := pl.Price
 
 		// Remaining capacity at this level: (to - 1) - currentBurned, because the
 		// last valid position is to-1 (the "to" boundary is exclusive).
@@ -84,12 +135,17 @@ func CalculateHaqqAmount(currentIslmTotalBurned, restAmountToBeBurned sdkmath.In
 
 		// track minting
 		haqqToBeMintedOnThisLevel := sdkmath.LegacyNewDecFromInt(burnOnThisLevel).Quo(unitPrice).TruncateInt()
-		totalHaqqToBeMinted = totalHaqqToBeMinted.Add(haqqToBeMintedOnThisLevel)
+		breakdown = append(breakdown, types.PriceLevelBreakdown{
+			Level:      uint64(i), //nolint: gosec // G115 -- index is never negative
+			IslmAmount: burnOnThisLevel,
+			Price:      unitPrice,
+			HaqqAmount: haqqToBeMintedOnThisLevel,
+		})
 	}
 
 	if restAmountToBeBurned.IsPositive() {
-		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrExceedsPricingCurve, "remaining unaccounted burn amount: %s", restAmountToBeBurned.String())
+		return nil, errorsmod.Wrapf(types.ErrExceedsPricingCurve, "remaining unaccounted burn amount: %s", restAmountToBeBurned.String())
 	}
 
-	return totalHaqqToBeMinted, nil
+	return breakdown, nil
 }
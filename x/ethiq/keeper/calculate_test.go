@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/haqq-network/haqq/x/ethiq/keeper"
+	"github.com/haqq-network/haqq/x/ethiq/types"
 )
 
 func (us *UnitTestSuite) TestCalculate() {
@@ -136,7 +137,7 @@ func (us *UnitTestSuite) TestCalculate() {
 
 	for _, tc := range testCases {
 		us.Run(tc.name, func() {
-			res, err := keeper.CalculateHaqqAmount(tc.alreadyBurntAmt(), tc.burnAmt())
+			res, err := keeper.CalculateHaqqAmountWithLevels(types.DefaultPriceLevels(), tc.alreadyBurntAmt(), tc.burnAmt())
 			if !tc.success {
 				us.Require().ErrorContains(err, tc.expErr)
 				us.Require().Equal(sdkmath.Int{}, res)
@@ -148,3 +149,31 @@ func (us *UnitTestSuite) TestCalculate() {
 		})
 	}
 }
+
+func (us *UnitTestSuite) TestCalculateBreakdown() {
+	// already burnt 36999999999999999999999999 aISLM, 3m ISLM left on level 1
+	alreadyBurnt, _ := sdkmath.NewIntFromString("36999999999999999999999999")
+	threeMillion, _ := sdkmath.NewIntFromString("3000000000000000000000000")
+	fourMillion, _ := sdkmath.NewIntFromString("4000000000000000000000000")
+	oneMillion, _ := sdkmath.NewIntFromString("1000000000000000000000000")
+
+	res, err := keeper.CalculateHaqqBreakdownWithLevels(types.DefaultPriceLevels(), alreadyBurnt, threeMillion.Add(fourMillion))
+	us.Require().NoError(err)
+	us.Require().Equal([]types.PriceLevelBreakdown{
+		{Level: 0, IslmAmount: threeMillion, Price: sdkmath.LegacyNewDec(3), HaqqAmount: oneMillion},
+		{Level: 1, IslmAmount: fourMillion, Price: sdkmath.LegacyNewDec(4), HaqqAmount: oneMillion},
+	}, res)
+
+	// zero amount doesn't touch any level
+	res, err = keeper.CalculateHaqqBreakdownWithLevels(types.DefaultPriceLevels(), alreadyBurnt, sdkmath.ZeroInt())
+	us.Require().NoError(err)
+	us.Require().Empty(res)
+
+	// the whole curve capacity is exceeded
+	levels := types.DefaultPriceLevels()
+	capacity := levels.RemainingCapacity(alreadyBurnt)
+	_, err = keeper.CalculateHaqqBreakdownWithLevels(levels, alreadyBurnt, capacity)
+	us.Require().NoError(err)
+	_, err = keeper.CalculateHaqqBreakdownWithLevels(levels, alreadyBurnt, capacity.AddRaw(1))
+	us.Require().ErrorIs(err, types.ErrExceedsPricingCurve)
+}
@@ -6,6 +6,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -72,17 +73,58 @@ func (k Keeper) Calculate(ctx context.Context, req *types.QueryCalculateRequest)
 	}, nil
 }
 
-func (k Keeper) CalculateForApplication(ctx context.Context, req *types.QueryCalculateForApplicationRequest) (*types.QueryCalculateForApplicationResponse, error) {
+// CalculateBreakdown implements the Query/CalculateBreakdown gRPC method
+func (k Keeper) CalculateBreakdown(ctx context.Context, req *types.QueryCalculateBreakdownRequest) (*types.QueryCalculateBreakdownResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	burnApplication, err := types.GetApplicationByID(req.ApplicationId)
+	islmAmount, ok := sdkmath.NewIntFromString(req.IslmAmount)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid islm amount: %s", req.IslmAmount))
+	}
+
+	if !islmAmount.GT(sdkmath.ZeroInt()) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("islm_amount must be positive and greater than zero: %s", req.IslmAmount))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	breakdown, totalBurnedAfter, err := k.CalculateHaqqBreakdown(sdkCtx, islmAmount)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, status.Error(codes.Internal, errorsmod.Wrap(err, "failed to calculate aHAQQ amount").Error())
+	}
+
+	haqqToBeMinted := sdkmath.ZeroInt()
+	for _, level := range breakdown {
+		haqqToBeMinted = haqqToBeMinted.Add(level.HaqqAmount)
 	}
 
-	haqqToBeMinted, err := CalculateHaqqAmount(burnApplication.BurnedBeforeAmount.Amount, burnApplication.BurnAmount.Amount)
+	return &types.QueryCalculateBreakdownResponse{
+		Levels:              breakdown,
+		EstimatedHaqqAmount: haqqToBeMinted,
+		TotalBurnedAfter:    totalBurnedAfter,
+		RemainingCapacity:   k.GetPriceLevels(sdkCtx).RemainingCapacity(totalBurnedAfter),
+	}, nil
+}
+
+func (k Keeper) CalculateForApplication(ctx context.Context, req *types.QueryCalculateForApplicationRequest) (*types.QueryCalculateForApplicationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	burnApplication, found := k.GetApplication(sdk.UnwrapSDKContext(ctx), req.ApplicationId)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("application not found: id = %d", req.ApplicationId))
+	}
+
+	// the application which is not approved yet is estimated as if it was approved now
+	burnedBefore := burnApplication.BurnedBeforeAmount.Amount
+	if !burnApplication.IsApproved {
+		burnedBefore = k.GetCurvePosition(sdk.UnwrapSDKContext(ctx))
+	}
+
+	haqqToBeMinted, err := k.CalculateHaqqAmount(sdk.UnwrapSDKContext(ctx), burnedBefore, burnApplication.BurnAmount.Amount)
 	if err != nil {
 		return nil, status.Error(codes.Internal, errorsmod.Wrap(err, "failed to calculate aHAQQ amount").Error())
 	}
@@ -106,113 +148,85 @@ func (k Keeper) CalculateForApplication(ctx context.Context, req *types.QueryCal
 	}, nil
 }
 
+// GetApplications implements the Query/GetApplications gRPC method
 func (k Keeper) GetApplications(ctx context.Context, req *types.QueryGetApplicationsRequest) (*types.QueryGetApplicationsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	var offset, limit uint64
-	offset = 0
-	limit = query.DefaultLimit
-	countTotal := false
-	total := types.TotalNumberOfApplications()
-
-	if req.Pagination != nil {
-		offset = req.Pagination.Offset
-		limit = req.Pagination.Limit
-		countTotal = req.Pagination.CountTotal
-	}
-	if limit == 0 {
-		limit = uint64(query.DefaultLimit)
-	}
-
-	lastOnThisPage := offset + limit - 1
-	if lastOnThisPage >= total {
-		lastOnThisPage = total - 1
-	}
-
-	applications := make([]types.BurnApplication, 0, limit)
-	paginationResponse := &query.PageResponse{}
-	if countTotal {
-		paginationResponse.Total = total
-	}
-
-	if offset >= total {
-		return &types.QueryGetApplicationsResponse{
-			Applications: applications,
-			Pagination:   paginationResponse,
-		}, nil
-	}
-
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for i := offset; i < total && i <= lastOnThisPage; i++ {
-		burnApplication, err := types.GetApplicationByID(i)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.ApplicationsPrefix)
+
+	applications := make([]types.BurnApplication, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var application types.BurnApplication
+		if err := k.cdc.Unmarshal(value, &application); err != nil {
+			return err
 		}
 
-		burnApplication.IsExecuted = k.IsApplicationExecuted(sdkCtx, burnApplication.Id)
-		applications = append(applications, *burnApplication)
+		application.IsExecuted = k.IsApplicationExecuted(sdkCtx, application.Id)
+		applications = append(applications, application)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryGetApplicationsResponse{
 		Applications: applications,
-		Pagination:   paginationResponse,
+		Pagination:   pageRes,
 	}, nil
 }
 
+// GetSendersApplications implements the Query/GetSendersApplications gRPC method
 func (k Keeper) GetSendersApplications(ctx context.Context, req *types.QueryGetSendersApplicationsRequest) (*types.QueryGetSendersApplicationsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	var offset, limit uint64
-	offset = 0
-	limit = query.DefaultLimit
-	countTotal := false
-	total := types.TotalNumberOfApplicationsBySender(req.SenderAddress)
-
-	if req.Pagination != nil {
-		offset = req.Pagination.Offset
-		limit = req.Pagination.Limit
-		countTotal = req.Pagination.CountTotal
-	}
-	if limit == 0 {
-		limit = uint64(query.DefaultLimit)
-	}
-
-	lastOnThisPage := offset + limit - 1
-	if lastOnThisPage >= total {
-		lastOnThisPage = total - 1
-	}
-
-	applications := make([]types.BurnApplication, 0, limit)
-	paginationResponse := &query.PageResponse{}
-	if countTotal {
-		paginationResponse.Total = total
-	}
+	applications := make([]types.BurnApplication, 0)
 
-	if offset >= total {
+	// unknown sender has no applications
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
 		return &types.QueryGetSendersApplicationsResponse{
 			Applications: applications,
-			Pagination:   paginationResponse,
+			Pagination:   &query.PageResponse{},
 		}, nil
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	for i := offset; i < total && i <= lastOnThisPage; i++ {
-		burnApplication, err := types.GetSendersApplicationIDByIndex(req.SenderAddress, i)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.GetApplicationsBySenderPrefix(sender))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		application, found := k.GetApplication(sdkCtx, sdk.BigEndianToUint64(key))
+		if !found {
+			return fmt.Errorf("application not found: id = %d", sdk.BigEndianToUint64(key))
 		}
 
-		burnApplication.IsExecuted = k.IsApplicationExecuted(sdkCtx, burnApplication.Id)
-		applications = append(applications, *burnApplication)
+		applications = append(applications, application)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &types.QueryGetSendersApplicationsResponse{
 		Applications: applications,
-		Pagination:   paginationResponse,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// PriceLevels implements the Query/PriceLevels gRPC method
+func (k Keeper) PriceLevels(ctx context.Context, req *types.QueryPriceLevelsRequest) (*types.QueryPriceLevelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryPriceLevelsResponse{
+		PriceLevels: k.GetPriceLevels(sdkCtx),
 	}, nil
 }
 
@@ -6,7 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/haqq-network/haqq/utils"
-	ethiqkeeper "github.com/haqq-network/haqq/x/ethiq/keeper"
 	ethiqtypes "github.com/haqq-network/haqq/x/ethiq/types"
 )
 
@@ -170,6 +169,108 @@ func (suite *KeeperTestSuite) TestCalculateGRPC() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCalculateBreakdownGRPC() {
+	testCases := []struct {
+		name        string
+		req         *ethiqtypes.QueryCalculateBreakdownRequest
+		malleate    func(ctx sdk.Context)
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - nil request",
+			malleate:    func(_ sdk.Context) {},
+			expErr:      true,
+			errContains: "empty request",
+		},
+		{
+			name: "fail - zero amount",
+			req: &ethiqtypes.QueryCalculateBreakdownRequest{
+				IslmAmount: "0",
+			},
+			malleate:    func(_ sdk.Context) {},
+			expErr:      true,
+			errContains: "islm_amount must be positive",
+		},
+		{
+			name: "fail - invalid string amount",
+			req: &ethiqtypes.QueryCalculateBreakdownRequest{
+				IslmAmount: "not-a-number",
+			},
+			malleate:    func(_ sdk.Context) {},
+			expErr:      true,
+			errContains: "invalid islm amount",
+		},
+		{
+			name: "fail - module disabled",
+			req: &ethiqtypes.QueryCalculateBreakdownRequest{
+				IslmAmount: "1",
+			},
+			malleate: func(ctx sdk.Context) {
+				p := s.network.App.EthiqKeeper.GetParams(ctx)
+				p.Enabled = false
+				s.network.App.EthiqKeeper.SetParams(ctx, p)
+			},
+			expErr:      true,
+			errContains: "module is disabled",
+		},
+		{
+			name: "fail - exceeds pricing curve",
+			req: &ethiqtypes.QueryCalculateBreakdownRequest{
+				IslmAmount: "1000000000000000000000000000000000",
+			},
+			malleate:    func(_ sdk.Context) {},
+			expErr:      true,
+			errContains: "burn amount exceeds pricing curve capacity",
+		},
+		{
+			name: "success - valid positive amount",
+			req: &ethiqtypes.QueryCalculateBreakdownRequest{
+				IslmAmount: "1000000000000000000",
+			},
+			malleate: func(_ sdk.Context) {},
+			expErr:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := s.network.GetContext()
+			tc.malleate(ctx)
+
+			res, err := s.network.App.EthiqKeeper.CalculateBreakdown(ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			suite.Require().NotEmpty(res.Levels)
+
+			islmAmount, ok := sdkmath.NewIntFromString(tc.req.IslmAmount)
+			suite.Require().True(ok)
+			expAmt, err := s.network.App.EthiqKeeper.CalculateHaqqCoinsToMint(ctx, islmAmount)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expAmt, res.EstimatedHaqqAmount)
+
+			islmSum, haqqSum := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+			for _, level := range res.Levels {
+				islmSum = islmSum.Add(level.IslmAmount)
+				haqqSum = haqqSum.Add(level.HaqqAmount)
+			}
+			suite.Require().Equal(islmAmount, islmSum)
+			suite.Require().Equal(res.EstimatedHaqqAmount, haqqSum)
+
+			levels := s.network.App.EthiqKeeper.GetPriceLevels(ctx)
+			suite.Require().Equal(levels.RemainingCapacity(res.TotalBurnedAfter), res.RemainingCapacity)
+			suite.Require().True(res.RemainingCapacity.IsPositive())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCalculateForApplicationsGRPC() {
 	testCases := []struct {
 		name        string
@@ -210,9 +311,9 @@ func (suite *KeeperTestSuite) TestCalculateForApplicationsGRPC() {
 			suite.SetupTest()
 			ctx := s.network.GetContext()
 			if tc.calcExpRes && tc.req != nil {
-				app, appErr := ethiqtypes.GetApplicationByID(tc.req.ApplicationId)
+				app, appErr := s.getApplication(tc.req.ApplicationId)
 				suite.Require().NoError(appErr)
-				expAmt, expErr := ethiqkeeper.CalculateHaqqAmount(app.BurnedBeforeAmount.Amount, app.BurnAmount.Amount)
+				expAmt, expErr := s.network.App.EthiqKeeper.CalculateHaqqAmount(ctx, app.BurnedBeforeAmount.Amount, app.BurnAmount.Amount)
 				suite.Require().NoError(expErr)
 				tc.expRes = &ethiqtypes.QueryCalculateForApplicationResponse{EstimatedHaqqAmount: expAmt}
 			}
@@ -249,7 +350,7 @@ func (suite *KeeperTestSuite) TestGetApplicationsGRPC() {
 			name:     "success - with nil pagination",
 			req:      &ethiqtypes.QueryGetApplicationsRequest{},
 			expLen:   query.DefaultLimit,
-			expTotal: 0,
+			expTotal: uint64(len(ethiqtypes.WaitlistApplications())),
 			expErr:   false,
 		},
 		{
@@ -274,7 +375,7 @@ func (suite *KeeperTestSuite) TestGetApplicationsGRPC() {
 				},
 			},
 			expLen:   10,
-			expTotal: ethiqtypes.TotalNumberOfApplications(),
+			expTotal: uint64(len(ethiqtypes.WaitlistApplications())),
 			expErr:   false,
 		},
 		{
@@ -283,7 +384,7 @@ func (suite *KeeperTestSuite) TestGetApplicationsGRPC() {
 				Pagination: &query.PageRequest{},
 			},
 			expLen:   query.DefaultLimit,
-			expTotal: 0,
+			expTotal: uint64(len(ethiqtypes.WaitlistApplications())),
 			expErr:   false,
 		},
 		{
@@ -291,7 +392,7 @@ func (suite *KeeperTestSuite) TestGetApplicationsGRPC() {
 			req: &ethiqtypes.QueryGetApplicationsRequest{
 				Pagination: &query.PageRequest{
 					Limit:  10,
-					Offset: ethiqtypes.TotalNumberOfApplications() + 100,
+					Offset: uint64(len(ethiqtypes.WaitlistApplications())) + 100,
 				},
 			},
 			expLen:   0,
@@ -343,8 +444,8 @@ func (suite *KeeperTestSuite) TestGetSendersApplicationsGRPC() {
 			req: &ethiqtypes.QueryGetSendersApplicationsRequest{
 				SenderAddress: knownSender,
 			},
-			expLen:   ethiqtypes.TotalNumberOfApplicationsBySender(knownSender),
-			expTotal: 0,
+			expLen:   countWaitlistApplicationsBySender(knownSender),
+			expTotal: countWaitlistApplicationsBySender(knownSender),
 			expErr:   false,
 		},
 		{
@@ -371,7 +472,7 @@ func (suite *KeeperTestSuite) TestGetSendersApplicationsGRPC() {
 				},
 			},
 			expLen:   5,
-			expTotal: ethiqtypes.TotalNumberOfApplicationsBySender(knownSender),
+			expTotal: countWaitlistApplicationsBySender(knownSender),
 			expErr:   false,
 		},
 		{
@@ -380,8 +481,8 @@ func (suite *KeeperTestSuite) TestGetSendersApplicationsGRPC() {
 				SenderAddress: knownSender,
 				Pagination:    &query.PageRequest{},
 			},
-			expLen:   ethiqtypes.TotalNumberOfApplicationsBySender(knownSender),
-			expTotal: 0,
+			expLen:   countWaitlistApplicationsBySender(knownSender),
+			expTotal: countWaitlistApplicationsBySender(knownSender),
 			expErr:   false,
 		},
 		{
@@ -458,3 +559,41 @@ func (suite *KeeperTestSuite) TestParamsGRPC() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPriceLevelsGRPC() {
+	testCases := []struct {
+		name        string
+		req         *ethiqtypes.QueryPriceLevelsRequest
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - nil request",
+			req:         nil,
+			expErr:      true,
+			errContains: "empty request",
+		},
+		{
+			name:   "success - returns default price levels",
+			req:    &ethiqtypes.QueryPriceLevelsRequest{},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := s.network.GetContext()
+
+			res, err := s.network.App.EthiqKeeper.PriceLevels(ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(ethiqtypes.DefaultPriceLevels(), ethiqtypes.PriceLevels(res.PriceLevels))
+			}
+		})
+	}
+}
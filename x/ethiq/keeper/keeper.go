@@ -20,6 +20,8 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	cdc        codec.BinaryCodec
 	paramStore paramtypes.Subspace
+	// the address capable of executing a MsgUpdatePriceLevels message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
@@ -32,6 +34,7 @@ type Keeper struct {
 func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -39,6 +42,11 @@ func NewKeeper(
 	lk types.LiquidVestingKeeper,
 	uc types.UCDAOKeeper,
 ) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	// ensure ethiq module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the ethiq module account has not been set")
@@ -53,6 +61,7 @@ func NewKeeper(
 		storeKey:            storeKey,
 		cdc:                 cdc,
 		paramStore:          ps,
+		authority:           authority,
 		accountKeeper:       ak,
 		bankKeeper:          bk,
 		erc20Keeper:         ek,
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/haqq-network/haqq/x/ethiq/types"
 )
@@ -70,3 +73,114 @@ func (k msgServer) MintHaqqByApplication(goCtx context.Context, msg *types.MsgMi
 		ToAddress:  toAddress.String(),
 	}, nil
 }
+
+// UpdatePriceLevels implements the gRPC MsgServer interface. After a successful governance vote
+// it replaces the burn price curve only if the requested authority
+// is the Cosmos SDK governance module account
+func (k msgServer) UpdatePriceLevels(goCtx context.Context, msg *types.MsgUpdatePriceLevels) (*types.MsgUpdatePriceLevelsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the new curve must go on past the current position, otherwise no burn can be priced
+	position := k.GetCurvePosition(ctx)
+	if types.PriceLevels(msg.PriceLevels).RemainingCapacity(position).IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidPriceLevels,
+			"price levels end at %s, leaving no capacity past the current curve position %s",
+			msg.PriceLevels[len(msg.PriceLevels)-1].To, position,
+		)
+	}
+
+	k.SetPriceLevels(ctx, msg.PriceLevels)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePriceLevels,
+			sdk.NewAttribute(types.AttributeKeyPriceLevelsCount, strconv.Itoa(len(msg.PriceLevels))),
+		),
+	)
+
+	return &types.MsgUpdatePriceLevelsResponse{}, nil
+}
+
+// validateAuthority is a helper function to validate that the provided authority
+// is the keeper's authority address
+func (k Keeper) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// SubmitBurnApplication implements the gRPC MsgServer interface. It registers a new burn application
+// which can be executed by its sender after governance approval
+func (k msgServer) SubmitBurnApplication(goCtx context.Context, msg *types.MsgSubmitBurnApplication) (*types.MsgSubmitBurnApplicationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Addresses are already validated above
+	fromAddress := sdk.MustAccAddressFromBech32(msg.FromAddress)
+	toAddress := sdk.MustAccAddressFromBech32(msg.ToAddress)
+
+	application, err := k.Keeper.SubmitApplication(ctx, fromAddress, toAddress, msg.Source, msg.BurnAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBurnApplicationResponse{
+		ApplicationId: application.Id,
+	}, nil
+}
+
+// CancelBurnApplication implements the gRPC MsgServer interface. It cancels the burn application
+// which is not executed yet on behalf of its sender
+func (k msgServer) CancelBurnApplication(goCtx context.Context, msg *types.MsgCancelBurnApplication) (*types.MsgCancelBurnApplicationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Address is already validated above
+	fromAddress := sdk.MustAccAddressFromBech32(msg.FromAddress)
+
+	if err := k.Keeper.CancelApplication(ctx, fromAddress, msg.ApplicationId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelBurnApplicationResponse{}, nil
+}
+
+// ApproveBurnApplication implements the gRPC MsgServer interface. After a successful governance vote
+// it approves the submitted burn application only if the requested authority
+// is the Cosmos SDK governance module account
+func (k msgServer) ApproveBurnApplication(goCtx context.Context, msg *types.MsgApproveBurnApplication) (*types.MsgApproveBurnApplicationResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ApproveApplication(ctx, msg.ApplicationId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveBurnApplicationResponse{}, nil
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/haqq-network/haqq/utils"
 
 	coinomicstypes "github.com/haqq-network/haqq/x/coinomics/types"
@@ -164,7 +166,7 @@ func (suite *KeeperTestSuite) TestMsgMintHaqqByApplication() {
 		{
 			name: "fail - insufficient funds",
 			malleate: func(_ sdk.Context) *ethiqtypes.MsgMintHaqqByApplication {
-				application, err := ethiqtypes.GetApplicationByID(6)
+				application, err := s.getApplication(6)
 				suite.Require().NoError(err)
 				fromAddress := sdk.MustAccAddressFromBech32(application.FromAddress)
 				suite.Require().NoError(s.network.FundAccount(
@@ -183,7 +185,7 @@ func (suite *KeeperTestSuite) TestMsgMintHaqqByApplication() {
 		{
 			name: "success - application executed",
 			malleate: func(_ sdk.Context) *ethiqtypes.MsgMintHaqqByApplication {
-				application, err := ethiqtypes.GetApplicationByID(6)
+				application, err := s.getApplication(6)
 				suite.Require().NoError(err)
 				fromAddress := sdk.MustAccAddressFromBech32(application.FromAddress)
 				suite.Require().NoError(s.network.FundAccount(
@@ -226,19 +228,12 @@ func (suite *KeeperTestSuite) TestMsgMintHaqqByApplicationSuccessApplicationIDZe
 	suite.SetupTest()
 	ctx := s.network.GetContext()
 
-	app6, err := ethiqtypes.GetApplicationByID(6)
+	app6, err := s.getApplication(6)
 	suite.Require().NoError(err)
 
-	item := ethiqtypes.ApplicationListItem{
-		ID:                         0,
-		FromAddress:                app6.FromAddress,
-		ToAddress:                  app6.ToAddress,
-		FundSource:                 app6.Source,
-		IslmAmount:                 app6.BurnAmount.Amount.String(),
-		IslmAccumulatedBurntAmount: app6.BurnedBeforeAmount.Amount.String(),
-	}
-	cleanup := ethiqtypes.ReplaceWaitlistForIntegrationTest([]ethiqtypes.ApplicationListItem{item})
-	defer cleanup()
+	app0 := *app6
+	app0.Id = 0
+	s.network.App.EthiqKeeper.SetApplication(ctx, app0)
 
 	fromAddr := sdk.MustAccAddressFromBech32(app6.FromAddress)
 	suite.Require().NoError(s.network.FundAccount(
@@ -269,7 +264,7 @@ func (suite *KeeperTestSuite) TestMsgMintHaqqByApplicationAlreadyExecuted() {
 
 	// Application 1: fromAddress = "haqq19pxv2r4key79twjfv0gdc5yhc4xmw9vqxkj2nl", burnAmount = 10e18
 	appID := uint64(1)
-	application, err := ethiqtypes.GetApplicationByID(appID)
+	application, err := s.getApplication(appID)
 	suite.Require().NoError(err)
 
 	fromAddr := sdk.MustAccAddressFromBech32(application.FromAddress)
@@ -301,3 +296,510 @@ func (suite *KeeperTestSuite) TestMsgMintHaqqByApplicationAlreadyExecuted() {
 	suite.Require().Error(err)
 	suite.Require().ErrorContains(err, "already executed")
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdatePriceLevels() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	validLevels := []ethiqtypes.PriceLevel{
+		{From: sdkmath.ZeroInt(), To: sdkmath.NewInt(100), Price: sdkmath.LegacyNewDec(2)},
+		{From: sdkmath.NewInt(100), To: sdkmath.NewInt(200), Price: sdkmath.LegacyNewDec(4)},
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		msg         *ethiqtypes.MsgUpdatePriceLevels
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "fail - invalid authority",
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority:   s.keyring.GetAccAddr(0).String(),
+				PriceLevels: validLevels,
+			},
+			expErr:      true,
+			errContains: "invalid authority",
+		},
+		{
+			name: "fail - empty price levels",
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority: authority,
+			},
+			expErr:      true,
+			errContains: "price levels cannot be empty",
+		},
+		{
+			name: "fail - gap between levels",
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority: authority,
+				PriceLevels: []ethiqtypes.PriceLevel{
+					validLevels[0],
+					{From: sdkmath.NewInt(101), To: sdkmath.NewInt(200), Price: sdkmath.LegacyNewDec(4)},
+				},
+			},
+			expErr:      true,
+			errContains: "must start where level 0 ends",
+		},
+		{
+			name: "fail - decreasing price",
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority: authority,
+				PriceLevels: []ethiqtypes.PriceLevel{
+					validLevels[0],
+					{From: sdkmath.NewInt(100), To: sdkmath.NewInt(200), Price: sdkmath.LegacyNewDec(1)},
+				},
+			},
+			expErr:      true,
+			errContains: "price must be greater than level 0 price",
+		},
+		{
+			name: "fail - curve ends at the current position",
+			malleate: func(ctx sdk.Context) {
+				s.network.App.EthiqKeeper.SetTotalBurnedAmount(ctx, sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(199)))
+			},
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority:   authority,
+				PriceLevels: validLevels,
+			},
+			expErr:      true,
+			errContains: "leaving no capacity past the current curve position 199",
+		},
+		{
+			name: "fail - curve ends below the current position",
+			malleate: func(ctx sdk.Context) {
+				s.network.App.EthiqKeeper.SetTotalBurnedAmount(ctx, sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(150)))
+				amount := s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx)
+				s.network.App.EthiqKeeper.SetSumOfAllApplications(ctx, amount.AddRaw(100))
+			},
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority:   authority,
+				PriceLevels: validLevels,
+			},
+			expErr:      true,
+			errContains: "leaving no capacity past the current curve position 250",
+		},
+		{
+			name: "success - price levels replaced",
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority:   authority,
+				PriceLevels: validLevels,
+			},
+			expErr: false,
+		},
+		{
+			name: "success - curve goes on past the current position",
+			malleate: func(ctx sdk.Context) {
+				s.network.App.EthiqKeeper.SetTotalBurnedAmount(ctx, sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(150)))
+			},
+			msg: &ethiqtypes.MsgUpdatePriceLevels{
+				Authority:   authority,
+				PriceLevels: validLevels,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := s.network.GetContext()
+
+			// move the curve position to zero, below the tested curves
+			s.network.App.EthiqKeeper.SetTotalBurnedAmount(ctx, sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()))
+			s.network.App.EthiqKeeper.SetSumOfAllApplications(ctx, s.network.App.EthiqKeeper.GetTotalBurnedFromApplicationsAmount(ctx).Amount)
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+
+			_, err := msgSrv.UpdatePriceLevels(ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().Equal(ethiqtypes.DefaultPriceLevels(), s.network.App.EthiqKeeper.GetPriceLevels(ctx))
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(ethiqtypes.PriceLevels(tc.msg.PriceLevels), s.network.App.EthiqKeeper.GetPriceLevels(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSubmitBurnApplication() {
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) *ethiqtypes.MsgSubmitBurnApplication
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "fail - module disabled",
+			malleate: func(ctx sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				params := s.network.App.EthiqKeeper.GetParams(ctx)
+				params.Enabled = false
+				s.network.App.EthiqKeeper.SetParams(ctx, params)
+
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr:      true,
+			errContains: "module is disabled",
+		},
+		{
+			name: "fail - invalid receiver address",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   "haqq1gcwaegn",
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr:      true,
+			errContains: "invalid to_address",
+		},
+		{
+			name: "fail - invalid denom",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					BurnAmount:  sdk.NewCoin("aLIQUID0", sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr:      true,
+			errContains: "invalid denom for burn_amount",
+		},
+		{
+			name: "fail - invalid source of funds",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					Source:      ethiqtypes.SourceOfFunds(10),
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr:      true,
+			errContains: "invalid source_of_funds",
+		},
+		{
+			name: "fail - insufficient funds",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18).MulRaw(1e7)),
+				}
+			},
+			expErr:      true,
+			errContains: "insufficient funds",
+		},
+		{
+			name: "fail - insufficient funds in ucdao escrow",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					Source:      ethiqtypes.SourceOfFunds_SOURCE_OF_FUNDS_UCDAO,
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr:      true,
+			errContains: "insufficient funds",
+		},
+		{
+			name: "success - application submitted",
+			malleate: func(_ sdk.Context) *ethiqtypes.MsgSubmitBurnApplication {
+				return &ethiqtypes.MsgSubmitBurnApplication{
+					FromAddress: s.keyring.GetAccAddr(0).String(),
+					ToAddress:   s.keyring.GetAccAddr(1).String(),
+					BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+				}
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := s.network.GetContext()
+
+			msg := tc.malleate(ctx)
+			msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+			nextID := s.network.App.EthiqKeeper.GetNextApplicationID(ctx)
+			burnedBefore := s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx)
+
+			res, err := msgSrv.SubmitBurnApplication(ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errContains)
+				suite.Require().Equal(nextID, s.network.App.EthiqKeeper.GetNextApplicationID(ctx))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(nextID, res.ApplicationId)
+			suite.Require().Equal(nextID+1, s.network.App.EthiqKeeper.GetNextApplicationID(ctx))
+
+			application, err := s.getApplication(res.ApplicationId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(msg.FromAddress, application.FromAddress)
+			suite.Require().Equal(msg.ToAddress, application.ToAddress)
+			suite.Require().Equal(msg.BurnAmount, application.BurnAmount)
+			suite.Require().True(application.BurnedBeforeAmount.IsZero())
+			suite.Require().False(application.IsApproved)
+			suite.Require().False(application.IsCanceled)
+			suite.Require().False(application.IsExecuted)
+			// the application takes no range of the price curve until it is approved
+			suite.Require().Equal(burnedBefore, s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgBurnApplicationApproveAndExecute() {
+	suite.SetupTest()
+	ctx := s.network.GetContext()
+
+	msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := s.keyring.GetAccAddr(0).String()
+
+	res, err := msgSrv.SubmitBurnApplication(ctx, &ethiqtypes.MsgSubmitBurnApplication{
+		FromAddress: sender,
+		ToAddress:   s.keyring.GetAccAddr(1).String(),
+		BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+	})
+	suite.Require().NoError(err)
+
+	mintMsg := &ethiqtypes.MsgMintHaqqByApplication{
+		FromAddress:   sender,
+		ApplicationId: res.ApplicationId,
+	}
+
+	// Execution is not possible before the governance approval
+	_, err = msgSrv.MintHaqqByApplication(ctx, mintMsg)
+	suite.Require().ErrorContains(err, "not approved")
+
+	// Only governance can approve the application
+	_, err = msgSrv.ApproveBurnApplication(ctx, &ethiqtypes.MsgApproveBurnApplication{
+		Authority:     sender,
+		ApplicationId: res.ApplicationId,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	approveMsg := &ethiqtypes.MsgApproveBurnApplication{
+		Authority:     authority,
+		ApplicationId: res.ApplicationId,
+	}
+	burnedBefore := s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx)
+	_, err = msgSrv.ApproveBurnApplication(ctx, approveMsg)
+	suite.Require().NoError(err)
+
+	// the approved application takes the range right after the last approved one
+	application, err := s.getApplication(res.ApplicationId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(burnedBefore, application.BurnedBeforeAmount.Amount)
+	suite.Require().Equal(burnedBefore.Add(sdkmath.NewInt(1e18)), s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+
+	_, err = msgSrv.ApproveBurnApplication(ctx, approveMsg)
+	suite.Require().ErrorContains(err, "already approved")
+
+	_, err = msgSrv.MintHaqqByApplication(ctx, mintMsg)
+	suite.Require().NoError(err)
+
+	application, err = s.getApplication(res.ApplicationId)
+	suite.Require().NoError(err)
+	suite.Require().True(application.IsApproved)
+	suite.Require().True(application.IsExecuted)
+
+	// Executed application can be neither canceled nor approved
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{
+		FromAddress:   sender,
+		ApplicationId: res.ApplicationId,
+	})
+	suite.Require().ErrorContains(err, "already executed")
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelBurnApplication() {
+	suite.SetupTest()
+	ctx := s.network.GetContext()
+
+	msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+	sender := s.keyring.GetAccAddr(0).String()
+	burnedBefore := s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx)
+
+	res, err := msgSrv.SubmitBurnApplication(ctx, &ethiqtypes.MsgSubmitBurnApplication{
+		FromAddress: sender,
+		ToAddress:   s.keyring.GetAccAddr(1).String(),
+		BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1e18)),
+	})
+	suite.Require().NoError(err)
+
+	// Only the sender can cancel the application
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{
+		FromAddress:   s.keyring.GetAccAddr(1).String(),
+		ApplicationId: res.ApplicationId,
+	})
+	suite.Require().ErrorContains(err, "can be canceled by")
+
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{
+		FromAddress:   sender,
+		ApplicationId: 99999,
+	})
+	suite.Require().ErrorContains(err, "application not found")
+
+	cancelMsg := &ethiqtypes.MsgCancelBurnApplication{
+		FromAddress:   sender,
+		ApplicationId: res.ApplicationId,
+	}
+	_, err = msgSrv.CancelBurnApplication(ctx, cancelMsg)
+	suite.Require().NoError(err)
+
+	application, err := s.getApplication(res.ApplicationId)
+	suite.Require().NoError(err)
+	suite.Require().True(application.IsCanceled)
+
+	// The last canceled application releases its range of the price curve
+	suite.Require().Equal(burnedBefore, s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+
+	_, err = msgSrv.CancelBurnApplication(ctx, cancelMsg)
+	suite.Require().ErrorContains(err, "is canceled")
+
+	_, err = msgSrv.ApproveBurnApplication(ctx, &ethiqtypes.MsgApproveBurnApplication{
+		Authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		ApplicationId: res.ApplicationId,
+	})
+	suite.Require().ErrorContains(err, "is canceled")
+}
+
+func (suite *KeeperTestSuite) TestBurnApplicationPriceCurveRange() {
+	suite.SetupTest()
+	ctx := s.network.GetContext()
+
+	msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := s.keyring.GetAccAddr(0).String()
+	burnedBefore := s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx)
+	haqqBefore, err := s.network.App.EthiqKeeper.CalculateHaqqCoinsToMint(ctx, sdkmath.NewInt(1e18))
+	suite.Require().NoError(err)
+
+	submit := func(amount int64) uint64 {
+		res, err := msgSrv.SubmitBurnApplication(ctx, &ethiqtypes.MsgSubmitBurnApplication{
+			FromAddress: sender,
+			ToAddress:   sender,
+			BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(amount)),
+		})
+		suite.Require().NoError(err)
+		return res.ApplicationId
+	}
+	first := submit(1e18)
+	second := submit(2e18)
+
+	// submitted applications don't move the price for anyone
+	haqqAfter, err := s.network.App.EthiqKeeper.CalculateHaqqCoinsToMint(ctx, sdkmath.NewInt(1e18))
+	suite.Require().NoError(err)
+	suite.Require().Equal(haqqBefore, haqqAfter)
+	suite.Require().Equal(burnedBefore, s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+
+	// ranges are assigned in the approval order
+	_, err = msgSrv.ApproveBurnApplication(ctx, &ethiqtypes.MsgApproveBurnApplication{Authority: authority, ApplicationId: second})
+	suite.Require().NoError(err)
+	_, err = msgSrv.ApproveBurnApplication(ctx, &ethiqtypes.MsgApproveBurnApplication{Authority: authority, ApplicationId: first})
+	suite.Require().NoError(err)
+
+	secondApp, err := s.getApplication(second)
+	suite.Require().NoError(err)
+	suite.Require().Equal(burnedBefore, secondApp.BurnedBeforeAmount.Amount)
+	firstApp, err := s.getApplication(first)
+	suite.Require().NoError(err)
+	suite.Require().Equal(burnedBefore.Add(sdkmath.NewInt(2e18)), firstApp.BurnedBeforeAmount.Amount)
+	suite.Require().Equal(burnedBefore.Add(sdkmath.NewInt(3e18)), s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+
+	// canceling the last approved application releases its range
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{FromAddress: sender, ApplicationId: first})
+	suite.Require().NoError(err)
+	suite.Require().Equal(burnedBefore.Add(sdkmath.NewInt(2e18)), s.network.App.EthiqKeeper.GetSumOfAllApplications(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBurnApplicationPriceCurveRangeAfterPublicBurns() {
+	suite.SetupTest()
+	ctx := s.network.GetContext()
+
+	msgSrv := ethiqkeeper.NewMsgServerImpl(s.network.App.EthiqKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := s.keyring.GetAccAddr(0).String()
+	position := s.network.App.EthiqKeeper.GetCurvePosition(ctx)
+
+	publicBurn := func(amount int64) {
+		_, err := msgSrv.MintHaqq(ctx, &ethiqtypes.MsgMintHaqq{
+			FromAddress: sender,
+			ToAddress:   s.keyring.GetAccAddr(1).String(),
+			IslmAmount:  sdkmath.NewInt(amount),
+		})
+		suite.Require().NoError(err)
+	}
+	submitAndApprove := func(amount int64) uint64 {
+		res, err := msgSrv.SubmitBurnApplication(ctx, &ethiqtypes.MsgSubmitBurnApplication{
+			FromAddress: sender,
+			ToAddress:   sender,
+			BurnAmount:  sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(amount)),
+		})
+		suite.Require().NoError(err)
+		_, err = msgSrv.ApproveBurnApplication(ctx, &ethiqtypes.MsgApproveBurnApplication{Authority: authority, ApplicationId: res.ApplicationId})
+		suite.Require().NoError(err)
+		return res.ApplicationId
+	}
+
+	publicBurn(1e18)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(1e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+
+	// the application takes the range right after the public burn
+	appID := submitAndApprove(2e18)
+	application, err := s.getApplication(appID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(1e18)), application.BurnedBeforeAmount.Amount)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(3e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+
+	// the next public burn is priced after the application range
+	breakdown, totalBurnedAfter, err := s.network.App.EthiqKeeper.CalculateHaqqBreakdown(ctx, sdkmath.NewInt(1e18))
+	suite.Require().NoError(err)
+	expBreakdown, err := ethiqkeeper.CalculateHaqqBreakdownWithLevels(s.network.App.EthiqKeeper.GetPriceLevels(ctx), position.Add(sdkmath.NewInt(3e18)), sdkmath.NewInt(1e18))
+	suite.Require().NoError(err)
+	suite.Require().Equal(expBreakdown, breakdown)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(4e18)), totalBurnedAfter)
+
+	publicBurn(1e18)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(4e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+
+	// executing the application mints at its own range and doesn't move the position
+	expHaqq, err := s.network.App.EthiqKeeper.CalculateHaqqAmount(ctx, position.Add(sdkmath.NewInt(1e18)), sdkmath.NewInt(2e18))
+	suite.Require().NoError(err)
+	res, err := msgSrv.MintHaqqByApplication(ctx, &ethiqtypes.MsgMintHaqqByApplication{FromAddress: sender, ApplicationId: appID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expHaqq, res.HaqqAmount)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(4e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+
+	// the canceled application followed by a public burn keeps its range
+	appID = submitAndApprove(1e18)
+	publicBurn(1e18)
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{FromAddress: sender, ApplicationId: appID})
+	suite.Require().NoError(err)
+	application, err = s.getApplication(appID)
+	suite.Require().NoError(err)
+	suite.Require().True(application.IsApproved)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(6e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+
+	// the canceled application at the end of the curve releases its range
+	appID = submitAndApprove(1e18)
+	_, err = msgSrv.CancelBurnApplication(ctx, &ethiqtypes.MsgCancelBurnApplication{FromAddress: sender, ApplicationId: appID})
+	suite.Require().NoError(err)
+	application, err = s.getApplication(appID)
+	suite.Require().NoError(err)
+	suite.Require().False(application.IsApproved)
+	suite.Require().Equal(position.Add(sdkmath.NewInt(6e18)), s.network.App.EthiqKeeper.GetCurvePosition(ctx))
+}
@@ -115,3 +115,40 @@ func (k Keeper) GetAllExecutedApplicationsIDs(ctx sdk.Context) []uint64 {
 
 	return apps
 }
+
+// GetPriceLevels returns the burn price curve ordered by level
+func (k Keeper) GetPriceLevels(ctx sdk.Context) types.PriceLevels {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceLevelsPrefix)
+	levels := make(types.PriceLevels, 0)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var level types.PriceLevel
+		k.cdc.MustUnmarshal(iterator.Value(), &level)
+		levels = append(levels, level)
+	}
+
+	return levels
+}
+
+// SetPriceLevels replaces the burn price curve
+func (k Keeper) SetPriceLevels(ctx sdk.Context, levels types.PriceLevels) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceLevelsPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for i, level := range levels {
+		store.Set(sdk.Uint64ToBigEndian(uint64(i)), k.cdc.MustMarshal(&level)) //nolint: gosec // G115 -- index is never negative
+	}
+}
@@ -147,14 +147,14 @@ func (suite *KeeperTestSuite) TestExecutedApplicationsBoundaryIDs() {
 }
 
 // TestExecutedApplicationsAllRegisteredIDs marks executed-store round-trips for every application ID
-// present in the bundled registeredApplications slice (0 .. TotalNumberOfApplications()-1).
+// present in the bundled waitlist (0 .. len(WaitlistApplications())-1).
 // This complements boundaryExecutedApplicationIDs with real in-repo waitlist coverage.
 func (suite *KeeperTestSuite) TestExecutedApplicationsAllRegisteredIDs() {
 	suite.SetupTest()
 	ctx := s.network.GetContext()
 	k := s.network.App.EthiqKeeper
 
-	n := ethiqtypes.TotalNumberOfApplications()
+	n := uint64(len(ethiqtypes.WaitlistApplications()))
 	suite.Require().Positive(n, "registeredApplications must be non-empty")
 
 	for id := uint64(0); id < n; id++ {
@@ -192,3 +192,26 @@ func (suite *KeeperTestSuite) TestExecutedApplicationsAllRegisteredIDs() {
 	slices.Sort(got)
 	suite.Require().Equal(wantSorted, got)
 }
+
+func (suite *KeeperTestSuite) TestPriceLevels() {
+	suite.SetupTest()
+	ctx := s.network.GetContext()
+
+	// genesis seeds the default price curve
+	suite.Require().Equal(ethiqtypes.DefaultPriceLevels(), s.network.App.EthiqKeeper.GetPriceLevels(ctx))
+
+	levels := ethiqtypes.PriceLevels{
+		{From: sdkmath.ZeroInt(), To: sdkmath.NewInt(100), Price: sdkmath.LegacyNewDec(2)},
+		{From: sdkmath.NewInt(100), To: sdkmath.NewInt(200), Price: sdkmath.LegacyNewDec(4)},
+	}
+	s.network.App.EthiqKeeper.SetPriceLevels(ctx, levels)
+	suite.Require().Equal(levels, s.network.App.EthiqKeeper.GetPriceLevels(ctx))
+
+	// calculation follows the stored curve
+	haqqAmount, err := s.network.App.EthiqKeeper.CalculateHaqqAmount(ctx, sdkmath.ZeroInt(), sdkmath.NewInt(99))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(49), haqqAmount)
+
+	_, err = s.network.App.EthiqKeeper.CalculateHaqqAmount(ctx, sdkmath.ZeroInt(), sdkmath.NewInt(300))
+	suite.Require().ErrorIs(err, ethiqtypes.ErrExceedsPricingCurve)
+}
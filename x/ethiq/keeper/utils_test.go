@@ -2,14 +2,38 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/haqq-network/haqq/testutil"
+	ethiqtypes "github.com/haqq-network/haqq/x/ethiq/types"
 	"github.com/haqq-network/haqq/x/vesting/types"
 )
 
+// getApplication returns the burn application stored in the module state
+func (suite *KeeperTestSuite) getApplication(appID uint64) (*ethiqtypes.BurnApplication, error) {
+	application, found := suite.network.App.EthiqKeeper.GetApplication(suite.network.GetContext(), appID)
+	if !found {
+		return nil, fmt.Errorf("application %d not found", appID)
+	}
+
+	return &application, nil
+}
+
+// countWaitlistApplicationsBySender returns the number of waitlist applications submitted by sender
+func countWaitlistApplicationsBySender(sender string) uint64 {
+	var count uint64
+	for _, application := range ethiqtypes.WaitlistApplications() {
+		if application.FromAddress == sender {
+			count++
+		}
+	}
+
+	return count
+}
+
 func (suite *KeeperTestSuite) setupClawbackVestingAccount(ctx sdk.Context, vestingAcc, funderAcc sdk.AccAddress, balances sdk.Coins, withCliff bool) error { //nolint: unparam
 	totalVestingCoins := testutil.TestVestingSchedule.TotalVestingCoins
 	if totalVestingCoins.IsAllGT(balances) {
@@ -0,0 +1,31 @@
+package v2
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/haqq-network/haqq/x/ethiq/types"
+)
+
+// MigrateStore migrates the x/ethiq module state from the consensus version 1 to
+// version 2. Specifically, it seeds the burn price curve, which was previously
+// embedded into the binary, into the module state.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	levels := types.DefaultPriceLevels()
+	if err := levels.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.PriceLevelsPrefix)
+	for i, level := range levels {
+		store.Set(sdk.Uint64ToBigEndian(uint64(i)), cdc.MustMarshal(&level)) //nolint: gosec // G115 -- index is never negative
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package v3
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/haqq-network/haqq/x/ethiq/types"
+)
+
+// MigrateStore migrates the x/ethiq module state from the consensus version 2 to
+// version 3. Specifically, it seeds the burn applications waitlist, which was
+// previously embedded into the binary, into the module state. The execution
+// status of the applications is kept in the existing executed applications index.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	applications := types.WaitlistApplications()
+
+	kvStore := ctx.KVStore(storeKey)
+	store := prefix.NewStore(kvStore, types.ApplicationsPrefix)
+	for _, application := range applications {
+		// the sender index doesn't depend on the configured prefix,
+		// as the waitlist applications use the mainnet one.
+		_, sender, err := bech32.DecodeAndConvert(application.FromAddress)
+		if err != nil {
+			return err
+		}
+
+		application.IsExecuted = false
+		store.Set(sdk.Uint64ToBigEndian(application.Id), cdc.MustMarshal(&application))
+
+		senderStore := prefix.NewStore(kvStore, types.GetApplicationsBySenderPrefix(sender))
+		senderStore.Set(sdk.Uint64ToBigEndian(application.Id), []byte{0})
+	}
+
+	kvStore.Set(types.NextApplicationIDKey, sdk.Uint64ToBigEndian(uint64(len(applications))))
+
+	approvedAmount, err := types.ApprovedApplicationsAmount(applications).Marshal()
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.ApprovedApplicationsAmountKey, approvedAmount)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/haqq-network/haqq/encoding"
+	v3 "github.com/haqq-network/haqq/x/ethiq/migrations/v3"
+	"github.com/haqq-network/haqq/x/ethiq/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	applications := types.WaitlistApplications()
+	require.NotEmpty(t, applications)
+	require.Equal(t, uint64(len(applications)), sdk.BigEndianToUint64(kvStore.Get(types.NextApplicationIDKey)))
+
+	appStore := prefix.NewStore(kvStore, types.ApplicationsPrefix)
+	for _, expected := range applications {
+		var application types.BurnApplication
+		cdc.MustUnmarshal(appStore.Get(sdk.Uint64ToBigEndian(expected.Id)), &application)
+		require.Equal(t, expected, application)
+	}
+
+	// the price curve position is right after the last waitlist application
+	last := applications[len(applications)-1]
+	expPosition := last.BurnedBeforeAmount.Amount
+	if !last.IsCanceled {
+		expPosition = expPosition.Add(last.BurnAmount.Amount)
+	}
+	var position sdkmath.Int
+	require.NoError(t, position.Unmarshal(kvStore.Get(types.ApprovedApplicationsAmountKey)))
+	require.Equal(t, expPosition, position)
+}
+
+func TestMigrateApplicationsBySenderConsistency(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	applications := types.WaitlistApplications()
+	expected := make(map[string][]uint64)
+	for _, app := range applications {
+		expected[app.FromAddress] = append(expected[app.FromAddress], app.Id)
+	}
+
+	// every sender index entry belongs to exactly one application
+	total := 0
+	iterator := prefix.NewStore(kvStore, types.ApplicationsBySenderPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		total++
+	}
+	require.NoError(t, iterator.Close())
+	require.Equal(t, len(applications), total)
+
+	for sender, expectedIDs := range expected {
+		_, senderBz, err := bech32.DecodeAndConvert(sender)
+		require.NoError(t, err)
+
+		gotIDs := make([]uint64, 0, len(expectedIDs))
+		senderStore := prefix.NewStore(kvStore, types.GetApplicationsBySenderPrefix(senderBz))
+		senderIterator := senderStore.Iterator(nil, nil)
+		for ; senderIterator.Valid(); senderIterator.Next() {
+			gotIDs = append(gotIDs, sdk.BigEndianToUint64(senderIterator.Key()))
+		}
+		require.NoError(t, senderIterator.Close())
+
+		require.Equalf(t, expectedIDs, gotIDs, "sender %s application ids mismatch", sender)
+
+		for i, id := range gotIDs {
+			require.Lessf(t, id, uint64(len(applications)), "sender %s index %d points out of range app id %d", sender, i, id)
+			require.Equalf(t, sender, applications[id].FromAddress, "sender %s index %d points to app id %d owned by another sender", sender, i, id)
+		}
+	}
+}
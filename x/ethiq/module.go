@@ -24,7 +24,7 @@ import (
 )
 
 // consensusVersion defines the current x/ethiq module consensus version.
-const consensusVersion = 1
+const consensusVersion = 3
 
 var (
 	_ module.AppModule           = AppModule{}
@@ -124,6 +124,14 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the ethiq module. It returns
@@ -10,6 +10,7 @@ import (
 	"github.com/haqq-network/haqq/utils"
 )
 
+// ApplicationListItem is a burn application registered via smart-contract
 type ApplicationListItem struct {
 	ID                         uint64
 	FromAddress                string
@@ -19,7 +20,23 @@ type ApplicationListItem struct {
 	IslmAccumulatedBurntAmount string
 }
 
+// AsBurnApplication converts the waitlist item into an approved burn application
 func (a ApplicationListItem) AsBurnApplication() (*BurnApplication, error) {
+	burnApplication, err := a.toBurnApplication()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := burnApplication.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return burnApplication, nil
+}
+
+// toBurnApplication converts the waitlist item without the stateless validation,
+// which depends on the bech32 prefixes configured for the process.
+func (a ApplicationListItem) toBurnApplication() (*BurnApplication, error) {
 	islmAmount, ok := sdkmath.NewIntFromString(a.IslmAmount)
 	if !ok {
 		return nil, fmt.Errorf("invalid islm_amount: %s", a.IslmAmount)
@@ -30,7 +47,7 @@ func (a ApplicationListItem) AsBurnApplication() (*BurnApplication, error) {
 		return nil, fmt.Errorf("invalid islm_accumulated_burnt_amount: %s", a.IslmAccumulatedBurntAmount)
 	}
 
-	burnApplication := &BurnApplication{
+	return &BurnApplication{
 		Id:                 a.ID,
 		FromAddress:        a.FromAddress,
 		ToAddress:          a.ToAddress,
@@ -39,15 +56,24 @@ func (a ApplicationListItem) AsBurnApplication() (*BurnApplication, error) {
 		BurnedBeforeAmount: sdk.NewCoin(utils.BaseDenom, islmAccumulatedBurntAmount),
 		IsExecuted:         false,
 		IsCanceled:         islmAmount.IsZero(),
-	}
+		IsApproved:         true,
+	}, nil
+}
 
-	if err := burnApplication.ValidateBasic(); err != nil {
-		return nil, err
+// ApprovedApplicationsAmount returns the amount of the price curve taken by approved applications.
+// Canceled applications keep their range unless they released it, which withdraws their approval.
+func ApprovedApplicationsAmount(applications []BurnApplication) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, application := range applications {
+		if application.IsApproved {
+			amount = amount.Add(application.BurnAmount.Amount)
+		}
 	}
 
-	return burnApplication, nil
+	return amount
 }
 
+// ValidateBasic performs stateless validation of the burn application
 func (ba *BurnApplication) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(ba.FromAddress); err != nil {
 		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from_address: %v", err)
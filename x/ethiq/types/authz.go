@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
@@ -139,12 +140,15 @@ func (m *MintHaqqByApplicationIDAuthorization) Accept(_ context.Context, msg sdk
 	}, nil
 }
 
+// ValidateBasic performs stateless validation of the applications list.
+// Applications are stored in the module state, so their existence is checked on execution.
 func (m *MintHaqqByApplicationIDAuthorization) ValidateBasic() error {
-	// Validate applications list
+	seen := make(map[uint64]struct{}, len(m.ApplicationsList))
 	for _, appID := range m.ApplicationsList {
-		if !IsApplicationExists(appID) {
-			return ErrInvalidApplicationID
+		if _, ok := seen[appID]; ok {
+			return errorsmod.Wrapf(ErrInvalidApplicationID, "duplicate application ID %d", appID)
 		}
+		seen[appID] = struct{}{}
 	}
 
 	return nil
@@ -20,6 +20,10 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintHaqq{}, "haqq/ethiq/MsgMintHaqq", nil)
 	cdc.RegisterConcrete(&MsgMintHaqqByApplication{}, "haqq/ethiq/MsgMintHaqqByApplication", nil)
+	cdc.RegisterConcrete(&MsgUpdatePriceLevels{}, "haqq/ethiq/MsgUpdatePriceLevels", nil)
+	cdc.RegisterConcrete(&MsgSubmitBurnApplication{}, "haqq/ethiq/MsgSubmitBurnApplication", nil)
+	cdc.RegisterConcrete(&MsgCancelBurnApplication{}, "haqq/ethiq/MsgCancelBurnApplication", nil)
+	cdc.RegisterConcrete(&MsgApproveBurnApplication{}, "haqq/ethiq/MsgApproveBurnApplication", nil)
 
 	cdc.RegisterConcrete(&MintHaqqAuthorization{}, "haqq/ethiq/MintHaqqAuthorization", nil)
 	cdc.RegisterConcrete(&MintHaqqByApplicationIDAuthorization{}, "haqq/ethiq/MintHaqqByApplicationIDAuthorization", nil)
@@ -30,6 +34,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintHaqq{},
 		&MsgMintHaqqByApplication{},
+		&MsgUpdatePriceLevels{},
+		&MsgSubmitBurnApplication{},
+		&MsgCancelBurnApplication{},
+		&MsgApproveBurnApplication{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -19,4 +19,5 @@ var (
 	ErrMintCoins            = sdkerrors.Register(ModuleName, 12, "failed to mint coins")
 	ErrExceedsMaxSupply     = sdkerrors.Register(ModuleName, 13, "total aHAQQ supply exceeds allowed maximum")
 	ErrExceedsPricingCurve  = sdkerrors.Register(ModuleName, 14, "burn amount exceeds pricing curve capacity")
+	ErrInvalidPriceLevels   = sdkerrors.Register(ModuleName, 15, "invalid price levels")
 )
@@ -66,12 +66,17 @@ type BurnApplication struct {
 	Source SourceOfFunds `protobuf:"varint,4,opt,name=source,proto3,enum=haqq.ethiq.v1.SourceOfFunds" json:"source,omitempty"`
 	// burn_amount is an amount to be burned by this BurnApplication
 	BurnAmount types.Coin `protobuf:"bytes,5,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount"`
-	// burned_before_amount is a sum of burn_amount's of all previous BurnApplication's
+	// burned_before_amount is the position on the price curve the application
+	// starts at, made of the public burns and the burn_amount's of the
+	// BurnApplication's approved before this one. It is assigned on approval
 	BurnedBeforeAmount types.Coin `protobuf:"bytes,6,opt,name=burned_before_amount,json=burnedBeforeAmount,proto3" json:"burned_before_amount"`
 	// is_executed is a flag of application execution status
 	IsExecuted bool `protobuf:"varint,7,opt,name=is_executed,json=isExecuted,proto3" json:"is_executed,omitempty"`
 	// is_canceled is a flag of application cancellation status
 	IsCanceled bool `protobuf:"varint,8,opt,name=is_canceled,json=isCanceled,proto3" json:"is_canceled,omitempty"`
+	// is_approved is a flag of application approval by governance, only
+	// approved applications can be executed
+	IsApproved bool `protobuf:"varint,9,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`
 }
 
 func (m *BurnApplication) Reset()         { *m = BurnApplication{} }
@@ -163,6 +168,109 @@ func (m *BurnApplication) GetIsCanceled() bool {
 	return false
 }
 
+func (m *BurnApplication) GetIsApproved() bool {
+	if m != nil {
+		return m.IsApproved
+	}
+	return false
+}
+
+// PriceLevel defines a single segment of the aISLM to aHAQQ burn price curve.
+// The level covers the total burned aISLM range [from, to).
+type PriceLevel struct {
+	// from is the total burned aISLM amount the level starts at
+	From cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=from,proto3,customtype=cosmossdk.io/math.Int" json:"from"`
+	// to is the total burned aISLM amount the level ends at, exclusive
+	To cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=to,proto3,customtype=cosmossdk.io/math.Int" json:"to"`
+	// price is the amount of aISLM to be burned for a single aHAQQ within the level
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_344776ff2d01262c, []int{1}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+// PriceLevelBreakdown describes the part of a burn that falls into a single price level.
+type PriceLevelBreakdown struct {
+	// level is the index of the price level on the curve
+	Level uint64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// islm_amount is the amount of aISLM burned within the level
+	IslmAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=islm_amount,json=islmAmount,proto3,customtype=cosmossdk.io/math.Int" json:"islm_amount"`
+	// price is the unit price of aHAQQ within the level
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// haqq_amount is the amount of aHAQQ minted within the level
+	HaqqAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=haqq_amount,json=haqqAmount,proto3,customtype=cosmossdk.io/math.Int" json:"haqq_amount"`
+}
+
+func (m *PriceLevelBreakdown) Reset()         { *m = PriceLevelBreakdown{} }
+func (m *PriceLevelBreakdown) String() string { return proto.CompactTextString(m) }
+func (*PriceLevelBreakdown) ProtoMessage()    {}
+func (*PriceLevelBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_344776ff2d01262c, []int{2}
+}
+func (m *PriceLevelBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevelBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevelBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevelBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevelBreakdown.Merge(m, src)
+}
+func (m *PriceLevelBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevelBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevelBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevelBreakdown proto.InternalMessageInfo
+
+func (m *PriceLevelBreakdown) GetLevel() uint64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
 // Params defines the parameters for the ethiq module.
 type Params struct {
 	// enabled defines whether the module is enabled
@@ -179,7 +287,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_344776ff2d01262c, []int{1}
+	return fileDescriptor_344776ff2d01262c, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,51 +319,62 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("haqq.ethiq.v1.SourceOfFunds", SourceOfFunds_name, SourceOfFunds_value)
 	proto.RegisterType((*BurnApplication)(nil), "haqq.ethiq.v1.BurnApplication")
+	proto.RegisterType((*PriceLevel)(nil), "haqq.ethiq.v1.PriceLevel")
+	proto.RegisterType((*PriceLevelBreakdown)(nil), "haqq.ethiq.v1.PriceLevelBreakdown")
 	proto.RegisterType((*Params)(nil), "haqq.ethiq.v1.Params")
 }
 
 func init() { proto.RegisterFile("haqq/ethiq/v1/ethiq.proto", fileDescriptor_344776ff2d01262c) }
 
 var fileDescriptor_344776ff2d01262c = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x10, 0xf7, 0xa6, 0xfd, 0xa7, 0xcd, 0xa6, 0x5f, 0x7f, 0x93, 0x4a, 0x4e, 0x85, 0x9c, 0xa8, 0xa7,
-	0x28, 0x52, 0x6d, 0xa5, 0x20, 0x21, 0xc1, 0x29, 0x1f, 0xad, 0x54, 0x21, 0x9a, 0x2a, 0xa1, 0x1c,
-	0xb8, 0x58, 0x6b, 0x7b, 0x9b, 0xac, 0x5a, 0xef, 0xba, 0xbb, 0xeb, 0xe2, 0xbe, 0x01, 0xe2, 0xc4,
-	0x0b, 0x20, 0xf5, 0xc8, 0x09, 0xf5, 0xc0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x2a, 0xd4, 0x1c,
-	0xca, 0x63, 0x20, 0x7b, 0x37, 0xa0, 0x70, 0x81, 0x5e, 0xac, 0x9d, 0xf9, 0x7d, 0xcc, 0x78, 0x76,
-	0x07, 0x56, 0xc7, 0xe8, 0xf4, 0xd4, 0xc5, 0x72, 0x4c, 0x4e, 0xdd, 0xb3, 0x96, 0x3a, 0x38, 0x31,
-	0x67, 0x92, 0x99, 0xcb, 0x19, 0xe4, 0xa8, 0xcc, 0x59, 0x6b, 0xa3, 0x32, 0x62, 0x23, 0x96, 0x23,
-	0x6e, 0x76, 0x52, 0xa4, 0x8d, 0xff, 0x51, 0x44, 0x28, 0x73, 0xf3, 0xaf, 0x4e, 0x55, 0x03, 0x26,
-	0x22, 0x26, 0x3c, 0xc5, 0x55, 0x81, 0x86, 0x6c, 0x15, 0xb9, 0x3e, 0x12, 0xd8, 0x3d, 0x6b, 0xf9,
-	0x58, 0xa2, 0x96, 0x1b, 0x30, 0x42, 0x15, 0xbe, 0xf9, 0x69, 0x0e, 0xae, 0x76, 0x12, 0x4e, 0xdb,
-	0x71, 0x7c, 0x42, 0x02, 0x24, 0x09, 0xa3, 0xe6, 0x0a, 0x2c, 0x90, 0xd0, 0x02, 0x75, 0xd0, 0x98,
-	0x1f, 0x14, 0x48, 0x68, 0x3e, 0x83, 0x4b, 0x47, 0x9c, 0x45, 0x1e, 0x0a, 0x43, 0x8e, 0x85, 0xb0,
-	0x0a, 0x75, 0xd0, 0x28, 0x75, 0xac, 0x2f, 0x9f, 0xb7, 0x2a, 0xba, 0x56, 0x5b, 0x21, 0x43, 0xc9,
-	0x09, 0x1d, 0x0d, 0xca, 0x19, 0x5b, 0xa7, 0xcc, 0x27, 0x10, 0x4a, 0xf6, 0x4b, 0x3a, 0xf7, 0x17,
-	0x69, 0x49, 0xb2, 0xa9, 0xf0, 0x31, 0x2c, 0x0a, 0x96, 0xf0, 0x00, 0x5b, 0xf3, 0x75, 0xd0, 0x58,
-	0xd9, 0x7e, 0xe8, 0xcc, 0x4c, 0xc7, 0x19, 0xe6, 0x60, 0xff, 0x68, 0x37, 0xa1, 0xa1, 0x18, 0x68,
-	0xae, 0xb9, 0x03, 0xcb, 0x7e, 0xc2, 0xa9, 0x87, 0x22, 0x96, 0x50, 0x69, 0xfd, 0x57, 0x07, 0x8d,
-	0xf2, 0x76, 0xd5, 0xd1, 0xc5, 0xb2, 0x29, 0x38, 0x7a, 0x0a, 0x4e, 0x97, 0x11, 0xda, 0x29, 0x5d,
-	0xdd, 0xd4, 0x8c, 0x8f, 0x77, 0x97, 0x4d, 0x30, 0x80, 0x99, 0xb0, 0x9d, 0xeb, 0xcc, 0x57, 0xb0,
-	0x92, 0x45, 0x38, 0xf4, 0x7c, 0x7c, 0xc4, 0x38, 0x9e, 0xfa, 0x15, 0xef, 0xe1, 0x67, 0x2a, 0x87,
-	0x4e, 0x6e, 0xa0, 0x7d, 0x6b, 0xb0, 0x4c, 0x84, 0x87, 0x53, 0x1c, 0x24, 0x12, 0x87, 0xd6, 0x42,
-	0x1d, 0x34, 0x16, 0x07, 0x90, 0x88, 0x1d, 0x9d, 0xd1, 0x84, 0x00, 0xd1, 0x00, 0x9f, 0xe0, 0xd0,
-	0x5a, 0x9c, 0x12, 0xba, 0x3a, 0xb3, 0xf9, 0xa1, 0x00, 0x8b, 0x07, 0x88, 0xa3, 0x48, 0x98, 0x16,
-	0x5c, 0xc0, 0x14, 0xf9, 0x19, 0x0f, 0xe4, 0xbc, 0x69, 0x68, 0xee, 0xc1, 0xd5, 0x88, 0x50, 0x2f,
-	0x22, 0x54, 0x7a, 0x31, 0xe6, 0x9e, 0x4c, 0xf5, 0xa5, 0x6d, 0x66, 0xed, 0x7d, 0xbb, 0xa9, 0xad,
-	0xab, 0x1f, 0x10, 0xe1, 0xb1, 0x43, 0x98, 0x1b, 0x21, 0x39, 0x76, 0xf6, 0xa8, 0x54, 0x7d, 0x2f,
-	0x45, 0x84, 0xbe, 0x20, 0x54, 0x1e, 0x60, 0xfe, 0x32, 0xcd, 0xad, 0x50, 0x3a, 0x63, 0x35, 0x77,
-	0x0f, 0x2b, 0x94, 0xfe, 0xb6, 0x6a, 0x43, 0x98, 0x59, 0x89, 0x24, 0x8e, 0x4f, 0xce, 0xf3, 0x5b,
-	0xfd, 0x37, 0x97, 0x52, 0x84, 0xd2, 0x61, 0x2e, 0x7a, 0x5a, 0x7f, 0x7b, 0x51, 0x33, 0x7e, 0x5c,
-	0xd4, 0x8c, 0x77, 0x77, 0x97, 0xcd, 0x07, 0xf9, 0x26, 0xa5, 0x7a, 0x97, 0xd4, 0x50, 0x9a, 0x3d,
-	0xb8, 0x3c, 0xf3, 0x32, 0x4c, 0x0b, 0x56, 0x86, 0xfd, 0xc3, 0x41, 0x77, 0xc7, 0xeb, 0xef, 0x7a,
-	0xbb, 0x87, 0xfb, 0xbd, 0xa1, 0xd7, 0x69, 0xef, 0x3f, 0x5f, 0x33, 0xcc, 0x2a, 0x5c, 0xff, 0x13,
-	0x39, 0xec, 0xf6, 0xda, 0xfd, 0x35, 0xd0, 0xe9, 0x5d, 0xdd, 0xda, 0xe0, 0xfa, 0xd6, 0x06, 0xdf,
-	0x6f, 0x6d, 0xf0, 0x7e, 0x62, 0x1b, 0xd7, 0x13, 0xdb, 0xf8, 0x3a, 0xb1, 0x8d, 0xd7, 0xcd, 0x11,
-	0x91, 0xe3, 0xc4, 0x77, 0x02, 0x16, 0xb9, 0x59, 0xfd, 0x2d, 0x8a, 0xe5, 0x1b, 0xc6, 0x8f, 0xdd,
-	0x99, 0x66, 0xe4, 0x79, 0x8c, 0x85, 0x5f, 0xcc, 0x77, 0xec, 0xd1, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf4, 0x5d, 0x0a, 0x39, 0xf3, 0x03, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0xe3, 0x46,
+	0x18, 0xc0, 0x63, 0x13, 0x02, 0x99, 0xf0, 0xaf, 0x43, 0x90, 0x1c, 0x5a, 0x25, 0x51, 0x4e, 0x51,
+	0x24, 0xec, 0x86, 0x56, 0xad, 0xd4, 0x5e, 0x1a, 0x27, 0x20, 0xa1, 0x52, 0x42, 0x93, 0xd2, 0x43,
+	0x2f, 0xd6, 0xc4, 0x1e, 0x92, 0x11, 0xf1, 0x8c, 0xb1, 0xc7, 0x21, 0xbc, 0x41, 0xd5, 0x53, 0x5f,
+	0xa0, 0x2a, 0xc7, 0x1e, 0x39, 0xf0, 0x10, 0xdc, 0x8a, 0x38, 0x55, 0x3d, 0xa0, 0x55, 0x38, 0xb0,
+	0x8f, 0xb1, 0x1a, 0xcf, 0x64, 0xd9, 0xec, 0x4a, 0xbb, 0x0b, 0xda, 0x8b, 0xe5, 0xef, 0xdf, 0xef,
+	0xfb, 0x33, 0x9f, 0x3e, 0x50, 0x18, 0xa0, 0xd3, 0x53, 0x0b, 0xf3, 0x01, 0x39, 0xb5, 0x46, 0x75,
+	0xf9, 0x63, 0x06, 0x21, 0xe3, 0x0c, 0x2e, 0x0b, 0x93, 0x29, 0x35, 0xa3, 0xfa, 0x66, 0xbe, 0xcf,
+	0xfa, 0x2c, 0xb1, 0x58, 0xe2, 0x4f, 0x3a, 0x6d, 0x7e, 0x86, 0x7c, 0x42, 0x99, 0x95, 0x7c, 0x95,
+	0xaa, 0xe0, 0xb2, 0xc8, 0x67, 0x91, 0x23, 0x7d, 0xa5, 0xa0, 0x4c, 0x45, 0x29, 0x59, 0x3d, 0x14,
+	0x61, 0x6b, 0x54, 0xef, 0x61, 0x8e, 0xea, 0x96, 0xcb, 0x08, 0x95, 0xf6, 0xca, 0xbf, 0x73, 0x60,
+	0xd5, 0x8e, 0x43, 0xda, 0x08, 0x82, 0x21, 0x71, 0x11, 0x27, 0x8c, 0xc2, 0x15, 0xa0, 0x13, 0xcf,
+	0xd0, 0xca, 0x5a, 0x35, 0xdd, 0xd1, 0x89, 0x07, 0xbf, 0x07, 0x4b, 0xc7, 0x21, 0xf3, 0x1d, 0xe4,
+	0x79, 0x21, 0x8e, 0x22, 0x43, 0x2f, 0x6b, 0xd5, 0xac, 0x6d, 0xdc, 0x5e, 0x6d, 0xe5, 0x55, 0xae,
+	0x86, 0xb4, 0x74, 0x79, 0x48, 0x68, 0xbf, 0x93, 0x13, 0xde, 0x4a, 0x05, 0xbf, 0x05, 0x80, 0xb3,
+	0xd7, 0xa1, 0x73, 0x1f, 0x08, 0xcd, 0x72, 0x36, 0x0d, 0xfc, 0x1a, 0x64, 0x22, 0x16, 0x87, 0x2e,
+	0x36, 0xd2, 0x65, 0xad, 0xba, 0xb2, 0xfd, 0x85, 0x39, 0x33, 0x1d, 0xb3, 0x9b, 0x18, 0xdb, 0xc7,
+	0xbb, 0x31, 0xf5, 0xa2, 0x8e, 0xf2, 0x85, 0x3b, 0x20, 0xd7, 0x8b, 0x43, 0xea, 0x20, 0x9f, 0xc5,
+	0x94, 0x1b, 0xf3, 0x65, 0xad, 0x9a, 0xdb, 0x2e, 0x98, 0x2a, 0x99, 0x98, 0x82, 0xa9, 0xa6, 0x60,
+	0x36, 0x19, 0xa1, 0x76, 0xf6, 0xfa, 0xae, 0x94, 0xfa, 0xe7, 0xe1, 0xb2, 0xa6, 0x75, 0x80, 0x08,
+	0x6c, 0x24, 0x71, 0xf0, 0x57, 0x90, 0x17, 0x12, 0xf6, 0x9c, 0x1e, 0x3e, 0x66, 0x21, 0x9e, 0xf2,
+	0x32, 0x4f, 0xe0, 0x41, 0x49, 0xb0, 0x13, 0x80, 0xe2, 0x96, 0x40, 0x8e, 0x44, 0x0e, 0x1e, 0x63,
+	0x37, 0xe6, 0xd8, 0x33, 0x16, 0xca, 0x5a, 0x75, 0xb1, 0x03, 0x48, 0xb4, 0xa3, 0x34, 0xca, 0xc1,
+	0x45, 0xd4, 0xc5, 0x43, 0xec, 0x19, 0x8b, 0x53, 0x87, 0xa6, 0xd2, 0x28, 0x07, 0x14, 0x04, 0x21,
+	0x1b, 0x61, 0xcf, 0xc8, 0x4e, 0x1d, 0x1a, 0x4a, 0x53, 0x99, 0x68, 0x00, 0x1c, 0x86, 0xc4, 0xc5,
+	0xfb, 0x78, 0x84, 0x87, 0xb0, 0x05, 0xd2, 0xe2, 0x39, 0x92, 0xe7, 0xcc, 0xda, 0x5f, 0x8a, 0xf2,
+	0xfe, 0xbf, 0x2b, 0x6d, 0xc8, 0x06, 0x22, 0xef, 0xc4, 0x24, 0xcc, 0xf2, 0x11, 0x1f, 0x98, 0x7b,
+	0x94, 0xdf, 0x5e, 0x6d, 0x01, 0xd5, 0xd9, 0x1e, 0xe5, 0xb2, 0x8b, 0x24, 0x1a, 0xfe, 0x00, 0x74,
+	0xce, 0x0c, 0xfd, 0x99, 0x0c, 0x9d, 0x33, 0xb8, 0x0f, 0xe6, 0x03, 0x51, 0x95, 0x5a, 0x81, 0x6f,
+	0x14, 0xe4, 0xf3, 0x77, 0x21, 0xfb, 0xb8, 0x8f, 0xdc, 0xf3, 0x16, 0x76, 0xdf, 0x40, 0xb5, 0xb0,
+	0x2b, 0x51, 0x12, 0x52, 0xf9, 0x5b, 0x07, 0xeb, 0x8f, 0x4d, 0xda, 0x21, 0x46, 0x27, 0x1e, 0x3b,
+	0xa3, 0x30, 0x0f, 0xe6, 0x87, 0x42, 0xa3, 0xb6, 0x57, 0x0a, 0xf0, 0x67, 0x31, 0xb3, 0xa1, 0x3f,
+	0x7d, 0xc4, 0xe7, 0xb6, 0x01, 0x04, 0x44, 0x3d, 0xe4, 0x27, 0x6d, 0x47, 0x14, 0x28, 0x96, 0x7b,
+	0x5a, 0x60, 0xfa, 0xb9, 0x05, 0x0a, 0x88, 0x2c, 0xb0, 0xf2, 0x97, 0x0e, 0x32, 0x87, 0x28, 0x44,
+	0x7e, 0x04, 0x0d, 0xb0, 0x80, 0x29, 0xea, 0x89, 0x7d, 0xd2, 0x92, 0x75, 0x99, 0x8a, 0x70, 0x0f,
+	0xac, 0xfa, 0x84, 0x3a, 0x3e, 0xa1, 0xdc, 0x09, 0x70, 0xe8, 0xf0, 0xb1, 0x1a, 0x4e, 0xe5, 0xbd,
+	0xb9, 0x65, 0xb6, 0x25, 0x9f, 0xd0, 0x9f, 0x08, 0xe5, 0x87, 0x38, 0xfc, 0x65, 0x9c, 0xa0, 0xd0,
+	0x78, 0x06, 0x35, 0xf7, 0x04, 0x14, 0x1a, 0x3f, 0xa2, 0x1a, 0x00, 0x08, 0x54, 0x14, 0x07, 0xc1,
+	0xf0, 0xdc, 0x48, 0x7f, 0x34, 0x25, 0xeb, 0xa3, 0x71, 0x37, 0x09, 0xfa, 0xae, 0xfc, 0xfb, 0x45,
+	0x29, 0xf5, 0xf2, 0xa2, 0x94, 0xfa, 0xe3, 0xe1, 0xb2, 0xb6, 0x9e, 0x5c, 0xdc, 0xb1, 0xba, 0xb9,
+	0x72, 0x28, 0xb5, 0x16, 0x58, 0x9e, 0xb9, 0x20, 0xd0, 0x00, 0xf9, 0x6e, 0xfb, 0xa8, 0xd3, 0xdc,
+	0x71, 0xda, 0xbb, 0xce, 0xee, 0xd1, 0x41, 0xab, 0xeb, 0xd8, 0x8d, 0x83, 0x1f, 0xd7, 0x52, 0xb0,
+	0x00, 0x36, 0xde, 0xb6, 0x1c, 0x35, 0x5b, 0x8d, 0xf6, 0x9a, 0x66, 0xb7, 0xae, 0x27, 0x45, 0xed,
+	0x66, 0x52, 0xd4, 0x5e, 0x4c, 0x8a, 0xda, 0x9f, 0xf7, 0xc5, 0xd4, 0xcd, 0x7d, 0x31, 0xf5, 0xdf,
+	0x7d, 0x31, 0xf5, 0x5b, 0xad, 0x4f, 0xf8, 0x20, 0xee, 0x99, 0x2e, 0xf3, 0x2d, 0x91, 0x7f, 0x8b,
+	0x62, 0x7e, 0xc6, 0xc2, 0x13, 0x6b, 0xa6, 0x18, 0x7e, 0x1e, 0xe0, 0xa8, 0x97, 0x49, 0x6e, 0xf1,
+	0x57, 0xaf, 0x06, 0x00, 0x6c, 0x4d, 0x55, 0x49, 0x1b, 0x06, 0x00, 0x00,
 }
 
 func (m *BurnApplication) Marshal() (dAtA []byte, err error) {
@@ -278,6 +397,16 @@ func (m *BurnApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsApproved {
+		i--
+		if m.IsApproved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.IsCanceled {
 		i--
 		if m.IsCanceled {
@@ -345,6 +474,117 @@ func (m *BurnApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.To.Size()
+		i -= size
+		if _, err := m.To.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.From.Size()
+		i -= size
+		if _, err := m.From.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevelBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevelBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevelBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.HaqqAmount.Size()
+		i -= size
+		if _, err := m.HaqqAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.IslmAmount.Size()
+		i -= size
+		if _, err := m.IslmAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthiq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Level != 0 {
+		i = encodeVarintEthiq(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -449,6 +689,42 @@ func (m *BurnApplication) Size() (n int) {
 	if m.IsCanceled {
 		n += 2
 	}
+	if m.IsApproved {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.From.Size()
+	n += 1 + l + sovEthiq(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovEthiq(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEthiq(uint64(l))
+	return n
+}
+
+func (m *PriceLevelBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovEthiq(uint64(m.Level))
+	}
+	l = m.IslmAmount.Size()
+	n += 1 + l + sovEthiq(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovEthiq(uint64(l))
+	l = m.HaqqAmount.Size()
+	n += 1 + l + sovEthiq(uint64(l))
 	return n
 }
 
@@ -713,6 +989,349 @@ func (m *BurnApplication) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsCanceled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsApproved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsApproved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthiq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthiq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthiq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevelBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthiq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevelBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevelBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IslmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthiq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthiq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEthiq(dAtA[iNdEx:])
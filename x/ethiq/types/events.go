@@ -3,6 +3,10 @@ package types
 const (
 	EventTypeMintExecuted                = "mint_executed"
 	EventTypeMintByApplicationIDExecuted = "mint_by_application_id_executed"
+	EventTypeUpdatePriceLevels           = "update_price_levels"
+	EventTypeSubmitBurnApplication       = "submit_burn_application"
+	EventTypeCancelBurnApplication       = "cancel_burn_application"
+	EventTypeApproveBurnApplication      = "approve_burn_application"
 
 	AttributeKeyHaqqMinted             = "minted"
 	AttributeKeyHaqqSupplyBefore       = "supply_before"
@@ -15,4 +19,7 @@ const (
 	AttributeKeyApplicationID          = "application_id"
 	AttributeKeyApplicationFundsSource = "application_funds_source"
 	AttributeKeyRedeemedLiquidAmount   = "redeemed_liquid_amount"
+	AttributeKeyPriceLevelsCount       = "price_levels_count"
+	AttributeKeyBurnAmount             = "burn_amount"
+	AttributeKeyBurnedBeforeAmount     = "burned_before_amount"
 )
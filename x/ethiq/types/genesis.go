@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,6 +14,8 @@ func DefaultGenesisState() *GenesisState {
 		Params:               DefaultParams(),
 		TotalBurnedAmount:    sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()),
 		ExecutedApplications: nil,
+		PriceLevels:          DefaultPriceLevels(),
+		Applications:         WaitlistApplications(),
 	}
 }
 
@@ -27,11 +30,27 @@ func (gs GenesisState) Validate() error {
 		return ErrInvalidAmount
 	}
 
+	applicationIDs := make(map[uint64]struct{}, len(gs.Applications))
+	for _, application := range gs.Applications {
+		if _, ok := applicationIDs[application.Id]; ok {
+			return errorsmod.Wrapf(ErrInvalidApplicationID, "duplicate application ID %d", application.Id)
+		}
+		applicationIDs[application.Id] = struct{}{}
+
+		if err := application.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid application %d", application.Id)
+		}
+	}
+
 	for _, appID := range gs.ExecutedApplications {
-		if !IsApplicationExists(appID) {
-			return ErrInvalidApplicationID
+		if _, ok := applicationIDs[appID]; !ok {
+			return errorsmod.Wrapf(ErrInvalidApplicationID, "executed application %d not found", appID)
 		}
 	}
 
+	if err := PriceLevels(gs.PriceLevels).Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -33,6 +33,10 @@ type GenesisState struct {
 	TotalBurnedAmount types.Coin `protobuf:"bytes,2,opt,name=total_burned_amount,json=totalBurnedAmount,proto3" json:"total_burned_amount"`
 	// executed_applications defines the list of executed applications
 	ExecutedApplications []uint64 `protobuf:"varint,3,rep,packed,name=executed_applications,json=executedApplications,proto3" json:"executed_applications,omitempty"`
+	// price_levels defines the aISLM to aHAQQ burn price curve
+	PriceLevels []PriceLevel `protobuf:"bytes,4,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels"`
+	// applications defines the list of registered burn applications
+	Applications []BurnApplication `protobuf:"bytes,5,rep,name=applications,proto3" json:"applications"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +93,20 @@ func (m *GenesisState) GetExecutedApplications() []uint64 {
 	return nil
 }
 
+func (m *GenesisState) GetPriceLevels() []PriceLevel {
+	if m != nil {
+		return m.PriceLevels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApplications() []BurnApplication {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "haqq.ethiq.v1.GenesisState")
 }
@@ -96,28 +114,31 @@ func init() {
 func init() { proto.RegisterFile("haqq/ethiq/v1/genesis.proto", fileDescriptor_c4749eef30a9422e) }
 
 var fileDescriptor_c4749eef30a9422e = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xe3, 0xdb, 0x7b, 0x2b, 0xdd, 0x00, 0x43, 0x43, 0x2b, 0xb5, 0x45, 0x32, 0x15, 0x53,
-	0x55, 0x09, 0x5b, 0x81, 0x05, 0xb1, 0x35, 0x20, 0xb1, 0x22, 0x60, 0x62, 0xa9, 0x9c, 0x60, 0xa5,
-	0x16, 0x8d, 0x4f, 0x1a, 0x9f, 0x94, 0xf2, 0x16, 0x3c, 0x06, 0x23, 0x8f, 0xd1, 0xb1, 0x23, 0x62,
-	0x40, 0xa8, 0x1d, 0x78, 0x0d, 0x14, 0x27, 0x48, 0xed, 0x12, 0xc5, 0xfe, 0xfe, 0xff, 0x3f, 0xbf,
-	0x8f, 0x7b, 0x30, 0x16, 0xd3, 0x29, 0x97, 0x38, 0x56, 0x53, 0x3e, 0xf3, 0x79, 0x2c, 0xb5, 0x34,
-	0xca, 0xb0, 0x34, 0x03, 0x04, 0x6f, 0xaf, 0x80, 0xcc, 0x42, 0x36, 0xf3, 0xbb, 0xcd, 0x18, 0x62,
-	0xb0, 0x84, 0x17, 0x7f, 0xa5, 0xa8, 0x4b, 0x23, 0x30, 0x09, 0x18, 0x1e, 0x0a, 0x23, 0xf9, 0xcc,
-	0x0f, 0x25, 0x0a, 0x9f, 0x47, 0xa0, 0x74, 0xc5, 0x1b, 0x22, 0x51, 0x1a, 0xb8, 0xfd, 0x56, 0x57,
-	0x9d, 0xed, 0xa1, 0xe5, 0x00, 0x8b, 0x8e, 0x3e, 0x88, 0xbb, 0x7b, 0x55, 0x96, 0xb8, 0x45, 0x81,
-	0xd2, 0x3b, 0x73, 0xeb, 0xa9, 0xc8, 0x44, 0x62, 0xda, 0xa4, 0x47, 0xfa, 0x3b, 0x27, 0x2d, 0xb6,
-	0x55, 0x8a, 0x5d, 0x5b, 0x18, 0xfc, 0x5f, 0x7c, 0x1e, 0x3a, 0xaf, 0xdf, 0x6f, 0x03, 0x72, 0x53,
-	0xe9, 0xbd, 0x3b, 0x77, 0x1f, 0x01, 0xc5, 0x64, 0x14, 0xe6, 0x99, 0x96, 0x0f, 0x23, 0x91, 0x40,
-	0xae, 0xb1, 0xfd, 0xc7, 0xc6, 0x74, 0x58, 0x59, 0x9b, 0x15, 0xb5, 0x59, 0x55, 0x9b, 0x5d, 0x80,
-	0xd2, 0x9b, 0x51, 0x0d, 0x1b, 0x10, 0x58, 0xff, 0xd0, 0xda, 0xbd, 0x73, 0xb7, 0x25, 0xe7, 0x32,
-	0xca, 0xb1, 0x48, 0x4c, 0xd3, 0x89, 0x8a, 0x04, 0x2a, 0xd0, 0xa6, 0x5d, 0xeb, 0xd5, 0xfa, 0x7f,
-	0x83, 0x7f, 0xa5, 0xb1, 0xf9, 0xab, 0x19, 0x6e, 0x48, 0x82, 0xcb, 0xc5, 0x8a, 0x92, 0xe5, 0x8a,
-	0x92, 0xaf, 0x15, 0x25, 0x2f, 0x6b, 0xea, 0x2c, 0xd7, 0xd4, 0x79, 0x5f, 0x53, 0xe7, 0x7e, 0x10,
-	0x2b, 0x1c, 0xe7, 0x21, 0x8b, 0x20, 0xe1, 0xc5, 0xfb, 0x8e, 0xb5, 0xc4, 0x27, 0xc8, 0x1e, 0xed,
-	0x81, 0xcf, 0xab, 0x5d, 0xe1, 0x73, 0x2a, 0x4d, 0x58, 0xb7, 0x9b, 0x3a, 0xfd, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xc9, 0xf8, 0xa9, 0xba, 0xbb, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbf, 0x6a, 0xdb, 0x40,
+	0x1c, 0xc7, 0x25, 0xff, 0x83, 0xca, 0xee, 0x60, 0xd5, 0x06, 0xd9, 0x85, 0xab, 0xe9, 0x64, 0x0c,
+	0xbd, 0x43, 0xed, 0x52, 0xba, 0x59, 0x2d, 0x78, 0x69, 0x20, 0x24, 0x99, 0xb2, 0x98, 0x93, 0x72,
+	0xc8, 0x47, 0xa4, 0x3b, 0x59, 0x77, 0x52, 0x9c, 0xb7, 0xc8, 0x63, 0x64, 0xcc, 0x63, 0x78, 0xf4,
+	0x98, 0x29, 0x04, 0x7b, 0xc8, 0x9e, 0x27, 0x08, 0x77, 0x52, 0x82, 0xe4, 0x45, 0x48, 0xf7, 0xfd,
+	0xfe, 0x3e, 0xbf, 0x8f, 0x38, 0xeb, 0xeb, 0x0a, 0xaf, 0xd7, 0x88, 0xc8, 0x15, 0x5d, 0xa3, 0xdc,
+	0x45, 0x21, 0x61, 0x44, 0x50, 0x01, 0x93, 0x94, 0x4b, 0x6e, 0x7f, 0x56, 0x21, 0xd4, 0x21, 0xcc,
+	0xdd, 0xf1, 0x20, 0xe4, 0x21, 0xd7, 0x09, 0x52, 0x6f, 0x45, 0x69, 0x0c, 0x02, 0x2e, 0x62, 0x2e,
+	0x90, 0x8f, 0x05, 0x41, 0xb9, 0xeb, 0x13, 0x89, 0x5d, 0x14, 0x70, 0xca, 0xca, 0xbc, 0x8f, 0x63,
+	0xca, 0x38, 0xd2, 0xcf, 0xf2, 0x68, 0x54, 0x5f, 0x5a, 0x2c, 0xd0, 0xd1, 0xf7, 0xd7, 0x86, 0xd5,
+	0x5b, 0x14, 0x12, 0xe7, 0x12, 0x4b, 0x62, 0xff, 0xb6, 0x3a, 0x09, 0x4e, 0x71, 0x2c, 0x1c, 0x73,
+	0x62, 0x4e, 0xbb, 0x3f, 0x87, 0xb0, 0x26, 0x05, 0x4f, 0x75, 0xe8, 0x7d, 0xda, 0x3e, 0x7d, 0x33,
+	0xee, 0x5f, 0x1e, 0x66, 0xe6, 0x59, 0xd9, 0xb7, 0x2f, 0xac, 0x2f, 0x92, 0x4b, 0x1c, 0x2d, 0xfd,
+	0x2c, 0x65, 0xe4, 0x6a, 0x89, 0x63, 0x9e, 0x31, 0xe9, 0x34, 0x34, 0x66, 0x04, 0x0b, 0x6d, 0xa8,
+	0xb4, 0x61, 0xa9, 0x0d, 0xff, 0x72, 0xca, 0xaa, 0xa8, 0xbe, 0x06, 0x78, 0x7a, 0x7e, 0xae, 0xc7,
+	0xed, 0x3f, 0xd6, 0x90, 0x6c, 0x48, 0x90, 0x49, 0x45, 0x4c, 0x92, 0x88, 0x06, 0x58, 0x52, 0xce,
+	0x84, 0xd3, 0x9c, 0x34, 0xa7, 0x2d, 0xaf, 0x5d, 0x0c, 0x0e, 0xde, 0x3b, 0xf3, 0x4a, 0xc5, 0x5e,
+	0x58, 0xbd, 0x24, 0xa5, 0x01, 0x59, 0x46, 0x24, 0x27, 0x91, 0x70, 0x5a, 0x93, 0xa6, 0x56, 0x39,
+	0xfa, 0x23, 0x55, 0xf9, 0xaf, 0x1a, 0x55, 0x95, 0x6e, 0xf2, 0x71, 0x2c, 0xec, 0x13, 0xab, 0x57,
+	0xdb, 0xdd, 0xd6, 0x20, 0x70, 0x04, 0x52, 0xde, 0x95, 0xfd, 0x55, 0x5a, 0x6d, 0xdc, 0xfb, 0xb7,
+	0xdd, 0x03, 0x73, 0xb7, 0x07, 0xe6, 0xf3, 0x1e, 0x98, 0x77, 0x07, 0x60, 0xec, 0x0e, 0xc0, 0x78,
+	0x3c, 0x00, 0xe3, 0x72, 0x16, 0x52, 0xb9, 0xca, 0x7c, 0x18, 0xf0, 0x18, 0x29, 0xf8, 0x0f, 0x46,
+	0xe4, 0x0d, 0x4f, 0xaf, 0xf5, 0x07, 0xda, 0x94, 0x77, 0x28, 0x6f, 0x13, 0x22, 0xfc, 0x8e, 0xbe,
+	0xc1, 0x5f, 0x6f, 0x03, 0x00, 0xca, 0x6a, 0x78, 0xa9, 0x53, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -140,6 +161,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PriceLevels) > 0 {
+		for iNdEx := len(m.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ExecutedApplications) > 0 {
 		dAtA2 := make([]byte, len(m.ExecutedApplications)*10)
 		var j1 int
@@ -209,6 +258,18 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.PriceLevels) > 0 {
+		for _, e := range m.PriceLevels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -389,6 +450,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedApplications", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLevels = append(m.PriceLevels, PriceLevel{})
+			if err := m.PriceLevels[len(m.PriceLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, BurnApplication{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
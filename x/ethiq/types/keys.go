@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the module name constant used in many places
 	ModuleName = "ethiq"
@@ -21,4 +26,19 @@ var (
 	TotalBurnedFromApplicationsAmountKey = []byte{0x02}
 	// ExecutedApplicationsPrefix is the key prefix for the index of executed application IDs
 	ExecutedApplicationsPrefix = []byte{0x03}
+	// PriceLevelsPrefix is the key prefix for the burn price curve levels, indexed by position
+	PriceLevelsPrefix = []byte{0x04}
+	// ApplicationsPrefix is the key prefix for burn applications, indexed by ID
+	ApplicationsPrefix = []byte{0x05}
+	// ApplicationsBySenderPrefix is the key prefix for the index of burn application IDs by sender address
+	ApplicationsBySenderPrefix = []byte{0x06}
+	// NextApplicationIDKey is the key for the ID of the next burn application to be submitted
+	NextApplicationIDKey = []byte{0x07}
+	// ApprovedApplicationsAmountKey is the key for the amount of the price curve taken by approved burn applications
+	ApprovedApplicationsAmountKey = []byte{0x08}
 )
+
+// GetApplicationsBySenderPrefix returns the key prefix for the sender's burn application IDs index
+func GetApplicationsBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(ApplicationsBySenderPrefix, address.MustLengthPrefix(sender)...)
+}
@@ -4,11 +4,17 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/haqq-network/haqq/utils"
 )
 
 var (
 	_ sdk.Msg = &MsgMintHaqq{}
 	_ sdk.Msg = &MsgMintHaqqByApplication{}
+	_ sdk.Msg = &MsgUpdatePriceLevels{}
+	_ sdk.Msg = &MsgSubmitBurnApplication{}
+	_ sdk.Msg = &MsgCancelBurnApplication{}
+	_ sdk.Msg = &MsgApproveBurnApplication{}
 )
 
 // GetSigners returns the signers of the message
@@ -48,3 +54,79 @@ func (msg *MsgMintHaqqByApplication) ValidateBasic() error {
 
 	return nil
 }
+
+// GetSigners returns the signers of the message
+func (msg *MsgUpdatePriceLevels) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation on the message
+func (msg *MsgUpdatePriceLevels) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid authority: %v", err)
+	}
+
+	return PriceLevels(msg.PriceLevels).Validate()
+}
+
+// GetSigners returns the signers of the message
+func (msg *MsgSubmitBurnApplication) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic performs basic validation on the message
+func (msg *MsgSubmitBurnApplication) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from_address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid to_address: %v", err)
+	}
+
+	if _, ok := FundSources[msg.Source]; !ok {
+		return errorsmod.Wrapf(ErrInvalidFundsSource, "invalid source_of_funds: %d", msg.Source)
+	}
+
+	if !msg.BurnAmount.IsValid() || !msg.BurnAmount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "burn_amount must be positive; got %s", msg.BurnAmount.String())
+	}
+
+	if msg.BurnAmount.Denom != utils.BaseDenom {
+		return errorsmod.Wrapf(ErrInvalidAmount, "invalid denom for burn_amount; expected aISLM, got %s", msg.BurnAmount.Denom)
+	}
+
+	return nil
+}
+
+// GetSigners returns the signers of the message
+func (msg *MsgCancelBurnApplication) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic performs basic validation on the message
+func (msg *MsgCancelBurnApplication) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from_address: %v", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the signers of the message
+func (msg *MsgApproveBurnApplication) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs basic validation on the message
+func (msg *MsgApproveBurnApplication) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid authority: %v", err)
+	}
+
+	return nil
+}
@@ -4,65 +4,92 @@ import (
 	_ "embed"
 	"encoding/json"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 )
 
-// Embed prices json file to the executable binary. Needed when importing as dependency.
+// Embed the default prices json file to the executable binary. It seeds the
+// price curve on genesis and on the store migration, afterwards the curve is
+// managed by governance.
 //
 //go:embed prices.json
 var pricesJSON []byte
 
-type PriceLevel struct {
-	From  string `json:"from"`
-	To    string `json:"to"`
-	Price string `json:"price"`
-}
-
+// PriceLevels defines the aISLM to aHAQQ burn price curve.
 type PriceLevels []PriceLevel
 
-var Prices PriceLevels
-
-func init() {
-	err := json.Unmarshal(pricesJSON, &Prices)
-	if err != nil {
+// DefaultPriceLevels returns the default price curve.
+func DefaultPriceLevels() PriceLevels {
+	var levels PriceLevels
+	if err := json.Unmarshal(pricesJSON, &levels); err != nil {
+		// should never happen as we know the original data
 		panic(err)
 	}
 
-	// Validate all price levels eagerly so that any invalid numeric strings
-	// cause a panic at startup rather than at runtime during tx execution.
-	for _, pl := range Prices {
-		pl.FromAmount()
-		pl.ToAmount()
-		pl.UnitPrice()
-	}
+	return levels
 }
 
-func (pl PriceLevel) FromAmount() sdkmath.Int {
-	amt, ok := sdkmath.NewIntFromString(pl.From)
-	if !ok {
-		// should never happen as we know the original data
-		panic("invalid price level start amount")
+// Validate checks that the price curve starts at zero, is continuous and
+// that the price strictly increases from one level to the next.
+func (pls PriceLevels) Validate() error {
+	if len(pls) == 0 {
+		return errorsmod.Wrap(ErrInvalidPriceLevels, "price levels cannot be empty")
 	}
 
-	return amt
+	for i, pl := range pls {
+		if err := pl.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "level %d", i)
+		}
+
+		if i == 0 {
+			if !pl.From.IsZero() {
+				return errorsmod.Wrapf(ErrInvalidPriceLevels, "first level must start from zero, got %s", pl.From)
+			}
+			continue
+		}
+
+		prev := pls[i-1]
+		if !pl.From.Equal(prev.To) {
+			return errorsmod.Wrapf(ErrInvalidPriceLevels, "level %d must start where level %d ends: %s != %s", i, i-1, pl.From, prev.To)
+		}
+		if !pl.Price.GT(prev.Price) {
+			return errorsmod.Wrapf(ErrInvalidPriceLevels, "level %d price must be greater than level %d price: %s <= %s", i, i-1, pl.Price, prev.Price)
+		}
+	}
+
+	return nil
 }
 
-func (pl PriceLevel) ToAmount() sdkmath.Int {
-	amt, ok := sdkmath.NewIntFromString(pl.To)
-	if !ok {
-		// should never happen as we know the original data
-		panic("invalid price level end amount")
+// RemainingCapacity returns the amount of aISLM that can still be burned on the
+// curve once totalBurned aISLM have been burned. The last valid position of
+// the curve is the upper bound of the last level minus one.
+func (pls PriceLevels) RemainingCapacity(totalBurned sdkmath.Int) sdkmath.Int {
+	if len(pls) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	capacity := pls[len(pls)-1].To.Sub(sdkmath.OneInt()).Sub(totalBurned)
+	if capacity.IsNegative() {
+		return sdkmath.ZeroInt()
 	}
 
-	return amt
+	return capacity
 }
 
-func (pl PriceLevel) UnitPrice() sdkmath.LegacyDec {
-	amt, err := sdkmath.LegacyNewDecFromStr(pl.Price)
-	if err != nil {
-		// should never happen as we know the original data
-		panic("invalid price")
+// Validate performs a stateless validation of a single price level.
+func (pl PriceLevel) Validate() error {
+	if pl.From.IsNil() || pl.To.IsNil() || pl.Price.IsNil() {
+		return errorsmod.Wrap(ErrInvalidPriceLevels, "from, to and price must be set")
+	}
+	if pl.From.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPriceLevels, "from cannot be negative: %s", pl.From)
+	}
+	if !pl.To.GT(pl.From) {
+		return errorsmod.Wrapf(ErrInvalidPriceLevels, "to must be greater than from: %s <= %s", pl.To, pl.From)
+	}
+	if !pl.Price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidPriceLevels, "price must be positive: %s", pl.Price)
 	}
 
-	return amt
+	return nil
 }
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultPriceLevels(t *testing.T) {
+	levels := DefaultPriceLevels()
+	require.NotEmpty(t, levels)
+	require.NoError(t, levels.Validate())
+}
+
+func TestPriceLevelsValidate(t *testing.T) {
+	level := func(from, to int64, price string) PriceLevel {
+		return PriceLevel{
+			From:  sdkmath.NewInt(from),
+			To:    sdkmath.NewInt(to),
+			Price: sdkmath.LegacyMustNewDecFromStr(price),
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		levels PriceLevels
+		expErr string
+	}{
+		{"valid", PriceLevels{level(0, 10, "1"), level(10, 20, "1.5")}, ""},
+		{"empty", PriceLevels{}, "price levels cannot be empty"},
+		{"unset fields", PriceLevels{{}}, "from, to and price must be set"},
+		{"not starting from zero", PriceLevels{level(1, 10, "1")}, "first level must start from zero"},
+		{"empty range", PriceLevels{level(0, 0, "1")}, "to must be greater than from"},
+		{"zero price", PriceLevels{level(0, 10, "0")}, "price must be positive"},
+		{"gap", PriceLevels{level(0, 10, "1"), level(11, 20, "2")}, "level 1 must start where level 0 ends"},
+		{"overlap", PriceLevels{level(0, 10, "1"), level(9, 20, "2")}, "level 1 must start where level 0 ends"},
+		{"same price", PriceLevels{level(0, 10, "1"), level(10, 20, "1")}, "level 1 price must be greater than level 0 price"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.levels.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
@@ -211,6 +211,99 @@ func (m *QueryCalculateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCalculateResponse proto.InternalMessageInfo
 
+// QueryCalculateBreakdownRequest is the request type for the Query/CalculateBreakdown RPC method.
+type QueryCalculateBreakdownRequest struct {
+	// islm_amount is the amount of aISLM coins to burn in exchange for aHAQQ
+	// using string as grpc-gateway doesn't support custom types (eg. cosmossdk.io/math.Int)
+	IslmAmount string `protobuf:"bytes,1,opt,name=islm_amount,json=islmAmount,proto3" json:"islm_amount,omitempty"`
+}
+
+func (m *QueryCalculateBreakdownRequest) Reset()         { *m = QueryCalculateBreakdownRequest{} }
+func (m *QueryCalculateBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculateBreakdownRequest) ProtoMessage()    {}
+func (*QueryCalculateBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa149d48606a1972, []int{4}
+}
+func (m *QueryCalculateBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculateBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculateBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCalculateBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculateBreakdownRequest.Merge(m, src)
+}
+func (m *QueryCalculateBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculateBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculateBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCalculateBreakdownRequest proto.InternalMessageInfo
+
+// QueryCalculateBreakdownResponse is the response type for the Query/CalculateBreakdown RPC method.
+type QueryCalculateBreakdownResponse struct {
+	// levels is the list of price levels the burn spreads over, in curve order
+	Levels []PriceLevelBreakdown `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels"`
+	// estimated_haqq_amount is the total amount of aHAQQ coins to be minted
+	EstimatedHaqqAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=estimated_haqq_amount,json=estimatedHaqqAmount,proto3,customtype=cosmossdk.io/math.Int" json:"estimated_haqq_amount"`
+	// total_burned_after is the cumulative burned aISLM amount on the price curve after the burn
+	TotalBurnedAfter cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_burned_after,json=totalBurnedAfter,proto3,customtype=cosmossdk.io/math.Int" json:"total_burned_after"`
+	// remaining_capacity is the amount of aISLM that can still be burned after the burn
+	// before the price curve is exhausted
+	RemainingCapacity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_capacity"`
+}
+
+func (m *QueryCalculateBreakdownResponse) Reset()         { *m = QueryCalculateBreakdownResponse{} }
+func (m *QueryCalculateBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalculateBreakdownResponse) ProtoMessage()    {}
+func (*QueryCalculateBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa149d48606a1972, []int{5}
+}
+func (m *QueryCalculateBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCalculateBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalculateBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCalculateBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalculateBreakdownResponse.Merge(m, src)
+}
+func (m *QueryCalculateBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalculateBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalculateBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCalculateBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryCalculateBreakdownResponse) GetLevels() []PriceLevelBreakdown {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
 // QueryCalculateForApplicationRequest is the request type for the Query/CalculateForApplication RPC method.
 type QueryCalculateForApplicationRequest struct {
 	// application_id is the unique ID of BurnApplication containing the amount of aISLM coins to burn in exchange for aHAQQ.
@@ -222,7 +315,7 @@ func (m *QueryCalculateForApplicationRequest) Reset()         { *m = QueryCalcul
 func (m *QueryCalculateForApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalculateForApplicationRequest) ProtoMessage()    {}
 func (*QueryCalculateForApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{4}
+	return fileDescriptor_fa149d48606a1972, []int{6}
 }
 func (m *QueryCalculateForApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +362,7 @@ func (m *QueryCalculateForApplicationResponse) Reset()         { *m = QueryCalcu
 func (m *QueryCalculateForApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCalculateForApplicationResponse) ProtoMessage()    {}
 func (*QueryCalculateForApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{5}
+	return fileDescriptor_fa149d48606a1972, []int{7}
 }
 func (m *QueryCalculateForApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +407,7 @@ func (m *QueryGetApplicationsRequest) Reset()         { *m = QueryGetApplication
 func (m *QueryGetApplicationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetApplicationsRequest) ProtoMessage()    {}
 func (*QueryGetApplicationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{6}
+	return fileDescriptor_fa149d48606a1972, []int{8}
 }
 func (m *QueryGetApplicationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +448,7 @@ func (m *QueryGetApplicationsResponse) Reset()         { *m = QueryGetApplicatio
 func (m *QueryGetApplicationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetApplicationsResponse) ProtoMessage()    {}
 func (*QueryGetApplicationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{7}
+	return fileDescriptor_fa149d48606a1972, []int{9}
 }
 func (m *QueryGetApplicationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +502,7 @@ func (m *QueryGetSendersApplicationsRequest) Reset()         { *m = QueryGetSend
 func (m *QueryGetSendersApplicationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSendersApplicationsRequest) ProtoMessage()    {}
 func (*QueryGetSendersApplicationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{8}
+	return fileDescriptor_fa149d48606a1972, []int{10}
 }
 func (m *QueryGetSendersApplicationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,7 +543,7 @@ func (m *QueryGetSendersApplicationsResponse) Reset()         { *m = QueryGetSen
 func (m *QueryGetSendersApplicationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSendersApplicationsResponse) ProtoMessage()    {}
 func (*QueryGetSendersApplicationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{9}
+	return fileDescriptor_fa149d48606a1972, []int{11}
 }
 func (m *QueryGetSendersApplicationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +586,89 @@ func (m *QueryGetSendersApplicationsResponse) GetPagination() *query.PageRespons
 	return nil
 }
 
+// QueryPriceLevelsRequest is the request type for the Query/PriceLevels RPC method.
+type QueryPriceLevelsRequest struct {
+}
+
+func (m *QueryPriceLevelsRequest) Reset()         { *m = QueryPriceLevelsRequest{} }
+func (m *QueryPriceLevelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceLevelsRequest) ProtoMessage()    {}
+func (*QueryPriceLevelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa149d48606a1972, []int{12}
+}
+func (m *QueryPriceLevelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceLevelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceLevelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceLevelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceLevelsRequest.Merge(m, src)
+}
+func (m *QueryPriceLevelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceLevelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceLevelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceLevelsRequest proto.InternalMessageInfo
+
+// QueryPriceLevelsResponse is the response type for the Query/PriceLevels RPC method.
+type QueryPriceLevelsResponse struct {
+	// price_levels is the current aISLM to aHAQQ burn price curve.
+	PriceLevels []PriceLevel `protobuf:"bytes,1,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels"`
+}
+
+func (m *QueryPriceLevelsResponse) Reset()         { *m = QueryPriceLevelsResponse{} }
+func (m *QueryPriceLevelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceLevelsResponse) ProtoMessage()    {}
+func (*QueryPriceLevelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa149d48606a1972, []int{13}
+}
+func (m *QueryPriceLevelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceLevelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceLevelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceLevelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceLevelsResponse.Merge(m, src)
+}
+func (m *QueryPriceLevelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceLevelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceLevelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceLevelsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceLevelsResponse) GetPriceLevels() []PriceLevel {
+	if m != nil {
+		return m.PriceLevels
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the request type for querying x/ethiq parameters.
 type QueryParamsRequest struct {
 }
@@ -501,7 +677,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{10}
+	return fileDescriptor_fa149d48606a1972, []int{14}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +715,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fa149d48606a1972, []int{11}
+	return fileDescriptor_fa149d48606a1972, []int{15}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,12 +756,16 @@ func init() {
 	proto.RegisterType((*QueryTotalBurnedResponse)(nil), "haqq.ethiq.v1.QueryTotalBurnedResponse")
 	proto.RegisterType((*QueryCalculateRequest)(nil), "haqq.ethiq.v1.QueryCalculateRequest")
 	proto.RegisterType((*QueryCalculateResponse)(nil), "haqq.ethiq.v1.QueryCalculateResponse")
+	proto.RegisterType((*QueryCalculateBreakdownRequest)(nil), "haqq.ethiq.v1.QueryCalculateBreakdownRequest")
+	proto.RegisterType((*QueryCalculateBreakdownResponse)(nil), "haqq.ethiq.v1.QueryCalculateBreakdownResponse")
 	proto.RegisterType((*QueryCalculateForApplicationRequest)(nil), "haqq.ethiq.v1.QueryCalculateForApplicationRequest")
 	proto.RegisterType((*QueryCalculateForApplicationResponse)(nil), "haqq.ethiq.v1.QueryCalculateForApplicationResponse")
 	proto.RegisterType((*QueryGetApplicationsRequest)(nil), "haqq.ethiq.v1.QueryGetApplicationsRequest")
 	proto.RegisterType((*QueryGetApplicationsResponse)(nil), "haqq.ethiq.v1.QueryGetApplicationsResponse")
 	proto.RegisterType((*QueryGetSendersApplicationsRequest)(nil), "haqq.ethiq.v1.QueryGetSendersApplicationsRequest")
 	proto.RegisterType((*QueryGetSendersApplicationsResponse)(nil), "haqq.ethiq.v1.QueryGetSendersApplicationsResponse")
+	proto.RegisterType((*QueryPriceLevelsRequest)(nil), "haqq.ethiq.v1.QueryPriceLevelsRequest")
+	proto.RegisterType((*QueryPriceLevelsResponse)(nil), "haqq.ethiq.v1.QueryPriceLevelsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "haqq.ethiq.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "haqq.ethiq.v1.QueryParamsResponse")
 }
@@ -593,73 +773,83 @@ func init() {
 func init() { proto.RegisterFile("haqq/ethiq/v1/query.proto", fileDescriptor_fa149d48606a1972) }
 
 var fileDescriptor_fa149d48606a1972 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
+	// 1215 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x26, 0x6d, 0x20, 0xcf, 0x71, 0x11, 0xd3, 0xb8, 0x71, 0xec, 0x62, 0xb7, 0xdb, 0x36,
-	0x8d, 0x02, 0xde, 0xc5, 0x8e, 0x04, 0xa5, 0x1c, 0x50, 0xdc, 0x90, 0x10, 0x09, 0x44, 0x70, 0x80,
-	0x03, 0x1c, 0x56, 0xe3, 0xf5, 0x64, 0xb3, 0xaa, 0x77, 0x67, 0xbd, 0x3b, 0x0e, 0x44, 0x51, 0x2e,
-	0x5c, 0xc8, 0x05, 0x09, 0x89, 0x0b, 0xc7, 0x1e, 0x39, 0x72, 0xa8, 0xe0, 0xc4, 0x15, 0x45, 0x1c,
-	0x50, 0x55, 0x2e, 0x88, 0x43, 0x85, 0x12, 0x24, 0xf8, 0x33, 0xd0, 0xce, 0xcc, 0xc6, 0xb3, 0xae,
-	0x9d, 0x90, 0x0a, 0x09, 0x0e, 0xbd, 0x44, 0xf6, 0xfb, 0xf1, 0xcd, 0xf7, 0x7e, 0xcc, 0x37, 0x0e,
-	0xcc, 0x6e, 0xe1, 0x6e, 0xd7, 0x24, 0x6c, 0xcb, 0xed, 0x9a, 0xdb, 0x35, 0xb3, 0xdb, 0x23, 0xe1,
-	0x8e, 0x11, 0x84, 0x94, 0x51, 0x94, 0x8b, 0x5d, 0x06, 0x77, 0x19, 0xdb, 0xb5, 0xe2, 0x82, 0x4d,
-	0x23, 0x8f, 0x46, 0x66, 0x0b, 0x47, 0x44, 0xc4, 0x99, 0xdb, 0xb5, 0x16, 0x61, 0xb8, 0x66, 0x06,
-	0xd8, 0x71, 0x7d, 0xcc, 0x5c, 0xea, 0x8b, 0xd4, 0xe2, 0xb4, 0x43, 0x1d, 0xca, 0x3f, 0x9a, 0xf1,
-	0x27, 0x69, 0xbd, 0xec, 0x50, 0xea, 0x74, 0x88, 0x89, 0x03, 0xd7, 0xc4, 0xbe, 0x4f, 0x19, 0x4f,
-	0x89, 0xa4, 0xb7, 0xac, 0xe2, 0x27, 0xc8, 0x36, 0x75, 0x13, 0xcc, 0x92, 0xf4, 0x27, 0x47, 0xab,
-	0x5c, 0x8b, 0xb3, 0xc2, 0x69, 0x89, 0x33, 0xc5, 0x17, 0xe9, 0x7a, 0x1e, 0x7b, 0xae, 0x4f, 0x4d,
-	0xfe, 0x37, 0x89, 0x4e, 0x17, 0x2d, 0x4a, 0xe4, 0x2e, 0xfd, 0x1a, 0xcc, 0xbc, 0x17, 0xe3, 0xbe,
-	0x4f, 0x19, 0xee, 0x34, 0x7a, 0xa1, 0x4f, 0xda, 0x4d, 0xd2, 0xed, 0x91, 0x88, 0xdd, 0x7e, 0x76,
-	0xff, 0x5e, 0x25, 0xf3, 0xd7, 0xbd, 0x4a, 0x46, 0xff, 0x59, 0x83, 0xc2, 0xe3, 0x51, 0x51, 0x40,
-	0xfd, 0x88, 0xa0, 0x55, 0x98, 0x62, 0xb1, 0xd9, 0x6a, 0x71, 0x7b, 0x41, 0xbb, 0xa2, 0xcd, 0x67,
-	0xeb, 0xb3, 0x86, 0x24, 0x15, 0x97, 0x67, 0xc8, 0xf2, 0x8c, 0x3b, 0xd4, 0xf5, 0x1b, 0x93, 0x07,
-	0x8f, 0x2a, 0x99, 0x6f, 0xfe, 0xfc, 0x76, 0x41, 0x6b, 0x66, 0x59, 0x1f, 0x10, 0xb9, 0x50, 0x56,
-	0x81, 0xac, 0xcd, 0x90, 0x7a, 0x16, 0x0e, 0x82, 0x8e, 0x6b, 0x8b, 0xc6, 0x15, 0xc6, 0xce, 0x00,
-	0x5d, 0x52, 0xa0, 0x57, 0x42, 0xea, 0x2d, 0x29, 0x40, 0x7a, 0x03, 0xf2, 0xbc, 0x9e, 0x3b, 0xb8,
-	0x63, 0xf7, 0x3a, 0x98, 0x11, 0x59, 0x33, 0xaa, 0x40, 0xd6, 0x8d, 0x3a, 0x9e, 0x85, 0x3d, 0xda,
-	0xf3, 0x19, 0xaf, 0x65, 0xb2, 0x09, 0xb1, 0x69, 0x89, 0x5b, 0x94, 0xa6, 0x7c, 0x3e, 0x0e, 0x97,
-	0x06, 0x41, 0x64, 0x4b, 0xda, 0x90, 0x27, 0x11, 0x73, 0x3d, 0xcc, 0x48, 0xdb, 0x8a, 0x7b, 0x9f,
-	0xc2, 0x6b, 0xbc, 0x1c, 0xb3, 0xfc, 0xed, 0x51, 0x25, 0x2f, 0xea, 0x88, 0xda, 0x77, 0x0d, 0x97,
-	0x9a, 0x1e, 0x66, 0x5b, 0xc6, 0x9a, 0xcf, 0x1e, 0xde, 0xaf, 0x82, 0x2c, 0x70, 0xcd, 0x67, 0xa2,
-	0x98, 0x8b, 0xc7, 0x70, 0x6f, 0xe1, 0x6e, 0x57, 0x50, 0x41, 0x1f, 0x40, 0x2e, 0xea, 0x05, 0x41,
-	0x67, 0xc7, 0x6a, 0x91, 0x4d, 0x1a, 0x12, 0xde, 0x9e, 0x27, 0x41, 0x9f, 0x12, 0x30, 0x0d, 0x8e,
-	0x82, 0x36, 0x40, 0x7e, 0xb7, 0xf0, 0x26, 0x23, 0x61, 0x61, 0xfc, 0x09, 0x51, 0xb3, 0x02, 0x65,
-	0x29, 0x06, 0x41, 0x1f, 0x43, 0x0e, 0x6f, 0x93, 0x10, 0x3b, 0xc4, 0x0a, 0x42, 0xd7, 0x26, 0x85,
-	0x73, 0x1c, 0xf5, 0x15, 0x89, 0x5a, 0x7a, 0x1c, 0xf5, 0x6d, 0xe2, 0x60, 0x7b, 0x67, 0x99, 0xd8,
-	0x0a, 0xf6, 0x32, 0xb1, 0x25, 0x63, 0x09, 0xb6, 0x1e, 0x63, 0xe9, 0x1f, 0xc2, 0xb5, 0xf4, 0x20,
-	0x56, 0x68, 0xa8, 0x8c, 0x3b, 0x99, 0xed, 0x0d, 0xb8, 0xa0, 0x6c, 0x93, 0xe5, 0x8a, 0x55, 0x3d,
-	0xd7, 0xcc, 0x29, 0xd6, 0xb5, 0xb6, 0xba, 0xf6, 0xe3, 0x70, 0xfd, 0x64, 0xe0, 0xa7, 0xf3, 0xfe,
-	0x0f, 0xe6, 0x8d, 0x5e, 0x05, 0x60, 0xd4, 0xc2, 0xed, 0x76, 0x48, 0xa2, 0xa8, 0x70, 0x9e, 0x23,
-	0x17, 0x1e, 0xde, 0xaf, 0x4e, 0xcb, 0xb4, 0x25, 0xe1, 0xd9, 0x60, 0xa1, 0xeb, 0x3b, 0xcd, 0x49,
-	0x46, 0xa5, 0x41, 0xa7, 0x50, 0xe2, 0xf3, 0x5c, 0x25, 0x4c, 0x95, 0x83, 0x64, 0x41, 0x56, 0x00,
-	0xfa, 0xca, 0x2e, 0x75, 0x6c, 0x2e, 0x25, 0x36, 0x42, 0x82, 0x13, 0xc9, 0x59, 0xc7, 0x4e, 0x22,
-	0x1c, 0x4d, 0x25, 0x53, 0xd9, 0xa0, 0xef, 0x34, 0xb8, 0x3c, 0xfc, 0x44, 0xb9, 0x39, 0xef, 0xc0,
-	0x54, 0x4a, 0xe1, 0xb4, 0x2b, 0xe3, 0xf3, 0xd9, 0x7a, 0xd9, 0x48, 0x3d, 0x45, 0x46, 0xac, 0x62,
-	0x4a, 0xba, 0x2a, 0x73, 0xa9, 0x74, 0xb4, 0x9a, 0xaa, 0x40, 0xc8, 0xe5, 0xcd, 0x53, 0x2b, 0x10,
-	0x5c, 0xd4, 0x12, 0xf4, 0xef, 0x35, 0xd0, 0x13, 0xe2, 0x1b, 0xc4, 0x6f, 0x93, 0x30, 0x1a, 0xd6,
-	0xb1, 0x37, 0xe0, 0x42, 0xc4, 0xbd, 0xc7, 0xd3, 0xd0, 0x4e, 0x99, 0x46, 0x4e, 0xc4, 0x4b, 0xe3,
-	0x40, 0xcb, 0xc7, 0xfe, 0x85, 0x96, 0xff, 0xa0, 0x49, 0x35, 0x18, 0xc5, 0xfc, 0x7f, 0xde, 0xf9,
-	0x69, 0x40, 0x9c, 0xfe, 0x3a, 0x0e, 0xb1, 0x97, 0x34, 0x5a, 0x7f, 0x17, 0x2e, 0xa6, 0xac, 0xb2,
-	0x88, 0x5b, 0x30, 0x11, 0x70, 0x8b, 0xdc, 0xd6, 0xfc, 0x00, 0x7d, 0x11, 0xae, 0xb2, 0x96, 0xf1,
-	0xf5, 0x83, 0x67, 0xe0, 0x3c, 0x47, 0x44, 0xfb, 0x1a, 0x64, 0x95, 0x77, 0x1d, 0xcd, 0x0d, 0x60,
-	0x8c, 0xf8, 0x79, 0x50, 0xbc, 0x79, 0x6a, 0x9c, 0x20, 0xa9, 0xcf, 0xef, 0xc7, 0x27, 0x7f, 0xf6,
-	0xcb, 0x1f, 0x5f, 0x8d, 0xbd, 0x80, 0x4a, 0x66, 0xfa, 0xb7, 0x88, 0xfa, 0xe2, 0xa3, 0x2f, 0x34,
-	0x98, 0x3c, 0xd6, 0x5a, 0x74, 0x7d, 0xd8, 0x01, 0x83, 0x2f, 0x76, 0xf1, 0xc6, 0x29, 0x51, 0x92,
-	0xc4, 0x62, 0x9f, 0xc4, 0x3c, 0x9a, 0x1b, 0x20, 0x61, 0x27, 0xe1, 0xe6, 0xae, 0xf2, 0xfa, 0xef,
-	0xa1, 0x9f, 0x34, 0x98, 0x19, 0xa1, 0xfd, 0xa8, 0x7e, 0xe2, 0xb9, 0x43, 0x5f, 0xa0, 0xe2, 0xe2,
-	0x99, 0x72, 0x24, 0xf3, 0x95, 0x3e, 0xf3, 0xd7, 0xd1, 0x6b, 0xa3, 0x98, 0x57, 0x37, 0x69, 0x58,
-	0x55, 0x36, 0xd2, 0xdc, 0x4d, 0xbf, 0x75, 0x7b, 0xe8, 0x6b, 0x0d, 0x9e, 0x1b, 0x90, 0x21, 0xb4,
-	0x30, 0x8c, 0xd0, 0x70, 0x75, 0x2c, 0xbe, 0xf8, 0x8f, 0x62, 0x25, 0xe9, 0x97, 0xfa, 0xa4, 0xaf,
-	0xa2, 0xca, 0x00, 0x69, 0x87, 0xb0, 0x6a, 0xea, 0xf2, 0xfc, 0xa8, 0xc1, 0xa5, 0xe1, 0xd7, 0x15,
-	0xd5, 0x46, 0x9c, 0x3a, 0x5a, 0x94, 0x8a, 0xf5, 0xb3, 0xa4, 0x48, 0xbe, 0x6f, 0xf6, 0xf9, 0xde,
-	0x46, 0xb7, 0x86, 0xf0, 0x15, 0xb2, 0x15, 0xa5, 0x78, 0x9b, 0xbb, 0x69, 0xf1, 0xdb, 0x43, 0x11,
-	0x4c, 0x88, 0x2b, 0x87, 0xae, 0x0e, 0x23, 0x91, 0xba, 0xd3, 0x45, 0xfd, 0xa4, 0x10, 0xc9, 0x4b,
-	0xef, 0xf3, 0x9a, 0x41, 0xf9, 0x01, 0x5e, 0xe2, 0x2a, 0x37, 0x96, 0x0f, 0x0e, 0xcb, 0xda, 0x83,
-	0xc3, 0xb2, 0xf6, 0xfb, 0x61, 0x59, 0xfb, 0xf2, 0xa8, 0x9c, 0x79, 0x70, 0x54, 0xce, 0xfc, 0x7a,
-	0x54, 0xce, 0x7c, 0xb4, 0xe0, 0xb8, 0x6c, 0xab, 0xd7, 0x32, 0x6c, 0xea, 0xf1, 0xd4, 0xaa, 0x4f,
-	0xd8, 0x27, 0x34, 0xbc, 0x2b, 0x70, 0x3e, 0x95, 0x48, 0x6c, 0x27, 0x20, 0x51, 0x6b, 0x82, 0xff,
-	0x3f, 0xb0, 0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xae, 0xde, 0x05, 0x21, 0x0d, 0x00,
-	0x00,
+	0x14, 0xf6, 0x26, 0x6d, 0x44, 0x9e, 0x9b, 0x42, 0xa7, 0x49, 0xe3, 0x38, 0xc5, 0x6e, 0x37, 0x6d,
+	0x12, 0x05, 0xbc, 0xdb, 0x38, 0x12, 0x94, 0x22, 0x84, 0xe2, 0xa4, 0x09, 0x11, 0x45, 0x14, 0x07,
+	0x38, 0x80, 0xc4, 0x6a, 0xbc, 0x9e, 0x6c, 0x56, 0xb1, 0x77, 0xd6, 0xbb, 0xe3, 0x94, 0x28, 0xca,
+	0x85, 0x0b, 0xb9, 0x20, 0x90, 0xb8, 0x70, 0xec, 0x91, 0x23, 0x42, 0x15, 0x9c, 0xb8, 0xa2, 0x8a,
+	0x03, 0xaa, 0xca, 0x05, 0x71, 0xa8, 0x50, 0x82, 0x04, 0x7f, 0x06, 0xda, 0x99, 0x59, 0x7b, 0xd6,
+	0xb1, 0xf3, 0xab, 0x48, 0x70, 0xe0, 0x12, 0x79, 0xe7, 0xbd, 0xf7, 0xbd, 0xef, 0xcd, 0x8f, 0xef,
+	0xbd, 0xc0, 0xd8, 0x3a, 0x6e, 0x34, 0x4c, 0xc2, 0xd6, 0xdd, 0x86, 0xb9, 0x39, 0x6b, 0x36, 0x9a,
+	0x24, 0xd8, 0x32, 0xfc, 0x80, 0x32, 0x8a, 0x86, 0x22, 0x93, 0xc1, 0x4d, 0xc6, 0xe6, 0x6c, 0x76,
+	0xc6, 0xa6, 0x61, 0x9d, 0x86, 0x66, 0x05, 0x87, 0x44, 0xf8, 0x99, 0x9b, 0xb3, 0x15, 0xc2, 0xf0,
+	0xac, 0xe9, 0x63, 0xc7, 0xf5, 0x30, 0x73, 0xa9, 0x27, 0x42, 0xb3, 0xc3, 0x0e, 0x75, 0x28, 0xff,
+	0x69, 0x46, 0xbf, 0xe4, 0xea, 0x65, 0x87, 0x52, 0xa7, 0x46, 0x4c, 0xec, 0xbb, 0x26, 0xf6, 0x3c,
+	0xca, 0x78, 0x48, 0x28, 0xad, 0x39, 0x15, 0x3f, 0x46, 0xb6, 0xa9, 0x1b, 0x63, 0x8e, 0x4b, 0x7b,
+	0x9c, 0x5a, 0xe5, 0x9a, 0x1d, 0x13, 0x46, 0x4b, 0xe4, 0x14, 0x1f, 0xd2, 0x74, 0x01, 0xd7, 0x5d,
+	0x8f, 0x9a, 0xfc, 0x6f, 0xec, 0x9d, 0x2c, 0x5a, 0x94, 0xc8, 0x4d, 0xfa, 0x04, 0x8c, 0xbe, 0x13,
+	0xe1, 0xbe, 0x4b, 0x19, 0xae, 0x95, 0x9a, 0x81, 0x47, 0xaa, 0x65, 0xd2, 0x68, 0x92, 0x90, 0xdd,
+	0x7a, 0x66, 0xf7, 0x7e, 0x3e, 0xf5, 0xd7, 0xfd, 0x7c, 0x4a, 0xff, 0x59, 0x83, 0xcc, 0x41, 0xaf,
+	0xd0, 0xa7, 0x5e, 0x48, 0xd0, 0x32, 0x9c, 0x63, 0xd1, 0xb2, 0x55, 0xe1, 0xeb, 0x19, 0xed, 0x8a,
+	0x36, 0x9d, 0x2e, 0x8e, 0x19, 0x92, 0x54, 0x54, 0x9e, 0x21, 0xcb, 0x33, 0x16, 0xa8, 0xeb, 0x95,
+	0x06, 0x1f, 0x3e, 0xc9, 0xa7, 0xbe, 0xfe, 0xf3, 0x9b, 0x19, 0xad, 0x9c, 0x66, 0x6d, 0x40, 0xe4,
+	0x42, 0x4e, 0x05, 0xb2, 0xd6, 0x02, 0x5a, 0xb7, 0xb0, 0xef, 0xd7, 0x5c, 0x5b, 0x6c, 0x5c, 0xa6,
+	0xef, 0x04, 0xd0, 0xe3, 0x0a, 0xf4, 0x52, 0x40, 0xeb, 0xf3, 0x0a, 0x90, 0x5e, 0x82, 0x11, 0x5e,
+	0xcf, 0x02, 0xae, 0xd9, 0xcd, 0x1a, 0x66, 0x44, 0xd6, 0x8c, 0xf2, 0x90, 0x76, 0xc3, 0x5a, 0xdd,
+	0xc2, 0x75, 0xda, 0xf4, 0x18, 0xaf, 0x65, 0xb0, 0x0c, 0xd1, 0xd2, 0x3c, 0x5f, 0x51, 0x36, 0xe5,
+	0xd3, 0x7e, 0xb8, 0xd4, 0x09, 0x22, 0xb7, 0xa4, 0x0a, 0x23, 0x24, 0x64, 0x6e, 0x1d, 0x33, 0x52,
+	0xb5, 0xa2, 0xbd, 0x4f, 0xe0, 0x95, 0x6e, 0x44, 0x2c, 0x7f, 0x7b, 0x92, 0x1f, 0x11, 0x75, 0x84,
+	0xd5, 0x0d, 0xc3, 0xa5, 0x66, 0x1d, 0xb3, 0x75, 0x63, 0xc5, 0x63, 0x8f, 0x1f, 0x14, 0x40, 0x16,
+	0xb8, 0xe2, 0x31, 0x51, 0xcc, 0xc5, 0x16, 0xdc, 0x1b, 0xb8, 0xd1, 0x10, 0x54, 0xd0, 0x7b, 0x30,
+	0x14, 0x36, 0x7d, 0xbf, 0xb6, 0x65, 0x55, 0xc8, 0x1a, 0x0d, 0x48, 0xa6, 0xef, 0x94, 0xe8, 0xe7,
+	0x04, 0x4c, 0x89, 0xa3, 0xa0, 0x55, 0x90, 0xdf, 0x16, 0x5e, 0x63, 0x24, 0xc8, 0xf4, 0x9f, 0x12,
+	0x35, 0x2d, 0x50, 0xe6, 0x23, 0x10, 0xf4, 0x21, 0x0c, 0xe1, 0x4d, 0x12, 0x60, 0x87, 0x58, 0x7e,
+	0xe0, 0xda, 0x24, 0x73, 0x86, 0xa3, 0xbe, 0x24, 0x51, 0xc7, 0x0f, 0xa2, 0xde, 0x21, 0x0e, 0xb6,
+	0xb7, 0x16, 0x89, 0xad, 0x60, 0x2f, 0x12, 0x5b, 0x32, 0x96, 0x60, 0x77, 0x23, 0x2c, 0xfd, 0x4d,
+	0xc8, 0x25, 0x0f, 0xa2, 0x14, 0x10, 0xbc, 0x51, 0xa5, 0xf7, 0xbc, 0x53, 0x1c, 0xeb, 0xe7, 0xfd,
+	0x90, 0xef, 0x89, 0x26, 0xcf, 0xf7, 0x36, 0x0c, 0xd4, 0xc8, 0x26, 0xa9, 0x85, 0x19, 0xed, 0x4a,
+	0xff, 0x74, 0xba, 0xa8, 0x1b, 0x09, 0xe9, 0x30, 0x38, 0xad, 0x3b, 0x91, 0x47, 0x2b, 0x56, 0xbd,
+	0x9a, 0x32, 0xb8, 0xf7, 0x35, 0xe9, 0xfb, 0x27, 0xaf, 0xc9, 0x47, 0x80, 0x12, 0xcf, 0xea, 0xe9,
+	0x4e, 0xf5, 0x39, 0xe5, 0x59, 0x89, 0xa3, 0xb5, 0x00, 0x05, 0xa4, 0x8e, 0x5d, 0xcf, 0xf5, 0x1c,
+	0xcb, 0xc6, 0x3e, 0xb6, 0x5d, 0xb6, 0x95, 0x39, 0x73, 0x4a, 0xfc, 0x0b, 0x2d, 0xac, 0x05, 0x09,
+	0xa5, 0xbf, 0x0f, 0x13, 0xc9, 0x03, 0x59, 0xa2, 0x81, 0xf2, 0x9a, 0xe3, 0x33, 0xbe, 0x0e, 0xe7,
+	0x15, 0xb1, 0xb0, 0x5c, 0xa1, 0x44, 0x67, 0xca, 0x43, 0xca, 0xea, 0x4a, 0x55, 0x55, 0xb5, 0x7e,
+	0xb8, 0x76, 0x38, 0xf0, 0xff, 0xcf, 0xf9, 0x5f, 0x78, 0xce, 0xe8, 0x65, 0x00, 0x46, 0x2d, 0x5c,
+	0xad, 0x06, 0x24, 0x0c, 0x33, 0x67, 0x39, 0x72, 0xe6, 0xf1, 0x83, 0xc2, 0xb0, 0x0c, 0x9b, 0x17,
+	0x96, 0x55, 0x16, 0xb8, 0x9e, 0x53, 0x1e, 0x64, 0x54, 0x2e, 0xe8, 0x14, 0xc6, 0xf9, 0x79, 0x2e,
+	0x13, 0xa6, 0xaa, 0x7d, 0x7c, 0x41, 0x96, 0x00, 0xda, 0x8d, 0x5b, 0xb6, 0xa9, 0xc9, 0x44, 0x2f,
+	0x11, 0x1d, 0x36, 0xee, 0x28, 0x77, 0xb1, 0x13, 0xf7, 0x85, 0xb2, 0x12, 0xa9, 0xdc, 0xa0, 0xef,
+	0x34, 0xb8, 0xdc, 0x3d, 0xa3, 0xbc, 0x39, 0x6f, 0xc1, 0xb9, 0x44, 0x03, 0x13, 0x72, 0x91, 0xeb,
+	0x90, 0x8b, 0xe8, 0x35, 0x29, 0xe1, 0xaa, 0x54, 0x24, 0xc2, 0xd1, 0x72, 0xa2, 0x02, 0xd1, 0x0d,
+	0xa7, 0x8e, 0xac, 0x40, 0x70, 0x51, 0x4b, 0xd0, 0xbf, 0xd7, 0x40, 0x8f, 0x89, 0xaf, 0x12, 0xaf,
+	0x4a, 0x82, 0xb0, 0xdb, 0x8e, 0xbd, 0x0e, 0xe7, 0x43, 0x6e, 0x6d, 0x9d, 0x86, 0x76, 0xc4, 0x69,
+	0x0c, 0x09, 0x7f, 0xb9, 0x88, 0x96, 0xba, 0x10, 0x7e, 0xba, 0x2d, 0xff, 0x41, 0x83, 0x89, 0x43,
+	0x99, 0xff, 0xc7, 0x77, 0x7e, 0x4c, 0xce, 0x5b, 0xed, 0x16, 0x11, 0xef, 0xb6, 0x6e, 0x43, 0xe6,
+	0xa0, 0xa9, 0x3d, 0x64, 0xf1, 0x87, 0x66, 0x25, 0xfa, 0xce, 0x58, 0xef, 0xbe, 0xa3, 0x0e, 0x59,
+	0x7e, 0x1b, 0x50, 0x1f, 0x06, 0x24, 0x92, 0xe0, 0x00, 0xd7, 0x5b, 0xa9, 0xdf, 0x86, 0x8b, 0x89,
+	0x55, 0x99, 0xf5, 0x26, 0x0c, 0xf8, 0x7c, 0x45, 0xbe, 0x96, 0x91, 0xce, 0x7c, 0xdc, 0x98, 0x68,
+	0x6d, 0xc2, 0xbf, 0xb8, 0x0b, 0x70, 0x96, 0x23, 0xa2, 0x5d, 0x0d, 0xd2, 0xca, 0xd8, 0x88, 0x26,
+	0x3b, 0x30, 0x7a, 0x4c, 0x9f, 0xd9, 0xa9, 0x23, 0xfd, 0x04, 0x49, 0x7d, 0x7a, 0x37, 0xca, 0xfc,
+	0xc9, 0x2f, 0x7f, 0x7c, 0xd9, 0xf7, 0x3c, 0x1a, 0x37, 0x93, 0xa3, 0xae, 0xda, 0xf9, 0xd0, 0x67,
+	0x1a, 0x0c, 0xb6, 0xb4, 0x1e, 0x5d, 0xeb, 0x96, 0xa0, 0x73, 0x20, 0xcc, 0x5e, 0x3f, 0xc2, 0x4b,
+	0x92, 0x98, 0x6b, 0x93, 0x98, 0x46, 0x93, 0x1d, 0x24, 0xec, 0xd8, 0xdd, 0xdc, 0x56, 0xa6, 0x90,
+	0x1d, 0xf4, 0xad, 0x06, 0xe8, 0xe0, 0x94, 0x81, 0x0a, 0x87, 0xa6, 0xec, 0x9c, 0x6d, 0xb2, 0xc6,
+	0x71, 0xdd, 0x25, 0xd5, 0xd7, 0xda, 0x54, 0x8b, 0xe8, 0x46, 0x2f, 0xaa, 0x85, 0x4a, 0x1c, 0xd8,
+	0x41, 0xfa, 0x27, 0x0d, 0x46, 0x7b, 0x34, 0x4c, 0x54, 0x3c, 0x94, 0x4a, 0xd7, 0xb6, 0x9d, 0x9d,
+	0x3b, 0x51, 0x8c, 0xac, 0x61, 0xa9, 0x5d, 0xc3, 0xab, 0xe8, 0x95, 0x9e, 0x35, 0xac, 0xd1, 0xa0,
+	0xa0, 0x3c, 0x63, 0x73, 0x3b, 0x39, 0x20, 0xec, 0xa0, 0xaf, 0x34, 0x78, 0xb6, 0x43, 0xbb, 0xd1,
+	0x4c, 0x37, 0x42, 0xdd, 0x5b, 0x4a, 0xf6, 0x85, 0x63, 0xf9, 0x4a, 0xd2, 0x2f, 0xb6, 0x49, 0x5f,
+	0x45, 0xf9, 0x0e, 0xd2, 0x0e, 0x61, 0x85, 0x84, 0xe2, 0xfc, 0xa8, 0xc1, 0xa5, 0xee, 0x1a, 0x87,
+	0x66, 0x7b, 0x64, 0xed, 0xad, 0xe4, 0xd9, 0xe2, 0x49, 0x42, 0x24, 0xdf, 0xdb, 0x6d, 0xbe, 0xb7,
+	0xd0, 0xcd, 0x2e, 0x7c, 0x85, 0xd6, 0x87, 0x09, 0xde, 0xe6, 0x76, 0xb2, 0x63, 0xec, 0x70, 0x01,
+	0x50, 0x24, 0xad, 0xbb, 0x00, 0x1c, 0x94, 0xc3, 0xec, 0xd4, 0x91, 0x7e, 0xc7, 0x11, 0x00, 0x55,
+	0x35, 0x51, 0x08, 0x03, 0x42, 0xb2, 0xd0, 0xd5, 0xae, 0xe0, 0xaa, 0x26, 0x66, 0xf5, 0xc3, 0x5c,
+	0x64, 0x6a, 0xbd, 0x9d, 0x7a, 0x14, 0x8d, 0x74, 0xa6, 0x16, 0xea, 0xb8, 0xf8, 0x70, 0x2f, 0xa7,
+	0x3d, 0xda, 0xcb, 0x69, 0xbf, 0xef, 0xe5, 0xb4, 0x2f, 0xf6, 0x73, 0xa9, 0x47, 0xfb, 0xb9, 0xd4,
+	0xaf, 0xfb, 0xb9, 0xd4, 0x07, 0x33, 0x8e, 0xcb, 0xd6, 0x9b, 0x15, 0xc3, 0xa6, 0x75, 0x1e, 0x5a,
+	0xf0, 0x08, 0xbb, 0x47, 0x83, 0x0d, 0x81, 0xf3, 0xb1, 0x44, 0x62, 0x5b, 0x3e, 0x09, 0x2b, 0x03,
+	0xfc, 0xdf, 0xf5, 0xb9, 0xbf, 0x07, 0x00, 0x35, 0x36, 0xf1, 0xca, 0xc0, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -678,12 +868,17 @@ type QueryClient interface {
 	TotalBurned(ctx context.Context, in *QueryTotalBurnedRequest, opts ...grpc.CallOption) (*QueryTotalBurnedResponse, error)
 	// Calculate returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
 	Calculate(ctx context.Context, in *QueryCalculateRequest, opts ...grpc.CallOption) (*QueryCalculateResponse, error)
+	// CalculateBreakdown returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
+	// split by the price levels the burn spreads over.
+	CalculateBreakdown(ctx context.Context, in *QueryCalculateBreakdownRequest, opts ...grpc.CallOption) (*QueryCalculateBreakdownResponse, error)
 	// CalculateForApplication returns the estimated amount of aHAQQ coins to be minted for given BurnApplication ID.
 	CalculateForApplication(ctx context.Context, in *QueryCalculateForApplicationRequest, opts ...grpc.CallOption) (*QueryCalculateForApplicationResponse, error)
 	// GetApplications returns the paginated list of all registered applications.
 	GetApplications(ctx context.Context, in *QueryGetApplicationsRequest, opts ...grpc.CallOption) (*QueryGetApplicationsResponse, error)
 	// GetSendersApplications returns the paginated list of all applications registered for a given sender_address.
 	GetSendersApplications(ctx context.Context, in *QueryGetSendersApplicationsRequest, opts ...grpc.CallOption) (*QueryGetSendersApplicationsResponse, error)
+	// PriceLevels returns the aISLM to aHAQQ burn price curve.
+	PriceLevels(ctx context.Context, in *QueryPriceLevelsRequest, opts ...grpc.CallOption) (*QueryPriceLevelsResponse, error)
 	// Params queries the parameters of x/ethiq module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -714,6 +909,15 @@ func (c *queryClient) Calculate(ctx context.Context, in *QueryCalculateRequest,
 	return out, nil
 }
 
+func (c *queryClient) CalculateBreakdown(ctx context.Context, in *QueryCalculateBreakdownRequest, opts ...grpc.CallOption) (*QueryCalculateBreakdownResponse, error) {
+	out := new(QueryCalculateBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Query/CalculateBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateForApplication(ctx context.Context, in *QueryCalculateForApplicationRequest, opts ...grpc.CallOption) (*QueryCalculateForApplicationResponse, error) {
 	out := new(QueryCalculateForApplicationResponse)
 	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Query/CalculateForApplication", in, out, opts...)
@@ -741,6 +945,15 @@ func (c *queryClient) GetSendersApplications(ctx context.Context, in *QueryGetSe
 	return out, nil
 }
 
+func (c *queryClient) PriceLevels(ctx context.Context, in *QueryPriceLevelsRequest, opts ...grpc.CallOption) (*QueryPriceLevelsResponse, error) {
+	out := new(QueryPriceLevelsResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Query/PriceLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Query/Params", in, out, opts...)
@@ -756,12 +969,17 @@ type QueryServer interface {
 	TotalBurned(context.Context, *QueryTotalBurnedRequest) (*QueryTotalBurnedResponse, error)
 	// Calculate returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
 	Calculate(context.Context, *QueryCalculateRequest) (*QueryCalculateResponse, error)
+	// CalculateBreakdown returns the estimated amount of aHAQQ coins to be minted for given aISLM amount
+	// split by the price levels the burn spreads over.
+	CalculateBreakdown(context.Context, *QueryCalculateBreakdownRequest) (*QueryCalculateBreakdownResponse, error)
 	// CalculateForApplication returns the estimated amount of aHAQQ coins to be minted for given BurnApplication ID.
 	CalculateForApplication(context.Context, *QueryCalculateForApplicationRequest) (*QueryCalculateForApplicationResponse, error)
 	// GetApplications returns the paginated list of all registered applications.
 	GetApplications(context.Context, *QueryGetApplicationsRequest) (*QueryGetApplicationsResponse, error)
 	// GetSendersApplications returns the paginated list of all applications registered for a given sender_address.
 	GetSendersApplications(context.Context, *QueryGetSendersApplicationsRequest) (*QueryGetSendersApplicationsResponse, error)
+	// PriceLevels returns the aISLM to aHAQQ burn price curve.
+	PriceLevels(context.Context, *QueryPriceLevelsRequest) (*QueryPriceLevelsResponse, error)
 	// Params queries the parameters of x/ethiq module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -776,6 +994,9 @@ func (*UnimplementedQueryServer) TotalBurned(ctx context.Context, req *QueryTota
 func (*UnimplementedQueryServer) Calculate(ctx context.Context, req *QueryCalculateRequest) (*QueryCalculateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Calculate not implemented")
 }
+func (*UnimplementedQueryServer) CalculateBreakdown(ctx context.Context, req *QueryCalculateBreakdownRequest) (*QueryCalculateBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateBreakdown not implemented")
+}
 func (*UnimplementedQueryServer) CalculateForApplication(ctx context.Context, req *QueryCalculateForApplicationRequest) (*QueryCalculateForApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateForApplication not implemented")
 }
@@ -785,6 +1006,9 @@ func (*UnimplementedQueryServer) GetApplications(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) GetSendersApplications(ctx context.Context, req *QueryGetSendersApplicationsRequest) (*QueryGetSendersApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSendersApplications not implemented")
 }
+func (*UnimplementedQueryServer) PriceLevels(ctx context.Context, req *QueryPriceLevelsRequest) (*QueryPriceLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceLevels not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -829,6 +1053,24 @@ func _Query_Calculate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CalculateBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalculateBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculateBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Query/CalculateBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculateBreakdown(ctx, req.(*QueryCalculateBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CalculateForApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCalculateForApplicationRequest)
 	if err := dec(in); err != nil {
@@ -883,6 +1125,24 @@ func _Query_GetSendersApplications_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Query/PriceLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceLevels(ctx, req.(*QueryPriceLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -913,6 +1173,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Calculate",
 			Handler:    _Query_Calculate_Handler,
 		},
+		{
+			MethodName: "CalculateBreakdown",
+			Handler:    _Query_CalculateBreakdown_Handler,
+		},
 		{
 			MethodName: "CalculateForApplication",
 			Handler:    _Query_CalculateForApplication_Handler,
@@ -925,6 +1189,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSendersApplications",
 			Handler:    _Query_GetSendersApplications_Handler,
 		},
+		{
+			MethodName: "PriceLevels",
+			Handler:    _Query_PriceLevels_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1093,6 +1361,103 @@ func (m *QueryCalculateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCalculateBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculateBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculateBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IslmAmount) > 0 {
+		i -= len(m.IslmAmount)
+		copy(dAtA[i:], m.IslmAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IslmAmount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalculateBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalculateBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalculateBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingCapacity.Size()
+		i -= size
+		if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalBurnedAfter.Size()
+		i -= size
+		if _, err := m.TotalBurnedAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EstimatedHaqqAmount.Size()
+		i -= size
+		if _, err := m.EstimatedHaqqAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Levels) > 0 {
+		for iNdEx := len(m.Levels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Levels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCalculateForApplicationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1366,7 +1731,7 @@ func (m *QueryGetSendersApplicationsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceLevelsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1376,12 +1741,12 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceLevelsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceLevelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1389,7 +1754,7 @@ func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPriceLevelsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1399,25 +1764,85 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPriceLevelsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPriceLevelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
+	if len(m.PriceLevels) > 0 {
+		for iNdEx := len(m.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1485,6 +1910,40 @@ func (m *QueryCalculateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCalculateBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IslmAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCalculateBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Levels) > 0 {
+		for _, e := range m.Levels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.EstimatedHaqqAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBurnedAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryCalculateForApplicationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1586,6 +2045,30 @@ func (m *QueryGetSendersApplicationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceLevelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceLevelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceLevels) > 0 {
+		for _, e := range m.PriceLevels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2046,6 +2529,274 @@ func (m *QueryCalculateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCalculateBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IslmAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Levels = append(m.Levels, PriceLevelBreakdown{})
+			if err := m.Levels[len(m.Levels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedHaqqAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EstimatedHaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBurnedAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCalculateForApplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2777,6 +3528,140 @@ func (m *QueryGetSendersApplicationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPriceLevelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceLevelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceLevelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceLevelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceLevelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceLevelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLevels = append(m.PriceLevels, PriceLevel{})
+			if err := m.PriceLevels[len(m.PriceLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
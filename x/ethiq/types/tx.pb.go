@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -204,48 +205,432 @@ func (m *MsgMintHaqqByApplicationResponse) GetToAddress() string {
 	return ""
 }
 
+// MsgUpdatePriceLevels defines a Msg for replacing the aISLM to aHAQQ burn price curve.
+type MsgUpdatePriceLevels struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// price_levels defines the new price curve.
+	// NOTE: The whole curve must be supplied.
+	PriceLevels []PriceLevel `protobuf:"bytes,2,rep,name=price_levels,json=priceLevels,proto3" json:"price_levels"`
+}
+
+func (m *MsgUpdatePriceLevels) Reset()         { *m = MsgUpdatePriceLevels{} }
+func (m *MsgUpdatePriceLevels) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceLevels) ProtoMessage()    {}
+func (*MsgUpdatePriceLevels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{4}
+}
+func (m *MsgUpdatePriceLevels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceLevels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceLevels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceLevels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceLevels.Merge(m, src)
+}
+func (m *MsgUpdatePriceLevels) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceLevels) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceLevels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceLevels proto.InternalMessageInfo
+
+func (m *MsgUpdatePriceLevels) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePriceLevels) GetPriceLevels() []PriceLevel {
+	if m != nil {
+		return m.PriceLevels
+	}
+	return nil
+}
+
+// MsgUpdatePriceLevelsResponse defines the response structure for executing a
+// MsgUpdatePriceLevels message.
+type MsgUpdatePriceLevelsResponse struct {
+}
+
+func (m *MsgUpdatePriceLevelsResponse) Reset()         { *m = MsgUpdatePriceLevelsResponse{} }
+func (m *MsgUpdatePriceLevelsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceLevelsResponse) ProtoMessage()    {}
+func (*MsgUpdatePriceLevelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{5}
+}
+func (m *MsgUpdatePriceLevelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceLevelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceLevelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceLevelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceLevelsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePriceLevelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceLevelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceLevelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceLevelsResponse proto.InternalMessageInfo
+
+// MsgSubmitBurnApplication defines a Msg for registering a new burn application.
+type MsgSubmitBurnApplication struct {
+	// from_address is the address that will execute the application
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to_address is the address that will receive the minted aHAQQ coins
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// source is the source of the aISLM coins to be burned
+	Source SourceOfFunds `protobuf:"varint,3,opt,name=source,proto3,enum=haqq.ethiq.v1.SourceOfFunds" json:"source,omitempty"`
+	// burn_amount is the amount of aISLM to be burned by the application
+	BurnAmount types.Coin `protobuf:"bytes,4,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount"`
+}
+
+func (m *MsgSubmitBurnApplication) Reset()         { *m = MsgSubmitBurnApplication{} }
+func (m *MsgSubmitBurnApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBurnApplication) ProtoMessage()    {}
+func (*MsgSubmitBurnApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{6}
+}
+func (m *MsgSubmitBurnApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBurnApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBurnApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBurnApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBurnApplication.Merge(m, src)
+}
+func (m *MsgSubmitBurnApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBurnApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBurnApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBurnApplication proto.InternalMessageInfo
+
+// MsgSubmitBurnApplicationResponse defines the Msg/SubmitBurnApplication response type.
+type MsgSubmitBurnApplicationResponse struct {
+	// application_id is the id of the registered application
+	ApplicationId uint64 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (m *MsgSubmitBurnApplicationResponse) Reset()         { *m = MsgSubmitBurnApplicationResponse{} }
+func (m *MsgSubmitBurnApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBurnApplicationResponse) ProtoMessage()    {}
+func (*MsgSubmitBurnApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{7}
+}
+func (m *MsgSubmitBurnApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBurnApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBurnApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBurnApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBurnApplicationResponse.Merge(m, src)
+}
+func (m *MsgSubmitBurnApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBurnApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBurnApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBurnApplicationResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitBurnApplicationResponse) GetApplicationId() uint64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+// MsgCancelBurnApplication defines a Msg for cancelling a burn application.
+type MsgCancelBurnApplication struct {
+	// from_address is the address the application was submitted by
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// application_id is the id of the application to be canceled
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (m *MsgCancelBurnApplication) Reset()         { *m = MsgCancelBurnApplication{} }
+func (m *MsgCancelBurnApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBurnApplication) ProtoMessage()    {}
+func (*MsgCancelBurnApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{8}
+}
+func (m *MsgCancelBurnApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBurnApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBurnApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBurnApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBurnApplication.Merge(m, src)
+}
+func (m *MsgCancelBurnApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBurnApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBurnApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBurnApplication proto.InternalMessageInfo
+
+// MsgCancelBurnApplicationResponse defines the Msg/CancelBurnApplication response type.
+type MsgCancelBurnApplicationResponse struct {
+}
+
+func (m *MsgCancelBurnApplicationResponse) Reset()         { *m = MsgCancelBurnApplicationResponse{} }
+func (m *MsgCancelBurnApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBurnApplicationResponse) ProtoMessage()    {}
+func (*MsgCancelBurnApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{9}
+}
+func (m *MsgCancelBurnApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBurnApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBurnApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBurnApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBurnApplicationResponse.Merge(m, src)
+}
+func (m *MsgCancelBurnApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBurnApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBurnApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBurnApplicationResponse proto.InternalMessageInfo
+
+// MsgApproveBurnApplication defines a Msg for approving a submitted burn application.
+type MsgApproveBurnApplication struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// application_id is the id of the application to be approved
+	ApplicationId uint64 `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+}
+
+func (m *MsgApproveBurnApplication) Reset()         { *m = MsgApproveBurnApplication{} }
+func (m *MsgApproveBurnApplication) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveBurnApplication) ProtoMessage()    {}
+func (*MsgApproveBurnApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{10}
+}
+func (m *MsgApproveBurnApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveBurnApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveBurnApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveBurnApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveBurnApplication.Merge(m, src)
+}
+func (m *MsgApproveBurnApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveBurnApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveBurnApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveBurnApplication proto.InternalMessageInfo
+
+func (m *MsgApproveBurnApplication) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgApproveBurnApplication) GetApplicationId() uint64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+// MsgApproveBurnApplicationResponse defines the Msg/ApproveBurnApplication response type.
+type MsgApproveBurnApplicationResponse struct {
+}
+
+func (m *MsgApproveBurnApplicationResponse) Reset()         { *m = MsgApproveBurnApplicationResponse{} }
+func (m *MsgApproveBurnApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveBurnApplicationResponse) ProtoMessage()    {}
+func (*MsgApproveBurnApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5a343caee143bc2, []int{11}
+}
+func (m *MsgApproveBurnApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveBurnApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveBurnApplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveBurnApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveBurnApplicationResponse.Merge(m, src)
+}
+func (m *MsgApproveBurnApplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveBurnApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveBurnApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveBurnApplicationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintHaqq)(nil), "haqq.ethiq.v1.MsgMintHaqq")
 	proto.RegisterType((*MsgMintHaqqResponse)(nil), "haqq.ethiq.v1.MsgMintHaqqResponse")
 	proto.RegisterType((*MsgMintHaqqByApplication)(nil), "haqq.ethiq.v1.MsgMintHaqqByApplication")
 	proto.RegisterType((*MsgMintHaqqByApplicationResponse)(nil), "haqq.ethiq.v1.MsgMintHaqqByApplicationResponse")
+	proto.RegisterType((*MsgUpdatePriceLevels)(nil), "haqq.ethiq.v1.MsgUpdatePriceLevels")
+	proto.RegisterType((*MsgUpdatePriceLevelsResponse)(nil), "haqq.ethiq.v1.MsgUpdatePriceLevelsResponse")
+	proto.RegisterType((*MsgSubmitBurnApplication)(nil), "haqq.ethiq.v1.MsgSubmitBurnApplication")
+	proto.RegisterType((*MsgSubmitBurnApplicationResponse)(nil), "haqq.ethiq.v1.MsgSubmitBurnApplicationResponse")
+	proto.RegisterType((*MsgCancelBurnApplication)(nil), "haqq.ethiq.v1.MsgCancelBurnApplication")
+	proto.RegisterType((*MsgCancelBurnApplicationResponse)(nil), "haqq.ethiq.v1.MsgCancelBurnApplicationResponse")
+	proto.RegisterType((*MsgApproveBurnApplication)(nil), "haqq.ethiq.v1.MsgApproveBurnApplication")
+	proto.RegisterType((*MsgApproveBurnApplicationResponse)(nil), "haqq.ethiq.v1.MsgApproveBurnApplicationResponse")
 }
 
 func init() { proto.RegisterFile("haqq/ethiq/v1/tx.proto", fileDescriptor_f5a343caee143bc2) }
 
 var fileDescriptor_f5a343caee143bc2 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0x48, 0x2c, 0x2c,
-	0xd4, 0x4f, 0x2d, 0xc9, 0xc8, 0x2c, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x05, 0x89, 0xeb, 0x81, 0xc5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e,
-	0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x4a, 0x89, 0x43, 0x78, 0xfa, 0xb9, 0xc5, 0xe9, 0x20, 0x73,
-	0x73, 0x8b, 0xd3, 0xa1, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x22, 0xa4,
-	0xd4, 0xce, 0xc4, 0xc5, 0xed, 0x5b, 0x9c, 0xee, 0x9b, 0x99, 0x57, 0xe2, 0x91, 0x58, 0x58, 0x28,
-	0x64, 0xcd, 0xc5, 0x93, 0x56, 0x94, 0x9f, 0x1b, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x0e, 0x47, 0x88,
-	0x4c, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x37, 0x48, 0x35, 0x54, 0x48, 0xc8, 0x9c, 0x8b,
-	0xab, 0x24, 0x1f, 0xae, 0x95, 0x89, 0x80, 0x56, 0xce, 0x92, 0x7c, 0x98, 0x46, 0x1f, 0x2e, 0xee,
-	0xcc, 0xe2, 0x9c, 0xdc, 0xf8, 0xc4, 0xdc, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x66, 0xb0, 0x4e, 0xed,
-	0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x17, 0x85, 0xe8, 0x2e, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc,
-	0xd7, 0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0xcc, 0x2b, 0xb9, 0xb4, 0x45, 0x97, 0x0b, 0x6a, 0xac,
-	0x67, 0x5e, 0x49, 0x10, 0x17, 0x48, 0xbf, 0x23, 0x58, 0xbb, 0x95, 0x49, 0xc7, 0x02, 0x79, 0x86,
-	0x17, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xf1, 0x4e, 0xd7, 0xf3, 0x0d, 0x5a, 0xc8,
-	0xa1, 0x8e, 0xe4, 0x73, 0xa5, 0x64, 0x2e, 0x61, 0x24, 0x6e, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0x2a, 0xc8, 0x69, 0x20, 0x0d, 0x30, 0xa7, 0x31, 0x92, 0xe1, 0x34, 0x90, 0x7e, 0x88, 0xd3,
-	0x94, 0x0e, 0x32, 0x72, 0x49, 0x20, 0xd9, 0xe2, 0x54, 0xe9, 0x58, 0x50, 0x90, 0x93, 0x99, 0x9c,
-	0x58, 0x92, 0x99, 0x9f, 0x47, 0x59, 0xd8, 0xab, 0x72, 0xf1, 0x25, 0x22, 0xcc, 0x8a, 0xcf, 0x4c,
-	0x01, 0x87, 0x22, 0x4b, 0x10, 0x2f, 0x92, 0xa8, 0x67, 0x8a, 0x95, 0x23, 0xde, 0xb0, 0x51, 0xc6,
-	0x1e, 0x36, 0x28, 0xce, 0x54, 0x5a, 0xc9, 0xc8, 0xa5, 0x80, 0x4b, 0x92, 0x36, 0xc1, 0x46, 0x76,
-	0xc2, 0x32, 0x3a, 0xcd, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0xe4, 0xc5, 0xc5, 0x01, 0x4f, 0xe2,
-	0x52, 0x7a, 0x28, 0xf9, 0x4b, 0x0f, 0xc9, 0x2f, 0x52, 0x4a, 0xb8, 0xe5, 0xe0, 0x5e, 0x2b, 0xe4,
-	0x12, 0xc5, 0x1e, 0x7f, 0xea, 0xb8, 0x35, 0xa3, 0x28, 0x94, 0xd2, 0x27, 0x52, 0x21, 0xcc, 0x4a,
-	0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0x64, 0xb6, 0x6e, 0x5e, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36, 0x98, 0xa3, 0x5f, 0x01, 0x8d, 0xd2,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xce, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x89, 0xa0, 0xd2, 0xba, 0x7f, 0x04, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0x13, 0x41,
+	0x14, 0xef, 0x96, 0x3f, 0xb1, 0x53, 0x20, 0xa1, 0x02, 0x96, 0x0d, 0xd9, 0xd6, 0x45, 0x23, 0x29,
+	0x61, 0xd7, 0x56, 0x22, 0x09, 0x9e, 0x5a, 0xfc, 0x57, 0x43, 0xa3, 0x29, 0xf1, 0xe2, 0xa5, 0xd9,
+	0x6e, 0x87, 0xed, 0x86, 0xee, 0xce, 0x76, 0x67, 0xb6, 0xc2, 0xcd, 0x78, 0xd1, 0x78, 0x30, 0x7e,
+	0x04, 0x8e, 0x7a, 0x23, 0x91, 0x2f, 0xe0, 0x8d, 0xc4, 0x0b, 0xe1, 0x64, 0x3c, 0x10, 0x03, 0x07,
+	0xfc, 0x18, 0x66, 0xf6, 0x4f, 0xbb, 0x65, 0xb7, 0x52, 0x0b, 0x5e, 0x08, 0x33, 0xef, 0xf7, 0xde,
+	0xfb, 0xbd, 0xdf, 0xbc, 0xf7, 0xb6, 0x60, 0xa6, 0x2e, 0x35, 0x9b, 0x22, 0x24, 0x75, 0xb5, 0x29,
+	0xb6, 0xb2, 0x22, 0xd9, 0x16, 0x0c, 0x13, 0x11, 0x94, 0x18, 0xa7, 0xf7, 0x82, 0x7d, 0x2f, 0xb4,
+	0xb2, 0xec, 0x94, 0x82, 0x14, 0x64, 0x5b, 0x44, 0xfa, 0x9f, 0x03, 0x62, 0x67, 0x65, 0x84, 0x35,
+	0x84, 0x2b, 0x8e, 0xc1, 0x39, 0xb8, 0xa6, 0x1b, 0xce, 0x49, 0xd4, 0xb0, 0x42, 0xe3, 0x6a, 0x58,
+	0x71, 0x0d, 0x93, 0x92, 0xa6, 0xea, 0x48, 0xb4, 0xff, 0xba, 0x57, 0x9c, 0x8b, 0xad, 0x4a, 0x18,
+	0x8a, 0xad, 0x6c, 0x15, 0x12, 0x29, 0x2b, 0xca, 0x48, 0xd5, 0xbd, 0x34, 0xdd, 0x1c, 0x1d, 0x52,
+	0xb6, 0x89, 0x7f, 0x17, 0x05, 0xf1, 0x12, 0x56, 0x4a, 0xaa, 0x4e, 0x9e, 0x4a, 0xcd, 0x66, 0xe2,
+	0x01, 0x18, 0xdb, 0x34, 0x91, 0x56, 0x91, 0x6a, 0x35, 0x13, 0x62, 0x9c, 0x64, 0xd2, 0xcc, 0x42,
+	0xac, 0x90, 0x3c, 0xda, 0x5f, 0x9a, 0x72, 0xe9, 0xe5, 0x1d, 0xcb, 0x06, 0x31, 0x55, 0x5d, 0x29,
+	0xc7, 0x29, 0xda, 0xbd, 0x4a, 0xac, 0x00, 0x40, 0x50, 0xdb, 0x35, 0x7a, 0x81, 0x6b, 0x8c, 0x20,
+	0xcf, 0x71, 0x1d, 0xc4, 0x55, 0xdc, 0xd0, 0x2a, 0x92, 0x86, 0x2c, 0x9d, 0x24, 0x87, 0x6c, 0xcf,
+	0xc5, 0x83, 0xe3, 0x54, 0xe4, 0xe7, 0x71, 0x6a, 0xda, 0xf1, 0xc6, 0xb5, 0x2d, 0x41, 0x45, 0xa2,
+	0x26, 0x91, 0xba, 0x50, 0xd4, 0xc9, 0xd1, 0xfe, 0x12, 0x70, 0xc3, 0x16, 0x75, 0x52, 0x06, 0xd4,
+	0x3f, 0x6f, 0xbb, 0xaf, 0x2e, 0xbf, 0xdf, 0x4d, 0x45, 0x7e, 0xef, 0xa6, 0x22, 0x6f, 0xcf, 0xf6,
+	0x32, 0x5d, 0xe5, 0x7c, 0x38, 0xdb, 0xcb, 0xf8, 0x1f, 0xcc, 0x57, 0x39, 0x2f, 0x83, 0xeb, 0xbe,
+	0x63, 0x19, 0x62, 0x03, 0xe9, 0x18, 0x52, 0x6a, 0xd4, 0xc1, 0xa3, 0xc6, 0x0c, 0x40, 0x8d, 0xfa,
+	0x3b, 0xd4, 0xf8, 0x6f, 0x0c, 0x48, 0xfa, 0xb2, 0x14, 0x76, 0xf2, 0x86, 0xd1, 0x50, 0x65, 0x89,
+	0xa8, 0x48, 0xbf, 0x9c, 0xf6, 0xb7, 0xc1, 0x84, 0xd4, 0x89, 0x55, 0x51, 0x6b, 0xb6, 0x8a, 0xc3,
+	0xe5, 0x71, 0xdf, 0x6d, 0xb1, 0xb6, 0x9a, 0xff, 0xab, 0x36, 0xf3, 0xe1, 0xda, 0x74, 0xd1, 0xe4,
+	0xbf, 0x30, 0x20, 0xdd, 0xcb, 0xf8, 0x7f, 0x64, 0x1b, 0xb8, 0xb1, 0xf8, 0xef, 0x0c, 0x98, 0x2a,
+	0x61, 0xe5, 0xa5, 0x51, 0x93, 0x08, 0x7c, 0x61, 0xaa, 0x32, 0x5c, 0x87, 0x2d, 0xd8, 0xc0, 0x89,
+	0xfb, 0x20, 0x26, 0x59, 0xa4, 0x8e, 0x4c, 0x95, 0xec, 0x5c, 0x28, 0x74, 0x07, 0x9a, 0x78, 0x02,
+	0xc6, 0x0c, 0x1a, 0xa6, 0xd2, 0xb0, 0xe3, 0x24, 0xa3, 0xe9, 0xa1, 0x85, 0x78, 0x6e, 0x56, 0xe8,
+	0x9a, 0x76, 0xa1, 0x93, 0xa9, 0x10, 0xa3, 0x35, 0x7f, 0x3e, 0xdb, 0xcb, 0x30, 0xe5, 0xb8, 0xd1,
+	0x21, 0xb0, 0x9a, 0xa3, 0x0f, 0xd0, 0x09, 0x4c, 0xd5, 0x4f, 0x75, 0xab, 0x1f, 0x20, 0xcd, 0x73,
+	0x60, 0x2e, 0xec, 0xde, 0x13, 0x9d, 0x3f, 0x8c, 0xda, 0xdd, 0xb5, 0x61, 0x55, 0x35, 0x95, 0x14,
+	0x2c, 0x53, 0xbf, 0xb2, 0xee, 0x1a, 0x78, 0xb2, 0x97, 0xc1, 0x28, 0x46, 0x96, 0x29, 0x43, 0xbb,
+	0x1d, 0x27, 0x72, 0x73, 0xe7, 0x94, 0xda, 0xb0, 0x8d, 0xcf, 0x37, 0x1f, 0x5b, 0x7a, 0x0d, 0x97,
+	0x5d, 0x6c, 0xe2, 0x11, 0x88, 0x57, 0x2d, 0x53, 0xf7, 0xba, 0x67, 0x38, 0xcd, 0xd8, 0x22, 0xbb,
+	0xc9, 0xe8, 0x9a, 0x13, 0xdc, 0x35, 0x27, 0xac, 0x21, 0x55, 0xf7, 0x8b, 0x0c, 0xa8, 0xa3, 0xbb,
+	0x08, 0xfe, 0xa9, 0xd9, 0x43, 0x55, 0xe3, 0x8b, 0x20, 0xdd, 0xcb, 0xd6, 0xee, 0xf5, 0xe0, 0xe8,
+	0x31, 0x21, 0xa3, 0xe7, 0xcd, 0xfe, 0x9a, 0xa4, 0xcb, 0xb0, 0x71, 0xa5, 0xaf, 0x13, 0x24, 0x10,
+	0xbd, 0xf4, 0xec, 0x87, 0xd2, 0xe4, 0x79, 0x90, 0xee, 0x65, 0x6b, 0x77, 0xe1, 0x57, 0x06, 0xcc,
+	0x96, 0xb0, 0x92, 0x37, 0x0c, 0x13, 0xb5, 0xe0, 0xf9, 0x42, 0x07, 0x1d, 0xbc, 0x3e, 0x6b, 0x5c,
+	0x09, 0x8e, 0xd5, 0xad, 0xee, 0xc2, 0xc2, 0x79, 0xf1, 0xf3, 0xe0, 0x66, 0x4f, 0xa3, 0x57, 0x5a,
+	0xee, 0xe3, 0x08, 0x18, 0x2a, 0x61, 0x25, 0xf1, 0x0c, 0x5c, 0x6b, 0x7f, 0x31, 0xd9, 0x73, 0x1d,
+	0xed, 0x5b, 0x8d, 0x2c, 0xdf, 0xdb, 0xd6, 0xee, 0x9e, 0x26, 0x98, 0x0e, 0xff, 0x1c, 0xdc, 0xe9,
+	0xed, 0xdc, 0x05, 0x64, 0xc5, 0x3e, 0x81, 0xed, 0x94, 0x10, 0x4c, 0x06, 0x37, 0xe2, 0x7c, 0x30,
+	0x4a, 0x00, 0xc4, 0x2e, 0xf6, 0x01, 0xf2, 0x57, 0x16, 0xbe, 0x8a, 0x42, 0x2a, 0x0b, 0x05, 0xb2,
+	0x62, 0x9f, 0x40, 0x7f, 0xca, 0xf0, 0xf9, 0x0a, 0x49, 0x19, 0x0a, 0x64, 0xc5, 0x3e, 0x81, 0xed,
+	0x94, 0x04, 0xcc, 0xf4, 0x68, 0xf5, 0x85, 0x60, 0xa8, 0x70, 0x24, 0x7b, 0xb7, 0x5f, 0xa4, 0x97,
+	0x95, 0x1d, 0x79, 0x43, 0xb7, 0x5d, 0xe1, 0xe1, 0xc1, 0x09, 0xc7, 0x1c, 0x9e, 0x70, 0xcc, 0xaf,
+	0x13, 0x8e, 0xf9, 0x74, 0xca, 0x45, 0x0e, 0x4f, 0xb9, 0xc8, 0x8f, 0x53, 0x2e, 0xf2, 0x2a, 0xa3,
+	0xa8, 0xa4, 0x6e, 0x55, 0x05, 0x19, 0x69, 0x22, 0x0d, 0xbe, 0xa4, 0x43, 0xf2, 0x1a, 0x99, 0x5b,
+	0xf6, 0x41, 0xdc, 0x76, 0xe7, 0x81, 0xec, 0x18, 0x10, 0x57, 0x47, 0xed, 0xdf, 0x82, 0xf7, 0xfe,
+	0x0c, 0x00, 0x23, 0x8e, 0xdd, 0x36, 0xcc, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -265,6 +650,16 @@ type MsgClient interface {
 	// MintHaqqByApplication defines a method to mint aHAQQ coins in exchange for aISLM coins
 	// trough whitelisted application submitted earlier via smart-contract
 	MintHaqqByApplication(ctx context.Context, in *MsgMintHaqqByApplication, opts ...grpc.CallOption) (*MsgMintHaqqByApplicationResponse, error)
+	// UpdatePriceLevels defines a governance operation for replacing the aISLM to aHAQQ burn price curve.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePriceLevels(ctx context.Context, in *MsgUpdatePriceLevels, opts ...grpc.CallOption) (*MsgUpdatePriceLevelsResponse, error)
+	// SubmitBurnApplication defines a method to register a new burn application
+	SubmitBurnApplication(ctx context.Context, in *MsgSubmitBurnApplication, opts ...grpc.CallOption) (*MsgSubmitBurnApplicationResponse, error)
+	// CancelBurnApplication defines a method to cancel a burn application which is not executed yet
+	CancelBurnApplication(ctx context.Context, in *MsgCancelBurnApplication, opts ...grpc.CallOption) (*MsgCancelBurnApplicationResponse, error)
+	// ApproveBurnApplication defines a governance operation for approving a submitted burn application.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ApproveBurnApplication(ctx context.Context, in *MsgApproveBurnApplication, opts ...grpc.CallOption) (*MsgApproveBurnApplicationResponse, error)
 }
 
 type msgClient struct {
@@ -293,6 +688,42 @@ func (c *msgClient) MintHaqqByApplication(ctx context.Context, in *MsgMintHaqqBy
 	return out, nil
 }
 
+func (c *msgClient) UpdatePriceLevels(ctx context.Context, in *MsgUpdatePriceLevels, opts ...grpc.CallOption) (*MsgUpdatePriceLevelsResponse, error) {
+	out := new(MsgUpdatePriceLevelsResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Msg/UpdatePriceLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBurnApplication(ctx context.Context, in *MsgSubmitBurnApplication, opts ...grpc.CallOption) (*MsgSubmitBurnApplicationResponse, error) {
+	out := new(MsgSubmitBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Msg/SubmitBurnApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBurnApplication(ctx context.Context, in *MsgCancelBurnApplication, opts ...grpc.CallOption) (*MsgCancelBurnApplicationResponse, error) {
+	out := new(MsgCancelBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Msg/CancelBurnApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveBurnApplication(ctx context.Context, in *MsgApproveBurnApplication, opts ...grpc.CallOption) (*MsgApproveBurnApplicationResponse, error) {
+	out := new(MsgApproveBurnApplicationResponse)
+	err := c.cc.Invoke(ctx, "/haqq.ethiq.v1.Msg/ApproveBurnApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintHaqq defines a method to mint aHAQQ coins in exchange for aISLM coins
@@ -300,6 +731,16 @@ type MsgServer interface {
 	// MintHaqqByApplication defines a method to mint aHAQQ coins in exchange for aISLM coins
 	// trough whitelisted application submitted earlier via smart-contract
 	MintHaqqByApplication(context.Context, *MsgMintHaqqByApplication) (*MsgMintHaqqByApplicationResponse, error)
+	// UpdatePriceLevels defines a governance operation for replacing the aISLM to aHAQQ burn price curve.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePriceLevels(context.Context, *MsgUpdatePriceLevels) (*MsgUpdatePriceLevelsResponse, error)
+	// SubmitBurnApplication defines a method to register a new burn application
+	SubmitBurnApplication(context.Context, *MsgSubmitBurnApplication) (*MsgSubmitBurnApplicationResponse, error)
+	// CancelBurnApplication defines a method to cancel a burn application which is not executed yet
+	CancelBurnApplication(context.Context, *MsgCancelBurnApplication) (*MsgCancelBurnApplicationResponse, error)
+	// ApproveBurnApplication defines a governance operation for approving a submitted burn application.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ApproveBurnApplication(context.Context, *MsgApproveBurnApplication) (*MsgApproveBurnApplicationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -312,6 +753,18 @@ func (*UnimplementedMsgServer) MintHaqq(ctx context.Context, req *MsgMintHaqq) (
 func (*UnimplementedMsgServer) MintHaqqByApplication(ctx context.Context, req *MsgMintHaqqByApplication) (*MsgMintHaqqByApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintHaqqByApplication not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePriceLevels(ctx context.Context, req *MsgUpdatePriceLevels) (*MsgUpdatePriceLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceLevels not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBurnApplication(ctx context.Context, req *MsgSubmitBurnApplication) (*MsgSubmitBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBurnApplication not implemented")
+}
+func (*UnimplementedMsgServer) CancelBurnApplication(ctx context.Context, req *MsgCancelBurnApplication) (*MsgCancelBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBurnApplication not implemented")
+}
+func (*UnimplementedMsgServer) ApproveBurnApplication(ctx context.Context, req *MsgApproveBurnApplication) (*MsgApproveBurnApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBurnApplication not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -353,34 +806,122 @@ func _Msg_MintHaqqByApplication_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "haqq.ethiq.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MintHaqq",
-			Handler:    _Msg_MintHaqq_Handler,
-		},
-		{
-			MethodName: "MintHaqqByApplication",
-			Handler:    _Msg_MintHaqqByApplication_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "haqq/ethiq/v1/tx.proto",
-}
-
-func (m *MsgMintHaqq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdatePriceLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceLevels)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Msg/UpdatePriceLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceLevels(ctx, req.(*MsgUpdatePriceLevels))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMintHaqq) MarshalTo(dAtA []byte) (int, error) {
+func _Msg_SubmitBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Msg/SubmitBurnApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBurnApplication(ctx, req.(*MsgSubmitBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Msg/CancelBurnApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBurnApplication(ctx, req.(*MsgCancelBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveBurnApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBurnApplication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveBurnApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.ethiq.v1.Msg/ApproveBurnApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveBurnApplication(ctx, req.(*MsgApproveBurnApplication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "haqq.ethiq.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MintHaqq",
+			Handler:    _Msg_MintHaqq_Handler,
+		},
+		{
+			MethodName: "MintHaqqByApplication",
+			Handler:    _Msg_MintHaqqByApplication_Handler,
+		},
+		{
+			MethodName: "UpdatePriceLevels",
+			Handler:    _Msg_UpdatePriceLevels_Handler,
+		},
+		{
+			MethodName: "SubmitBurnApplication",
+			Handler:    _Msg_SubmitBurnApplication_Handler,
+		},
+		{
+			MethodName: "CancelBurnApplication",
+			Handler:    _Msg_CancelBurnApplication_Handler,
+		},
+		{
+			MethodName: "ApproveBurnApplication",
+			Handler:    _Msg_ApproveBurnApplication_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "haqq/ethiq/v1/tx.proto",
+}
+
+func (m *MsgMintHaqq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintHaqq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -525,85 +1066,1075 @@ func (m *MsgMintHaqqByApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePriceLevels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMintHaqq) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdatePriceLevels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceLevels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceLevels) > 0 {
+		for iNdEx := len(m.PriceLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePriceLevelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceLevelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceLevelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBurnApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBurnApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBurnApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.IslmAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	if m.Source != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintHaqqResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBurnApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBurnApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBurnApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBurnApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBurnApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBurnApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBurnApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBurnApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBurnApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveBurnApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveBurnApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBurnApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApplicationId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveBurnApplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveBurnApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBurnApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintHaqq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IslmAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintHaqqResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HaqqAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintHaqqByApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgMintHaqqByApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HaqqAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceLevels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PriceLevels) > 0 {
+		for _, e := range m.PriceLevels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceLevelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBurnApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTx(uint64(m.Source))
+	}
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitBurnApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgCancelBurnApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgCancelBurnApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveBurnApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplicationId != 0 {
+		n += 1 + sovTx(uint64(m.ApplicationId))
+	}
+	return n
+}
+
+func (m *MsgApproveBurnApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintHaqq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintHaqq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IslmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintHaqqResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintHaqqResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintHaqqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintHaqqByApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintHaqqByApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintHaqqByApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
+			}
+			m.ApplicationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintHaqqByApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintHaqqByApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintHaqqByApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePriceLevels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePriceLevels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePriceLevels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceLevels = append(m.PriceLevels, PriceLevel{})
+			if err := m.PriceLevels[len(m.PriceLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.HaqqAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgMintHaqqByApplication) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ApplicationId != 0 {
-		n += 1 + sovTx(uint64(m.ApplicationId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgMintHaqqByApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.HaqqAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdatePriceLevelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePriceLevelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePriceLevelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBurnApplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -626,10 +2157,10 @@ func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintHaqq: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBurnApplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintHaqq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBurnApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -697,10 +2228,29 @@ func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= SourceOfFunds(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IslmAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -710,23 +2260,22 @@ func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IslmAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -751,7 +2300,7 @@ func (m *MsgMintHaqq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintHaqqResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBurnApplicationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -774,17 +2323,17 @@ func (m *MsgMintHaqqResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintHaqqResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBurnApplicationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintHaqqResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBurnApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
 			}
-			var stringLen uint64
+			m.ApplicationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -794,26 +2343,11 @@ func (m *MsgMintHaqqResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApplicationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -835,7 +2369,7 @@ func (m *MsgMintHaqqResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintHaqqByApplication) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBurnApplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -858,10 +2392,10 @@ func (m *MsgMintHaqqByApplication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintHaqqByApplication: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBurnApplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintHaqqByApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBurnApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -896,7 +2430,7 @@ func (m *MsgMintHaqqByApplication) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
 			}
@@ -936,7 +2470,7 @@ func (m *MsgMintHaqqByApplication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintHaqqByApplicationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelBurnApplicationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -959,15 +2493,65 @@ func (m *MsgMintHaqqByApplicationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintHaqqByApplicationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelBurnApplicationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintHaqqByApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelBurnApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveBurnApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveBurnApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveBurnApplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HaqqAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -995,15 +2579,13 @@ func (m *MsgMintHaqqByApplicationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HaqqAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationId", wireType)
 			}
-			var stringLen uint64
+			m.ApplicationId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1013,24 +2595,61 @@ func (m *MsgMintHaqqByApplicationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApplicationId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveBurnApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveBurnApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveBurnApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ var (
 	// IslmAmount == "0" denotes a canceled application.
 	//
 	// registeredApplications represents the list of applications present in smart-contract.
+	// It only seeds the module state, see WaitlistApplications.
 	//
 	// NOTE: Now here's only values for testing purposes. Final list will be set up on release.
 	registeredApplications = []ApplicationListItem{
@@ -177,134 +178,4 @@ var (
 		{166, "haqq1kawnyp8w7ydk9fgtvp7m8t8kqf0vypykr3rj7v", "haqq1kawnyp8w7ydk9fgtvp7m8t8kqf0vypykr3rj7v", 1, "499999997000000000000000000", "682053000642991406137183556"},
 	}
 	// 682053000642991406137183556 + 499999997000000000000000000 = total aISLM (sumAmount of last + amount of last)
-
-	// registeredApplicationsBySender is a helper index of applications grouped by sender address.
-	registeredApplicationsBySender = map[string][]uint64{
-		"haqq10eq96nffm076nz3mxewqjphg0lnjax92a8vjm7": {27},
-		"haqq10gdjtqt67udzrg0gpctat9dgswtvlmvaa5s45d": {19},
-		"haqq10nu2sq68x205698frc5zjkj6x92emr3j4wxued": {65},
-		"haqq129lsqnv4cpdqtuqj20fljwvmj8cy7kllezqx59": {40},
-		"haqq12cph8474dhplzukj97pdqdw2mlw9j57n8marul": {57},
-		"haqq12eug4trfzjmmaqvh0mqvs4jzmtnfv6repd5w4w": {22},
-		"haqq12lfcj8s2p200wtwm4r97g5jlhtke2qtzvfuehy": {165},
-		"haqq137867ch7xxe3a95a2rhs9xntzjp9q7ygrxnzvh": {16, 17},
-		"haqq13racrla0fnrcs3jr9w0v47cwksj9ll40gxwg8n": {147, 148},
-		"haqq13tznakuqvyr4q8kflalrkmf7uqnsg0psqhawlv": {8},
-		"haqq13x3h3t9fqc69er64jmf3za5wuz9fkd2zpgl737": {82, 83, 84, 85, 86},
-		"haqq14k7yta4e7af2c84vzy337lxda4r9l9chd0pyde": {72},
-		"haqq155ue4wm6uslhplpdcg57h2vs5xxhd7eumuzhkd": {58},
-		"haqq15lc8ynsqla2y7ketemz9nhhrkeszpenydw5l6u": {15},
-		"haqq15lfmvu54dwxf68uw06eg8am58tlezv6hz8qtte": {157},
-		"haqq166qpmkhpjpcts8nmdzgj2d0ghktzszsqt0ldgc": {89, 90},
-		"haqq168lrdnr224qgtdl4vfrlxs8vmey9csl7023zl3": {46},
-		"haqq16ajzzak99ftl3rp5efjysnrn4sg5kkp7rzuk23": {105, 115},
-		"haqq16fcn8enuhcukgat2mhz6j0u0yr3atngcsqm46f": {70},
-		"haqq16jrcmtp7czsmqup3vgzjet75vzj9h7klwldf72": {142, 143},
-		"haqq16k82fxutdsyk9m859cx4uuswt5jg5e689n9gx8": {135},
-		"haqq16rgvtrdzr4s092r7l4hx3z2rs75h58w9du8ulj": {87},
-		"haqq16uflhdn44p6jmrpkxp4x4s8nvdrx5p9d4jpk96": {112},
-		"haqq172whkhhcv8076y9sjslj8nqm8w639uw0sjqd3c": {64, 138, 139},
-		"haqq17al5mzpe4qknu3f020d3vyzpjmr653rvdm3rmj": {52},
-		"haqq17fa648z3cyxys6mcvxfny9j9kq3nc43tm3fjcq": {153},
-		"haqq17s89t23xmyp2fsavnmaphxcvt4q0ejwvwf89ws": {106},
-		"haqq17zp7fvdqlpywr55k9vgfeyyk7r5ajzydap56ld": {37, 38},
-		"haqq180l5wmf04suvg8tsrf4d0782pcptve84rt8au0": {28},
-		"haqq1892ue2rnv3hj6c2evk8m2eyahshr2zvzacg3yf": {123},
-		"haqq18cm23gk4dmw50dnw4q9gpmnhhhqwjh097f4lr2": {80},
-		"haqq18fh68cjgyxvfhtnye3gnejd33mc047ftarkwn4": {93, 94},
-		"haqq18mgz59q57y03zzfe8gjr67t2rwpfk5emvpl5cx": {113, 114},
-		"haqq18pyf4c2q0cqqd3nm2mhggsvqxmv9009nmq0sdt": {117, 118, 130},
-		"haqq199p63utfq8du5a27fuzgykv9s96xylrcju3y0d": {31},
-		"haqq19pgh0packgcy0fh002jausn03tswckpg43wwah": {66},
-		"haqq19pxv2r4key79twjfv0gdc5yhc4xmw9vqxkj2nl": {5},
-		"haqq1a86m0nrq87vfufp9g0c3y2jqzpvhxkrqq6rjq3": {63, 75, 119},
-		"haqq1am5c3kclk7dxq88y7ussp6rv4hp5exmqh06ql6": {97},
-		"haqq1ayyld0qup2njh0cn9xrguzfmd4k04jek6dkg6d": {120},
-		"haqq1c57qkm92l9q7v5x67rap29um7uf6tar5c585wx": {60, 62},
-		"haqq1c66zlpq02s4nzhrg35tr4vg6d4yy9l593cfezc": {145},
-		"haqq1cxkwdretn2mz5f4r06qlevdd4yv8xg6prvadgr": {26},
-		"haqq1d2lcvdp7k2lj2tv5a2xqukv94k9zrh74h5qgdg": {160},
-		"haqq1d83fz57tysqdyfhu0sua7dzy29y4h7dqe30qer": {152},
-		"haqq1dsscr680uvt6y3s7v07gephfj64305qcx3qa9s": {44},
-		"haqq1en0059nhetkweqtl4xke6y47l8vk0mx3urs2cl": {36},
-		"haqq1esy5m56f7gsgckjgvwreq6yd95rp5k2u9kt7y0": {100},
-		"haqq1f5t73c364qwjkza4pdcjed65205x3w5vx3nxy6": {48},
-		"haqq1f9ncj6tqfht8327w8378qfxg4tv2cv5scut95u": {99},
-		"haqq1fagmupyv9cnvlj6wlxsl62d664pr4gxadjuu77": {104},
-		"haqq1fj3kllasstzmlslwuj8qg8exnsjzkkgg6ecu6x": {79},
-		"haqq1gcwaegn8j68t02qersxdk95y53dey5xfq9alp0": {13, 14},
-		"haqq1ghxpqqmkju4lx0ucsw43mg8f05cuuxjksu3n34": {74},
-		"haqq1gp7nxss0t5jpl6qxqpfq3hpr7fdkuna0ygpwa6": {108, 163},
-		"haqq1gq3s87xhmjtekd0823l3tmchnc90x8g0lzue66": {81},
-		"haqq1hc04df4sh90vg6nw7urlg6p5ek7qqvrq2kc2hr": {134, 136},
-		"haqq1hexv24j6g035fzwj7qhdj6qtgdh9jcurkug09z": {47, 49},
-		"haqq1hy4qd69362ltm23y9yc7zsnjg30l6fal9l7u98": {45},
-		"haqq1jnk82c4zcwyfyajgt2yddcyz0hsyfkwnz4tt6m": {1, 2, 3},
-		"haqq1jnxfs2e5c8vpxrwm0p6t3cz2gw0v833hpdpqm0": {61},
-		"haqq1jt70r5w5q56fers0a4z2x95l92v360pwtey60k": {29},
-		"haqq1jup5j38l4nucfrmn8dnkp5f8srju2epndlmfud": {116},
-		"haqq1jyvclva6pngjtgjvke2z7tcx2dnuuw62rzzphl": {59},
-		"haqq1jz26hdhvu4mxlc8ex0gpmryz4acp30tv8x06jh": {4},
-		"haqq1k5tkey8ym6596jsmsfkxpfhswuhv9xk5q5n362": {0},
-		"haqq1k9jgk497v0cwpxnare0348ne2qd256ateznv46": {103},
-		"haqq1kawnyp8w7ydk9fgtvp7m8t8kqf0vypykr3rj7v": {150, 166},
-		"haqq1l04mcr5k0pn9zx9dlan3t7f335z3e454n3r07v": {35, 98, 107},
-		"haqq1l20lvcsk37cuqt5wxprsqmxm6tseezfcrdawlp": {121, 122},
-		"haqq1l3nfqhj85c6m995ycgyjszqs80urtflkmg35p8": {6, 7},
-		"haqq1lny0m8sezjanhfqara9c8mz8t5yj7rsr7dftc5": {95},
-		"haqq1lxq7f0a4mwkxnqnr0ta4cweh0gsfmfrcpywfl8": {30},
-		"haqq1lyjrsnd33hdzw7yrxppxsm4dzs6z75xrgkqudz": {91},
-		"haqq1m2q0lsn655c7gxudz3srepgpm5sfv0dfwuje52": {12},
-		"haqq1mapqt40fv9pgdspzlttnvjau9v86a6cu5d3ul7": {141},
-		"haqq1ml2070yf5l3hapd2wrr3n7l06c69j95ff07a4m": {124, 159},
-		"haqq1mr7m6cter0x5vru0hxjq05lur67zxvnap0szyt": {162},
-		"haqq1ms6lns7jclf8zde3am7qpxs9gc5ss07nqgkjaj": {34},
-		"haqq1mwy2pmdmva5yt5cynfh5e8m723gjy8fc48rrmj": {67},
-		"haqq1ndfgfwh8k6253gensplefp3nmkk8dd64rld0l0": {50},
-		"haqq1nhj7dffdyut62vkdrs7e20s3fqsgzlx6ly52yl": {9},
-		"haqq1p0n5n8g2htnclrfynlney5fuanxkgg509vhwnc": {109},
-		"haqq1p2m77hnjeyrjn75rjrxmymls0z8x5en6kwtwxs": {164},
-		"haqq1p8k6xk94u24vv9dmxu3vkgg43fs3v72g05dun6": {23},
-		"haqq1phrkjzpfdwt0pfu2h34ul3dzw4a4l86qlgsm0v": {131},
-		"haqq1pqteeuxf8t9vfptqwjv8qk43q48qny758lpmdl": {73, 96},
-		"haqq1pz0xg5ejepdy7h7lr28jpade4gn6ye3lfgr0fg": {137, 140},
-		"haqq1q0hcvgfvphfx4falj4v2vtyv69fp9amz0tjs9r": {111},
-		"haqq1qqlvdwv36ex6fue0u9lh9plzt9nhr542pt5u0d": {78},
-		"haqq1r75haftq93xnf2654xym9u8wwp6vxvn552xlqa": {158},
-		"haqq1r9y580lw6gkf3qx3srrw2gtgmvth4mkm8vqe70": {146},
-		"haqq1rrza5u89np7dz30aqm9tz7lh5vrmwfuflk7h97": {92},
-		"haqq1rwkesqkx42j6ngnxqz0wk8ctr77duwynzexxh4": {125, 126},
-		"haqq1sgkt4ktme24pungmvdx8aqpatut33fghksc830": {56},
-		"haqq1sudrde65khwj9m3n437hqsdsglmf8zt5yetgg5": {71},
-		"haqq1t0gqj43sd3auxka79h40mw3u8uq6k0fhpkyv9l": {20},
-		"haqq1t80rnldwswp3pdeymza9x0ju3tduwfqmukdl3x": {24},
-		"haqq1t9kjgy2uawyz8ayyspgacccul7qw4wxq7nq5aj": {10},
-		"haqq1tg0vjrhumfaj0rvq8k849v85rcdy8m0204d854": {42},
-		"haqq1tgffqpkcahd4pfmjfk46yxe80whf3882zv8qaq": {39},
-		"haqq1u32kdvz3t2ef7l894z8jyp64l8pme9kqxers3p": {69},
-		"haqq1u96w5hahu5ehyf6f9sszv5hvdy8w8d9hp0rumz": {110},
-		"haqq1udglxy84ns5nuwfa7tgmdjfjlph30mrrszj93y": {77},
-		"haqq1v2qeeuy64ft03rjyj27vvyjfaxsegfxam9gjnu": {156},
-		"haqq1v85jdqct6hlk3e67n6arkdzl3r2rz9ug7drptr": {32},
-		"haqq1vc74rl68tvvpmtad583zexzvt9a3turq50r23p": {33},
-		"haqq1vf7sm9f8g9fl2yawvraupjxeplka6l6jxfvkqk": {54},
-		"haqq1vk6pw8yqkrha6k4vf7ccmnmxqg9geqje79jtux": {51},
-		"haqq1wclryhevxuwzt08fgf3ahkl449um59cgdud8mf": {55},
-		"haqq1wh3wrj3z9a5ngacv49rqa3zw6e7er2gh4slhmf": {18},
-		"haqq1wj2jf4n86p5y38u8djuasqh0vzft84f8jvymje": {132},
-		"haqq1wqqxamh9vn33gakxwwjtp90nvwwrrkxvnh2kyc": {161},
-		"haqq1wwnpmzzvs25prs3r6kfajupd6usy5gufqsyr75": {11},
-		"haqq1wwpeqyek2v5yzz9qduv3dfp5zcw6fdfuww86kf": {154},
-		"haqq1wx8g7x4xq7w4xnvenzzxdkttmwtp3h0r35q5yj": {21, 25},
-		"haqq1xfukn8mn3hzzyvyml54l7zhyv87k86hyuc7dpl": {144},
-		"haqq1xnkjh0n4rlpzsgnc5ugdx3577jqdk42rrudtj8": {41, 43, 76, 151},
-		"haqq1xwx40gug5urcsvk2wzl4rfrtxrphgq9e2gux9y": {68, 88},
-		"haqq1y7eu3n928f3taquctfluh9t3ergc6gst5890sd": {155},
-		"haqq1ydpxfzen98270ag53tzzscm22j40zrn0djqakg": {128, 129},
-		"haqq1ylj36axpu99p9aanetr4c9ltfhd97yymtywry4": {101, 102},
-		"haqq1ynlv4pneetsjdsp4qzecafw9swr6ukasueprrd": {149},
-		"haqq1yskmsxyyk6kta097zgnhgrz422nyszaqyy5t7y": {127},
-		"haqq1zk77lved6lczv207nnlk42r8q449p0r5s8m2xu": {53},
-		"haqq1zp2hep5w80jjm9tnpuk7aqxmg0qhl88xkjmjdl": {133},
-	}
 )
@@ -18,118 +18,47 @@ func TestRegisteredApplicationsInvariants(t *testing.T) {
 		t.Fatal("registeredApplications must not be empty")
 	}
 
-	if TotalNumberOfApplications() != uint64(len(registeredApplications)) {
-		t.Fatalf("total applications mismatch: expected %d, got %d", len(registeredApplications), TotalNumberOfApplications())
+	applications := WaitlistApplications()
+	if len(applications) != len(registeredApplications) {
+		t.Fatalf("total applications mismatch: expected %d, got %d", len(registeredApplications), len(applications))
 	}
 
-	seenIDs := make(map[uint64]struct{}, len(registeredApplications))
-
-	var expectedID uint64
-	for i, app := range registeredApplications {
-		if app.ID != expectedID {
-			t.Fatalf("application id mismatch at index %d: expected %d, got %d", i, expectedID, app.ID)
-		}
-
-		if _, exists := seenIDs[app.ID]; exists {
-			t.Fatalf("duplicate application id found: %d", app.ID)
+	for i, app := range applications {
+		if app.Id != uint64(i) { //nolint: gosec
+			t.Fatalf("application id mismatch at index %d: got %d", i, app.Id)
 		}
-		seenIDs[app.ID] = struct{}{}
 
-		if !IsApplicationExists(app.ID) {
-			t.Fatalf("IsApplicationExists returned false for existing id %d", app.ID)
+		if err := app.ValidateBasic(); err != nil {
+			t.Fatalf("waitlist application %d is invalid: %v", app.Id, err)
 		}
 
-		gotApp, err := GetApplicationByID(app.ID)
-		if err != nil {
-			t.Fatalf("GetApplicationByID failed for id %d: %v", app.ID, err)
-		}
-		if gotApp.Id != app.ID {
-			t.Fatalf("GetApplicationByID returned wrong id: expected %d, got %d", app.ID, gotApp.Id)
+		if !app.IsApproved {
+			t.Fatalf("waitlist application %d must be approved", app.Id)
 		}
 
-		if _, err := app.AsBurnApplication(); err != nil {
-			t.Fatalf("invalid registered application id %d: %v", app.ID, err)
+		if app.IsCanceled != app.BurnAmount.IsZero() {
+			t.Fatalf("waitlist application %d cancellation mismatch", app.Id)
 		}
-		expectedID++
-	}
-
-	if IsApplicationExists(uint64(len(registeredApplications))) {
-		t.Fatalf("IsApplicationExists returned true for out-of-range id %d", len(registeredApplications))
-	}
-	if _, err := GetApplicationByID(uint64(len(registeredApplications))); err == nil {
-		t.Fatalf("expected error for out-of-range GetApplicationByID(%d)", len(registeredApplications))
 	}
 }
 
-func TestRegisteredApplicationsBySenderInvariants(t *testing.T) {
-	if len(registeredApplicationsBySender) == 0 {
-		t.Fatal("registeredApplicationsBySender must not be empty")
-	}
+func TestRegisteredApplicationsBurnedBeforeInvariant(t *testing.T) {
+	applications := WaitlistApplications()
 
-	expected := make(map[string][]uint64)
-	for _, app := range registeredApplications {
-		expected[app.FromAddress] = append(expected[app.FromAddress], app.ID)
+	if !applications[0].BurnedBeforeAmount.IsZero() {
+		t.Fatalf("first application must have zero burned before amount, got %s", applications[0].BurnedBeforeAmount)
 	}
 
-	for sender, expectedIDs := range expected {
-		gotIDs, ok := registeredApplicationsBySender[sender]
-		if !ok {
-			t.Fatalf("sender %s is missing in registeredApplicationsBySender", sender)
-		}
-		if len(gotIDs) == 0 {
-			t.Fatalf("sender %s has empty application list", sender)
-		}
-		if len(expectedIDs) != len(gotIDs) {
-			t.Fatalf("sender %s application count mismatch: expected %d, got %d", sender, len(expectedIDs), len(gotIDs))
-		}
-		if TotalNumberOfApplicationsBySender(sender) != uint64(len(gotIDs)) {
-			t.Fatalf("sender %s total mismatch: expected %d, got %d", sender, len(gotIDs), TotalNumberOfApplicationsBySender(sender))
-		}
-
-		for i := range expectedIDs {
-			if expectedIDs[i] != gotIDs[i] {
-				t.Fatalf("sender %s app id mismatch at index %d: expected %d, got %d", sender, i, expectedIDs[i], gotIDs[i])
-			}
-
-			appID := gotIDs[i]
-			if appID >= uint64(len(registeredApplications)) {
-				t.Fatalf("sender %s index %d points out of range app id %d", sender, i, appID)
-			}
-			if registeredApplications[appID].FromAddress != sender {
-				t.Fatalf("sender %s index %d points to app id %d owned by %s", sender, i, appID, registeredApplications[appID].FromAddress)
-			}
-		}
-
-		// Boundary check for sender index lookup.
-		if _, err := GetSendersApplicationIDByIndex(sender, uint64(len(gotIDs))); err == nil {
-			t.Fatalf("expected out-of-range error for sender %s at index %d", sender, len(gotIDs))
+	for i := 1; i < len(applications); i++ {
+		prev := applications[i-1]
+		expected := prev.BurnedBeforeAmount.Add(prev.BurnAmount)
+		if !applications[i].BurnedBeforeAmount.Equal(expected) {
+			t.Fatalf(
+				"application %d burned before amount mismatch: expected %s, got %s",
+				applications[i].Id,
+				expected,
+				applications[i].BurnedBeforeAmount,
+			)
 		}
 	}
-
-	// Unknown sender should have zero applications and fail on indexed lookup.
-	unknownSender := "haqq1zzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzzz"
-	if TotalNumberOfApplicationsBySender(unknownSender) != 0 {
-		t.Fatalf("expected zero applications for unknown sender %s", unknownSender)
-	}
-	if _, err := GetSendersApplicationIDByIndex(unknownSender, 0); err == nil {
-		t.Fatalf("expected error for unknown sender %s", unknownSender)
-	}
-}
-
-func TestGetSumOfAllApplicationsInvariant(t *testing.T) {
-	sum, err := GetSumOfAllApplications()
-	if err != nil {
-		t.Fatalf("GetSumOfAllApplications failed: %v", err)
-	}
-
-	last := registeredApplications[len(registeredApplications)-1]
-	lastBurnApp, err := last.AsBurnApplication()
-	if err != nil {
-		t.Fatalf("failed to parse last application: %v", err)
-	}
-
-	expected := lastBurnApp.BurnAmount.Add(lastBurnApp.BurnedBeforeAmount).Amount
-	if !sum.Equal(expected) {
-		t.Fatalf("sum mismatch: expected %s, got %s", expected.String(), sum.String())
-	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	haqqtypes "github.com/haqq-network/haqq/types"
@@ -35,6 +36,9 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// maxAccessListIterations is the maximum number of times CreateAccessList
+	// runs the call, waiting for the access list to stop changing.
+	maxAccessListIterations = 10
 )
 
 // Account implements the Query/Account gRPC method
@@ -232,11 +236,24 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	stateOverride, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	if len(stateOverride) > 0 {
+		// the overrides must never be written to the query context
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, stateOverride); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -289,6 +306,11 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	stateOverride, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -322,6 +344,15 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	if len(stateOverride) > 0 {
+		// the overrides must never be written to the query context
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, stateOverride); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -591,6 +622,90 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the state of the requested block. The return
+// value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateOverride, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.BlockNumber > 0 {
+		ctx = ctx.WithBlockHeight(req.BlockNumber)
+		ctx = ctx.WithBlockTime(req.BlockTime)
+		ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+		ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+		})
+	}
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	if len(stateOverride) > 0 {
+		// the overrides must never be written to the query context
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, stateOverride); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -601,6 +716,179 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// CreateAccessList implements the `eth_createAccessList` rpc api. It runs the
+// call with the access list tracer until the accessed addresses and storage
+// slots no longer change, and returns the resulting list with the gas required
+// by the call when the list is attached.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	stateOverride, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx = applyBlockOverrides(ctx, cfg, blockOverrides)
+	if len(stateOverride) > 0 {
+		// the overrides must never be written to the query context
+		ctx, _ = ctx.CacheContext()
+		if err := k.applyStateOverrides(ctx, stateOverride); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the recipient of a contract creation is the address of the new contract
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// the sender, the recipient and the precompiles are always warm, so they are
+	// excluded from the list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	defaultPrecompiles := vm.DefaultActivePrecompiles(rules)
+	staticPrecompiles := cfg.Params.GetActiveStaticPrecompilesAddrs()
+	precompiles := make([]common.Address, 0, len(defaultPrecompiles)+len(staticPrecompiles))
+	precompiles = append(precompiles, defaultPrecompiles...)
+	precompiles = append(precompiles, staticPrecompiles...)
+
+	var prevList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(prevList, from, to, precompiles)
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for i := 0; i < maxAccessListIterations; i++ {
+		// retrieve the current access list to expand
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// apply the message with the access list tracer (see types.TracerAccessList),
+		// passing false to not commit StateDB
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !tracer.Equal(prevTracer) {
+			prevTracer = tracer
+			continue
+		}
+
+		rsp := &types.QueryCreateAccessListResponse{
+			AccessList: types.NewAccessList(&accessList),
+			VmError:    res.VmError,
+		}
+		if res.Failed() {
+			return rsp, nil
+		}
+
+		// the gas used by the message is raised to the minimum gas multiplier of the
+		// gas cap, so estimate the gas of the call with the final access list instead
+		estimateReq := *req
+		estimateReq.Args, err = json.Marshal(&args)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		estimate, err := k.EstimateGasInternal(c, &estimateReq, types.RPC)
+		if err != nil {
+			return nil, err
+		}
+		rsp.GasUsed = estimate.Gas
+
+		return rsp, nil
+	}
+
+	// the accessed addresses and slots of the call depend on the access list itself
+	return nil, status.Errorf(codes.InvalidArgument, "access list did not settle after %d iterations", maxAccessListIterations)
+}
+
+// SimulateV1 implements the `eth_simulateV1` rpc api. It executes the calls of
+// the requested blocks in order on top of the current state, and returns the
+// JSON-encoded simulated blocks.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	opts, err := req.UnmarshalOpts()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the simulated state must never be written to the query context
+	ctx, _ = ctx.CacheContext()
+	results, err := k.simulateBlocks(ctx, cfg, opts, req.GasCap)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateV1Response{Data: data}, nil
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -609,10 +897,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	"github.com/haqq-network/haqq/precompiles/staking"
 	"github.com/haqq-network/haqq/server/config"
 	"github.com/haqq-network/haqq/testutil/integration/haqq/factory"
 	testkeyring "github.com/haqq-network/haqq/testutil/integration/haqq/keyring"
@@ -1634,6 +1637,582 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	var (
+		sender   = suite.keyring.GetAddr(0)
+		contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		slot0    = common.Hash{}
+		slot1    = common.BigToHash(big.NewInt(1))
+		// returns the value of the storage slot 0
+		sloadCode = hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+		// returns the balance of the caller
+		balanceCode = hexutil.Bytes(common.FromHex("0x333160005260206000f3"))
+		// returns the block number, timestamp, coinbase and base fee
+		blockCode = hexutil.Bytes(common.FromHex("0x4360005242602052416040524860605260806000f3"))
+	)
+
+	ethCall := func(overrides types.StateOverride, blockOverrides *types.BlockOverrides) (*types.MsgEthereumTxResponse, error) {
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+		suite.Require().NoError(err)
+		req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+		if overrides != nil {
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		if blockOverrides != nil {
+			req.BlockOverrides, err = json.Marshal(blockOverrides)
+			suite.Require().NoError(err)
+		}
+		return suite.network.GetEvmClient().EthCall(suite.network.GetContext(), req)
+	}
+
+	suite.Run("fail - both state and stateDiff", func() {
+		suite.SetupTest()
+		storage := map[common.Hash]common.Hash{slot0: slot1}
+		_, err := ethCall(types.StateOverride{
+			contract: {Code: &sloadCode, State: &storage, StateDiff: &storage},
+		}, nil)
+		suite.Require().ErrorContains(err, "has both 'state' and 'stateDiff'")
+	})
+
+	suite.Run("fail - code of a non ethereum account", func() {
+		suite.SetupTest()
+		ctx := suite.network.GetContext()
+		acct := suite.network.App.AccountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(contract.Bytes()))
+		suite.network.App.AccountKeeper.SetAccount(ctx, acct)
+
+		_, err := ethCall(types.StateOverride{
+			contract: {Code: &sloadCode},
+		}, nil)
+		suite.Require().ErrorContains(err, "cannot override the code of "+contract.Hex())
+	})
+
+	suite.Run("success - code and stateDiff", func() {
+		suite.SetupTest()
+		storage := map[common.Hash]common.Hash{slot0: common.BigToHash(big.NewInt(42))}
+		res, err := ethCall(types.StateOverride{
+			contract: {Code: &sloadCode, StateDiff: &storage},
+		}, nil)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Require().Equal(big.NewInt(42), new(big.Int).SetBytes(res.Ret))
+
+		// the overrides are not persisted
+		ctx := suite.network.GetContext()
+		suite.Require().Empty(suite.network.App.EvmKeeper.GetCode(ctx, crypto.Keccak256Hash(sloadCode)))
+	})
+
+	suite.Run("success - state replaces the contract storage", func() {
+		suite.SetupTest()
+		ctx := suite.network.GetContext()
+		suite.network.App.EvmKeeper.SetState(ctx, contract, slot0, slot1.Bytes())
+
+		storage := map[common.Hash]common.Hash{slot1: slot1}
+		res, err := ethCall(types.StateOverride{
+			contract: {Code: &sloadCode, State: &storage},
+		}, nil)
+		suite.Require().NoError(err)
+		suite.Require().Zero(new(big.Int).SetBytes(res.Ret).Sign())
+		suite.Require().Equal(slot1, suite.network.App.EvmKeeper.GetState(ctx, contract, slot0))
+	})
+
+	suite.Run("success - balance", func() {
+		suite.SetupTest()
+		balance := (*hexutil.Big)(big.NewInt(123456789))
+		res, err := ethCall(types.StateOverride{
+			contract: {Code: &balanceCode},
+			sender:   {Balance: &balance},
+		}, nil)
+		suite.Require().NoError(err)
+		suite.Require().Equal(big.NewInt(123456789), new(big.Int).SetBytes(res.Ret))
+	})
+
+	suite.Run("success - block overrides", func() {
+		suite.SetupTest()
+		timestamp := hexutil.Uint64(1700000000)
+		coinbase := common.HexToAddress("0x2000000000000000000000000000000000000002")
+		res, err := ethCall(types.StateOverride{
+			contract: {Code: &blockCode},
+		}, &types.BlockOverrides{
+			Number:   (*hexutil.Big)(big.NewInt(1000)),
+			Time:     &timestamp,
+			Coinbase: &coinbase,
+			BaseFee:  (*hexutil.Big)(big.NewInt(7)),
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Ret, 128)
+		suite.Require().Equal(big.NewInt(1000), new(big.Int).SetBytes(res.Ret[:32]))
+		suite.Require().Equal(big.NewInt(1700000000), new(big.Int).SetBytes(res.Ret[32:64]))
+		suite.Require().Equal(coinbase, common.BytesToAddress(res.Ret[64:96]))
+		suite.Require().Equal(big.NewInt(7), new(big.Int).SetBytes(res.Ret[96:]))
+	})
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasOverrides() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	// reverts unless the storage slot 0 is set
+	code := hexutil.Bytes(common.FromHex("0x600054600a57600080fd5b00"))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+	suite.Require().NoError(err)
+
+	overrides := types.StateOverride{contract: {Code: &code}}
+	bz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+	res, err := suite.network.GetEvmClient().EstimateGas(
+		suite.network.GetContext(),
+		&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: bz},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+
+	storage := map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(1))}
+	overrides = types.StateOverride{contract: {Code: &code, StateDiff: &storage}}
+	bz, err = json.Marshal(overrides)
+	suite.Require().NoError(err)
+	res, err = suite.network.GetEvmClient().EstimateGas(
+		suite.network.GetContext(),
+		&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: bz},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	suite.Require().Greater(res.Gas, ethparams.TxGas)
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var (
+		sender   = suite.keyring.GetAddr(0)
+		contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		other    = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		slot0    = common.Hash{}
+		// loads the storage slot 0 and the balance of the other address
+		code = hexutil.Bytes(common.FromHex("0x60005450732000000000000000000000000000000000000002315000"))
+	)
+
+	createAccessList := func(to *common.Address, overrides types.StateOverride) (*types.QueryCreateAccessListResponse, error) {
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: to})
+		suite.Require().NoError(err)
+		req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+		if overrides != nil {
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		return suite.network.GetEvmClient().CreateAccessList(suite.network.GetContext(), req)
+	}
+
+	suite.Run("success - transfer has an empty access list", func() {
+		suite.SetupTest()
+		res, err := createAccessList(&other, nil)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Require().Empty(res.AccessList)
+		suite.Require().Equal(ethparams.TxGas, res.GasUsed)
+	})
+
+	suite.Run("success - contract call lists the accessed storage and accounts", func() {
+		suite.SetupTest()
+		res, err := createAccessList(&contract, types.StateOverride{contract: {Code: &code}})
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+		suite.Require().ElementsMatch(ethtypes.AccessList{
+			{Address: contract, StorageKeys: []common.Hash{slot0}},
+			{Address: other, StorageKeys: []common.Hash{}},
+		}, *res.AccessList.ToEthAccessList())
+		suite.Require().Greater(res.GasUsed, ethparams.TxGas)
+	})
+
+	suite.Run("success - reverted call returns the vm error", func() {
+		suite.SetupTest()
+		revertCode := hexutil.Bytes(common.FromHex("0x60006000fd"))
+		res, err := createAccessList(&contract, types.StateOverride{contract: {Code: &revertCode}})
+		suite.Require().NoError(err)
+		suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+	})
+
+	suite.Run("fail - access list never settles", func() {
+		suite.SetupTest()
+		// loads the storage slots from 0 on while they are warm, so every
+		// access list makes the call load one more slot
+		unsettledCode := hexutil.Bytes(common.FromHex("0x60005b5a8154505a9003610800106017576001016002565b00"))
+		_, err := createAccessList(&contract, types.StateOverride{contract: {Code: &unsettledCode}})
+		suite.Require().ErrorContains(err, "access list did not settle")
+	})
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	var (
+		sender   common.Address
+		call     types.TransactionArgs
+		contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		// increments the storage slot 0 and returns its new value
+		counterCode = hexutil.Bytes(common.FromHex("0x6000546001018060005560005260206000f3"))
+		// emits an empty LOG0
+		logCode = hexutil.Bytes(common.FromHex("0x60006000a000"))
+		// reverts with an empty reason
+		revertCode = hexutil.Bytes(common.FromHex("0x60006000fd"))
+	)
+
+	simulateWithGasCap := func(opts types.SimOpts, gasCap uint64) ([]*types.SimBlockResult, error) {
+		bz, err := json.Marshal(opts)
+		suite.Require().NoError(err)
+		res, err := suite.network.GetEvmClient().SimulateV1(
+			suite.network.GetContext(),
+			&types.QuerySimulateV1Request{Opts: bz, GasCap: gasCap},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var blocks []*types.SimBlockResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &blocks))
+		return blocks, nil
+	}
+	simulate := func(opts types.SimOpts) ([]*types.SimBlockResult, error) {
+		return simulateWithGasCap(opts, config.DefaultGasCap)
+	}
+	// the keyring is recreated on each setup
+	setup := func() {
+		suite.SetupTest()
+		sender = suite.keyring.GetAddr(0)
+		call = types.TransactionArgs{From: &sender, To: &contract}
+	}
+
+	suite.Run("fail - empty input", func() {
+		setup()
+		_, err := simulate(types.SimOpts{})
+		suite.Require().ErrorContains(err, "empty input")
+	})
+
+	suite.Run("fail - too many calls in a block", func() {
+		setup()
+		_, err := simulate(types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{Calls: make([]types.TransactionArgs, types.MaxSimulateCallsPerBlock+1)},
+		}})
+		suite.Require().ErrorContains(err, "too many calls in block 0")
+	})
+
+	suite.Run("fail - gas cap shared by the calls of all the blocks", func() {
+		setup()
+		other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+		transferGas := hexutil.Uint64(ethparams.TxGas)
+		transfer := types.TransactionArgs{From: &sender, To: &other, Gas: &transferGas}
+		opts := types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{Calls: []types.TransactionArgs{transfer}},
+			{Calls: []types.TransactionArgs{transfer}},
+		}}
+
+		// each call fits in the gas cap, but not both of them
+		_, err := simulateWithGasCap(opts, 2*ethparams.TxGas-1)
+		suite.Require().ErrorContains(err, "simulation gas cap")
+
+		blocks, err := simulateWithGasCap(opts, 2*ethparams.TxGas)
+		suite.Require().NoError(err)
+		suite.Require().Len(blocks, 2)
+	})
+
+	suite.Run("fail - block numbers not in order", func() {
+		setup()
+		number := (*hexutil.Big)(big.NewInt(1))
+		_, err := simulate(types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{BlockOverrides: &types.BlockOverrides{Number: number}},
+		}})
+		suite.Require().ErrorContains(err, "block numbers must be in order")
+	})
+
+	suite.Run("fail - validation with invalid nonce", func() {
+		setup()
+		nonce := hexutil.Uint64(100)
+		_, err := simulate(types.SimOpts{
+			Validation: true,
+			BlockStateCalls: []types.SimBlock{
+				{Calls: []types.TransactionArgs{{From: &sender, To: &contract, Nonce: &nonce}}},
+			},
+		})
+		suite.Require().ErrorContains(err, "invalid nonce")
+	})
+
+	suite.Run("success - validation charges the fees", func() {
+		setup()
+		ctx := suite.network.GetContext()
+		balance := suite.network.App.EvmKeeper.GetBalance(ctx, sender)
+		gasPrice := (*hexutil.Big)(big.NewInt(1e9))
+		// returns the balance of the caller
+		balanceCode := hexutil.Bytes(common.FromHex("0x333160005260206000f3"))
+		transferGas, callGas := hexutil.Uint64(ethparams.TxGas), hexutil.Uint64(100000)
+		other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+		blocks, err := simulate(types.SimOpts{
+			Validation: true,
+			BlockStateCalls: []types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{contract: {Code: &balanceCode}},
+					Calls: []types.TransactionArgs{
+						{From: &sender, To: &other, Gas: &transferGas, GasPrice: gasPrice},
+						{From: &sender, To: &contract, Gas: &callGas, GasPrice: gasPrice},
+					},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+		for _, res := range blocks[0].Calls {
+			suite.Require().Nil(res.Error)
+		}
+
+		// the fees of the whole gas limit are deducted before the execution
+		fees := new(big.Int).Mul(big.NewInt(int64(transferGas+callGas)), gasPrice.ToInt())
+		suite.Require().Equal(new(big.Int).Sub(balance, fees), new(big.Int).SetBytes(blocks[0].Calls[1].ReturnValue))
+	})
+
+	suite.Run("success - calls share the state across blocks", func() {
+		setup()
+		ctx := suite.network.GetContext()
+		nonce := suite.network.App.EvmKeeper.GetNonce(ctx, sender)
+
+		blocks, err := simulate(types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{
+				StateOverrides: &types.StateOverride{contract: {Code: &counterCode}},
+				Calls:          []types.TransactionArgs{call, call},
+			},
+			{Calls: []types.TransactionArgs{call}},
+		}})
+		suite.Require().NoError(err)
+		suite.Require().Len(blocks, 2)
+
+		suite.Require().Equal(hexutil.Uint64(ctx.BlockHeight()+1), blocks[0].Number)
+		suite.Require().Equal(blocks[0].Number+1, blocks[1].Number)
+		suite.Require().Equal(blocks[0].Timestamp+types.SimulateTimestampIncrement, blocks[1].Timestamp)
+		suite.Require().Equal(blocks[0].Hash, blocks[1].ParentHash)
+
+		var results []*big.Int
+		for _, block := range blocks {
+			suite.Require().Len(block.Transactions, len(block.Calls))
+			for _, res := range block.Calls {
+				suite.Require().Nil(res.Error)
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), res.Status)
+				results = append(results, new(big.Int).SetBytes(res.ReturnValue))
+			}
+		}
+		suite.Require().Equal([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}, results)
+
+		// the simulation is not persisted
+		suite.Require().Equal(nonce, suite.network.App.EvmKeeper.GetNonce(ctx, sender))
+		suite.Require().Equal(common.Hash{}, suite.network.App.EvmKeeper.GetState(ctx, contract, common.Hash{}))
+	})
+
+	suite.Run("success - logs with traced transfers", func() {
+		setup()
+		value := (*hexutil.Big)(big.NewInt(1000))
+		blocks, err := simulate(types.SimOpts{
+			TraceTransfers: true,
+			BlockStateCalls: []types.SimBlock{
+				{
+					StateOverrides: &types.StateOverride{contract: {Code: &logCode}},
+					Calls: []types.TransactionArgs{
+						{From: &sender, To: &contract, Value: value},
+						{From: &sender, To: &contract},
+					},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(blocks, 1)
+		suite.Require().Len(blocks[0].Calls, 2)
+
+		logs := blocks[0].Calls[0].Logs
+		suite.Require().Len(logs, 2)
+		suite.Require().Equal(types.TransferAddress, logs[0].Address)
+		suite.Require().Equal(
+			[]common.Hash{types.TransferTopic, common.BytesToHash(sender.Bytes()), common.BytesToHash(contract.Bytes())},
+			logs[0].Topics,
+		)
+		suite.Require().Equal(common.BigToHash(value.ToInt()).Bytes(), logs[0].Data)
+		suite.Require().Equal(contract, logs[1].Address)
+		suite.Require().Equal(uint(1), logs[1].Index)
+
+		logs = blocks[0].Calls[1].Logs
+		suite.Require().Len(logs, 1)
+		suite.Require().Equal(contract, logs[0].Address)
+		suite.Require().Equal(uint(2), logs[0].Index)
+		suite.Require().Equal(uint(1), logs[0].TxIndex)
+		suite.Require().Equal(blocks[0].Transactions[1], logs[0].TxHash)
+	})
+
+	suite.Run("success - reverted call", func() {
+		setup()
+		blocks, err := simulate(types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{
+				StateOverrides: &types.StateOverride{contract: {Code: &revertCode}},
+				Calls:          []types.TransactionArgs{call},
+			},
+		}})
+		suite.Require().NoError(err)
+		res := blocks[0].Calls[0]
+		suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), res.Status)
+		suite.Require().NotNil(res.Error)
+		suite.Require().Equal(types.SimErrCodeReverted, res.Error.Code)
+	})
+
+	suite.Run("success - static precompile state is shared between calls", func() {
+		setup()
+		stakingAddr := common.HexToAddress(types.StakingPrecompileAddress)
+		validator := suite.network.GetValidators()[0].OperatorAddress
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		suite.Require().NoError(err)
+		amount := big.NewInt(1e18)
+
+		// the genesis delegation of the sender
+		prevDelegation, err := suite.network.App.StakingKeeper.GetDelegation(suite.network.GetContext(), sender.Bytes(), valAddr)
+		suite.Require().NoError(err)
+		prevAmount := suite.network.GetValidators()[0].TokensFromShares(prevDelegation.Shares).TruncateInt()
+
+		stakingABI, err := staking.LoadABI()
+		suite.Require().NoError(err)
+		delegateInput, err := stakingABI.Pack(staking.DelegateMethod, sender, validator, amount)
+		suite.Require().NoError(err)
+		delegationInput, err := stakingABI.Pack(staking.DelegationMethod, sender, validator)
+		suite.Require().NoError(err)
+
+		blocks, err := simulate(types.SimOpts{BlockStateCalls: []types.SimBlock{
+			{Calls: []types.TransactionArgs{
+				{From: &sender, To: &stakingAddr, Input: (*hexutil.Bytes)(&delegateInput)},
+				{From: &sender, To: &stakingAddr, Input: (*hexutil.Bytes)(&delegationInput)},
+			}},
+		}})
+		suite.Require().NoError(err)
+		for _, res := range blocks[0].Calls {
+			suite.Require().Nil(res.Error)
+		}
+
+		var out staking.DelegationOutput
+		suite.Require().NoError(stakingABI.UnpackIntoInterface(&out, staking.DelegationMethod, blocks[0].Calls[1].ReturnValue))
+		suite.Require().Equal(prevAmount.Add(sdkmath.NewIntFromBigInt(amount)).String(), out.Balance.Amount.String())
+
+		// the delegation is not persisted
+		delegation, err := suite.network.App.StakingKeeper.GetDelegation(suite.network.GetContext(), sender.Bytes(), valAddr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(prevDelegation.Shares, delegation.Shares)
+	})
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		sender   = suite.keyring.GetAddr(0)
+		contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		// returns the value of the storage slot 0
+		sloadCode = hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+		storage   = map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))}
+		overrides = types.StateOverride{contract: {Code: &sloadCode, StateDiff: &storage}}
+	)
+
+	testCases := []struct {
+		msg           string
+		getRequest    func() *types.QueryTraceCallRequest
+		expPass       bool
+		expectedTrace []string
+	}{
+		{
+			msg: "fail - negative output limit",
+			getRequest: func() *types.QueryTraceCallRequest {
+				req := getDefaultTraceCallRequest(suite.network, sender, contract, overrides)
+				req.TraceConfig = &types.TraceConfig{Limit: -1}
+				return req
+			},
+			expPass: false,
+		},
+		{
+			msg: "fail - both state and stateDiff",
+			getRequest: func() *types.QueryTraceCallRequest {
+				invalidOverrides := types.StateOverride{contract: {Code: &sloadCode, State: &storage, StateDiff: &storage}}
+				return getDefaultTraceCallRequest(suite.network, sender, contract, invalidOverrides)
+			},
+			expPass: false,
+		},
+		{
+			msg: "default trace",
+			getRequest: func() *types.QueryTraceCallRequest {
+				return getDefaultTraceCallRequest(suite.network, sender, contract, overrides)
+			},
+			expPass: true,
+			expectedTrace: []string{
+				"\"failed\":false",
+				"\"returnValue\":\"000000000000000000000000000000000000000000000000000000000000002a\"",
+				"\"op\":\"SLOAD\"",
+			},
+		},
+		{
+			msg: "call tracer with tracer config",
+			getRequest: func() *types.QueryTraceCallRequest {
+				req := getDefaultTraceCallRequest(suite.network, sender, contract, overrides)
+				req.TraceConfig = &types.TraceConfig{
+					Tracer:           "callTracer",
+					TracerJsonConfig: `{"onlyTopCall":true}`,
+				}
+				return req
+			},
+			expPass: true,
+			expectedTrace: []string{
+				"\"type\":\"CALL\"",
+				"\"to\":\"" + strings.ToLower(contract.Hex()) + "\"",
+				"\"output\":\"0x000000000000000000000000000000000000000000000000000000000000002a\"",
+			},
+		},
+		{
+			msg: "javascript tracer",
+			getRequest: func() *types.QueryTraceCallRequest {
+				req := getDefaultTraceCallRequest(suite.network, sender, contract, overrides)
+				req.TraceConfig = &types.TraceConfig{
+					Tracer: "{data: [], fault: function(log) {}, step: function(log) { this.data.push(log.op.toString()); }, result: function() { return this.data; }}",
+				}
+				return req
+			},
+			expPass:       true,
+			expectedTrace: []string{"[\"PUSH1\",\"SLOAD\",\"PUSH1\",\"MSTORE\",\"PUSH1\",\"PUSH1\",\"RETURN\"]"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			res, err := suite.network.GetEvmClient().TraceCall(suite.network.GetContext(), tc.getRequest())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			for _, expected := range tc.expectedTrace {
+				suite.Require().Contains(string(res.Data), expected)
+			}
+
+			// the overrides are not persisted
+			suite.Require().Empty(suite.network.App.EvmKeeper.GetCode(suite.network.GetContext(), crypto.Keccak256Hash(sloadCode)))
+		})
+	}
+}
+
+func getDefaultTraceCallRequest(
+	unitNetwork network.Network,
+	from, to common.Address,
+	overrides types.StateOverride,
+) *types.QueryTraceCallRequest {
+	ctx := unitNetwork.GetContext()
+	args, _ := json.Marshal(&types.TransactionArgs{From: &from, To: &to})
+	overridesBz, _ := json.Marshal(overrides)
+	return &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		BlockNumber: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+		BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+		ChainId:     unitNetwork.GetEIP155ChainID().Int64(),
+		Overrides:   overridesBz,
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	suite.SetupTest()
 	k := suite.network.App.EvmKeeper
@@ -1702,6 +2281,24 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.network.GetContext(), nil)
+			},
+		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.network.GetContext(), nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(suite.network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	haqqtypes "github.com/haqq-network/haqq/types"
+	"github.com/haqq-network/haqq/x/evm/statedb"
+	"github.com/haqq-network/haqq/x/evm/types"
+)
+
+// applyStateOverrides writes the overridden account fields into the given context.
+// It must only be called with a cached context that is discarded after the call.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	for addr, override := range overrides {
+		acct := k.GetAccount(ctx, addr)
+		if acct == nil {
+			acct = statedb.NewEmptyAccount()
+		}
+
+		if override.Nonce != nil {
+			acct.Nonce = uint64(*override.Nonce)
+		}
+		if override.Balance != nil {
+			acct.Balance = new(big.Int).Set((*big.Int)(*override.Balance))
+		}
+		if override.Code != nil {
+			// only ethereum accounts can hold code, the others would silently drop it
+			if len(*override.Code) > 0 {
+				cosmosAcct := k.accountKeeper.GetAccount(ctx, addr.Bytes())
+				if _, ok := cosmosAcct.(haqqtypes.EthAccountI); cosmosAcct != nil && !ok {
+					return errorsmod.Wrapf(types.ErrInvalidAccount, "cannot override the code of %s (%T)", addr.Hex(), cosmosAcct)
+				}
+			}
+			codeHash := crypto.Keccak256Hash(*override.Code)
+			k.SetCode(ctx, codeHash.Bytes(), *override.Code)
+			acct.CodeHash = codeHash.Bytes()
+		}
+
+		if err := k.SetAccount(ctx, addr, *acct); err != nil {
+			return errorsmod.Wrapf(err, "failed to override account %s", addr.Hex())
+		}
+
+		// replace the whole storage of the account
+		if override.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.SetState(ctx, addr, key, nil)
+			}
+			k.setOverriddenStorage(ctx, addr, *override.State)
+		}
+
+		// apply the diff on top of the current storage
+		if override.StateDiff != nil {
+			k.setOverriddenStorage(ctx, addr, *override.StateDiff)
+		}
+	}
+
+	return nil
+}
+
+// setOverriddenStorage sets the given storage slots of the account, deleting the zero ones.
+func (k *Keeper) setOverriddenStorage(ctx sdk.Context, addr common.Address, storage map[common.Hash]common.Hash) {
+	for key, value := range storage {
+		if value == (common.Hash{}) {
+			k.SetState(ctx, addr, key, nil)
+			continue
+		}
+		k.SetState(ctx, addr, key, value.Bytes())
+	}
+}
+
+// applyBlockOverrides returns the context and EVM config with the overridden block
+// number, time, coinbase and base fee.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) //nolint:gosec // block time fits into int64
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx
+}
@@ -0,0 +1,397 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
+	haqqtypes "github.com/haqq-network/haqq/types"
+	evmante "github.com/haqq-network/haqq/x/evm/ante"
+	"github.com/haqq-network/haqq/x/evm/core/vm"
+	"github.com/haqq-network/haqq/x/evm/statedb"
+	"github.com/haqq-network/haqq/x/evm/types"
+)
+
+// simulateBlocks executes the blocks of an `eth_simulateV1` request in order, on
+// top of the given context. Like the transactions of a real block, every call is
+// executed with its own StateDB that is committed to the context, so the next
+// calls and blocks see the changes of the previous ones, including the ones made
+// by static precompiles. The context must be discarded after the simulation.
+//
+// The gas cap is the gas budget of the whole simulation: each call is capped to
+// the gas left by the previous ones, and the simulation fails once it runs out.
+// The calls are charged with the gas used as on chain, including the minimum gas
+// multiplier of the fee market. A zero gas cap disables the budget.
+func (k *Keeper) simulateBlocks(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	opts *types.SimOpts,
+	gasCap uint64,
+) ([]*types.SimBlockResult, error) {
+	var (
+		number     = big.NewInt(ctx.BlockHeight())
+		timestamp  = uint64(ctx.BlockTime().Unix()) //nolint:gosec // block time is positive
+		parentHash = common.BytesToHash(ctx.HeaderHash())
+		results    = make([]*types.SimBlockResult, 0, len(opts.BlockStateCalls))
+		gasLeft    = gasCap
+	)
+
+	for _, block := range opts.BlockStateCalls {
+		var overrides types.BlockOverrides
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		// the blocks follow each other by default, the overridden numbers and
+		// timestamps must keep increasing
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).Add(number, common.Big1))
+		}
+		if n := overrides.Number.ToInt(); n.Cmp(number) <= 0 || !n.IsInt64() {
+			return nil, status.Errorf(codes.InvalidArgument, "block numbers must be in order: %s <= %s", n, number)
+		}
+		if overrides.Time == nil {
+			t := hexutil.Uint64(timestamp + types.SimulateTimestampIncrement)
+			overrides.Time = &t
+		}
+		if uint64(*overrides.Time) <= timestamp {
+			return nil, status.Errorf(codes.InvalidArgument, "block timestamps must be in order: %d <= %d", *overrides.Time, timestamp)
+		}
+		number = overrides.Number.ToInt()
+		timestamp = uint64(*overrides.Time)
+
+		blockCfg := *cfg
+		blockCtx := applyBlockOverrides(ctx, &blockCfg, &overrides)
+		hash := simulatedBlockHash(parentHash, number.Uint64(), timestamp)
+		blockCtx = blockCtx.WithHeaderHash(hash.Bytes())
+
+		if block.StateOverrides != nil {
+			if err := k.applyStateOverrides(blockCtx, *block.StateOverrides); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		result := &types.SimBlockResult{
+			Number:       hexutil.Uint64(number.Uint64()),
+			Hash:         hash,
+			ParentHash:   parentHash,
+			Timestamp:    hexutil.Uint64(timestamp),
+			GasLimit:     hexutil.Uint64(haqqtypes.BlockGasLimit(blockCtx)),
+			Miner:        blockCfg.CoinBase,
+			Transactions: make([]common.Hash, 0, len(block.Calls)),
+			Calls:        make([]types.SimCallResult, 0, len(block.Calls)),
+		}
+		if blockCfg.BaseFee != nil {
+			result.BaseFeePerGas = (*hexutil.Big)(blockCfg.BaseFee)
+		}
+
+		var logIndex uint
+		for i, args := range block.Calls {
+			if gasCap > 0 && (gasLeft < ethparams.TxGas || (args.Gas != nil && uint64(*args.Gas) > gasLeft)) {
+				return nil, status.Errorf(codes.InvalidArgument, "simulation gas cap %d reached: %d gas left", gasCap, gasLeft)
+			}
+
+			txHash, callResult, err := k.simulateCall(blockCtx, &blockCfg, args, uint(i), logIndex, result, opts, gasLeft)
+			if err != nil {
+				return nil, err
+			}
+
+			if gasCap > 0 {
+				gasLeft -= min(gasLeft, uint64(callResult.GasUsed))
+			}
+
+			logIndex += uint(len(callResult.Logs))
+			result.GasUsed += callResult.GasUsed
+			result.Transactions = append(result.Transactions, txHash)
+			result.Calls = append(result.Calls, *callResult)
+		}
+
+		results = append(results, result)
+		parentHash = hash
+	}
+
+	return results, nil
+}
+
+// simulateCall executes a single call of a simulated block and commits its state
+// changes to the context. When validation is enabled, the call is checked and
+// charged with the fees as a transaction would be in the ante handler.
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.TransactionArgs,
+	txIndex, logIndex uint,
+	block *types.SimBlockResult,
+	opts *types.SimOpts,
+	gasCap uint64,
+) (common.Hash, *types.SimCallResult, error) {
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if opts.Validation && uint64(*args.Nonce) != nonce {
+		return common.Hash{}, nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid nonce: address %s, tx: %d state: %d", from.Hex(), uint64(*args.Nonce), nonce,
+		)
+	}
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(cfg.ChainConfig.ChainID)
+	}
+
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return common.Hash{}, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the hash of the unsigned transaction identifies the call
+	gas := msg.Gas()
+	args.Gas = (*hexutil.Uint64)(&gas)
+	txHash := args.ToTransaction().AsTransaction().Hash()
+
+	if opts.Validation {
+		if err := k.chargeSimulatedCall(ctx, cfg, msg, block); err != nil {
+			return common.Hash{}, nil, err
+		}
+	}
+
+	// the ante handler increments the sender nonce before the message is executed
+	acct := k.GetAccount(ctx, from)
+	if acct == nil {
+		acct = statedb.NewEmptyAccount()
+	}
+	acct.Nonce = msg.Nonce() + 1
+	if err := k.SetAccount(ctx, from, *acct); err != nil {
+		return common.Hash{}, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var (
+		transfers *transferTracer
+		tracer    vm.EVMLogger
+	)
+	if opts.TraceTransfers {
+		transfers = &transferTracer{}
+		tracer = transfers
+	}
+
+	txConfig := statedb.NewTxConfig(block.Hash, txHash, txIndex, logIndex)
+	callCtx := evmante.BuildEvmExecutionCtx(ctx).WithGasMeter(haqqtypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+
+	// pass true to commit the StateDB, the next calls are executed on top of it
+	res, err := k.ApplyMessageWithConfig(callCtx, msg, tracer, true, cfg, txConfig)
+	if err != nil {
+		return common.Hash{}, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if opts.Validation {
+		if err := k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+			return common.Hash{}, nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if transfers != nil {
+		logs = transfers.mergeLogs(logs)
+	}
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	for i, log := range logs {
+		log.BlockNumber = uint64(block.Number)
+		log.BlockHash = block.Hash
+		log.TxHash = txHash
+		log.TxIndex = txIndex
+		log.Index = logIndex + uint(i)
+	}
+
+	result := &types.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		result.Error = newSimCallError(res)
+	}
+
+	return txHash, result, nil
+}
+
+// chargeSimulatedCall validates the gas, fees and balance of a simulated call and
+// deducts the fees from the sender balance.
+func (k *Keeper) chargeSimulatedCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	msg ethtypes.Message,
+	block *types.SimBlockResult,
+) error {
+	// a zero gas limit means that the block gas limit is unknown
+	if block.GasLimit > 0 && msg.Gas() > uint64(block.GasLimit-block.GasUsed) {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"block gas limit reached: %d > %d", msg.Gas(), uint64(block.GasLimit-block.GasUsed),
+		)
+	}
+	if cfg.BaseFee != nil && msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"max fee per gas less than block base fee: address %s, maxFeePerGas: %s, baseFee: %s",
+			msg.From().Hex(), msg.GasFeeCap(), cfg.BaseFee,
+		)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap())
+	cost.Add(cost, msg.Value())
+	if balance := k.GetBalance(ctx, msg.From()); balance.Cmp(cost) < 0 {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"insufficient funds for gas * price + value: address %s have %s want %s",
+			msg.From().Hex(), balance, cost,
+		)
+	}
+
+	fees := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+	if fees.Sign() == 0 {
+		return nil
+	}
+	feeCoins := sdk.Coins{sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(fees))}
+	if err := k.DeductTxCostsFromUserBalance(ctx, feeCoins, msg.From()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// simulatedBlockHash returns a deterministic hash for a simulated block, as the
+// simulated blocks have no Tendermint header.
+func simulatedBlockHash(parentHash common.Hash, number, timestamp uint64) common.Hash {
+	return crypto.Keccak256Hash(
+		parentHash.Bytes(),
+		binary.BigEndian.AppendUint64(nil, number),
+		binary.BigEndian.AppendUint64(nil, timestamp),
+	)
+}
+
+// newSimCallError returns the error of a failed simulated call, decoding the
+// revert reason if any.
+func newSimCallError(res *types.MsgEthereumTxResponse) *types.SimCallError {
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		return &types.SimCallError{Code: types.SimErrCodeVMError, Message: res.VmError}
+	}
+
+	message := res.VmError
+	if reason, err := abi.UnpackRevert(res.Ret); err == nil {
+		message += ": " + reason
+	}
+	return &types.SimCallError{
+		Code:    types.SimErrCodeReverted,
+		Message: message,
+		Data:    hexutil.Encode(res.Ret),
+	}
+}
+
+var _ vm.EVMLogger = &transferTracer{}
+
+// transferTracer records the ETH transfers of a simulated call as ERC-7528 logs.
+// The logs of the reverted call frames are dropped, and the position of the logs
+// emitted by the EVM is recorded so that both can be merged in execution order.
+type transferTracer struct {
+	// frames holds the logs of each call frame, nil entries mark the logs
+	// emitted by the EVM
+	frames [][]*ethtypes.Log
+}
+
+func (t *transferTracer) CaptureTxStart(uint64) {}
+
+func (t *transferTracer) CaptureTxEnd(uint64) {}
+
+func (t *transferTracer) CaptureStart(_ *vm.EVM, from, to common.Address, _ bool, _ []byte, _ uint64, value *big.Int) {
+	t.frames = [][]*ethtypes.Log{{}}
+	t.captureTransfer(from, to, value)
+}
+
+func (t *transferTracer) CaptureEnd(_ []byte, _ uint64, _ time.Duration, err error) {
+	if err != nil && len(t.frames) > 0 {
+		t.frames[0] = nil
+	}
+}
+
+func (t *transferTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, []*ethtypes.Log{})
+	if typ != vm.DELEGATECALL {
+		t.captureTransfer(from, to, value)
+	}
+}
+
+func (t *transferTracer) CaptureExit(_ []byte, _ uint64, err error) {
+	size := len(t.frames)
+	if size <= 1 {
+		return
+	}
+
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	if err == nil {
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+func (t *transferTracer) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+	if op >= vm.LOG0 && op <= vm.LOG4 {
+		t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], nil)
+	}
+}
+
+func (t *transferTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+func (t *transferTracer) captureTransfer(from, to common.Address, value *big.Int) {
+	if value == nil || value.Sign() <= 0 {
+		return
+	}
+
+	frame := len(t.frames) - 1
+	t.frames[frame] = append(t.frames[frame], &ethtypes.Log{
+		Address: types.TransferAddress,
+		Topics:  []common.Hash{types.TransferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:    common.BigToHash(value).Bytes(),
+	})
+}
+
+// mergeLogs inserts the given EVM logs into the recorded transfer logs. The EVM
+// logs that were not recorded, such as the ones emitted by static precompiles,
+// are appended at the end.
+func (t *transferTracer) mergeLogs(evmLogs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.frames) == 0 {
+		return evmLogs
+	}
+
+	logs := make([]*ethtypes.Log, 0, len(t.frames[0])+len(evmLogs))
+	for _, log := range t.frames[0] {
+		if log != nil {
+			logs = append(logs, log)
+			continue
+		}
+		if len(evmLogs) > 0 {
+			logs = append(logs, evmLogs[0])
+			evmLogs = evmLogs[1:]
+		}
+	}
+
+	return append(logs, evmLogs...)
+}
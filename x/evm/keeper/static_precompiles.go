@@ -8,23 +8,33 @@ import (
 	"maps"
 	"slices"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
 	ethiqkeeper "github.com/haqq-network/haqq/x/ethiq/keeper"
 	liquidkeeper "github.com/haqq-network/haqq/x/liquidvesting/keeper"
 
+	authzprecompile "github.com/haqq-network/haqq/precompiles/authz"
 	bankprecompile "github.com/haqq-network/haqq/precompiles/bank"
 	"github.com/haqq-network/haqq/precompiles/bech32"
+	coinomicsprecompile "github.com/haqq-network/haqq/precompiles/coinomics"
 	distprecompile "github.com/haqq-network/haqq/precompiles/distribution"
 	ethiqprecompile "github.com/haqq-network/haqq/precompiles/ethiq"
+	govprecompile "github.com/haqq-network/haqq/precompiles/gov"
 	ics20precompile "github.com/haqq-network/haqq/precompiles/ics20"
 	"github.com/haqq-network/haqq/precompiles/liquid"
 	"github.com/haqq-network/haqq/precompiles/p256"
+	slashingprecompile "github.com/haqq-network/haqq/precompiles/slashing"
 	stakingprecompile "github.com/haqq-network/haqq/precompiles/staking"
 	ucdaoprecompile "github.com/haqq-network/haqq/precompiles/ucdao"
+	vestingprecompile "github.com/haqq-network/haqq/precompiles/vesting"
+	coinomicskeeper "github.com/haqq-network/haqq/x/coinomics/keeper"
+	epochskeeper "github.com/haqq-network/haqq/x/epochs/keeper"
 	erc20Keeper "github.com/haqq-network/haqq/x/erc20/keeper"
 	"github.com/haqq-network/haqq/x/evm/core/vm"
 	"github.com/haqq-network/haqq/x/evm/types"
@@ -43,13 +53,18 @@ func NewAvailableStaticPrecompiles(
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20Keeper.Keeper,
-	_ vestingkeeper.Keeper, // temporary unused
+	vestingKeeper vestingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
 	ethiqKeeper ethiqkeeper.Keeper,
 	daoKeeper ucdaokeeper.Keeper,
 	liquidVestingKeeper liquidkeeper.Keeper,
+	govKeeper govkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
+	coinomicsKeeper coinomicskeeper.Keeper,
+	epochsKeeper epochskeeper.Keeper,
+	cdc codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -81,21 +96,37 @@ func NewAvailableStaticPrecompiles(
 		transferKeeper,
 		channelKeeper,
 		authzKeeper,
+		erc20Keeper,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
 	}
 
-	// vestingPrecompile, err := vestingprecompile.NewPrecompile(vestingKeeper, authzKeeper)
-	// if err != nil {
-	//	panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
-	// }
+	vestingPrecompile, err := vestingprecompile.NewPrecompile(vestingKeeper, bankKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
+	}
 
 	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, cdc, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
+	}
+
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
 	// Ethiq module precompile
 	ethiqPrecompile, err := ethiqprecompile.NewPrecompile(ethiqKeeper, authzKeeper)
 	if err != nil {
@@ -103,7 +134,7 @@ func NewAvailableStaticPrecompiles(
 	}
 
 	// Liquidvesting module precompile
-	liquidPrecompile, err := liquid.NewPrecompile(liquidVestingKeeper, authzKeeper)
+	liquidPrecompile, err := liquid.NewPrecompile(liquidVestingKeeper, bankKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate liquid precompile: %w", err))
 	}
@@ -114,6 +145,12 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate ucdao precompile: %w", err))
 	}
 
+	// Coinomics and epochs modules precompile
+	coinomicsPrecompile, err := coinomicsprecompile.NewPrecompile(coinomicsKeeper, epochsKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate coinomics precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -122,11 +159,15 @@ func NewAvailableStaticPrecompiles(
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
-	// precompiles[vestingPrecompile.Address()] = vestingPrecompile
+	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
 	precompiles[liquidPrecompile.Address()] = liquidPrecompile
 	precompiles[ethiqPrecompile.Address()] = ethiqPrecompile
 	precompiles[ucdaoPrecompile.Address()] = ucdaoPrecompile
+	precompiles[coinomicsPrecompile.Address()] = coinomicsPrecompile
 
 	return precompiles
 }
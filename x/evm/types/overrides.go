@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate returns an error if any of the overridden accounts sets both
+// the full state and the state diff.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override in the block context
+// of a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// UnmarshalOverrides decodes the JSON-encoded state and block overrides of the
+// request. Nil values are returned for the overrides that are not set.
+func (m EthCallRequest) UnmarshalOverrides() (StateOverride, *BlockOverrides, error) {
+	return unmarshalOverrides(m.Overrides, m.BlockOverrides)
+}
+
+// UnmarshalOverrides decodes the JSON-encoded state and block overrides of the
+// request. Nil values are returned for the overrides that are not set.
+func (m QueryTraceCallRequest) UnmarshalOverrides() (StateOverride, *BlockOverrides, error) {
+	return unmarshalOverrides(m.Overrides, m.BlockOverrides)
+}
+
+func unmarshalOverrides(overridesBz, blockOverridesBz []byte) (StateOverride, *BlockOverrides, error) {
+	var (
+		stateOverride  StateOverride
+		blockOverrides *BlockOverrides
+	)
+
+	if len(overridesBz) > 0 {
+		if err := json.Unmarshal(overridesBz, &stateOverride); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverride.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(blockOverridesBz) > 0 {
+		blockOverrides = new(BlockOverrides)
+		if err := json.Unmarshal(blockOverridesBz, blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+	}
+
+	return stateOverride, blockOverrides, nil
+}
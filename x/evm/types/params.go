@@ -31,11 +31,15 @@ var (
 		StakingPrecompileAddress,      // Staking precompile
 		DistributionPrecompileAddress, // Distribution precompile
 		ICS20PrecompileAddress,        // ICS20 transfer precompile
-		// VestingPrecompileAddress,      // Vesting precompile
-		BankPrecompileAddress,   // Bank precompile
-		EthiqPrecompileAddress,  // Ethiq precompile
-		UcdaoPrecompileAddress,  // UCDAO precompile
-		LiquidPrecompileAddress, // Liquid precompile
+		VestingPrecompileAddress,      // Vesting precompile
+		BankPrecompileAddress,         // Bank precompile
+		GovPrecompileAddress,          // Gov precompile
+		SlashingPrecompileAddress,     // Slashing precompile
+		AuthzPrecompileAddress,        // Authz precompile
+		EthiqPrecompileAddress,        // Ethiq precompile
+		UcdaoPrecompileAddress,        // UCDAO precompile
+		LiquidPrecompileAddress,       // Liquid precompile
+		CoinomicsPrecompileAddress,    // Coinomics precompile
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -14,9 +14,13 @@ const (
 	ICS20PrecompileAddress        = "0x0000000000000000000000000000000000000802"
 	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
+	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
+	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000807"
 	EthiqPrecompileAddress        = "0x0000000000000000000000000000000000000900"
 	UcdaoPrecompileAddress        = "0x0000000000000000000000000000000000000901"
 	LiquidPrecompileAddress       = "0x0000000000000000000000000000000000000902"
+	CoinomicsPrecompileAddress    = "0x0000000000000000000000000000000000000903"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -29,9 +33,13 @@ var AvailableStaticPrecompiles = []string{
 	StakingPrecompileAddress,
 	DistributionPrecompileAddress,
 	ICS20PrecompileAddress,
-	// VestingPrecompileAddress,
+	VestingPrecompileAddress,
 	BankPrecompileAddress,
+	GovPrecompileAddress,
+	SlashingPrecompileAddress,
+	AuthzPrecompileAddress,
 	EthiqPrecompileAddress,
 	UcdaoPrecompileAddress,
 	LiquidPrecompileAddress,
+	CoinomicsPrecompileAddress,
 }
@@ -798,6 +798,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the JSON-encoded state overrides applied to the accounts before
+	// the call, using the same format as the json rpc api
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the JSON-encoded overrides of the block context the call
+	// is executed in, using the same format as the json rpc api
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -861,6 +867,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1246,6 +1266,374 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// block_number of the block the call is traced against
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of the block the call is traced against
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is traced against
+	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block the call is traced against
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,7,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block the call is traced against
+	BlockMaxGas int64 `protobuf:"varint,9,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	// overrides is the JSON-encoded state overrides applied to the accounts before
+	// the call, using the same format as the json rpc api
+	Overrides []byte `protobuf:"bytes,10,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the JSON-encoded overrides of the block context the call
+	// is executed in, using the same format as the json rpc api
+	BlockOverrides []byte `protobuf:"bytes,11,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{23}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QueryCreateAccessListResponse defines the CreateAccessList response
+type QueryCreateAccessListResponse struct {
+	// access_list is the access list accessed by the call
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"accessList"`
+	// gas_used is the gas used by the call with the access list applied
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by the vm execution with the access list applied
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *QueryCreateAccessListResponse) Reset()         { *m = QueryCreateAccessListResponse{} }
+func (m *QueryCreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateAccessListResponse) ProtoMessage()    {}
+func (*QueryCreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QueryCreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateAccessListResponse.Merge(m, src)
+}
+func (m *QueryCreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreateAccessListResponse proto.InternalMessageInfo
+
+func (m *QueryCreateAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *QueryCreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryCreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// QuerySimulateV1Request defines the SimulateV1 request
+type QuerySimulateV1Request struct {
+	// opts is the JSON-encoded simulation options, using the same format as the
+	// json rpc api
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used for each call
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QuerySimulateV1Request) Reset()         { *m = QuerySimulateV1Request{} }
+func (m *QuerySimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Request) ProtoMessage()    {}
+func (*QuerySimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *QuerySimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Request.Merge(m, src)
+}
+func (m *QuerySimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Request proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QuerySimulateV1Response defines the SimulateV1 response
+type QuerySimulateV1Response struct {
+	// data is the JSON-encoded list of the simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QuerySimulateV1Response) Reset()         { *m = QuerySimulateV1Response{} }
+func (m *QuerySimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Response) ProtoMessage()    {}
+func (*QuerySimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *QuerySimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Response.Merge(m, src)
+}
+func (m *QuerySimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Response proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1255,7 +1643,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1682,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1346,6 +1734,11 @@ func init() {
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QueryCreateAccessListResponse)(nil), "ethermint.evm.v1.QueryCreateAccessListResponse")
+	proto.RegisterType((*QuerySimulateV1Request)(nil), "ethermint.evm.v1.QuerySimulateV1Request")
+	proto.RegisterType((*QuerySimulateV1Response)(nil), "ethermint.evm.v1.QuerySimulateV1Response")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 }
@@ -1353,102 +1746,119 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x93, 0x36, 0x9d, 0xa6, 0xad, 0xe3, 0x26, 0x76, 0xba, 0xdf,
-	0x97, 0xc6, 0xed, 0xd7, 0xec, 0x36, 0xf9, 0x3e, 0x55, 0xfa, 0xe0, 0x00, 0x75, 0x94, 0xfe, 0xa0,
-	0x2d, 0x2a, 0x4b, 0xc4, 0x01, 0x09, 0x59, 0xe3, 0xf5, 0x74, 0xbd, 0x8a, 0x77, 0xc7, 0xd9, 0x19,
-	0x1b, 0xa7, 0x55, 0x0f, 0x54, 0x08, 0xa8, 0xb8, 0x54, 0xe2, 0xc6, 0xa9, 0x47, 0x24, 0x2e, 0xdc,
-	0x7a, 0xe4, 0xda, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x41, 0x2d, 0x12, 0xfc, 0x0d, 0x9c, 0xd0, 0xfc,
-	0x58, 0x7b, 0xd7, 0xf6, 0xc6, 0x29, 0x2a, 0x37, 0x2e, 0xf6, 0xce, 0xcc, 0x3b, 0xef, 0xf3, 0xcc,
-	0x3b, 0xef, 0xbc, 0xef, 0x03, 0x96, 0x30, 0x6b, 0xe0, 0xc0, 0x73, 0x7d, 0x66, 0xe2, 0x8e, 0x67,
-	0x76, 0x36, 0xcc, 0xbd, 0x36, 0x0e, 0xf6, 0x8d, 0x56, 0x40, 0x18, 0x81, 0xf3, 0xbd, 0x55, 0x03,
-	0x77, 0x3c, 0xa3, 0xb3, 0x51, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x95, 0x46, 0x85, 0xf3,
-	0x36, 0xa1, 0x1e, 0xa1, 0x66, 0x0d, 0x51, 0x2c, 0x77, 0x9b, 0x9d, 0x8d, 0x1a, 0x66, 0x68, 0xc3,
-	0x6c, 0x21, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x43, 0x70, 0xdc, 0xaf, 0x5c, 0x5b,
-	0x1c, 0x5a, 0x63, 0x5d, 0xb5, 0xb4, 0xe0, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x25,
-	0x87, 0x10, 0xa7, 0x89, 0x4d, 0xd4, 0x72, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x6a, 0xed, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x5a, 0xd2, 0x40, 0xff,
-	0x3f, 0x38, 0xfe, 0x1e, 0x67, 0x7b, 0xd9, 0xb6, 0x49, 0xdb, 0x67, 0x16, 0xde, 0x6b, 0x63, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xbd, 0x1e, 0x60, 0x4a, 0xf3, 0xda, 0x8a, 0x56, 0x9e, 0xb1, 0xc2, 0xe1,
-	0x1b, 0xd9, 0x2f, 0x1e, 0x97, 0x26, 0x7e, 0x7f, 0x5c, 0x9a, 0xd0, 0x6d, 0xb0, 0x10, 0xdf, 0x4a,
-	0x5b, 0xc4, 0xa7, 0x98, 0xef, 0xad, 0xa1, 0x26, 0xf2, 0x6d, 0x1c, 0xee, 0x55, 0x43, 0x78, 0x1a,
-	0xcc, 0xd8, 0xa4, 0x8e, 0xab, 0x0d, 0x44, 0x1b, 0xf9, 0x49, 0xb1, 0x96, 0xe5, 0x13, 0xd7, 0x10,
-	0x6d, 0xc0, 0x05, 0x30, 0xe5, 0x13, 0xbe, 0x29, 0xb5, 0xa2, 0x95, 0xd3, 0x96, 0x1c, 0xe8, 0x6f,
-	0x81, 0x45, 0x01, 0xb2, 0x25, 0xc2, 0xfb, 0x17, 0x58, 0x7e, 0xa6, 0x81, 0xc2, 0x28, 0x0f, 0x8a,
-	0xec, 0x2a, 0x38, 0x22, 0x6f, 0xae, 0x1a, 0xf7, 0x34, 0x27, 0x67, 0x2f, 0xcb, 0x49, 0x58, 0x00,
-	0x59, 0xca, 0x41, 0x39, 0xbf, 0x49, 0xc1, 0xaf, 0x37, 0xe6, 0x2e, 0x90, 0xf4, 0x5a, 0xf5, 0xdb,
-	0x5e, 0x0d, 0x07, 0xea, 0x04, 0x73, 0x6a, 0xf6, 0x5d, 0x31, 0xa9, 0xdf, 0x00, 0x4b, 0x82, 0xc7,
-	0x07, 0xa8, 0xe9, 0xd6, 0x11, 0x23, 0xc1, 0xc0, 0x61, 0xce, 0x80, 0x59, 0x9b, 0xf8, 0x83, 0x3c,
-	0x72, 0x7c, 0xee, 0xf2, 0xd0, 0xa9, 0xbe, 0xd4, 0xc0, 0x72, 0x82, 0x37, 0x75, 0xb0, 0x35, 0x70,
-	0x34, 0x64, 0x15, 0xf7, 0x18, 0x92, 0x7d, 0x8d, 0x47, 0x0b, 0x93, 0xa8, 0x22, 0xef, 0xf9, 0x55,
-	0xae, 0xe7, 0xa2, 0x4a, 0xa2, 0xde, 0xd6, 0x71, 0x49, 0xa4, 0xdf, 0x50, 0x60, 0xef, 0x33, 0x12,
-	0x20, 0x67, 0x3c, 0x18, 0x9c, 0x07, 0xa9, 0x5d, 0xbc, 0xaf, 0xf2, 0x8d, 0x7f, 0x46, 0xe0, 0x2f,
-	0x28, 0xf8, 0x9e, 0x33, 0x05, 0xbf, 0x00, 0xa6, 0x3a, 0xa8, 0xd9, 0x0e, 0xc1, 0xe5, 0x40, 0xbf,
-	0x04, 0xe6, 0x55, 0x2a, 0xd5, 0x5f, 0xe9, 0x90, 0x6b, 0xe0, 0x58, 0x64, 0x9f, 0x82, 0x80, 0x20,
-	0xcd, 0x73, 0x5f, 0xec, 0x9a, 0xb5, 0xc4, 0xb7, 0x7e, 0x17, 0x40, 0x61, 0xb8, 0xd3, 0xbd, 0x49,
-	0x1c, 0x1a, 0x42, 0x40, 0x90, 0x16, 0x2f, 0x46, 0xfa, 0x17, 0xdf, 0xf0, 0x0a, 0x00, 0xfd, 0xba,
-	0x22, 0xce, 0x96, 0xdb, 0x3c, 0x6b, 0xc8, 0xa4, 0x35, 0x78, 0x11, 0x32, 0x64, 0x09, 0x53, 0x45,
-	0xc8, 0xb8, 0xdd, 0x0f, 0x95, 0x15, 0xd9, 0x19, 0x21, 0xf9, 0x50, 0x53, 0x81, 0x0d, 0xc1, 0x15,
-	0xcf, 0x73, 0x20, 0xdd, 0x24, 0x0e, 0x3f, 0x5d, 0xaa, 0x9c, 0xdb, 0x3c, 0x61, 0x0c, 0x56, 0x43,
-	0xe3, 0x26, 0x71, 0x2c, 0x61, 0x02, 0xaf, 0x8e, 0x20, 0xb5, 0x36, 0x96, 0x94, 0xc4, 0x89, 0xb2,
-	0xd2, 0x17, 0x54, 0x1c, 0x6e, 0xa3, 0x00, 0x79, 0x61, 0x1c, 0x74, 0x4b, 0x11, 0x0c, 0x67, 0x15,
-	0xc1, 0x37, 0xc1, 0x74, 0x4b, 0xcc, 0x88, 0x00, 0xe5, 0x36, 0xf3, 0xc3, 0x14, 0xe5, 0x8e, 0xca,
-	0xcc, 0xd3, 0xe7, 0xa5, 0x89, 0x6f, 0x7e, 0xfb, 0xee, 0xbc, 0x66, 0xa9, 0x2d, 0xfa, 0x13, 0x0d,
-	0x1c, 0xd9, 0x66, 0x8d, 0x2d, 0xd4, 0x6c, 0x46, 0xc2, 0x8d, 0x02, 0x87, 0x86, 0x17, 0xc3, 0xbf,
-	0xe1, 0x29, 0x90, 0x71, 0x10, 0xad, 0xda, 0xa8, 0xa5, 0xde, 0xc8, 0xb4, 0x83, 0xe8, 0x16, 0x6a,
-	0xc1, 0x8f, 0xc0, 0x7c, 0x2b, 0x20, 0x2d, 0x42, 0x71, 0xd0, 0x7b, 0x67, 0xfc, 0x8d, 0xcc, 0x56,
-	0x36, 0xff, 0x78, 0x5e, 0x32, 0x1c, 0x97, 0x35, 0xda, 0x35, 0xc3, 0x26, 0x9e, 0xa9, 0x1a, 0x84,
-	0xfc, 0x5b, 0xa7, 0xf5, 0x5d, 0x93, 0xed, 0xb7, 0x30, 0x35, 0xb6, 0xfa, 0x0f, 0xdc, 0x3a, 0x1a,
-	0xfa, 0x0a, 0x1f, 0xe7, 0x22, 0xc8, 0xda, 0x0d, 0xe4, 0xfa, 0x55, 0xb7, 0x9e, 0x4f, 0xaf, 0x68,
-	0xe5, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x75, 0x7d, 0x07, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x57, 0x51, 0x3f, 0x1a, 0xf3, 0x20, 0xe5, 0x20, 0x49, 0x3e, 0x6d, 0xf1, 0x4f, 0x3e, 0x13, 0x60,
-	0x26, 0x78, 0xcf, 0x5a, 0xfc, 0x93, 0x7b, 0xed, 0x78, 0x55, 0x1c, 0x04, 0x44, 0x3e, 0xe8, 0x19,
-	0x2b, 0xd3, 0xf1, 0xb6, 0xf9, 0x50, 0x7f, 0x98, 0x0e, 0xb3, 0x20, 0x40, 0x36, 0xde, 0xe9, 0x86,
-	0x41, 0xd9, 0x00, 0x29, 0x8f, 0x3a, 0x2a, 0xc2, 0xa5, 0xe1, 0x08, 0xdf, 0xa2, 0xce, 0x36, 0x9f,
-	0xc3, 0x6d, 0x6f, 0xa7, 0x6b, 0x71, 0x5b, 0xf8, 0x36, 0x98, 0x65, 0xdc, 0x49, 0xd5, 0x26, 0xfe,
-	0x1d, 0xd7, 0x11, 0x48, 0xb9, 0xcd, 0xe5, 0xe1, 0xbd, 0x02, 0x6a, 0x4b, 0x18, 0x59, 0x39, 0xd6,
-	0x1f, 0xc0, 0x2d, 0x30, 0xdb, 0x0a, 0x70, 0x1d, 0xdb, 0x98, 0x52, 0x12, 0xd0, 0x7c, 0x5a, 0xa4,
-	0xe0, 0x58, 0xf4, 0xd8, 0x26, 0x5e, 0x57, 0x6b, 0x4d, 0x62, 0xef, 0x86, 0x15, 0x6c, 0x4a, 0x84,
-	0x31, 0x27, 0xe6, 0x64, 0xfd, 0x82, 0xcb, 0x00, 0x48, 0x13, 0xf1, 0xcc, 0xa6, 0x45, 0x44, 0x66,
-	0xc4, 0x8c, 0xe8, 0x4c, 0xd7, 0xc2, 0x65, 0xde, 0x3c, 0xf3, 0x19, 0x71, 0x8c, 0x82, 0x21, 0x3b,
-	0xab, 0x11, 0x76, 0x56, 0x63, 0x27, 0xec, 0xac, 0x95, 0x39, 0x9e, 0x66, 0x8f, 0x7e, 0x2e, 0x69,
-	0x32, 0xd5, 0xa4, 0x27, 0xbe, 0x3c, 0x32, 0x5b, 0xb2, 0x7f, 0x4f, 0xb6, 0xcc, 0xc4, 0xb2, 0x05,
-	0xea, 0x60, 0x4e, 0x9e, 0xc1, 0x43, 0xdd, 0x2a, 0x4f, 0x10, 0x10, 0x09, 0xc3, 0x2d, 0xd4, 0xbd,
-	0x8a, 0xe8, 0x3b, 0xe9, 0xec, 0xe4, 0x7c, 0xca, 0xca, 0xb2, 0x6e, 0xd5, 0xf5, 0xeb, 0xb8, 0xab,
-	0x9f, 0x57, 0xc5, 0xb1, 0x97, 0x0a, 0xfd, 0xca, 0x55, 0x47, 0x0c, 0x85, 0x0f, 0x84, 0x7f, 0xeb,
-	0x4f, 0x52, 0xe0, 0x64, 0xdf, 0xb8, 0xc2, 0xbd, 0x46, 0x52, 0x87, 0x75, 0xc3, 0xfa, 0x31, 0x3e,
-	0x75, 0x58, 0x97, 0xbe, 0x86, 0xd4, 0xf9, 0xe7, 0xd6, 0x0f, 0x79, 0xeb, 0xfa, 0x3a, 0x38, 0x35,
-	0x74, 0x71, 0x07, 0x5c, 0xf4, 0x89, 0x5e, 0xaf, 0xa7, 0xf8, 0x0a, 0x0e, 0x7b, 0x8a, 0x7e, 0xb3,
-	0xd7, 0xc7, 0xd5, 0xb4, 0x72, 0xf1, 0x3f, 0x90, 0xe5, 0x85, 0xbf, 0x7a, 0x07, 0xab, 0x5e, 0x5a,
-	0x59, 0xfc, 0xe9, 0x79, 0xe9, 0x84, 0x3c, 0x21, 0xad, 0xef, 0x1a, 0x2e, 0x31, 0x3d, 0xc4, 0x1a,
-	0xc6, 0x75, 0x9f, 0xf1, 0x1e, 0x2f, 0x76, 0x6f, 0x7e, 0x3f, 0x0b, 0xa6, 0x84, 0x3b, 0xf8, 0x89,
-	0x06, 0x32, 0x4a, 0xda, 0xc0, 0xd5, 0xe1, 0xfb, 0x1f, 0xa1, 0x5d, 0x0b, 0x67, 0xc7, 0x99, 0x49,
-	0x6a, 0xfa, 0xda, 0x83, 0x1f, 0x7e, 0xfd, 0x6a, 0xf2, 0x0c, 0x2c, 0x71, 0xa5, 0x4d, 0x68, 0xa8,
-	0xb7, 0x95, 0xb4, 0x31, 0xef, 0xa9, 0xab, 0xba, 0x0f, 0xbf, 0xd6, 0xc0, 0x5c, 0x4c, 0x3d, 0xc2,
-	0xff, 0x24, 0x40, 0x8c, 0x52, 0xa9, 0x85, 0x0b, 0x87, 0x33, 0x56, 0xac, 0x0c, 0xc1, 0xaa, 0x0c,
-	0xcf, 0xc6, 0x59, 0x85, 0x22, 0x75, 0x88, 0xdc, 0xb7, 0x1a, 0x98, 0x1f, 0x14, 0x81, 0xd0, 0x48,
-	0x80, 0x4c, 0xd0, 0x9e, 0x05, 0xf3, 0xd0, 0xf6, 0x8a, 0xe5, 0x25, 0xc1, 0xf2, 0x22, 0x34, 0xe2,
-	0x2c, 0x3b, 0xa1, 0x7d, 0x9f, 0x68, 0x54, 0xd3, 0xde, 0x87, 0x0f, 0x34, 0x90, 0x51, 0x52, 0x2f,
-	0xf1, 0x3a, 0xe3, 0x2a, 0x32, 0xf1, 0x3a, 0x07, 0x14, 0xa3, 0x5e, 0x16, 0x94, 0x74, 0xb8, 0x12,
-	0xa7, 0xa4, 0x64, 0x23, 0x8d, 0x84, 0xec, 0x73, 0x0d, 0x64, 0x94, 0xe0, 0x4b, 0x24, 0x11, 0x57,
-	0x97, 0x89, 0x24, 0x06, 0x74, 0xa3, 0xbe, 0x2e, 0x48, 0xac, 0xc1, 0xd5, 0x38, 0x09, 0x2a, 0xcd,
-	0xfa, 0x1c, 0xcc, 0x7b, 0xbb, 0x78, 0xff, 0x3e, 0xec, 0x80, 0x34, 0xd7, 0x84, 0x50, 0x4f, 0x4c,
-	0x91, 0x9e, 0xd0, 0x2c, 0xfc, 0xeb, 0x40, 0x1b, 0x85, 0xbf, 0x2a, 0xf0, 0x4b, 0x70, 0x79, 0x30,
-	0x7b, 0xea, 0xb1, 0x08, 0x50, 0x30, 0x2d, 0x25, 0x11, 0xfc, 0x77, 0x82, 0xd7, 0x98, 0xf2, 0x2a,
-	0xac, 0x8e, 0xb1, 0x52, 0xe8, 0x4b, 0x02, 0xfd, 0x24, 0x5c, 0x88, 0xa3, 0x4b, 0xa9, 0x05, 0x19,
-	0xc8, 0x28, 0xa5, 0x05, 0x57, 0x86, 0xfd, 0xc5, 0x45, 0x58, 0x61, 0x6d, 0x5c, 0x9f, 0x08, 0x31,
-	0x8b, 0x02, 0x33, 0x0f, 0x4f, 0xc6, 0x31, 0x31, 0x6b, 0x54, 0x6d, 0x0e, 0x75, 0x17, 0xe4, 0x22,
-	0x32, 0xe9, 0x10, 0xc8, 0x23, 0xce, 0x3a, 0x42, 0x67, 0xe9, 0xba, 0xc0, 0x5d, 0x82, 0x85, 0x01,
-	0x5c, 0x65, 0xca, 0xab, 0x2d, 0xec, 0x82, 0x8c, 0xea, 0x9d, 0x89, 0x79, 0x16, 0x97, 0x59, 0x89,
-	0x79, 0x36, 0xd0, 0x82, 0x93, 0x4e, 0x2d, 0x9b, 0x26, 0xeb, 0xc2, 0x4f, 0x35, 0x00, 0xfa, 0x05,
-	0x1d, 0x96, 0x0f, 0x72, 0x1b, 0x6d, 0xd6, 0x85, 0x73, 0x87, 0xb0, 0x54, 0x1c, 0xce, 0x08, 0x0e,
-	0xa7, 0xe1, 0xe2, 0x28, 0x0e, 0xa2, 0xc3, 0xf0, 0x00, 0xa8, 0x86, 0x70, 0xc0, 0x6b, 0x8f, 0xf6,
-	0x91, 0x03, 0x5e, 0x7b, 0xac, 0xaf, 0x24, 0x05, 0x20, 0xec, 0x35, 0x95, 0xca, 0xd3, 0x17, 0x45,
-	0xed, 0xd9, 0x8b, 0xa2, 0xf6, 0xcb, 0x8b, 0xa2, 0xf6, 0xe8, 0x65, 0x71, 0xe2, 0xd9, 0xcb, 0xe2,
-	0xc4, 0x8f, 0x2f, 0x8b, 0x13, 0x1f, 0x96, 0x23, 0xfd, 0xb6, 0x81, 0xf6, 0xf6, 0xd6, 0x7d, 0xcc,
-	0x3e, 0x26, 0xc1, 0xae, 0x18, 0x98, 0x5d, 0xe1, 0x49, 0x74, 0xdd, 0xda, 0xb4, 0xe8, 0xf2, 0xff,
-	0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xdb, 0xa9, 0xb0, 0x1f, 0x12, 0x00, 0x00,
+	// 1785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x8f, 0x23, 0x47,
+	0x15, 0x9f, 0x5e, 0x7b, 0xc6, 0xf6, 0xf3, 0xcc, 0xee, 0xa4, 0xd6, 0xbb, 0xf1, 0x74, 0x66, 0xc7,
+	0xb3, 0x0d, 0x33, 0xe3, 0x5d, 0x76, 0xbb, 0x33, 0x03, 0x8a, 0x04, 0x1c, 0x60, 0x3d, 0xda, 0x6c,
+	0x42, 0x36, 0x10, 0x9c, 0x21, 0x07, 0x24, 0x64, 0xd5, 0xb4, 0x6b, 0xdb, 0xad, 0x71, 0x77, 0x79,
+	0xbb, 0xca, 0xc6, 0x9b, 0x68, 0x25, 0x88, 0x10, 0x10, 0x71, 0x20, 0x12, 0x37, 0x4e, 0x39, 0x22,
+	0xb8, 0x70, 0xe3, 0x82, 0xc4, 0x35, 0xc7, 0x48, 0x5c, 0x10, 0x48, 0x1b, 0xb4, 0x8b, 0x04, 0xe2,
+	0x1f, 0x40, 0xe2, 0x80, 0x50, 0x7d, 0xb4, 0xbb, 0xdb, 0xdf, 0x89, 0x26, 0x12, 0x07, 0x2e, 0x76,
+	0xd7, 0xab, 0x57, 0xef, 0xfd, 0xaa, 0xde, 0xaf, 0x5e, 0xbd, 0x07, 0xdb, 0x84, 0x77, 0x48, 0x14,
+	0xf8, 0x21, 0x77, 0xc8, 0x20, 0x70, 0x06, 0x87, 0xce, 0xc3, 0x3e, 0x89, 0x1e, 0xd9, 0xbd, 0x88,
+	0x72, 0x8a, 0x36, 0x47, 0xb3, 0x36, 0x19, 0x04, 0xf6, 0xe0, 0xd0, 0x7c, 0x0e, 0x07, 0x7e, 0x48,
+	0x1d, 0xf9, 0xab, 0x94, 0xcc, 0x9b, 0x2e, 0x65, 0x01, 0x65, 0xce, 0x29, 0x66, 0x44, 0xad, 0x76,
+	0x06, 0x87, 0xa7, 0x84, 0xe3, 0x43, 0xa7, 0x87, 0x3d, 0x3f, 0xc4, 0xdc, 0xa7, 0xa1, 0xd6, 0x35,
+	0x27, 0xdc, 0x09, 0xbb, 0x6a, 0x6e, 0x6b, 0x62, 0x8e, 0x0f, 0xf5, 0x54, 0xc5, 0xa3, 0x1e, 0x95,
+	0x9f, 0x8e, 0xf8, 0xd2, 0xd2, 0x6d, 0x8f, 0x52, 0xaf, 0x4b, 0x1c, 0xdc, 0xf3, 0x1d, 0x1c, 0x86,
+	0x94, 0x4b, 0x4f, 0x4c, 0xcf, 0xd6, 0xf4, 0xac, 0x1c, 0x9d, 0xf6, 0x1f, 0x38, 0xdc, 0x0f, 0x08,
+	0xe3, 0x38, 0xe8, 0x29, 0x05, 0xeb, 0xcb, 0x70, 0xf9, 0xdb, 0x02, 0xed, 0x1d, 0xd7, 0xa5, 0xfd,
+	0x90, 0x37, 0xc9, 0xc3, 0x3e, 0x61, 0x1c, 0x55, 0xa1, 0x80, 0xdb, 0xed, 0x88, 0x30, 0x56, 0x35,
+	0x76, 0x8d, 0x7a, 0xa9, 0x19, 0x0f, 0xbf, 0x52, 0xfc, 0xe9, 0x07, 0xb5, 0x95, 0x7f, 0x7c, 0x50,
+	0x5b, 0xb1, 0x5c, 0xa8, 0x64, 0x97, 0xb2, 0x1e, 0x0d, 0x19, 0x11, 0x6b, 0x4f, 0x71, 0x17, 0x87,
+	0x2e, 0x89, 0xd7, 0xea, 0x21, 0x7a, 0x01, 0x4a, 0x2e, 0x6d, 0x93, 0x56, 0x07, 0xb3, 0x4e, 0xf5,
+	0x82, 0x9c, 0x2b, 0x0a, 0xc1, 0x2b, 0x98, 0x75, 0x50, 0x05, 0x56, 0x43, 0x2a, 0x16, 0xe5, 0x76,
+	0x8d, 0x7a, 0xbe, 0xa9, 0x06, 0xd6, 0xd7, 0x60, 0x4b, 0x3a, 0x39, 0x96, 0xc7, 0xfb, 0x29, 0x50,
+	0xfe, 0xd8, 0x00, 0x73, 0x9a, 0x05, 0x0d, 0x76, 0x0f, 0x2e, 0xaa, 0xc8, 0xb5, 0xb2, 0x96, 0x36,
+	0x94, 0xf4, 0x8e, 0x12, 0x22, 0x13, 0x8a, 0x4c, 0x38, 0x15, 0xf8, 0x2e, 0x48, 0x7c, 0xa3, 0xb1,
+	0x30, 0x81, 0x95, 0xd5, 0x56, 0xd8, 0x0f, 0x4e, 0x49, 0xa4, 0x77, 0xb0, 0xa1, 0xa5, 0xdf, 0x94,
+	0x42, 0xeb, 0x35, 0xd8, 0x96, 0x38, 0xde, 0xc2, 0x5d, 0xbf, 0x8d, 0x39, 0x8d, 0xc6, 0x36, 0x73,
+	0x1d, 0xd6, 0x5d, 0x1a, 0x8e, 0xe3, 0x28, 0x0b, 0xd9, 0x9d, 0x89, 0x5d, 0xfd, 0xcc, 0x80, 0x6b,
+	0x33, 0xac, 0xe9, 0x8d, 0x1d, 0xc0, 0xa5, 0x18, 0x55, 0xd6, 0x62, 0x0c, 0xf6, 0x1c, 0xb7, 0x16,
+	0x93, 0xa8, 0xa1, 0xe2, 0xfc, 0x49, 0xc2, 0xf3, 0x22, 0x54, 0xb2, 0x4b, 0x17, 0x91, 0xc8, 0x7a,
+	0x4d, 0x3b, 0x7b, 0x93, 0xd3, 0x08, 0x7b, 0x8b, 0x9d, 0xa1, 0x4d, 0xc8, 0x9d, 0x91, 0x47, 0x9a,
+	0x6f, 0xe2, 0x33, 0xe5, 0xfe, 0x16, 0x54, 0xb2, 0xc6, 0xb4, 0xfb, 0x0a, 0xac, 0x0e, 0x70, 0xb7,
+	0x1f, 0x3b, 0x57, 0x03, 0xeb, 0x25, 0xd8, 0xd4, 0x54, 0x6a, 0x7f, 0xa2, 0x4d, 0x1e, 0xc0, 0x73,
+	0xa9, 0x75, 0xda, 0x05, 0x82, 0xbc, 0xe0, 0xbe, 0x5c, 0xb5, 0xde, 0x94, 0xdf, 0xd6, 0xdb, 0x80,
+	0xa4, 0xe2, 0xc9, 0xf0, 0x3e, 0xf5, 0x58, 0xec, 0x02, 0x41, 0x5e, 0xde, 0x18, 0x65, 0x5f, 0x7e,
+	0xa3, 0x97, 0x01, 0x92, 0xbc, 0x22, 0xf7, 0x56, 0x3e, 0xda, 0xb7, 0x15, 0x69, 0x6d, 0x91, 0x84,
+	0x6c, 0x95, 0xc2, 0x74, 0x12, 0xb2, 0xdf, 0x48, 0x8e, 0xaa, 0x99, 0x5a, 0x99, 0x02, 0xf9, 0x9e,
+	0x01, 0x97, 0x33, 0xce, 0x35, 0xce, 0x1b, 0x90, 0xef, 0x52, 0x4f, 0xec, 0x2e, 0x57, 0x2f, 0x1f,
+	0x5d, 0xb1, 0xc7, 0xb3, 0xa1, 0x7d, 0x9f, 0x7a, 0x4d, 0xa9, 0x82, 0xee, 0x4d, 0x01, 0x75, 0xb0,
+	0x10, 0x94, 0xf2, 0x93, 0x46, 0x65, 0x55, 0xf4, 0x39, 0xbc, 0x81, 0x23, 0x1c, 0xc4, 0xe7, 0x60,
+	0x35, 0xe1, 0x72, 0x46, 0xaa, 0x01, 0x7e, 0x15, 0xd6, 0x7a, 0x52, 0x22, 0x0f, 0xa8, 0x7c, 0x54,
+	0x9d, 0x84, 0xa8, 0x56, 0x34, 0x4a, 0x1f, 0x3e, 0xa9, 0xad, 0xfc, 0xea, 0xef, 0xbf, 0xbd, 0x69,
+	0x34, 0xf5, 0x12, 0xeb, 0x3f, 0x06, 0x5c, 0xbc, 0xcb, 0x3b, 0xc7, 0xb8, 0xdb, 0x4d, 0x1d, 0x37,
+	0x8e, 0x3c, 0x16, 0x07, 0x46, 0x7c, 0xa3, 0xe7, 0xa1, 0xe0, 0x61, 0xd6, 0x72, 0x71, 0x4f, 0xdf,
+	0x91, 0x35, 0x0f, 0xb3, 0x63, 0xdc, 0x43, 0xdf, 0x83, 0xcd, 0x5e, 0x44, 0x7b, 0x94, 0x91, 0x68,
+	0x74, 0xcf, 0xc4, 0x1d, 0x59, 0x6f, 0x1c, 0xfd, 0xfb, 0x49, 0xcd, 0xf6, 0x7c, 0xde, 0xe9, 0x9f,
+	0xda, 0x2e, 0x0d, 0x1c, 0xfd, 0x40, 0xa8, 0xbf, 0xdb, 0xac, 0x7d, 0xe6, 0xf0, 0x47, 0x3d, 0xc2,
+	0xec, 0xe3, 0xe4, 0x82, 0x37, 0x2f, 0xc5, 0xb6, 0xe2, 0xcb, 0xb9, 0x05, 0x45, 0xb7, 0x83, 0xfd,
+	0xb0, 0xe5, 0xb7, 0xab, 0xf9, 0x5d, 0xa3, 0x9e, 0x6b, 0x16, 0xe4, 0xf8, 0xd5, 0x36, 0xda, 0x86,
+	0x12, 0x1d, 0x90, 0x28, 0xf2, 0xdb, 0x84, 0x55, 0x57, 0x25, 0xd6, 0x44, 0x20, 0xae, 0xff, 0x69,
+	0x97, 0xba, 0x67, 0xad, 0x44, 0x67, 0x4d, 0xea, 0x5c, 0x94, 0xe2, 0x6f, 0xc5, 0x52, 0xeb, 0x04,
+	0x2e, 0xdf, 0x65, 0xdc, 0x0f, 0x30, 0x27, 0xf7, 0x70, 0x72, 0xa8, 0x9b, 0x90, 0xf3, 0xb0, 0x3a,
+	0x83, 0x7c, 0x53, 0x7c, 0x0a, 0x49, 0x44, 0xb8, 0xdc, 0xfe, 0x7a, 0x53, 0x7c, 0x0a, 0x70, 0x83,
+	0xa0, 0x45, 0xa2, 0x88, 0xaa, 0xbc, 0x50, 0x6a, 0x16, 0x06, 0xc1, 0x5d, 0x31, 0xb4, 0xde, 0xcb,
+	0xc7, 0x64, 0x8a, 0xb0, 0x4b, 0x4e, 0x86, 0xf1, 0xd9, 0x1e, 0x42, 0x2e, 0x60, 0x9e, 0x0e, 0x54,
+	0x6d, 0x32, 0x50, 0xaf, 0x33, 0xef, 0xae, 0x90, 0x91, 0x7e, 0x70, 0x32, 0x6c, 0x0a, 0x5d, 0xf4,
+	0x75, 0x58, 0xe7, 0xc2, 0x48, 0xcb, 0xa5, 0xe1, 0x03, 0xdf, 0x93, 0x9e, 0xca, 0x47, 0xd7, 0x26,
+	0xd7, 0x4a, 0x57, 0xc7, 0x52, 0xa9, 0x59, 0xe6, 0xc9, 0x00, 0x1d, 0xc3, 0x7a, 0x2f, 0x22, 0x6d,
+	0xe2, 0x12, 0xc6, 0x68, 0xc4, 0xaa, 0xf9, 0xdd, 0xdc, 0x32, 0xde, 0x33, 0x8b, 0x44, 0x7a, 0x56,
+	0x07, 0xaa, 0x13, 0xe1, 0xaa, 0x8c, 0x46, 0x59, 0xca, 0x54, 0x1a, 0x44, 0xd7, 0x00, 0x94, 0x8a,
+	0xbc, 0xad, 0x6b, 0xf2, 0x44, 0x4a, 0x52, 0x22, 0x1f, 0xb8, 0x57, 0xe2, 0x69, 0xf1, 0x06, 0x57,
+	0x0b, 0x72, 0x1b, 0xa6, 0xad, 0x1e, 0x68, 0x3b, 0x7e, 0xa0, 0xed, 0x93, 0xf8, 0x81, 0x6e, 0x6c,
+	0x08, 0xb6, 0xbe, 0xff, 0x71, 0xcd, 0x50, 0x8c, 0x55, 0x96, 0xc4, 0xf4, 0x54, 0xd2, 0x15, 0x3f,
+	0x1b, 0xd2, 0x95, 0xb2, 0xa4, 0xb3, 0x60, 0x43, 0xed, 0x21, 0xc0, 0xc3, 0x96, 0x20, 0x08, 0xa4,
+	0x8e, 0xe1, 0x75, 0x3c, 0xbc, 0x87, 0xd9, 0x37, 0xf2, 0xc5, 0x0b, 0x9b, 0xb9, 0x66, 0x91, 0x0f,
+	0x5b, 0x7e, 0xd8, 0x26, 0x43, 0xeb, 0xa6, 0xce, 0xb1, 0x23, 0x2a, 0x24, 0x09, 0xb0, 0x8d, 0x39,
+	0x8e, 0xef, 0x99, 0xf8, 0xb6, 0x7e, 0x97, 0x83, 0xab, 0x89, 0x72, 0x43, 0x58, 0x4d, 0x51, 0x87,
+	0x0f, 0xe3, 0x34, 0xb4, 0x98, 0x3a, 0x7c, 0xc8, 0xce, 0x81, 0x3a, 0xff, 0x8f, 0xfa, 0x92, 0x51,
+	0xb7, 0x6e, 0xc3, 0xf3, 0x13, 0x81, 0x9b, 0x13, 0xe8, 0x7f, 0xe5, 0xe0, 0x4a, 0xa2, 0xff, 0xa9,
+	0xd3, 0xef, 0xf9, 0x47, 0x38, 0xbf, 0x28, 0xc2, 0xab, 0xf3, 0x23, 0xbc, 0x76, 0xce, 0x11, 0x2e,
+	0x7c, 0x36, 0x11, 0x2e, 0x2e, 0x88, 0x70, 0x69, 0x22, 0xc2, 0xd9, 0x07, 0x07, 0x96, 0x78, 0x70,
+	0xca, 0x53, 0x1f, 0x9c, 0x5b, 0x70, 0x75, 0x3c, 0xf0, 0x73, 0x78, 0xf2, 0xfb, 0xb8, 0xd0, 0x3d,
+	0x8e, 0x08, 0xe6, 0xe4, 0x8e, 0xeb, 0x12, 0xc6, 0xee, 0xfb, 0x2c, 0x29, 0x74, 0x09, 0x94, 0xb1,
+	0x94, 0xb6, 0xba, 0x3e, 0xe3, 0x3a, 0x3f, 0x4c, 0x61, 0x80, 0x5a, 0x7a, 0xd2, 0xef, 0x75, 0x49,
+	0x63, 0x4f, 0x84, 0xe0, 0x9f, 0x4f, 0x6a, 0x80, 0x47, 0xf6, 0x7e, 0xfd, 0x71, 0x0d, 0x12, 0xeb,
+	0x2a, 0x34, 0xa9, 0x69, 0x71, 0x78, 0x82, 0x82, 0x7d, 0x46, 0xda, 0x9a, 0x83, 0x82, 0x92, 0xdf,
+	0x61, 0xa4, 0x3d, 0xef, 0x1d, 0xfc, 0x83, 0xa1, 0x77, 0xfb, 0xa6, 0x1f, 0xf4, 0xbb, 0x98, 0x93,
+	0xb7, 0x0e, 0x53, 0x3c, 0xa7, 0x3d, 0x3e, 0xe2, 0xb9, 0xf8, 0xfe, 0x1f, 0x2c, 0x33, 0x46, 0xf7,
+	0x3a, 0xbd, 0x81, 0x39, 0xf1, 0xba, 0x32, 0x6a, 0x05, 0x18, 0x79, 0x99, 0xc4, 0x25, 0xa7, 0x75,
+	0x1f, 0x2a, 0x59, 0xb1, 0x36, 0xf1, 0x25, 0x28, 0x8a, 0xba, 0xb0, 0xf5, 0x80, 0xe8, 0x52, 0xbb,
+	0xb1, 0xf5, 0xe7, 0x27, 0xb5, 0x2b, 0x0a, 0x3d, 0x6b, 0x9f, 0xd9, 0x3e, 0x75, 0x02, 0xcc, 0x3b,
+	0xf6, 0xab, 0x21, 0x17, 0x2d, 0x80, 0x5c, 0x7d, 0xf4, 0x97, 0x4b, 0xb0, 0x2a, 0xcd, 0xa1, 0x1f,
+	0x1a, 0x50, 0xd0, 0x9d, 0x0f, 0xda, 0x9b, 0x8c, 0xf9, 0x94, 0xd6, 0xd6, 0xdc, 0x5f, 0xa4, 0xa6,
+	0xa0, 0x59, 0x07, 0xef, 0xfe, 0xf1, 0x6f, 0xbf, 0xb8, 0x70, 0x1d, 0xd5, 0x44, 0x23, 0x4e, 0x59,
+	0xdc, 0x8e, 0xeb, 0xce, 0xc7, 0x79, 0x47, 0x87, 0xe1, 0x31, 0xfa, 0xa5, 0x01, 0x1b, 0x99, 0xe6,
+	0x12, 0x7d, 0x61, 0x86, 0x8b, 0x69, 0x4d, 0xac, 0x79, 0x6b, 0x39, 0x65, 0x8d, 0xca, 0x96, 0xa8,
+	0xea, 0x68, 0x3f, 0x8b, 0x2a, 0xee, 0x61, 0x27, 0xc0, 0xfd, 0xc6, 0x80, 0xcd, 0xf1, 0x1e, 0x11,
+	0xd9, 0x33, 0x5c, 0xce, 0x68, 0x4d, 0x4d, 0x67, 0x69, 0x7d, 0x8d, 0xf2, 0x25, 0x89, 0xf2, 0x45,
+	0x64, 0x67, 0x51, 0x0e, 0x62, 0xfd, 0x04, 0x68, 0xba, 0xe5, 0x7d, 0x8c, 0xde, 0x35, 0xa0, 0xa0,
+	0x3b, 0xc1, 0x99, 0xe1, 0xcc, 0x36, 0x99, 0xe6, 0xfe, 0x22, 0x35, 0x0d, 0xa9, 0x2e, 0x21, 0x59,
+	0x68, 0x37, 0x0b, 0x49, 0x77, 0x95, 0x2c, 0x75, 0x64, 0x3f, 0x31, 0xa0, 0xa0, 0xfb, 0xc1, 0x99,
+	0x20, 0xb2, 0xcd, 0xa7, 0xb9, 0xbf, 0x48, 0x4d, 0x83, 0xb8, 0x2d, 0x41, 0x1c, 0xa0, 0xbd, 0x2c,
+	0x08, 0xa6, 0xd4, 0x12, 0x0c, 0xce, 0x3b, 0x67, 0xe4, 0xd1, 0x63, 0x34, 0x80, 0xbc, 0x68, 0x19,
+	0x91, 0x35, 0x93, 0x22, 0xa3, 0x3e, 0xd4, 0xfc, 0xdc, 0x5c, 0x1d, 0xed, 0x7f, 0x4f, 0xfa, 0xaf,
+	0xa1, 0x6b, 0xe3, 0xec, 0x69, 0x67, 0x4e, 0x80, 0xc1, 0x9a, 0xea, 0x98, 0xd0, 0xe7, 0x67, 0x58,
+	0xcd, 0x34, 0x66, 0xe6, 0xde, 0x02, 0x2d, 0xed, 0x7d, 0x5b, 0x7a, 0xbf, 0x8a, 0x2a, 0x59, 0xef,
+	0xaa, 0x13, 0x43, 0x1c, 0x0a, 0xba, 0x11, 0x43, 0xbb, 0x93, 0xf6, 0xb2, 0x3d, 0x9a, 0x79, 0xb0,
+	0xa8, 0xfe, 0x8b, 0x7d, 0xee, 0x48, 0x9f, 0x55, 0x74, 0x35, 0xeb, 0x93, 0xf0, 0x4e, 0xcb, 0x15,
+	0xae, 0xde, 0x86, 0x72, 0xaa, 0xfd, 0x59, 0xc2, 0xf3, 0x94, 0xbd, 0x4e, 0xe9, 0x9f, 0x2c, 0x4b,
+	0xfa, 0xdd, 0x46, 0xe6, 0x98, 0x5f, 0xad, 0x2a, 0xde, 0x58, 0x34, 0x84, 0x82, 0xae, 0x89, 0x67,
+	0xf2, 0x2c, 0xdb, 0x3e, 0x99, 0xfb, 0x8b, 0xd4, 0xe6, 0xef, 0x5a, 0x95, 0x4a, 0x7c, 0x88, 0x7e,
+	0x64, 0x00, 0x24, 0x85, 0x1a, 0xaa, 0xcf, 0x33, 0x9b, 0x2e, 0xc2, 0xcd, 0x1b, 0x4b, 0x68, 0x6a,
+	0x0c, 0xd7, 0x25, 0x86, 0x17, 0xd0, 0xd6, 0x34, 0x0c, 0xb2, 0x26, 0x40, 0x3f, 0x30, 0xa0, 0x34,
+	0x2a, 0x03, 0xd0, 0xc1, 0x3c, 0xdb, 0xe9, 0x10, 0xd4, 0x17, 0x2b, 0x6a, 0x0c, 0xbb, 0x12, 0x83,
+	0x89, 0xaa, 0xd3, 0x30, 0xc8, 0xf8, 0xff, 0xdc, 0x80, 0xcd, 0xf1, 0xd2, 0x62, 0x09, 0x16, 0xcc,
+	0xca, 0x88, 0xb3, 0xaa, 0x94, 0x59, 0xe9, 0xc7, 0x95, 0xfa, 0xad, 0x54, 0x01, 0x23, 0x63, 0x93,
+	0x3c, 0xb6, 0x33, 0x63, 0x33, 0x51, 0x50, 0x98, 0x37, 0x96, 0xd0, 0x9c, 0x1f, 0x1b, 0xa6, 0x35,
+	0x5b, 0x83, 0x43, 0x41, 0x4e, 0xfd, 0x58, 0xcf, 0xc9, 0xc4, 0xe9, 0x37, 0xde, 0xdc, 0x5f, 0xa4,
+	0x36, 0x9f, 0x9c, 0x71, 0x1d, 0xd0, 0x68, 0x7c, 0xf8, 0x74, 0xc7, 0xf8, 0xe8, 0xe9, 0x8e, 0xf1,
+	0xd7, 0xa7, 0x3b, 0xc6, 0xfb, 0xcf, 0x76, 0x56, 0x3e, 0x7a, 0xb6, 0xb3, 0xf2, 0xa7, 0x67, 0x3b,
+	0x2b, 0xdf, 0xad, 0xa7, 0xea, 0x9c, 0x0e, 0x7e, 0xf8, 0xf0, 0x76, 0x48, 0xf8, 0xf7, 0x69, 0x74,
+	0x26, 0x07, 0xce, 0x50, 0x5a, 0x92, 0xd5, 0xce, 0xe9, 0x9a, 0xac, 0xbb, 0xbf, 0xf8, 0xdf, 0x01,
+	0x00, 0x10, 0x7f, 0xf7, 0xb9, 0xda, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1487,6 +1897,12 @@ type QueryClient interface {
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1599,20 +2015,47 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
-func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
-	out := new(QueryBaseFeeResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Account queries an Ethereum account.
-	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error) {
+	out := new(QueryCreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error) {
+	out := new(QuerySimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Account queries an Ethereum account.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
 	CosmosAccount(context.Context, *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error)
 	// ValidatorAccount queries an Ethereum account's from a validator consensus
 	// Address.
@@ -1634,6 +2077,12 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*QueryCreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1676,6 +2125,15 @@ func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxR
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*QueryCreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *QuerySimulateV1Request) (*QuerySimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1882,6 +2340,60 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*QuerySimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -1948,6 +2460,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2523,6 +3047,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2836,7 +3374,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2846,20 +3384,95 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2869,103 +3482,282 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryCreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
 	}
 	return n
 }
@@ -3160,6 +3952,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3289,55 +4089,173 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
+func (m *QueryTraceCallResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	l = len(m.Data)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *QueryCreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
@@ -4968,6 +5886,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5224,10 +6210,747 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5254,12 +6977,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
@@ -5278,7 +7003,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
@@ -5310,7 +7035,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
@@ -5343,7 +7068,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5377,7 +7102,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5396,7 +7121,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
@@ -5415,6 +7140,74 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5436,7 +7229,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5459,10 +7252,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5520,7 +7313,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCreateAccessListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5543,49 +7336,15 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5612,18 +7371,16 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.BlockNumber = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5633,14 +7390,14 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5668,13 +7425,63 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5684,26 +7491,46 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5737,7 +7564,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5756,25 +7583,6 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5796,7 +7604,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateV1Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5819,10 +7627,10 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateV1Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -0,0 +1,113 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single `eth_simulateV1` request.
+	MaxSimulateBlocks = 256
+	// MaxSimulateCallsPerBlock is the maximum number of calls of a simulated block.
+	MaxSimulateCallsPerBlock = 1000
+	// SimulateTimestampIncrement is the default number of seconds between two
+	// simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+// JSON-RPC error codes returned for the failed calls of a simulation.
+const (
+	SimErrCodeReverted = 3
+	SimErrCodeVMError  = -32015
+)
+
+// TransferAddress is the address of the ERC-7528 pseudo-contract emitting the
+// logs of the ETH transfers traced during a simulation.
+var TransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// TransferTopic is the topic of the ERC-20 Transfer(address,address,uint256)
+// event, used by the logs of the traced ETH transfers.
+var TransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// SimOpts are the inputs of an `eth_simulateV1` request.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls executed in the same simulated block, on top of
+// the given state and block overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Transactions  []common.Hash   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// UnmarshalOpts decodes and validates the JSON-encoded simulation options of
+// the request.
+func (m QuerySimulateV1Request) UnmarshalOpts() (*SimOpts, error) {
+	var opts SimOpts
+	if err := json.Unmarshal(m.Opts, &opts); err != nil {
+		return nil, fmt.Errorf("invalid simulation options: %w", err)
+	}
+
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+
+	for i, block := range opts.BlockStateCalls {
+		if len(block.Calls) > MaxSimulateCallsPerBlock {
+			return nil, fmt.Errorf("too many calls in block %d: %d > %d", i, len(block.Calls), MaxSimulateCallsPerBlock)
+		}
+		if block.StateOverrides == nil {
+			continue
+		}
+		if err := block.StateOverrides.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &opts, nil
+}
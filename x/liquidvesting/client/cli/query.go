@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(GetDenomCmd())
 	cmd.AddCommand(GetDenomsCmd())
+	cmd.AddCommand(GetDenomScheduleCmd())
+	cmd.AddCommand(GetAccountLiquidPositionsCmd())
 
 	return cmd
 }
@@ -94,3 +96,68 @@ func GetDenomsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetDenomScheduleCmd returns command for querying liquid denom schedule
+func GetDenomScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-schedule DENOM",
+		Short: "Gets schedule of liquid vesting token resolved at current block time",
+		Long:  "Gets schedule of liquid vesting token resolved at current block time with redeemable and locked amounts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomScheduleRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAccountLiquidPositionsCmd returns command for querying liquid vesting
+// tokens held by account
+func GetAccountLiquidPositionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-liquid-positions ADDRESS",
+		Short: "Gets liquid vesting tokens held by account",
+		Long:  "Gets liquid vesting tokens held by account with their schedules resolved at current block time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAccountLiquidPositionsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.AccountLiquidPositions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
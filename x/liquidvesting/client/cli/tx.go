@@ -25,6 +25,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewMsgLiquidateCmd(),
 		NewMsgRedeemCmd(),
+		NewMsgMergeLiquidCmd(),
+		NewMsgSplitLiquidCmd(),
 	)
 
 	return txCmd
@@ -111,3 +113,82 @@ func NewMsgRedeemCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgMergeLiquidCmd returns command for composing MsgMergeLiquid and sending it to blockchain
+func NewMsgMergeLiquidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-liquid AMOUNTS [RECEIVER]",
+		Short: "Merge several liquid tokens into a new liquid token",
+		Long:  "Merge several liquid tokens into a new liquid token, e.g. merge-liquid 100aLIQUID1,200aLIQUID2",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var mergeTo sdk.AccAddress
+			mergeFrom := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				mergeTo, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			} else {
+				mergeTo = mergeFrom
+			}
+
+			msg := types.NewMsgMergeLiquid(mergeFrom, mergeTo, coins)
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgSplitLiquidCmd returns command for composing MsgSplitLiquid and sending it to blockchain
+func NewMsgSplitLiquidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-liquid AMOUNT [RECEIVER]",
+		Short: "Split part of liquid token into a new liquid token",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var splitTo sdk.AccAddress
+			splitFrom := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				splitTo, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			} else {
+				splitTo = splitFrom
+			}
+
+			msg := types.NewMsgSplitLiquid(splitFrom, splitTo, coin)
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/haqq-network/haqq/x/liquidvesting/types"
+)
+
+// CleanupExhaustedDenoms cleans up every liquid denom ever created which has no supply left
+func (k BaseKeeper) CleanupExhaustedDenoms(ctx sdk.Context) error {
+	counter := k.GetDenomCounter(ctx)
+	for id := uint64(0); id < counter; id++ {
+		baseDenom := types.DenomBaseNameFromID(id)
+		if !k.bankKeeper.GetSupply(ctx, baseDenom).IsZero() {
+			continue
+		}
+
+		if err := k.cleanupLiquidDenom(ctx, baseDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanupLiquidDenom deletes liquid denom along with its bank metadata, erc20 token pair
+// and dynamic precompile. Emits cleanup event if anything bound to the denom was left.
+func (k BaseKeeper) cleanupLiquidDenom(ctx sdk.Context, baseDenom string) error {
+	cleaned := false
+
+	if _, found := k.GetDenom(ctx, baseDenom); found {
+		k.DeleteDenom(ctx, baseDenom)
+		cleaned = true
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, baseDenom); found {
+		if err := k.bankKeeper.DeleteDenomMetaData(ctx, baseDenom); err != nil {
+			return errorsmod.Wrapf(err, "failed to delete metadata of %s", baseDenom)
+		}
+		cleaned = true
+	}
+
+	contractAddr := ""
+	if tokenPairID := k.erc20Keeper.GetTokenPairID(ctx, baseDenom); len(tokenPairID) > 0 {
+		if tokenPair, found := k.erc20Keeper.GetTokenPair(ctx, tokenPairID); found {
+			k.erc20Keeper.DeleteTokenPair(ctx, tokenPair)
+			if err := k.erc20Keeper.DisableDynamicPrecompiles(ctx, tokenPair.GetERC20Contract()); err != nil {
+				return errorsmod.Wrapf(err, "failed to disable precompile of %s", baseDenom)
+			}
+			contractAddr = tokenPair.Erc20Address
+			cleaned = true
+		}
+	}
+
+	if !cleaned {
+		return nil
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCleanupLiquidDenom,
+				sdk.NewAttribute(types.AttributeKeyDenom, baseDenom),
+				sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr),
+			),
+		},
+	)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/haqq-network/haqq/testutil"
+	"github.com/haqq-network/haqq/x/liquidvesting/types"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
+)
+
+// setupLiquidDenoms liquidates coins of a new vesting account into liquid denoms
+// and returns their erc20 contract addresses
+func (suite *KeeperTestSuite) setupLiquidDenoms(ctx sdk.Context, liquidations ...sdk.Coin) []common.Address {
+	fromAccNumber := suite.keyring.AddKey()
+	fromAddr = suite.keyring.GetAccAddr(fromAccNumber)
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+	baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+	startTime := ctx.BlockTime().Add(-10 * time.Second)
+	clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, amount, startTime, lockupPeriods, vestingPeriods, nil)
+	err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+	suite.Require().NoError(err)
+	suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+
+	contracts := make([]common.Address, 0, len(liquidations))
+	for _, liquidation := range liquidations {
+		_, contractAddr, err := suite.network.App.LiquidVestingKeeper.Liquidate(ctx, fromAddr, fromAddr, liquidation)
+		suite.Require().NoError(err)
+		contracts = append(contracts, common.HexToAddress(contractAddr))
+	}
+
+	return contracts
+}
+
+// requireCleanedUp checks nothing bound to the liquid denom is left
+func (suite *KeeperTestSuite) requireCleanedUp(ctx sdk.Context, baseDenom string, contract common.Address) {
+	_, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, baseDenom)
+	suite.Require().False(found)
+	_, found = suite.network.App.BankKeeper.GetDenomMetaData(ctx, baseDenom)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.network.App.Erc20Keeper.GetTokenPairID(ctx, baseDenom))
+	suite.Require().False(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(contract))
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCleanupLiquidDenom {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyDenom && attr.Value == baseDenom {
+				return
+			}
+		}
+	}
+	suite.Fail("cleanup event not emitted", baseDenom)
+}
+
+func (suite *KeeperTestSuite) TestRedeemCleansUpExhaustedDenom() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	contracts := suite.setupLiquidDenoms(ctx, third[0], third[0])
+	suite.Require().True(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(contracts[0]))
+
+	// partial redeem keeps the denom
+	partial := sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 400_000)
+	err := suite.network.App.LiquidVestingKeeper.Redeem(ctx, fromAddr, fromAddr, partial)
+	suite.Require().NoError(err)
+	_, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, types.DenomBaseNameFromID(0))
+	suite.Require().True(found)
+	suite.Require().True(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(contracts[0]))
+
+	// redeem of the rest cleans it up
+	rest := sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 600_000)
+	err = suite.network.App.LiquidVestingKeeper.Redeem(ctx, fromAddr, fromAddr, rest)
+	suite.Require().NoError(err)
+	suite.requireCleanedUp(ctx, types.DenomBaseNameFromID(0), contracts[0])
+
+	// other liquid denom is untouched
+	_, found = suite.network.App.LiquidVestingKeeper.GetDenom(ctx, types.DenomBaseNameFromID(1))
+	suite.Require().True(found)
+	suite.Require().True(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(contracts[1]))
+}
+
+func (suite *KeeperTestSuite) TestCleanupExhaustedDenoms() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	contracts := suite.setupLiquidDenoms(ctx, third[0], third[0])
+
+	// exhaust the first liquid denom leaving its records behind,
+	// the way redeem used to do it
+	exhausted := sdk.NewCoins(sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000))
+	err := suite.network.App.BankKeeper.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, exhausted)
+	suite.Require().NoError(err)
+	err = suite.network.App.BankKeeper.BurnCoins(ctx, types.ModuleName, exhausted)
+	suite.Require().NoError(err)
+	suite.network.App.LiquidVestingKeeper.DeleteDenom(ctx, types.DenomBaseNameFromID(0))
+
+	err = suite.network.App.LiquidVestingKeeper.CleanupExhaustedDenoms(ctx)
+	suite.Require().NoError(err)
+
+	suite.requireCleanedUp(ctx, types.DenomBaseNameFromID(0), contracts[0])
+
+	_, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, types.DenomBaseNameFromID(1))
+	suite.Require().True(found)
+	suite.Require().NotEmpty(suite.network.App.Erc20Keeper.GetTokenPairID(ctx, types.DenomBaseNameFromID(1)))
+	suite.Require().True(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(contracts[1]))
+
+	// cleanup is idempotent and emits no more events
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.network.App.LiquidVestingKeeper.CleanupExhaustedDenoms(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(ctx.EventManager().Events())
+}
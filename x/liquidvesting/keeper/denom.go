@@ -8,26 +8,32 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/haqq-network/haqq/x/liquidvesting/types"
+	vestingtypes "github.com/haqq-network/haqq/x/vesting/types"
 )
 
-// CreateDenom creates new liquid denom and stores it
+// CreateDenom creates new liquid denom and stores it, funder is the clawback
+// funder of the unvested coins backing the denom, empty if all coins are vested
 func (k BaseKeeper) CreateDenom(
 	ctx sdk.Context,
 	originalDenom string,
 	startTime int64,
-	periods sdkvesting.Periods,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	funder string,
 ) (types.Denom, error) {
 	counter := k.GetDenomCounter(ctx)
 
 	denom := types.Denom{
-		StartTime:     time.Unix(startTime, 0),
-		LockupPeriods: periods,
-		OriginalDenom: originalDenom,
-		EndTime:       time.Unix(startTime+periods.TotalLength(), 0),
-		BaseDenom:     types.DenomBaseNameFromID(counter),
-		DisplayDenom:  types.DenomDisplayNameFromID(counter),
+		StartTime:      time.Unix(startTime, 0),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+		OriginalDenom:  originalDenom,
+		EndTime:        time.Unix(startTime+vestingtypes.Max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()), 0),
+		BaseDenom:      types.DenomBaseNameFromID(counter),
+		DisplayDenom:   types.DenomDisplayNameFromID(counter),
+		FunderAddress:  funder,
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKeyPrefix)
@@ -40,13 +46,14 @@ func (k BaseKeeper) CreateDenom(
 	return denom, nil
 }
 
-// UpdateDenomPeriods updates schedule periods bound to liquid denom
-func (k BaseKeeper) UpdateDenomPeriods(ctx sdk.Context, baseDenom string, newPeriods sdkvesting.Periods) error {
+// UpdateDenomPeriods updates lockup and vesting schedule periods bound to liquid denom
+func (k BaseKeeper) UpdateDenomPeriods(ctx sdk.Context, baseDenom string, newLockupPeriods, newVestingPeriods sdkvesting.Periods) error {
 	d, found := k.GetDenom(ctx, baseDenom)
 	if !found {
 		return types.ErrDenomNotFound
 	}
-	d.LockupPeriods = newPeriods
+	d.LockupPeriods = newLockupPeriods
+	d.VestingPeriods = newVestingPeriods
 	k.SetDenom(ctx, d)
 	return nil
 }
@@ -70,6 +77,21 @@ func (k BaseKeeper) GetDenom(ctx sdk.Context, baseDenom string) (val types.Denom
 	return val, true
 }
 
+// GetDenomByERC20 queries denom by the ERC20 contract address of its token pair
+func (k BaseKeeper) GetDenomByERC20(ctx sdk.Context, erc20Address common.Address) (val types.Denom, found bool) {
+	tokenPairID := k.erc20Keeper.GetTokenPairID(ctx, erc20Address.Hex())
+	if len(tokenPairID) == 0 {
+		return val, false
+	}
+
+	tokenPair, found := k.erc20Keeper.GetTokenPair(ctx, tokenPairID)
+	if !found {
+		return val, false
+	}
+
+	return k.GetDenom(ctx, tokenPair.Denom)
+}
+
 // SetDenom sets denom in the store
 func (k BaseKeeper) SetDenom(ctx sdk.Context, denom types.Denom) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKeyPrefix)
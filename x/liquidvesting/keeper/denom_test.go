@@ -6,7 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
+	utiltx "github.com/haqq-network/haqq/testutil/tx"
 	"github.com/haqq-network/haqq/utils"
+	erc20types "github.com/haqq-network/haqq/x/erc20/types"
 	"github.com/haqq-network/haqq/x/liquidvesting/types"
 )
 
@@ -25,7 +27,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 					{Length: 100000, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1_000_000))},
 				}
 
-				denom, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, startTime, periods)
+				denom, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, startTime, periods, nil, "")
 				suite.Require().NoError(err)
 
 				suite.Require().Equal(types.DenomBaseNameFromID(0), denom.BaseDenom)
@@ -46,7 +48,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 				counterBefore := suite.network.App.LiquidVestingKeeper.GetDenomCounter(ctx)
 				suite.Require().Equal(uint64(0), counterBefore)
 
-				_, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods)
+				_, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 
 				counterAfter := suite.network.App.LiquidVestingKeeper.GetDenomCounter(ctx)
@@ -60,17 +62,17 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 					{Length: 100000, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1_000_000))},
 				}
 
-				denom0, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods)
+				denom0, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.DenomBaseNameFromID(0), denom0.BaseDenom)
 				suite.Require().Equal(types.DenomDisplayNameFromID(0), denom0.DisplayDenom)
 
-				denom1, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 2_000_000, periods)
+				denom1, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 2_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.DenomBaseNameFromID(1), denom1.BaseDenom)
 				suite.Require().Equal(types.DenomDisplayNameFromID(1), denom1.DisplayDenom)
 
-				denom2, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 3_000_000, periods)
+				denom2, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 3_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 				suite.Require().Equal(types.DenomBaseNameFromID(2), denom2.BaseDenom)
 				suite.Require().Equal(types.DenomDisplayNameFromID(2), denom2.DisplayDenom)
@@ -87,7 +89,7 @@ func (suite *KeeperTestSuite) TestCreateDenom() {
 					{Length: 86400, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500_000))},
 				}
 
-				denom, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, startTime, periods)
+				denom, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, startTime, periods, nil, "")
 				suite.Require().NoError(err)
 
 				expectedStart := time.Unix(startTime, 0)
@@ -161,6 +163,36 @@ func (suite *KeeperTestSuite) TestSetAndGetDenom() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetDenomByERC20() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	denom := types.Denom{
+		BaseDenom:     types.DenomBaseNameFromID(0),
+		DisplayDenom:  types.DenomDisplayNameFromID(0),
+		OriginalDenom: utils.BaseDenom,
+		StartTime:     time.Unix(1_000_000, 0).UTC(),
+		EndTime:       time.Unix(1_100_000, 0).UTC(),
+	}
+	suite.network.App.LiquidVestingKeeper.SetDenom(ctx, denom)
+
+	erc20Address := utiltx.GenerateAddress()
+
+	// no token pair registered yet
+	_, found := suite.network.App.LiquidVestingKeeper.GetDenomByERC20(ctx, erc20Address)
+	suite.Require().False(found)
+
+	tokenPair := erc20types.NewTokenPair(erc20Address, denom.BaseDenom, erc20types.OWNER_MODULE)
+	suite.network.App.Erc20Keeper.SetTokenPair(ctx, tokenPair)
+	suite.network.App.Erc20Keeper.SetDenomMap(ctx, tokenPair.Denom, tokenPair.GetID())
+	suite.network.App.Erc20Keeper.SetERC20Map(ctx, erc20Address, tokenPair.GetID())
+
+	got, found := suite.network.App.LiquidVestingKeeper.GetDenomByERC20(ctx, erc20Address)
+	suite.Require().True(found)
+	suite.Require().Equal(denom.BaseDenom, got.BaseDenom)
+	suite.Require().Equal(denom.OriginalDenom, got.OriginalDenom)
+}
+
 func (suite *KeeperTestSuite) TestDeleteDenom() {
 	suite.SetupTest()
 	ctx := suite.network.GetContext()
@@ -218,7 +250,7 @@ func (suite *KeeperTestSuite) TestUpdateDenomPeriods() {
 					{Length: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500_000))},
 				}
 
-				err := suite.network.App.LiquidVestingKeeper.UpdateDenomPeriods(ctx, denom.BaseDenom, newPeriods)
+				err := suite.network.App.LiquidVestingKeeper.UpdateDenomPeriods(ctx, denom.BaseDenom, newPeriods, nil)
 				suite.Require().NoError(err)
 
 				got, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, denom.BaseDenom)
@@ -233,7 +265,7 @@ func (suite *KeeperTestSuite) TestUpdateDenomPeriods() {
 					{Length: 100000, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1_000_000))},
 				}
 
-				err := suite.network.App.LiquidVestingKeeper.UpdateDenomPeriods(ctx, types.DenomBaseNameFromID(999), newPeriods)
+				err := suite.network.App.LiquidVestingKeeper.UpdateDenomPeriods(ctx, types.DenomBaseNameFromID(999), newPeriods, nil)
 				suite.Require().Error(err)
 				suite.Require().ErrorIs(err, types.ErrDenomNotFound)
 			},
@@ -337,11 +369,11 @@ func (suite *KeeperTestSuite) TestDenomCounter() {
 
 				suite.Require().Equal(uint64(0), suite.network.App.LiquidVestingKeeper.GetDenomCounter(ctx))
 
-				_, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods)
+				_, err := suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 1_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 				suite.Require().Equal(uint64(1), suite.network.App.LiquidVestingKeeper.GetDenomCounter(ctx))
 
-				_, err = suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 2_000_000, periods)
+				_, err = suite.network.App.LiquidVestingKeeper.CreateDenom(ctx, utils.BaseDenom, 2_000_000, periods, nil, "")
 				suite.Require().NoError(err)
 				suite.Require().Equal(uint64(2), suite.network.App.LiquidVestingKeeper.GetDenomCounter(ctx))
 			},
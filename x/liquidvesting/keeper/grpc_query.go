@@ -56,3 +56,60 @@ func (k BaseKeeper) Denoms(goCtx context.Context, req *types.QueryDenomsRequest)
 
 	return &types.QueryDenomsResponse{Denoms: chains, Pagination: pageRes}, nil
 }
+
+// DenomSchedule retrieves liquid token schedule resolved at current block time
+func (k BaseKeeper) DenomSchedule(goCtx context.Context, req *types.QueryDenomScheduleRequest) (*types.QueryDenomScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, found := k.GetDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	schedule, err := denom.ResolveSchedule(denom.LockupPeriods.TotalAmount().AmountOf(denom.OriginalDenom), ctx.BlockTime())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomScheduleResponse{Schedule: schedule}, nil
+}
+
+// AccountLiquidPositions retrieves liquid tokens held by account with their
+// schedules resolved at current block time
+func (k BaseKeeper) AccountLiquidPositions(goCtx context.Context, req *types.QueryAccountLiquidPositionsRequest) (*types.QueryAccountLiquidPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryAccountLiquidPositionsResponse{
+		Positions:       []types.LiquidPosition{},
+		TotalRedeemable: sdk.NewCoins(),
+		TotalLocked:     sdk.NewCoins(),
+	}
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, address) {
+		denom, found := k.GetDenom(ctx, balance.Denom)
+		if !found {
+			continue
+		}
+
+		schedule, err := denom.ResolveSchedule(balance.Amount, ctx.BlockTime())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		res.Positions = append(res.Positions, types.LiquidPosition{Balance: balance, Schedule: schedule})
+		res.TotalRedeemable = res.TotalRedeemable.Add(schedule.Redeemable...)
+		res.TotalLocked = res.TotalLocked.Add(schedule.Locked...)
+	}
+
+	return res, nil
+}
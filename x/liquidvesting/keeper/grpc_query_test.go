@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/haqq-network/haqq/testutil"
 	"github.com/haqq-network/haqq/utils"
 	"github.com/haqq-network/haqq/x/liquidvesting/types"
 )
@@ -180,3 +182,184 @@ func (suite *KeeperTestSuite) TestDenomsQuery() {
 		})
 	}
 }
+
+// setScheduledDenom stores liquid denom started 150 seconds before current block
+// time with one of two lockup tranches and both vesting tranches released
+func (suite *KeeperTestSuite) setScheduledDenom(ctx sdk.Context) types.Denom {
+	denom := types.Denom{
+		BaseDenom:     types.DenomBaseNameFromID(0),
+		DisplayDenom:  types.DenomDisplayNameFromID(0),
+		OriginalDenom: utils.BaseDenom,
+		StartTime:     ctx.BlockTime().Add(-150 * time.Second),
+		EndTime:       ctx.BlockTime().Add(50 * time.Second),
+		LockupPeriods: sdkvesting.Periods{
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))},
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))},
+		},
+		VestingPeriods: sdkvesting.Periods{
+			{Length: 50, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500))},
+			{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1500))},
+		},
+	}
+	suite.network.App.LiquidVestingKeeper.SetDenom(ctx, denom)
+
+	return denom
+}
+
+func (suite *KeeperTestSuite) TestDenomScheduleQuery() {
+	testCases := []struct {
+		name        string
+		req         *types.QueryDenomScheduleRequest
+		expectPass  bool
+		expectedErr codes.Code
+	}{
+		{
+			name:       "ok - get existing denom schedule",
+			req:        &types.QueryDenomScheduleRequest{Denom: types.DenomBaseNameFromID(0)},
+			expectPass: true,
+		},
+		{
+			name:        "fail - denom not found",
+			req:         &types.QueryDenomScheduleRequest{Denom: "aLIQUID99"},
+			expectPass:  false,
+			expectedErr: codes.NotFound,
+		},
+		{
+			name:        "fail - nil request",
+			req:         nil,
+			expectPass:  false,
+			expectedErr: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			denom := suite.setScheduledDenom(ctx)
+
+			var (
+				resp *types.QueryDenomScheduleResponse
+				err  error
+			)
+			if tc.req == nil {
+				resp, err = suite.network.App.LiquidVestingKeeper.DenomSchedule(ctx, nil)
+			} else {
+				resp, err = suite.queryClient.DenomSchedule(context.Background(), tc.req)
+			}
+
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				schedule := resp.Schedule
+				suite.Require().Equal(denom.BaseDenom, schedule.Denom)
+
+				suite.Require().Len(schedule.LockupTranches, 2)
+				suite.Require().True(schedule.LockupTranches[0].UnlockTime.Equal(denom.StartTime.Add(100 * time.Second)))
+				suite.Require().True(schedule.LockupTranches[0].Released)
+				suite.Require().True(schedule.LockupTranches[1].UnlockTime.Equal(denom.EndTime))
+				suite.Require().False(schedule.LockupTranches[1].Released)
+
+				suite.Require().Len(schedule.VestingTranches, 2)
+				suite.Require().True(schedule.VestingTranches[0].Released)
+				suite.Require().True(schedule.VestingTranches[1].Released)
+
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)).String(), schedule.Redeemable.String())
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)).String(), schedule.Locked.String())
+			} else {
+				suite.Require().Error(err)
+				st, ok := status.FromError(err)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.expectedErr, st.Code())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccountLiquidPositionsQuery() {
+	holderAddr := sdk.AccAddress([]byte("liquid_positions_holder"))
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		req         *types.QueryAccountLiquidPositionsRequest
+		expectPass  bool
+		expectedLen int
+		redeemable  sdk.Coins
+		locked      sdk.Coins
+		expectedErr codes.Code
+	}{
+		{
+			name:        "ok - no liquid tokens",
+			malleate:    func(_ sdk.Context) {},
+			req:         &types.QueryAccountLiquidPositionsRequest{Address: holderAddr.String()},
+			expectPass:  true,
+			expectedLen: 0,
+			redeemable:  sdk.NewCoins(),
+			locked:      sdk.NewCoins(),
+		},
+		{
+			name: "ok - share of liquid token schedule",
+			malleate: func(ctx sdk.Context) {
+				suite.setScheduledDenom(ctx)
+				err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, holderAddr, sdk.NewCoins(
+					sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 500),
+					sdk.NewInt64Coin(utils.BaseDenom, 1000),
+				))
+				suite.Require().NoError(err)
+			},
+			req:         &types.QueryAccountLiquidPositionsRequest{Address: holderAddr.String()},
+			expectPass:  true,
+			expectedLen: 1,
+			redeemable:  sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 250)),
+			locked:      sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 250)),
+		},
+		{
+			name:        "fail - invalid address",
+			malleate:    func(_ sdk.Context) {},
+			req:         &types.QueryAccountLiquidPositionsRequest{Address: "invalid"},
+			expectPass:  false,
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			name:        "fail - nil request",
+			malleate:    func(_ sdk.Context) {},
+			req:         nil,
+			expectPass:  false,
+			expectedErr: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+
+			tc.malleate(ctx)
+
+			var (
+				resp *types.QueryAccountLiquidPositionsResponse
+				err  error
+			)
+			if tc.req == nil {
+				resp, err = suite.network.App.LiquidVestingKeeper.AccountLiquidPositions(ctx, nil)
+			} else {
+				resp, err = suite.queryClient.AccountLiquidPositions(context.Background(), tc.req)
+			}
+
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().Len(resp.Positions, tc.expectedLen)
+				suite.Require().Equal(tc.redeemable.String(), resp.TotalRedeemable.String())
+				suite.Require().Equal(tc.locked.String(), resp.TotalLocked.String())
+			} else {
+				suite.Require().Error(err)
+				st, ok := status.FromError(err)
+				suite.Require().True(ok)
+				suite.Require().Equal(tc.expectedErr, st.Code())
+			}
+		})
+	}
+}
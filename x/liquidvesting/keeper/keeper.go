@@ -12,6 +12,7 @@ import (
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/haqq-network/haqq/utils"
 	erc20types "github.com/haqq-network/haqq/x/erc20/types"
@@ -25,10 +26,8 @@ var _ Keeper = (*BaseKeeper)(nil)
 type Keeper interface {
 	Liquidate(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) (sdk.Coin, string, error)
 	Redeem(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) error
-
-	// Bank balance helpers used by the precompile to mirror SDK bank deltas into
-	// the EVM StateDB journal when the precompile is invoked from a contract.
-	BaseDenomBankBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int
+	MergeLiquid(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amounts sdk.Coins) (sdk.Coin, string, error)
+	SplitLiquid(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) (sdk.Coin, string, error)
 
 	// Params methods
 	GetParams(ctx sdk.Context) types.Params
@@ -38,19 +37,23 @@ type Keeper interface {
 	ResetParamsToDefault(ctx sdk.Context)
 
 	// Denom methods
-	CreateDenom(ctx sdk.Context, originalDenom string, startTime int64, periods sdkvesting.Periods) (types.Denom, error)
-	UpdateDenomPeriods(ctx sdk.Context, baseDenom string, newPeriods sdkvesting.Periods) error
+	CreateDenom(ctx sdk.Context, originalDenom string, startTime int64, lockupPeriods, vestingPeriods sdkvesting.Periods, funder string) (types.Denom, error)
+	UpdateDenomPeriods(ctx sdk.Context, baseDenom string, newLockupPeriods, newVestingPeriods sdkvesting.Periods) error
 	DeleteDenom(ctx sdk.Context, baseDenom string)
 	GetDenom(ctx sdk.Context, baseDenom string) (val types.Denom, found bool)
+	GetDenomByERC20(ctx sdk.Context, erc20Address common.Address) (val types.Denom, found bool)
 	SetDenom(ctx sdk.Context, denom types.Denom)
 	GetAllDenoms(ctx sdk.Context) []types.Denom
 	GetDenomCounter(ctx sdk.Context) uint64
 	SetDenomCounter(ctx sdk.Context, counter uint64)
 	IterateDenoms(ctx sdk.Context, cb func(account types.Denom) (stop bool))
+	CleanupExhaustedDenoms(ctx sdk.Context) error
 
 	// grpc query endpoints
 	Denom(goCtx context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error)
 	Denoms(goCtx context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error)
+	DenomSchedule(goCtx context.Context, req *types.QueryDenomScheduleRequest) (*types.QueryDenomScheduleResponse, error)
+	AccountLiquidPositions(goCtx context.Context, req *types.QueryAccountLiquidPositionsRequest) (*types.QueryAccountLiquidPositionsResponse, error)
 }
 
 type BaseKeeper struct {
@@ -90,15 +93,6 @@ func NewKeeper(
 	}
 }
 
-// BaseDenomBankBalance returns the bank balance of aISLM for addr (utils.BaseDenom).
-// On Haqq, EvmDenom defaults to utils.BaseDenom; this is the balance the EVM keeper
-// reconciles for native EVM accounts on tx commit, so the liquid precompile uses it
-// to compute the bank delta produced by Liquidate/Redeem keeper calls and mirror
-// that delta into the EVM StateDB journal when the caller is a contract.
-func (k BaseKeeper) BaseDenomBankBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int {
-	return k.bankKeeper.GetBalance(ctx, addr, utils.BaseDenom).Amount
-}
-
 // Liquidate liquidates specified amount of token locked in vesting into liquid token
 func (k BaseKeeper) Liquidate(ctx sdk.Context, liquidateFromAddress sdk.AccAddress, liquidateToAddress sdk.AccAddress, amount sdk.Coin) (sdk.Coin, string, error) {
 	if !k.IsLiquidVestingEnabled(ctx) {
@@ -131,38 +125,41 @@ func (k BaseKeeper) Liquidate(ctx sdk.Context, liquidateFromAddress sdk.AccAddre
 		return sdk.Coin{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s is regular nothing to liquidate", liquidateFrom)
 	}
 
-	// check there is not vesting periods on the schedule
-	if !va.GetVestingCoins(ctx.BlockTime()).IsZero() {
-		return sdk.Coin{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has vesting ongoing periods, unable to liquidate unvested coins", liquidateFrom)
-	}
-
-	// check account has liquidation target denom locked in vesting
-	hasTargetDenom, lockedBalance := va.GetLockedUpCoins(ctx.BlockTime()).Find(amount.Denom)
+	// check account has liquidation target denom locked in vesting or still vesting
+	liquidatableCoins := va.OriginalVesting.Sub(va.GetUnlockedVestedCoins(ctx.BlockTime())...)
+	hasTargetDenom, liquidatableBalance := liquidatableCoins.Find(amount.Denom)
 	if !(hasTargetDenom) {
 		return sdk.Coin{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s doesn't contain coin specified as liquidation target", liquidateFrom)
 	}
 
-	// validate current locked periods have sufficient amount to be liquidated
-	if lockedBalance.IsLT(amount) {
+	// validate current locked and vesting periods have sufficient amount to be liquidated
+	if liquidatableBalance.IsLT(amount) {
 		return sdk.Coin{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s doesn't have sufficient amount of target coin for liquidation", liquidateFrom)
 	}
 
-	// calculate new schedule
-	upcomingPeriods := types.ExtractUpcomingPeriods(va.GetStartTime(), va.GetEndTime(), va.LockupPeriods, ctx.BlockTime().Unix())
-	decreasedPeriods, diffPeriods, err := types.SubtractAmountFromPeriods(upcomingPeriods, amount)
-	if err != nil {
-		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to calculate new schedule: %s", err.Error())
+	// unvested coins are taken first, the liquid denom keeps the account funder
+	// so the redeemed unvested coins can still be clawed back
+	var funder string
+	if hasUnvested, _ := va.GetVestingCoins(ctx.BlockTime()).Find(amount.Denom); hasUnvested {
+		funder = va.FunderAddress
 	}
-	va.LockupPeriods = types.ReplacePeriodsTail(va.LockupPeriods, decreasedPeriods)
-	va.OriginalVesting = va.OriginalVesting.Sub(amount)
 
-	// all vesting periods are completed at this point, so we can reduce amounts without additional extracting logic
-	decreasedVestingPeriods, _, err := types.SubtractAmountFromPeriods(va.VestingPeriods, amount)
+	// calculate new schedules, the liquidated amount is taken from the upcoming
+	// lockup and vesting periods first, so the unlocked vested coins of the
+	// account stay the same
+	blockTime := ctx.BlockTime().Unix()
+	decreasedLockupPeriods, lockupDiffPeriods, err := types.SubtractAmountFromSchedule(va.GetStartTime(), va.GetEndTime(), va.LockupPeriods, amount, blockTime)
 	if err != nil {
-		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to calculate new schedule: %s", err.Error())
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to calculate new lockup schedule: %s", err.Error())
+	}
+	decreasedVestingPeriods, vestingDiffPeriods, err := types.SubtractAmountFromSchedule(va.GetStartTime(), va.GetEndTime(), va.VestingPeriods, amount, blockTime)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to calculate new vesting schedule: %s", err.Error())
 	}
 
-	va.VestingPeriods = types.ReplacePeriodsTail(va.VestingPeriods, decreasedVestingPeriods)
+	va.LockupPeriods = decreasedLockupPeriods
+	va.VestingPeriods = decreasedVestingPeriods
+	va.OriginalVesting = va.OriginalVesting.Sub(amount)
 
 	k.accountKeeper.SetAccount(ctx, va)
 
@@ -172,62 +169,14 @@ func (k BaseKeeper) Liquidate(ctx sdk.Context, liquidateFromAddress sdk.AccAddre
 		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to transfer liquidated locked coins from account to module: %s", err.Error())
 	}
 
-	diffPeriods[0].Length -= types.CurrentPeriodShift(va.StartTime.Unix(), ctx.BlockTime().Unix(), va.LockupPeriods)
-	liquidDenom, err := k.CreateDenom(ctx, amount.Denom, ctx.BlockTime().Unix(), diffPeriods)
+	liquidDenom, err := k.CreateDenom(ctx, amount.Denom, blockTime, lockupDiffPeriods, vestingDiffPeriods, funder)
 	if err != nil {
 		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to create denom for liquid token: %s", err.Error())
 	}
 
-	// create new sdk denom for liquidated locked coins
-	liquidTokenMetadata := banktypes.Metadata{
-		Description: "Liquid vesting token",
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    liquidDenom.GetBaseDenom(),
-				Exponent: 0,
-			},
-			{
-				Denom:    liquidDenom.GetDisplayDenom(),
-				Exponent: 18,
-			},
-		},
-		Base:    liquidDenom.GetBaseDenom(),
-		Display: liquidDenom.GetDisplayDenom(),
-		Name:    liquidDenom.GetDisplayDenom(),
-		Symbol:  liquidDenom.GetDisplayDenom(),
-	}
-
-	liquidTokenCoin := sdk.NewCoin(liquidDenom.GetBaseDenom(), amount.Amount)
-	liquidTokenCoins := sdk.NewCoins(liquidTokenCoin)
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, liquidTokenCoins)
-	if err != nil {
-		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to mint liquid token: %s", err.Error())
-	}
-
-	k.bankKeeper.SetDenomMetaData(ctx, liquidTokenMetadata)
-
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidateToAddress, liquidTokenCoins)
-	if err != nil {
-		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to transfer liquid tokens to account %s", err.Error())
-	}
-
-	// bind newly created denom to erc20 token
-	// Create dummy IBC denom, just to bind ERC20 Precompile with newly created aLiquid denom
-	fakeIBCDenom := utils.ComputeIBCDenom(types.ModuleName, liquidTokenMetadata.Base, amount.Denom)
-	tokenPair, err := erc20types.NewTokenPairSTRv2(fakeIBCDenom)
+	liquidTokenCoin, contractAddr, err := k.mintLiquidToken(ctx, liquidDenom, amount.Amount, liquidateToAddress)
 	if err != nil {
-		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrLiquidationFailed, "failed to create erc20 token pair: %s", err.Error())
-	}
-	// Set real denom to token pair, so precompile could handle transfers properly
-	tokenPair.Denom = liquidTokenMetadata.Base
-	// k.erc20Keeper.SetToken(ctx, tokenPair) unwrap it below due to pointer receiver in original method.
-	k.erc20Keeper.SetTokenPair(ctx, tokenPair)
-	k.erc20Keeper.SetDenomMap(ctx, tokenPair.Denom, tokenPair.GetID())
-	k.erc20Keeper.SetERC20Map(ctx, tokenPair.GetERC20Contract(), tokenPair.GetID())
-
-	err = k.erc20Keeper.EnableDynamicPrecompiles(ctx, tokenPair.GetERC20Contract())
-	if err != nil {
-		return sdk.Coin{}, "", err
+		return sdk.Coin{}, "", errorsmod.Wrap(types.ErrLiquidationFailed, err.Error())
 	}
 
 	ctx.EventManager().EmitEvents(
@@ -241,7 +190,7 @@ func (k BaseKeeper) Liquidate(ctx sdk.Context, liquidateFromAddress sdk.AccAddre
 		},
 	)
 
-	return liquidTokenCoin, tokenPair.Erc20Address, nil
+	return liquidTokenCoin, contractAddr, nil
 }
 
 // Redeem redeems specified amount of liquid token into original locked token and adds them to account
@@ -271,47 +220,13 @@ func (k BaseKeeper) Redeem(ctx sdk.Context, fromAddress sdk.AccAddress, toAddres
 		return errorsmod.Wrapf(errortypes.ErrNotFound, "token pair for denom %s not found", amount.Denom)
 	}
 
-	// check fromAccount has enough liquid token in balance
-	if balance := k.bankKeeper.GetBalance(ctx, fromAddress, amount.Denom); balance.IsLT(amount) {
-		return errorsmod.Wrapf(types.ErrRedeemFailed, "from account has insufficient balance")
-	}
-
-	// transfer liquid denom to liquidvesting module
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, types.ModuleName, sdk.NewCoins(amount))
+	// burn liquid token specified amount and subtract it from token schedules,
+	// liquid denom with no supply left is cleaned up
+	_, lockupDiffPeriods, vestingDiffPeriods, err := k.burnLiquidToken(ctx, fromAddress, amount)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to transfer liquid token to module: %s", err.Error())
+		return errorsmod.Wrap(types.ErrRedeemFailed, err.Error())
 	}
-
-	// burn liquid token specified amount
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to burn liquid tokens: %s", err.Error())
-	}
-
-	// subtract burned amount from token schedule
 	originalDenomCoin := sdk.NewCoin(liquidDenom.GetOriginalDenom(), amount.Amount)
-	decreasedPeriods, diffPeriods, err := types.SubtractAmountFromPeriods(liquidDenom.LockupPeriods, originalDenomCoin)
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to calculate new liquid denom schedule: %s", err.Error())
-	}
-	// save modified token schedule
-	if decreasedPeriods.TotalAmount().IsZero() {
-		k.DeleteDenom(ctx, liquidDenom.GetBaseDenom())
-		if tokenPair.Enabled {
-			_, err := k.erc20Keeper.ToggleConversion(ctx, &erc20types.MsgToggleConversion{
-				Authority: k.accountKeeper.GetModuleAddress("gov").String(),
-				Token:     amount.Denom,
-			})
-			if err != nil {
-				return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to disable conversion: %s", err.Error())
-			}
-		}
-	} else {
-		err = k.UpdateDenomPeriods(ctx, liquidDenom.GetBaseDenom(), decreasedPeriods)
-		if err != nil {
-			return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to update liquid denom schedule: %s", err.Error())
-		}
-	}
 
 	// transfer original token to account
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, sdk.NewCoins(originalDenomCoin))
@@ -319,15 +234,21 @@ func (k BaseKeeper) Redeem(ctx sdk.Context, fromAddress sdk.AccAddress, toAddres
 		return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to transfer original denom to target account: %s", err.Error())
 	}
 
-	upcomingPeriods := types.ExtractUpcomingPeriods(
+	upcomingLockupPeriods := types.ExtractUpcomingPeriods(
 		liquidDenom.GetStartTime().Unix(),
 		liquidDenom.GetEndTime().Unix(),
-		diffPeriods,
+		lockupDiffPeriods,
+		ctx.BlockTime().Unix(),
+	)
+	upcomingVestingPeriods := types.ExtractUpcomingPeriods(
+		liquidDenom.GetStartTime().Unix(),
+		liquidDenom.GetEndTime().Unix(),
+		vestingDiffPeriods,
 		ctx.BlockTime().Unix(),
 	)
 
-	// if there are upcoming periods, apply vesting schedule on target account
-	if len(upcomingPeriods) > 0 {
+	// if there are upcoming periods, apply lockup and vesting schedules on target account
+	if len(upcomingLockupPeriods) > 0 || len(upcomingVestingPeriods) > 0 {
 		funder := k.accountKeeper.GetModuleAddress(types.ModuleName)
 		if funder == nil {
 			return errorsmod.Wrapf(types.ErrRedeemFailed, "failed to get funder address")
@@ -342,6 +263,11 @@ func (k BaseKeeper) Redeem(ctx sdk.Context, fromAddress sdk.AccAddress, toAddres
 		if isClawback {
 			funder = sdk.MustAccAddressFromBech32(toVestingAcc.FunderAddress)
 		}
+		// unvested coins are granted on behalf of the funder of the liquidated
+		// account, so they stay within its clawback reach
+		if len(upcomingVestingPeriods) > 0 && liquidDenom.FunderAddress != "" {
+			funder = sdk.MustAccAddressFromBech32(liquidDenom.FunderAddress)
+		}
 
 		_, _, _, err = k.vestingKeeper.ApplyVestingSchedule(
 			ctx,
@@ -349,8 +275,8 @@ func (k BaseKeeper) Redeem(ctx sdk.Context, fromAddress sdk.AccAddress, toAddres
 			toAddress,
 			sdk.NewCoins(originalDenomCoin),
 			liquidDenom.GetStartTime(),
-			diffPeriods,
-			sdkvesting.Periods{{Length: 0, Amount: sdk.NewCoins(originalDenomCoin)}},
+			lockupDiffPeriods,
+			vestingDiffPeriods,
 			true,
 		)
 		if err != nil {
@@ -371,3 +297,241 @@ func (k BaseKeeper) Redeem(ctx sdk.Context, fromAddress sdk.AccAddress, toAddres
 
 	return nil
 }
+
+// MergeLiquid burns specified amounts of several liquid tokens and mints a new liquid token
+// with the schedule combined from the burnt amounts schedules
+func (k BaseKeeper) MergeLiquid(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amounts sdk.Coins) (sdk.Coin, string, error) {
+	if !k.IsLiquidVestingEnabled(ctx) {
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrModuleIsDisabled, "liquid vesting module is disabled")
+	}
+
+	if len(amounts) < 2 {
+		return sdk.Coin{}, "", errorsmod.Wrapf(errortypes.ErrInvalidRequest, "at least two different liquid denoms required to merge")
+	}
+
+	var (
+		originalDenom, funder         string
+		startTime                     int64
+		lockupPeriods, vestingPeriods sdkvesting.Periods
+		mergedAmount                  = sdkmath.ZeroInt()
+	)
+	for i, amount := range amounts {
+		liquidDenom, lockupDiffPeriods, vestingDiffPeriods, err := k.burnLiquidToken(ctx, fromAddress, amount)
+		if err != nil {
+			return sdk.Coin{}, "", errorsmod.Wrap(types.ErrMergeFailed, err.Error())
+		}
+
+		// unvested coins of different funders can't share a clawback funder
+		if liquidDenom.FunderAddress != "" && liquidDenom.HasUnvestedCoins(ctx.BlockTime()) {
+			if funder != "" && funder != liquidDenom.FunderAddress {
+				return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrMergeFailed, "liquid denoms have unvested coins of different funders: %s, %s", funder, liquidDenom.FunderAddress)
+			}
+			funder = liquidDenom.FunderAddress
+		}
+
+		denomStartTime := liquidDenom.GetStartTime().Unix()
+		if i == 0 {
+			originalDenom = liquidDenom.GetOriginalDenom()
+			startTime = denomStartTime
+			lockupPeriods = lockupDiffPeriods
+			vestingPeriods = vestingDiffPeriods
+		} else {
+			if liquidDenom.GetOriginalDenom() != originalDenom {
+				return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrMergeFailed, "liquid denoms derived from different original denoms: %s, %s", originalDenom, liquidDenom.GetOriginalDenom())
+			}
+
+			// combine schedules period-wise, the resulting schedule starts at the earliest start time
+			var mergedStartTime int64
+			mergedStartTime, _, lockupPeriods = vestingtypes.DisjunctPeriods(startTime, denomStartTime, lockupPeriods, lockupDiffPeriods)
+			_, _, vestingPeriods = vestingtypes.DisjunctPeriods(startTime, denomStartTime, vestingPeriods, vestingDiffPeriods)
+			startTime = mergedStartTime
+		}
+
+		mergedAmount = mergedAmount.Add(amount.Amount)
+	}
+
+	liquidDenom, err := k.CreateDenom(ctx, originalDenom, startTime, lockupPeriods, vestingPeriods, funder)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrMergeFailed, "failed to create denom for liquid token: %s", err.Error())
+	}
+
+	liquidTokenCoin, contractAddr, err := k.mintLiquidToken(ctx, liquidDenom, mergedAmount, toAddress)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrap(types.ErrMergeFailed, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMergeLiquid,
+				sdk.NewAttribute(sdk.AttributeKeySender, fromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, toAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amounts.String()),
+				sdk.NewAttribute(types.AttributeKeyMinted, liquidTokenCoin.String()),
+			),
+		},
+	)
+
+	return liquidTokenCoin, contractAddr, nil
+}
+
+// SplitLiquid burns specified amount of liquid token and mints a new liquid token with
+// the schedule proportional to the burnt amount
+func (k BaseKeeper) SplitLiquid(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) (sdk.Coin, string, error) {
+	if !k.IsLiquidVestingEnabled(ctx) {
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrModuleIsDisabled, "liquid vesting module is disabled")
+	}
+
+	liquidDenom, lockupDiffPeriods, vestingDiffPeriods, err := k.burnLiquidToken(ctx, fromAddress, amount)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrap(types.ErrSplitFailed, err.Error())
+	}
+
+	// keep denoms liquidated from fully vested coins without vesting periods
+	if len(liquidDenom.VestingPeriods) == 0 {
+		vestingDiffPeriods = nil
+	}
+
+	splitDenom, err := k.CreateDenom(ctx, liquidDenom.GetOriginalDenom(), liquidDenom.GetStartTime().Unix(), lockupDiffPeriods, vestingDiffPeriods, liquidDenom.FunderAddress)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(types.ErrSplitFailed, "failed to create denom for liquid token: %s", err.Error())
+	}
+
+	liquidTokenCoin, contractAddr, err := k.mintLiquidToken(ctx, splitDenom, amount.Amount, toAddress)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrap(types.ErrSplitFailed, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSplitLiquid,
+				sdk.NewAttribute(sdk.AttributeKeySender, fromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, toAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyMinted, liquidTokenCoin.String()),
+			),
+		},
+	)
+
+	return liquidTokenCoin, contractAddr, nil
+}
+
+// burnLiquidToken burns specified amount of liquid token from account and subtracts it
+// from the liquid denom schedules. Denom left with no supply is cleaned up.
+// Returns the liquid denom state before the burn and the schedules of the burnt amount.
+func (k BaseKeeper) burnLiquidToken(
+	ctx sdk.Context,
+	fromAddress sdk.AccAddress,
+	amount sdk.Coin,
+) (liquidDenom types.Denom, lockupDiffPeriods, vestingDiffPeriods sdkvesting.Periods, err error) {
+	liquidDenom, found := k.GetDenom(ctx, amount.Denom)
+	if !found {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(types.ErrDenomNotFound, "liquidDenom %s does not exist", amount.Denom)
+	}
+
+	if balance := k.bankKeeper.GetBalance(ctx, fromAddress, amount.Denom); balance.IsLT(amount) {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "account has insufficient balance of %s", amount.Denom)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to transfer liquid token to module")
+	}
+
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to burn liquid tokens")
+	}
+
+	originalDenomCoin := sdk.NewCoin(liquidDenom.GetOriginalDenom(), amount.Amount)
+	decreasedLockupPeriods, lockupDiffPeriods, err := types.SubtractAmountFromPeriods(liquidDenom.LockupPeriods, originalDenomCoin)
+	if err != nil {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to calculate new liquid denom lockup schedule")
+	}
+	decreasedVestingPeriods, vestingDiffPeriods, err := types.SubtractAmountFromPeriods(liquidDenom.GetVestingSchedule(), originalDenomCoin)
+	if err != nil {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to calculate new liquid denom vesting schedule")
+	}
+
+	// liquid denom with no supply left is cleaned up
+	if k.bankKeeper.GetSupply(ctx, amount.Denom).IsZero() {
+		if err := k.cleanupLiquidDenom(ctx, liquidDenom.GetBaseDenom()); err != nil {
+			return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to clean up liquid denom")
+		}
+		return liquidDenom, lockupDiffPeriods, vestingDiffPeriods, nil
+	}
+
+	// keep denoms liquidated from fully vested coins without vesting periods
+	if len(liquidDenom.VestingPeriods) == 0 {
+		decreasedVestingPeriods = nil
+	}
+	if err := k.UpdateDenomPeriods(ctx, liquidDenom.GetBaseDenom(), decreasedLockupPeriods, decreasedVestingPeriods); err != nil {
+		return types.Denom{}, nil, nil, errorsmod.Wrapf(err, "failed to update liquid denom schedule")
+	}
+
+	return liquidDenom, lockupDiffPeriods, vestingDiffPeriods, nil
+}
+
+// mintLiquidToken mints specified amount of liquid token for the stored liquid denom,
+// sends it to account and binds the denom to a new erc20 token pair
+func (k BaseKeeper) mintLiquidToken(
+	ctx sdk.Context,
+	liquidDenom types.Denom,
+	amount sdkmath.Int,
+	toAddress sdk.AccAddress,
+) (sdk.Coin, string, error) {
+	// create new sdk denom for liquid token
+	liquidTokenMetadata := banktypes.Metadata{
+		Description: "Liquid vesting token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    liquidDenom.GetBaseDenom(),
+				Exponent: 0,
+			},
+			{
+				Denom:    liquidDenom.GetDisplayDenom(),
+				Exponent: 18,
+			},
+		},
+		Base:    liquidDenom.GetBaseDenom(),
+		Display: liquidDenom.GetDisplayDenom(),
+		Name:    liquidDenom.GetDisplayDenom(),
+		Symbol:  liquidDenom.GetDisplayDenom(),
+	}
+
+	liquidTokenCoin := sdk.NewCoin(liquidDenom.GetBaseDenom(), amount)
+	liquidTokenCoins := sdk.NewCoins(liquidTokenCoin)
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, liquidTokenCoins)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(err, "failed to mint liquid token")
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, liquidTokenMetadata)
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, liquidTokenCoins)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(err, "failed to transfer liquid tokens to account")
+	}
+
+	// bind newly created denom to erc20 token
+	// Create dummy IBC denom, just to bind ERC20 Precompile with newly created aLiquid denom
+	fakeIBCDenom := utils.ComputeIBCDenom(types.ModuleName, liquidTokenMetadata.Base, liquidDenom.GetOriginalDenom())
+	tokenPair, err := erc20types.NewTokenPairSTRv2(fakeIBCDenom)
+	if err != nil {
+		return sdk.Coin{}, "", errorsmod.Wrapf(err, "failed to create erc20 token pair")
+	}
+	// Set real denom to token pair, so precompile could handle transfers properly
+	tokenPair.Denom = liquidTokenMetadata.Base
+	// k.erc20Keeper.SetToken(ctx, tokenPair) unwrap it below due to pointer receiver in original method.
+	k.erc20Keeper.SetTokenPair(ctx, tokenPair)
+	k.erc20Keeper.SetDenomMap(ctx, tokenPair.Denom, tokenPair.GetID())
+	k.erc20Keeper.SetERC20Map(ctx, tokenPair.GetERC20Contract(), tokenPair.GetID())
+
+	err = k.erc20Keeper.EnableDynamicPrecompiles(ctx, tokenPair.GetERC20Contract())
+	if err != nil {
+		return sdk.Coin{}, "", err
+	}
+
+	return liquidTokenCoin, tokenPair.Erc20Address, nil
+}
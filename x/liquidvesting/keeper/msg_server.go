@@ -64,3 +64,49 @@ func (k msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.M
 
 	return &types.MsgRedeemResponse{}, nil
 }
+
+// MergeLiquid merges specified amounts of several liquid tokens into a new liquid token
+func (k msgServer) MergeLiquid(goCtx context.Context, msg *types.MsgMergeLiquid) (*types.MsgMergeLiquidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// already validated in ValidateBasic
+	fromAddress := sdk.MustAccAddressFromBech32(msg.MergeFrom)
+	toAddress := sdk.MustAccAddressFromBech32(msg.MergeTo)
+
+	liquidTokenCoin, contractAddr, err := k.Keeper.MergeLiquid(ctx, fromAddress, toAddress, msg.Amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMergeLiquidResponse{
+		Minted:       liquidTokenCoin,
+		ContractAddr: contractAddr,
+	}, nil
+}
+
+// SplitLiquid splits specified amount of liquid token into a new liquid token
+func (k msgServer) SplitLiquid(goCtx context.Context, msg *types.MsgSplitLiquid) (*types.MsgSplitLiquidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// already validated in ValidateBasic
+	fromAddress := sdk.MustAccAddressFromBech32(msg.SplitFrom)
+	toAddress := sdk.MustAccAddressFromBech32(msg.SplitTo)
+
+	liquidTokenCoin, contractAddr, err := k.Keeper.SplitLiquid(ctx, fromAddress, toAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSplitLiquidResponse{
+		Minted:       liquidTokenCoin,
+		ContractAddr: contractAddr,
+	}, nil
+}
@@ -34,6 +34,11 @@ var (
 	vestingPeriods = sdkvesting.Periods{
 		{Length: 0, Amount: amount},
 	}
+	ongoingVestingPeriods = sdkvesting.Periods{
+		{Length: 0, Amount: third},
+		{Length: 100000, Amount: third},
+		{Length: 100000, Amount: third},
+	}
 	funder   = sdk.AccAddress(types.ModuleName)
 	fromAddr sdk.AccAddress
 	toAddr   sdk.AccAddress
@@ -193,7 +198,7 @@ func (suite *KeeperTestSuite) TestLiquidate() {
 			expectPass: false,
 		},
 		{
-			name: "fail - vesting periods have length",
+			name: "ok - liquidate coins still vesting",
 			malleate: func() {
 				baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
 				baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
@@ -205,6 +210,21 @@ func (suite *KeeperTestSuite) TestLiquidate() {
 				suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
 			},
 			amount:     sdk.NewInt64Coin(utils.BaseDenom, 2_000_000),
+			expectPass: true,
+		},
+		{
+			name: "fail - liquidate vested and unlocked coins",
+			malleate: func() {
+				baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+				baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+				vestingPeriods := sdkvesting.Periods{{Length: 100, Amount: amount}}
+				startTime := ctx.BlockTime().Add(-100001 * time.Second)
+				clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, amount, startTime, lockupPeriods, vestingPeriods, nil)
+				err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+				suite.Require().NoError(err)
+				suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+			},
+			amount:     sdk.NewInt64Coin(utils.BaseDenom, 2_500_000),
 			expectPass: false,
 		},
 	}
@@ -367,6 +387,48 @@ func (suite *KeeperTestSuite) TestMultipleLiquidationsFromOneAccount() {
 	suite.Require().Equal(third[0].Amount.String(), balanceOfLiquidTokeErc20Pair1.String())
 }
 
+func (suite *KeeperTestSuite) TestClawbackAfterRedeemOfUnvestedCoins() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	fromAccNumber := suite.keyring.AddKey()
+	fromAddr = suite.keyring.GetAccAddr(fromAccNumber)
+	toAccNumber := suite.keyring.AddKey()
+	toAddr = suite.keyring.GetAccAddr(toAccNumber)
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+	baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+	startTime := ctx.BlockTime().Add(-10 * time.Second)
+	clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, amount, startTime, lockupPeriods, ongoingVestingPeriods, nil)
+	err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+	suite.Require().NoError(err)
+	suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+
+	// liquidation takes one of the two unvested thirds
+	_, _, err = suite.network.App.LiquidVestingKeeper.Liquidate(ctx, fromAddr, toAddr, third[0])
+	suite.Require().NoError(err)
+	liquidDenom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, types.DenomBaseNameFromID(0))
+	suite.Require().True(found)
+	suite.Require().Equal(funder.String(), liquidDenom.FunderAddress)
+
+	// redeemed unvested coins are granted on behalf of the same funder
+	err = suite.network.App.LiquidVestingKeeper.Redeem(ctx, toAddr, toAddr, sdk.NewCoin(types.DenomBaseNameFromID(0), third[0].Amount))
+	suite.Require().NoError(err)
+	toVa, isClawback := suite.network.App.AccountKeeper.GetAccount(ctx, toAddr).(*vestingtypes.ClawbackVestingAccount)
+	suite.Require().True(isClawback)
+	suite.Require().Equal(funder.String(), toVa.FunderAddress)
+	suite.Require().Equal(third, toVa.GetVestingCoins(ctx.BlockTime()))
+
+	// the funder claws back the unvested third from both accounts
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		_, err = suite.network.App.VestingKeeper.Clawback(ctx, &vestingtypes.MsgClawback{
+			FunderAddress:  funder.String(),
+			AccountAddress: addr.String(),
+		})
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(amount.Sub(third...), suite.network.App.BankKeeper.GetAllBalances(ctx, funder))
+}
+
 func (suite *KeeperTestSuite) TestRedeem() {
 	var ctx sdk.Context
 
@@ -906,6 +968,294 @@ func (suite *KeeperTestSuite) TestMsgServerRedeem() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMergeLiquid() {
+	var ctx sdk.Context
+
+	// liquidate creates liquid denoms from the vesting account
+	liquidate := func(startTime time.Time, liquidations ...sdk.Coin) {
+		baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+		baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+		clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, amount, startTime, lockupPeriods, vestingPeriods, nil)
+		err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+		suite.Require().NoError(err)
+		suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+		for _, liquidation := range liquidations {
+			_, _, err = suite.network.App.LiquidVestingKeeper.Liquidate(ctx, fromAddr, fromAddr, liquidation)
+			suite.Require().NoError(err)
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		amounts        sdk.Coins
+		expDeregistred []string
+		expPass        bool
+	}{
+		{
+			name: "ok - merge two liquid denoms fully",
+			malleate: func() {
+				liquidate(ctx.BlockTime().Add(-10*time.Second), third[0], third[0])
+			},
+			amounts: sdk.NewCoins(
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(1), 1_000_000),
+			),
+			expDeregistred: []string{types.DenomBaseNameFromID(0), types.DenomBaseNameFromID(1)},
+			expPass:        true,
+		},
+		{
+			name: "ok - merge parts of liquid denoms",
+			malleate: func() {
+				liquidate(ctx.BlockTime().Add(-10*time.Second), third[0], third[0])
+			},
+			amounts: sdk.NewCoins(
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 500_000),
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(1), 1_000_000),
+			),
+			expDeregistred: []string{types.DenomBaseNameFromID(1)},
+			expPass:        true,
+		},
+		{
+			name: "ok - merge liquid denoms with different start times",
+			malleate: func() {
+				startTime := ctx.BlockTime().Add(-10 * time.Second)
+				liquidate(startTime, third[0])
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50_000 * time.Second))
+				liquidate(startTime, third[0])
+			},
+			amounts: sdk.NewCoins(
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(1), 1_000_000),
+			),
+			expDeregistred: []string{types.DenomBaseNameFromID(0), types.DenomBaseNameFromID(1)},
+			expPass:        true,
+		},
+		{
+			name: "fail - single liquid denom",
+			malleate: func() {
+				liquidate(ctx.BlockTime().Add(-10*time.Second), third[0])
+			},
+			amounts: sdk.NewCoins(sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000)),
+			expPass: false,
+		},
+		{
+			name: "fail - insufficient balance",
+			malleate: func() {
+				liquidate(ctx.BlockTime().Add(-10*time.Second), third[0], third[0])
+			},
+			amounts: sdk.NewCoins(
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(1), 2_000_000),
+			),
+			expPass: false,
+		},
+		{
+			name: "fail - liquid denom does not exist",
+			malleate: func() {
+				liquidate(ctx.BlockTime().Add(-10*time.Second), third[0])
+			},
+			amounts: sdk.NewCoins(
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+				sdk.NewInt64Coin(types.DenomBaseNameFromID(5), 1_000_000),
+			),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			fromAccNumber := suite.keyring.AddKey()
+			fromAddr = suite.keyring.GetAccAddr(fromAccNumber)
+			toAccNumber := suite.keyring.AddKey()
+			toAddr = suite.keyring.GetAccAddr(toAccNumber)
+
+			tc.malleate()
+
+			mergedDenoms := make([]types.Denom, 0, len(tc.amounts))
+			for _, coin := range tc.amounts {
+				if denom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, coin.Denom); found {
+					mergedDenoms = append(mergedDenoms, denom)
+				}
+			}
+
+			minted, contractAddr, err := suite.network.App.LiquidVestingKeeper.MergeLiquid(ctx, fromAddr, toAddr, tc.amounts)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(contractAddr)
+			suite.Require().Equal(tc.amounts.Sort()[0].Amount.Add(tc.amounts[1].Amount).String(), minted.Amount.String())
+
+			// check merged denom carries the sum of schedules
+			mergedDenom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, minted.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(minted.Amount.String(), mergedDenom.LockupPeriods.TotalAmount().AmountOf(utils.BaseDenom).String())
+			suite.Require().Equal(minted.Amount.String(), mergedDenom.VestingPeriods.TotalAmount().AmountOf(utils.BaseDenom).String())
+			suite.Require().Equal(mergedDenoms[0].StartTime, mergedDenom.StartTime)
+			suite.Require().Equal(mergedDenoms[len(mergedDenoms)-1].EndTime, mergedDenom.EndTime)
+
+			// check balances
+			balance := suite.network.App.BankKeeper.GetBalance(ctx, toAddr, minted.Denom)
+			suite.Require().Equal(minted.String(), balance.String())
+			for _, coin := range tc.amounts {
+				balance := suite.network.App.BankKeeper.GetBalance(ctx, fromAddr, coin.Denom)
+				suite.Require().Equal(third[0].Amount.Sub(coin.Amount).String(), balance.Amount.String())
+			}
+
+			// check exhausted denoms are deregistered
+			for _, denom := range tc.expDeregistred {
+				_, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, denom)
+				suite.Require().False(found)
+				suite.Require().Empty(suite.network.App.Erc20Keeper.GetTokenPairID(ctx, denom))
+			}
+
+			// check merged token pair
+			pairResp, err := suite.network.App.Erc20Keeper.TokenPair(ctx, &erc20types.QueryTokenPairRequest{Token: minted.Denom})
+			suite.Require().NoError(err)
+			suite.Require().Equal(contractAddr, pairResp.TokenPair.Erc20Address)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLiquidUnvestedOfDifferentFunders() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	toAccNumber := suite.keyring.AddKey()
+	toAddr = suite.keyring.GetAccAddr(toAccNumber)
+
+	// liquidate unvested coins of two accounts with different funders
+	for _, accFunder := range []sdk.AccAddress{funder, sdk.AccAddress("other_funder")} {
+		fromAccNumber := suite.keyring.AddKey()
+		fromAddr = suite.keyring.GetAccAddr(fromAccNumber)
+		baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+		baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+		startTime := ctx.BlockTime().Add(-10 * time.Second)
+		clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, accFunder, amount, startTime, lockupPeriods, ongoingVestingPeriods, nil)
+		err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+		suite.Require().NoError(err)
+		suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+		_, _, err = suite.network.App.LiquidVestingKeeper.Liquidate(ctx, fromAddr, toAddr, third[0])
+		suite.Require().NoError(err)
+	}
+
+	_, _, err := suite.network.App.LiquidVestingKeeper.MergeLiquid(ctx, toAddr, toAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+		sdk.NewInt64Coin(types.DenomBaseNameFromID(1), 1_000_000),
+	))
+	suite.Require().ErrorContains(err, "different funders")
+}
+
+func (suite *KeeperTestSuite) TestSplitLiquid() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name    string
+		amount  sdk.Coin
+		expPass bool
+	}{
+		{
+			name:    "ok - split one third",
+			amount:  sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 1_000_000),
+			expPass: true,
+		},
+		{
+			name:    "ok - split whole amount",
+			amount:  sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 3_000_000),
+			expPass: true,
+		},
+		{
+			name:    "fail - insufficient balance",
+			amount:  sdk.NewInt64Coin(types.DenomBaseNameFromID(0), 4_000_000),
+			expPass: false,
+		},
+		{
+			name:    "fail - liquid denom does not exist",
+			amount:  sdk.NewInt64Coin(types.DenomBaseNameFromID(5), 1_000_000),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			fromAccNumber := suite.keyring.AddKey()
+			fromAddr = suite.keyring.GetAccAddr(fromAccNumber)
+			toAccNumber := suite.keyring.AddKey()
+			toAddr = suite.keyring.GetAccAddr(toAccNumber)
+
+			baseAccount := authtypes.NewBaseAccountWithAddress(fromAddr)
+			baseAccount.AccountNumber = suite.network.App.AccountKeeper.NextAccountNumber(ctx)
+			startTime := ctx.BlockTime().Add(-10 * time.Second)
+			clawbackAccount := vestingtypes.NewClawbackVestingAccount(baseAccount, funder, amount, startTime, lockupPeriods, vestingPeriods, nil)
+			err := testutil.FundAccount(ctx, suite.network.App.BankKeeper, fromAddr, amount)
+			suite.Require().NoError(err)
+			suite.network.App.AccountKeeper.SetAccount(ctx, clawbackAccount)
+			_, _, err = suite.network.App.LiquidVestingKeeper.Liquidate(ctx, fromAddr, fromAddr, amount[0])
+			suite.Require().NoError(err)
+			originalDenom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, types.DenomBaseNameFromID(0))
+			suite.Require().True(found)
+
+			minted, contractAddr, err := suite.network.App.LiquidVestingKeeper.SplitLiquid(ctx, fromAddr, toAddr, tc.amount)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(contractAddr)
+			suite.Require().Equal(sdk.NewCoin(types.DenomBaseNameFromID(1), tc.amount.Amount), minted)
+
+			// check split denom schedule is proportional to the original one
+			splitDenom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, minted.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(originalDenom.StartTime, splitDenom.StartTime)
+			suite.Require().Equal(originalDenom.EndTime, splitDenom.EndTime)
+			suite.Require().Equal(len(originalDenom.LockupPeriods), len(splitDenom.LockupPeriods))
+			suite.Require().Equal(tc.amount.Amount.String(), splitDenom.LockupPeriods.TotalAmount().AmountOf(utils.BaseDenom).String())
+
+			balance := suite.network.App.BankKeeper.GetBalance(ctx, toAddr, minted.Denom)
+			suite.Require().Equal(minted.String(), balance.String())
+
+			remaining := amount[0].Amount.Sub(tc.amount.Amount)
+			balance = suite.network.App.BankKeeper.GetBalance(ctx, fromAddr, tc.amount.Denom)
+			suite.Require().Equal(remaining.String(), balance.Amount.String())
+
+			updatedDenom, found := suite.network.App.LiquidVestingKeeper.GetDenom(ctx, tc.amount.Denom)
+			if remaining.IsZero() {
+				suite.Require().False(found)
+				suite.Require().Empty(suite.network.App.Erc20Keeper.GetTokenPairID(ctx, tc.amount.Denom))
+				return
+			}
+			suite.Require().True(found)
+			suite.Require().Equal(remaining.String(), updatedDenom.LockupPeriods.TotalAmount().AmountOf(utils.BaseDenom).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServerMergeLiquid() {
+	msgServer := keeper.NewMsgServerImpl(suite.network.App.LiquidVestingKeeper)
+	addr := suite.keyring.GetAccAddr(0)
+
+	_, err := msgServer.MergeLiquid(suite.network.GetContext(), types.NewMsgMergeLiquid(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("aLIQUID0", 100))))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgServerSplitLiquid() {
+	msgServer := keeper.NewMsgServerImpl(suite.network.App.LiquidVestingKeeper)
+	addr := suite.keyring.GetAccAddr(0)
+
+	_, err := msgServer.SplitLiquid(suite.network.GetContext(), types.NewMsgSplitLiquid(addr, addr, sdk.NewInt64Coin("aLIQUID0", 0)))
+	suite.Require().Error(err)
+}
+
 // Ensure the unused imports pulled in for the existing tests are still referenced.
 var (
 	_ = math.NewInt
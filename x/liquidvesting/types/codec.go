@@ -15,8 +15,10 @@ var (
 
 const (
 	// Amino names
-	liquidate = "haqq/MsgLiquidate"
-	redeem    = "haqq/MsgRedeem"
+	liquidate   = "haqq/MsgLiquidate"
+	redeem      = "haqq/MsgRedeem"
+	mergeLiquid = "haqq/MsgMergeLiquid"
+	splitLiquid = "haqq/MsgSplitLiquid"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -31,6 +33,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgLiquidate{},
 		&MsgRedeem{},
+		&MsgMergeLiquid{},
+		&MsgSplitLiquid{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -42,4 +46,6 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidate{}, liquidate, nil)
 	cdc.RegisterConcrete(&MsgRedeem{}, redeem, nil)
+	cdc.RegisterConcrete(&MsgMergeLiquid{}, mergeLiquid, nil)
+	cdc.RegisterConcrete(&MsgSplitLiquid{}, splitLiquid, nil)
 }
@@ -4,6 +4,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 const (
@@ -34,3 +39,69 @@ func DenomIDFromBaseName(baseName string) (uint64, error) {
 
 	return uint64(result), err
 }
+
+// GetVestingSchedule returns the vesting periods of the denom. Denoms liquidated
+// from fully vested coins have no vesting periods, their whole amount is vested
+// from the start.
+func (d Denom) GetVestingSchedule() sdkvesting.Periods {
+	if len(d.VestingPeriods) > 0 {
+		return d.VestingPeriods
+	}
+
+	return sdkvesting.Periods{{Length: 0, Amount: d.LockupPeriods.TotalAmount()}}
+}
+
+// HasUnvestedCoins reports whether the denom vesting schedule still has periods
+// to complete after blockTime.
+func (d Denom) HasUnvestedCoins(blockTime time.Time) bool {
+	return d.StartTime.Unix()+d.GetVestingSchedule().TotalLength() > blockTime.Unix()
+}
+
+// ResolveSchedule returns the share of the denom schedule backing the given
+// amount of liquid token, resolved into absolute unlock times at readTime.
+// The share is computed the same way redeem does it.
+func (d Denom) ResolveSchedule(amount math.Int, readTime time.Time) (DenomSchedule, error) {
+	originalDenomCoin := sdk.NewCoin(d.OriginalDenom, amount)
+	_, lockupPeriods, err := SubtractAmountFromPeriods(d.LockupPeriods, originalDenomCoin)
+	if err != nil {
+		return DenomSchedule{}, err
+	}
+	_, vestingPeriods, err := SubtractAmountFromPeriods(d.GetVestingSchedule(), originalDenomCoin)
+	if err != nil {
+		return DenomSchedule{}, err
+	}
+
+	lockupTranches, unlocked := resolveTranches(d.StartTime, lockupPeriods, readTime)
+	vestingTranches, vested := resolveTranches(d.StartTime, vestingPeriods, readTime)
+	redeemable := unlocked.Min(vested)
+
+	return DenomSchedule{
+		Denom:           d.BaseDenom,
+		LockupTranches:  lockupTranches,
+		VestingTranches: vestingTranches,
+		Redeemable:      redeemable,
+		Locked:          sdk.NewCoins(originalDenomCoin).Sub(redeemable...),
+	}, nil
+}
+
+// resolveTranches converts periods started at startTime into tranches with
+// absolute unlock times and returns them with the amount released at readTime
+func resolveTranches(startTime time.Time, periods sdkvesting.Periods, readTime time.Time) ([]ScheduleTranche, sdk.Coins) {
+	tranches := make([]ScheduleTranche, 0, len(periods))
+	released := sdk.NewCoins()
+	unlockTime := startTime
+	for _, period := range periods {
+		unlockTime = unlockTime.Add(time.Duration(period.Length) * time.Second)
+		isReleased := !unlockTime.After(readTime)
+		if isReleased {
+			released = released.Add(period.Amount...)
+		}
+		tranches = append(tranches, ScheduleTranche{
+			UnlockTime: unlockTime,
+			Amount:     period.Amount,
+			Released:   isReleased,
+		})
+	}
+
+	return tranches, released
+}
@@ -11,4 +11,6 @@ var (
 	ErrRedeemFailed      = sdkerrors.Register(ModuleName, 1103, "redeem failed")
 	ErrDenomNotFound     = sdkerrors.Register(ModuleName, 1104, "denom not found")
 	ErrModuleIsDisabled  = sdkerrors.Register(ModuleName, 1105, "module is disabled")
+	ErrMergeFailed       = sdkerrors.Register(ModuleName, 1106, "merge failed")
+	ErrSplitFailed       = sdkerrors.Register(ModuleName, 1107, "split failed")
 )
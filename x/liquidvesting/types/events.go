@@ -2,9 +2,15 @@ package types
 
 // liquidvesting events
 const (
-	EventTypeLiquidate = "liquidate_locked_vesting_coins"
-	EventTypeRedeem    = "redeem_liquid_token"
+	EventTypeLiquidate          = "liquidate_locked_vesting_coins"
+	EventTypeRedeem             = "redeem_liquid_token"
+	EventTypeMergeLiquid        = "merge_liquid_tokens"
+	EventTypeSplitLiquid        = "split_liquid_token"
+	EventTypeCleanupLiquidDenom = "cleanup_liquid_denom"
 
-	AttributeKeyAmount      = "amount"
-	AttributeKeyDestination = "destination"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyDestination  = "destination"
+	AttributeKeyMinted       = "minted"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyContractAddr = "contract_addr"
 )
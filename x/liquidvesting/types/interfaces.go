@@ -32,12 +32,15 @@ type BankKeeper interface {
 
 	HasBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	DeleteDenomMetaData(ctx context.Context, denom string) error
 }
 
 // ERC20Keeper defines the expected interface for the ERC20 module.
@@ -49,9 +52,11 @@ type ERC20Keeper interface {
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
 	BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int
 	EnableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
+	DisableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
 	SetTokenPair(ctx sdk.Context, tokenPair erc20types.TokenPair)
 	SetDenomMap(ctx sdk.Context, denom string, id []byte)
 	SetERC20Map(ctx sdk.Context, erc20 common.Address, id []byte)
+	DeleteTokenPair(ctx sdk.Context, tokenPair erc20types.TokenPair)
 }
 
 // VestingKeeper defines the expected interface for the Vesting module.
@@ -44,6 +44,12 @@ type Denom struct {
 	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	// lockup periods
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,6,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting periods, empty for denoms whose coins are all vested
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,7,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// funder_address of the vesting account the coins were liquidated from, set
+	// for denoms which carry unvested coins. Redeem applies the vesting schedule
+	// with this funder, so the unvested coins stay within its clawback reach.
+	FunderAddress string `protobuf:"bytes,8,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -121,6 +127,20 @@ func (m *Denom) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_t
 	return nil
 }
 
+func (m *Denom) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *Denom) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Denom)(nil), "haqq.liquidvesting.v1.Denom")
 }
@@ -130,34 +150,37 @@ func init() {
 }
 
 var fileDescriptor_ce2378517a6b5c6c = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0xeb, 0x12, 0x41,
-	0x18, 0xc7, 0x77, 0x32, 0x2d, 0xc7, 0x56, 0x48, 0x0a, 0x64, 0xa1, 0x5d, 0xe9, 0x0f, 0x98, 0xe0,
-	0x0c, 0xda, 0xa9, 0x8e, 0x22, 0xd1, 0xa1, 0x43, 0x48, 0xa7, 0x2e, 0x32, 0xeb, 0x4e, 0xeb, 0xe0,
-	0xee, 0xce, 0xba, 0x33, 0x6b, 0xfa, 0x16, 0x82, 0xc0, 0x97, 0x11, 0x9d, 0x84, 0xde, 0x84, 0x47,
-	0x8f, 0x9d, 0x32, 0xf4, 0xe0, 0xdb, 0x88, 0x99, 0x59, 0x33, 0xa3, 0xcb, 0xef, 0xa2, 0x33, 0xdf,
-	0xe7, 0xb3, 0xdf, 0xe7, 0xcb, 0xf3, 0x0c, 0x7c, 0x3e, 0x25, 0xf3, 0x39, 0x8e, 0xd8, 0x3c, 0x67,
-	0xc1, 0x82, 0x0a, 0xc9, 0x92, 0x10, 0x2f, 0x7a, 0xd7, 0x02, 0x4a, 0x33, 0x2e, 0x79, 0xe3, 0xa1,
-	0x42, 0xd1, 0x75, 0x65, 0xd1, 0x73, 0xee, 0x93, 0x98, 0x25, 0x1c, 0xeb, 0x5f, 0x43, 0x3a, 0x0f,
-	0x42, 0x1e, 0x72, 0x7d, 0xc4, 0xea, 0x54, 0xa8, 0x4f, 0x27, 0x5c, 0xc4, 0x5c, 0xe0, 0x4b, 0x1b,
-	0x9f, 0x4a, 0xd2, 0xc3, 0x57, 0x5d, 0x1c, 0x2f, 0xe4, 0x3c, 0x8c, 0x28, 0xd6, 0x37, 0x3f, 0xff,
-	0x88, 0x25, 0x8b, 0xa9, 0x90, 0x24, 0x4e, 0x0d, 0xf0, 0xf8, 0x7b, 0x09, 0x96, 0x87, 0x34, 0xe1,
-	0x71, 0xe3, 0x11, 0x84, 0x3e, 0x11, 0x74, 0x1c, 0xa8, 0x5b, 0x13, 0xb4, 0x40, 0xbb, 0x3a, 0xaa,
-	0x2a, 0xc5, 0x94, 0x9f, 0x40, 0x3b, 0x60, 0x22, 0x8d, 0xc8, 0xaa, 0x20, 0x6e, 0x69, 0xe2, 0x5e,
-	0x21, 0x1a, 0xe8, 0x19, 0xac, 0xf3, 0x8c, 0x85, 0x2c, 0x21, 0x51, 0x41, 0x95, 0x34, 0x65, 0x9f,
-	0x55, 0x83, 0xbd, 0x81, 0x50, 0x48, 0x92, 0xc9, 0xb1, 0x4a, 0xd3, 0xbc, 0xdd, 0x02, 0xed, 0x5a,
-	0xdf, 0x41, 0x26, 0x2a, 0x3a, 0x47, 0x45, 0xef, 0xcf, 0x51, 0x07, 0xf6, 0xf6, 0xa7, 0x67, 0xad,
-	0xf7, 0x1e, 0xf8, 0x7a, 0xda, 0x74, 0xc0, 0xa8, 0xaa, 0x3f, 0x56, 0xe5, 0xc6, 0x10, 0xde, 0xa5,
-	0x49, 0x60, 0x7c, 0xca, 0x37, 0xf5, 0xb9, 0x43, 0x93, 0x40, 0xbb, 0x7c, 0x01, 0xb0, 0x1e, 0xf1,
-	0xc9, 0x2c, 0x4f, 0xc7, 0x29, 0xcd, 0x18, 0x0f, 0x44, 0xb3, 0xd2, 0x2a, 0xb5, 0x6b, 0x7d, 0x17,
-	0x99, 0x29, 0xa3, 0xcb, 0x86, 0xf4, 0x94, 0xd1, 0x3b, 0x8d, 0x0d, 0x5e, 0x2b, 0xc3, 0x6f, 0x7b,
-	0xef, 0x65, 0xc8, 0xe4, 0x34, 0xf7, 0xd1, 0x84, 0xc7, 0xb8, 0xd8, 0x8b, 0xf9, 0xeb, 0x8a, 0x60,
-	0x86, 0x97, 0x98, 0xe4, 0x72, 0xfa, 0x67, 0x53, 0x72, 0x95, 0x52, 0x51, 0x38, 0x08, 0x93, 0xc4,
-	0x36, 0xdd, 0x0b, 0xed, 0x95, 0xf7, 0xf9, 0xb4, 0xe9, 0x38, 0xfa, 0x2d, 0x2d, 0xff, 0x79, 0x4d,
-	0x7a, 0x80, 0x83, 0xb7, 0xdb, 0x83, 0x0b, 0x76, 0x07, 0x17, 0xfc, 0x3a, 0xb8, 0x60, 0x7d, 0x74,
-	0xad, 0xdd, 0xd1, 0xb5, 0x7e, 0x1c, 0x5d, 0xeb, 0x43, 0xff, 0xaf, 0x24, 0xca, 0xa0, 0x9b, 0x50,
-	0xf9, 0x89, 0x67, 0x33, 0xfc, 0x5f, 0x37, 0x1d, 0xc5, 0xaf, 0xe8, 0x51, 0xbd, 0xf8, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x00, 0x5f, 0x6b, 0x4f, 0xbe, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3d, 0x6f, 0x13, 0x31,
+	0x1c, 0xc6, 0x63, 0xfa, 0x1a, 0x97, 0x04, 0x11, 0x81, 0x14, 0x9d, 0xc4, 0x5d, 0x04, 0x54, 0x0a,
+	0x95, 0x6a, 0x2b, 0x61, 0x82, 0x8d, 0xa8, 0x42, 0x0c, 0x0c, 0xa8, 0x62, 0x62, 0x39, 0xf9, 0x62,
+	0xf7, 0x62, 0xe5, 0xce, 0xbe, 0x9c, 0x7d, 0xa1, 0xfd, 0x0a, 0x48, 0x88, 0x7e, 0x0c, 0xc4, 0xd4,
+	0x8f, 0xd1, 0xb1, 0x23, 0x13, 0x45, 0xc9, 0xd0, 0x85, 0x0f, 0x81, 0xfc, 0x72, 0x2d, 0x41, 0x2c,
+	0x2c, 0x5d, 0x12, 0xfb, 0xf9, 0xff, 0xee, 0xf1, 0x23, 0x3f, 0x32, 0x7c, 0x36, 0x21, 0xb3, 0x19,
+	0xce, 0xf8, 0xac, 0xe2, 0x74, 0xce, 0x94, 0xe6, 0x22, 0xc5, 0xf3, 0xc1, 0xaa, 0x80, 0x8a, 0x52,
+	0x6a, 0xd9, 0x79, 0x68, 0x50, 0xb4, 0x3a, 0x99, 0x0f, 0x82, 0xfb, 0x24, 0xe7, 0x42, 0x62, 0xfb,
+	0xeb, 0xc8, 0xe0, 0x41, 0x2a, 0x53, 0x69, 0x97, 0xd8, 0xac, 0xbc, 0xfa, 0x74, 0x2c, 0x55, 0x2e,
+	0x15, 0xbe, 0x39, 0x26, 0x61, 0x9a, 0x0c, 0xf0, 0xca, 0x29, 0x41, 0x94, 0x4a, 0x99, 0x66, 0x0c,
+	0xdb, 0x5d, 0x52, 0x1d, 0x61, 0xcd, 0x73, 0xa6, 0x34, 0xc9, 0x0b, 0x07, 0x3c, 0xfe, 0xb5, 0x0e,
+	0x37, 0x0e, 0x98, 0x90, 0x79, 0xe7, 0x11, 0x84, 0x09, 0x51, 0x2c, 0xa6, 0x66, 0xd7, 0x05, 0x3d,
+	0xd0, 0x6f, 0x1e, 0x36, 0x8d, 0xe2, 0xc6, 0x4f, 0x60, 0x8b, 0x72, 0x55, 0x64, 0xe4, 0xc4, 0x13,
+	0x77, 0x2c, 0x71, 0xd7, 0x8b, 0x0e, 0xda, 0x85, 0x6d, 0x59, 0xf2, 0x94, 0x0b, 0x92, 0x79, 0x6a,
+	0xcd, 0x52, 0xad, 0x5a, 0x75, 0xd8, 0x1b, 0x08, 0x95, 0x26, 0xa5, 0x8e, 0x4d, 0x9a, 0xee, 0x7a,
+	0x0f, 0xf4, 0x77, 0x86, 0x01, 0x72, 0x51, 0x51, 0x1d, 0x15, 0xbd, 0xaf, 0xa3, 0x8e, 0x5a, 0xe7,
+	0x3f, 0xa2, 0xc6, 0xe9, 0x65, 0x04, 0xbe, 0x5e, 0x9d, 0xed, 0x81, 0xc3, 0xa6, 0xfd, 0xd8, 0x8c,
+	0x3b, 0x07, 0x70, 0x9b, 0x09, 0xea, 0x7c, 0x36, 0xfe, 0xd7, 0x67, 0x8b, 0x09, 0x6a, 0x5d, 0x3e,
+	0x03, 0xd8, 0xce, 0xe4, 0x78, 0x5a, 0x15, 0x71, 0xc1, 0x4a, 0x2e, 0xa9, 0xea, 0x6e, 0xf6, 0xd6,
+	0xfa, 0x3b, 0xc3, 0x10, 0xb9, 0x5b, 0x46, 0x37, 0x0d, 0xd9, 0x5b, 0x46, 0xef, 0x2c, 0x36, 0x7a,
+	0x6d, 0x0c, 0xbf, 0x5d, 0x46, 0x2f, 0x52, 0xae, 0x27, 0x55, 0x82, 0xc6, 0x32, 0xc7, 0xbe, 0x17,
+	0xf7, 0xb7, 0xaf, 0xe8, 0x14, 0x1f, 0x63, 0x52, 0xe9, 0xc9, 0x75, 0x53, 0xfa, 0xa4, 0x60, 0xca,
+	0x3b, 0x28, 0x97, 0xa4, 0xe5, 0x4e, 0xf7, 0x5a, 0xe7, 0x0b, 0x80, 0xf7, 0x3c, 0x7d, 0x1d, 0x68,
+	0xeb, 0x56, 0x03, 0xb5, 0xfd, 0xac, 0x4e, 0xb4, 0x0b, 0xdb, 0x47, 0x95, 0xa0, 0xac, 0x8c, 0x09,
+	0xa5, 0x25, 0x53, 0xaa, 0xbb, 0xed, 0x8a, 0x75, 0xea, 0x2b, 0x27, 0xbe, 0x8c, 0x3e, 0x5d, 0x9d,
+	0xed, 0x05, 0xf6, 0x11, 0x1c, 0xff, 0xf5, 0x0c, 0x6c, 0xf3, 0xa3, 0xb7, 0xe7, 0x8b, 0x10, 0x5c,
+	0x2c, 0x42, 0xf0, 0x73, 0x11, 0x82, 0xd3, 0x65, 0xd8, 0xb8, 0x58, 0x86, 0x8d, 0xef, 0xcb, 0xb0,
+	0xf1, 0x61, 0xf8, 0x47, 0x62, 0x63, 0xb0, 0x2f, 0x98, 0xfe, 0x28, 0xcb, 0x29, 0xfe, 0xa7, 0x9b,
+	0x8d, 0x9c, 0x6c, 0xda, 0x8e, 0x9f, 0xff, 0x1e, 0x00, 0x06, 0x57, 0xb5, 0x34, 0x77, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Denom) Marshal() (dAtA []byte, err error) {
@@ -180,6 +203,27 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintLiquidvesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidvesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.LockupPeriods) > 0 {
 		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -273,6 +317,16 @@ func (m *Denom) Size() (n int) {
 			n += 1 + l + sovLiquidvesting(uint64(l))
 		}
 	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovLiquidvesting(uint64(l))
+		}
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidvesting(uint64(l))
+	}
 	return n
 }
 
@@ -507,6 +561,72 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidvesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidvesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidvesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidvesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidvesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidvesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidvesting(dAtA[iNdEx:])
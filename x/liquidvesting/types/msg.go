@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	TypeMsgLiquidate = "liquidate"
-	TypeMsgRedeem    = "redeem"
+	TypeMsgLiquidate   = "liquidate"
+	TypeMsgRedeem      = "redeem"
+	TypeMsgMergeLiquid = "merge_liquid"
+	TypeMsgSplitLiquid = "split_liquid"
 )
 
 // NewMsgLiquidate creates new instance of MsgLiquidate
@@ -98,3 +100,95 @@ func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.RedeemFrom)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgMergeLiquid creates new instance of MsgMergeLiquid
+func NewMsgMergeLiquid(mergeFrom, mergeTo sdk.AccAddress, amounts sdk.Coins) *MsgMergeLiquid {
+	return &MsgMergeLiquid{
+		MergeFrom: mergeFrom.String(),
+		MergeTo:   mergeTo.String(),
+		Amounts:   amounts,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgMergeLiquid) Route() string { return RouterKey }
+
+// Type returns the action type
+func (msg MsgMergeLiquid) Type() string { return TypeMsgMergeLiquid }
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgMergeLiquid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMergeLiquid) ValidateBasic() error {
+	if err := msg.Amounts.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	if len(msg.Amounts) < 2 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "at least two different liquid denoms required to merge")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.MergeFrom)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address mergeFrom")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.MergeTo)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address mergeTo")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMergeLiquid) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.MergeFrom)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSplitLiquid creates new instance of MsgSplitLiquid
+func NewMsgSplitLiquid(splitFrom, splitTo sdk.AccAddress, amount sdk.Coin) *MsgSplitLiquid {
+	return &MsgSplitLiquid{
+		SplitFrom: splitFrom.String(),
+		SplitTo:   splitTo.String(),
+		Amount:    amount,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSplitLiquid) Route() string { return RouterKey }
+
+// Type returns the action type
+func (msg MsgSplitLiquid) Type() string { return TypeMsgSplitLiquid }
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSplitLiquid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSplitLiquid) ValidateBasic() error {
+	if !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "cannot split non-positive amount")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.SplitFrom)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address splitFrom")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.SplitTo)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address splitTo")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSplitLiquid) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.SplitFrom)
+	return []sdk.AccAddress{addr}
+}
@@ -258,3 +258,149 @@ func (suite *MsgTestSuite) TestMsgRedeem() {
 		})
 	}
 }
+
+func (suite *MsgTestSuite) TestMsgMergeLiquid() {
+	validFrom := sdk.AccAddress([]byte("mergefrom___________"))
+	validTo := sdk.AccAddress([]byte("mergeto_____________"))
+	validAmounts := sdk.NewCoins(
+		sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+		sdk.NewCoin("aLIQUID2", sdkmath.NewInt(500)),
+	)
+
+	testCases := []struct {
+		name        string
+		msg         MsgMergeLiquid
+		expectError bool
+	}{
+		{
+			name: "valid message",
+			msg: MsgMergeLiquid{
+				MergeFrom: validFrom.String(),
+				MergeTo:   validTo.String(),
+				Amounts:   validAmounts,
+			},
+			expectError: false,
+		},
+		{
+			name: "single denom",
+			msg: MsgMergeLiquid{
+				MergeFrom: validFrom.String(),
+				MergeTo:   validTo.String(),
+				Amounts:   sdk.NewCoins(sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500))),
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicated denoms",
+			msg: MsgMergeLiquid{
+				MergeFrom: validFrom.String(),
+				MergeTo:   validTo.String(),
+				Amounts: sdk.Coins{
+					sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+					sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "zero amount",
+			msg: MsgMergeLiquid{
+				MergeFrom: validFrom.String(),
+				MergeTo:   validTo.String(),
+				Amounts: sdk.Coins{
+					sdk.NewCoin("aLIQUID1", sdkmath.NewInt(0)),
+					sdk.NewCoin("aLIQUID2", sdkmath.NewInt(500)),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid from address",
+			msg: MsgMergeLiquid{
+				MergeFrom: "not-a-valid-bech32",
+				MergeTo:   validTo.String(),
+				Amounts:   validAmounts,
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid to address",
+			msg: MsgMergeLiquid{
+				MergeFrom: validFrom.String(),
+				MergeTo:   "not-a-valid-bech32",
+				Amounts:   validAmounts,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSplitLiquid() {
+	validFrom := sdk.AccAddress([]byte("splitfrom___________"))
+	validTo := sdk.AccAddress([]byte("splitto_____________"))
+
+	testCases := []struct {
+		name        string
+		msg         MsgSplitLiquid
+		expectError bool
+	}{
+		{
+			name: "valid message",
+			msg: MsgSplitLiquid{
+				SplitFrom: validFrom.String(),
+				SplitTo:   validTo.String(),
+				Amount:    sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+			},
+			expectError: false,
+		},
+		{
+			name: "zero amount",
+			msg: MsgSplitLiquid{
+				SplitFrom: validFrom.String(),
+				SplitTo:   validTo.String(),
+				Amount:    sdk.NewCoin("aLIQUID1", sdkmath.NewInt(0)),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid from address",
+			msg: MsgSplitLiquid{
+				SplitFrom: "not-a-valid-bech32",
+				SplitTo:   validTo.String(),
+				Amount:    sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid to address",
+			msg: MsgSplitLiquid{
+				SplitFrom: validFrom.String(),
+				SplitTo:   "not-a-valid-bech32",
+				Amount:    sdk.NewCoin("aLIQUID1", sdkmath.NewInt(500)),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expectError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
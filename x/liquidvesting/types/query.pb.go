@@ -6,24 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -224,45 +230,488 @@ func (m *QueryDenomsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// ScheduleTranche is a schedule period resolved into absolute unlock time
+type ScheduleTranche struct {
+	// unlock_time is the time the tranche amount is released at
+	UnlockTime time.Time `protobuf:"bytes,1,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	// amount released by the tranche
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// released is true if the tranche is already released at current block time
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *ScheduleTranche) Reset()         { *m = ScheduleTranche{} }
+func (m *ScheduleTranche) String() string { return proto.CompactTextString(m) }
+func (*ScheduleTranche) ProtoMessage()    {}
+func (*ScheduleTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{4}
+}
+func (m *ScheduleTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleTranche.Merge(m, src)
+}
+func (m *ScheduleTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleTranche proto.InternalMessageInfo
+
+func (m *ScheduleTranche) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
+func (m *ScheduleTranche) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ScheduleTranche) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+// DenomSchedule is liquid vesting token schedule resolved at current block
+// time
+type DenomSchedule struct {
+	// denom is liquidated vesting token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// lockup_tranches are resolved lockup periods
+	LockupTranches []ScheduleTranche `protobuf:"bytes,2,rep,name=lockup_tranches,json=lockupTranches,proto3" json:"lockup_tranches"`
+	// vesting_tranches are resolved vesting periods
+	VestingTranches []ScheduleTranche `protobuf:"bytes,3,rep,name=vesting_tranches,json=vestingTranches,proto3" json:"vesting_tranches"`
+	// redeemable is amount of original denom both unlocked and vested, it is
+	// spendable right after redeem
+	Redeemable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=redeemable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redeemable"`
+	// locked is amount of original denom still locked or vesting
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+}
+
+func (m *DenomSchedule) Reset()         { *m = DenomSchedule{} }
+func (m *DenomSchedule) String() string { return proto.CompactTextString(m) }
+func (*DenomSchedule) ProtoMessage()    {}
+func (*DenomSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{5}
+}
+func (m *DenomSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSchedule.Merge(m, src)
+}
+func (m *DenomSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSchedule proto.InternalMessageInfo
+
+func (m *DenomSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomSchedule) GetLockupTranches() []ScheduleTranche {
+	if m != nil {
+		return m.LockupTranches
+	}
+	return nil
+}
+
+func (m *DenomSchedule) GetVestingTranches() []ScheduleTranche {
+	if m != nil {
+		return m.VestingTranches
+	}
+	return nil
+}
+
+func (m *DenomSchedule) GetRedeemable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Redeemable
+	}
+	return nil
+}
+
+func (m *DenomSchedule) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+// QueryDenomScheduleRequest is request for DenomSchedule rpc method
+type QueryDenomScheduleRequest struct {
+	// denom is liquidated vesting token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomScheduleRequest) Reset()         { *m = QueryDenomScheduleRequest{} }
+func (m *QueryDenomScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomScheduleRequest) ProtoMessage()    {}
+func (*QueryDenomScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{6}
+}
+func (m *QueryDenomScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomScheduleRequest.Merge(m, src)
+}
+func (m *QueryDenomScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryDenomScheduleRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomScheduleResponse is response for DenomSchedule rpc method
+type QueryDenomScheduleResponse struct {
+	// schedule is liquid vesting token schedule for its whole supply
+	Schedule DenomSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryDenomScheduleResponse) Reset()         { *m = QueryDenomScheduleResponse{} }
+func (m *QueryDenomScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomScheduleResponse) ProtoMessage()    {}
+func (*QueryDenomScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{7}
+}
+func (m *QueryDenomScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomScheduleResponse.Merge(m, src)
+}
+func (m *QueryDenomScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryDenomScheduleResponse) GetSchedule() DenomSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return DenomSchedule{}
+}
+
+// LiquidPosition is liquid vesting token amount held by account
+type LiquidPosition struct {
+	// balance is amount of liquid vesting token held
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// schedule is the share of liquid vesting token schedule for the balance
+	Schedule DenomSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *LiquidPosition) Reset()         { *m = LiquidPosition{} }
+func (m *LiquidPosition) String() string { return proto.CompactTextString(m) }
+func (*LiquidPosition) ProtoMessage()    {}
+func (*LiquidPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{8}
+}
+func (m *LiquidPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidPosition.Merge(m, src)
+}
+func (m *LiquidPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidPosition proto.InternalMessageInfo
+
+func (m *LiquidPosition) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *LiquidPosition) GetSchedule() DenomSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return DenomSchedule{}
+}
+
+// QueryAccountLiquidPositionsRequest is request for AccountLiquidPositions rpc
+// method
+type QueryAccountLiquidPositionsRequest struct {
+	// address is account holding liquid vesting tokens
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountLiquidPositionsRequest) Reset()         { *m = QueryAccountLiquidPositionsRequest{} }
+func (m *QueryAccountLiquidPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLiquidPositionsRequest) ProtoMessage()    {}
+func (*QueryAccountLiquidPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{9}
+}
+func (m *QueryAccountLiquidPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLiquidPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLiquidPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLiquidPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLiquidPositionsRequest.Merge(m, src)
+}
+func (m *QueryAccountLiquidPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLiquidPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLiquidPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLiquidPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountLiquidPositionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountLiquidPositionsResponse is response for AccountLiquidPositions
+// rpc method
+type QueryAccountLiquidPositionsResponse struct {
+	// positions are liquid vesting tokens held by account
+	Positions []LiquidPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// total_redeemable is sum of all positions redeemable amounts
+	TotalRedeemable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_redeemable,json=totalRedeemable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_redeemable"`
+	// total_locked is sum of all positions locked amounts
+	TotalLocked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_locked,json=totalLocked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_locked"`
+}
+
+func (m *QueryAccountLiquidPositionsResponse) Reset()         { *m = QueryAccountLiquidPositionsResponse{} }
+func (m *QueryAccountLiquidPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountLiquidPositionsResponse) ProtoMessage()    {}
+func (*QueryAccountLiquidPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d414430b780fcf7, []int{10}
+}
+func (m *QueryAccountLiquidPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountLiquidPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountLiquidPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountLiquidPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountLiquidPositionsResponse.Merge(m, src)
+}
+func (m *QueryAccountLiquidPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountLiquidPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountLiquidPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountLiquidPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountLiquidPositionsResponse) GetPositions() []LiquidPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryAccountLiquidPositionsResponse) GetTotalRedeemable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRedeemable
+	}
+	return nil
+}
+
+func (m *QueryAccountLiquidPositionsResponse) GetTotalLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalLocked
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryDenomRequest)(nil), "haqq.liquidvesting.v1.QueryDenomRequest")
 	proto.RegisterType((*QueryDenomResponse)(nil), "haqq.liquidvesting.v1.QueryDenomResponse")
 	proto.RegisterType((*QueryDenomsRequest)(nil), "haqq.liquidvesting.v1.QueryDenomsRequest")
 	proto.RegisterType((*QueryDenomsResponse)(nil), "haqq.liquidvesting.v1.QueryDenomsResponse")
+	proto.RegisterType((*ScheduleTranche)(nil), "haqq.liquidvesting.v1.ScheduleTranche")
+	proto.RegisterType((*DenomSchedule)(nil), "haqq.liquidvesting.v1.DenomSchedule")
+	proto.RegisterType((*QueryDenomScheduleRequest)(nil), "haqq.liquidvesting.v1.QueryDenomScheduleRequest")
+	proto.RegisterType((*QueryDenomScheduleResponse)(nil), "haqq.liquidvesting.v1.QueryDenomScheduleResponse")
+	proto.RegisterType((*LiquidPosition)(nil), "haqq.liquidvesting.v1.LiquidPosition")
+	proto.RegisterType((*QueryAccountLiquidPositionsRequest)(nil), "haqq.liquidvesting.v1.QueryAccountLiquidPositionsRequest")
+	proto.RegisterType((*QueryAccountLiquidPositionsResponse)(nil), "haqq.liquidvesting.v1.QueryAccountLiquidPositionsResponse")
 }
 
 func init() { proto.RegisterFile("haqq/liquidvesting/v1/query.proto", fileDescriptor_0d414430b780fcf7) }
 
 var fileDescriptor_0d414430b780fcf7 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8b, 0x13, 0x31,
-	0x1c, 0xc5, 0x27, 0x95, 0x16, 0x36, 0x9e, 0x36, 0xae, 0xb0, 0x0c, 0x75, 0x76, 0x1d, 0xfc, 0xb1,
-	0x2d, 0x98, 0x30, 0xf5, 0x2c, 0xc2, 0x22, 0x7a, 0xf1, 0xa0, 0xf5, 0x26, 0x5e, 0x32, 0xbb, 0x21,
-	0x1b, 0xdc, 0x49, 0x66, 0x9a, 0xcc, 0xe8, 0x5e, 0x7b, 0xf0, 0x2c, 0x88, 0x67, 0xaf, 0x1e, 0xfd,
-	0x33, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0xd2, 0x0a, 0xfe, 0x1b, 0x32, 0x49, 0x8a, 0x33, 0x5a, 0x6d,
-	0xbd, 0x94, 0xa4, 0xf3, 0xbe, 0xef, 0x7d, 0xf2, 0x12, 0x78, 0xfd, 0x8c, 0x16, 0x05, 0x39, 0x17,
-	0x45, 0x29, 0x4e, 0x2b, 0xa6, 0x8d, 0x90, 0x9c, 0x54, 0x09, 0x29, 0x4a, 0x36, 0xb9, 0xc0, 0xf9,
-	0x44, 0x19, 0x85, 0xae, 0xd6, 0x12, 0xdc, 0x92, 0xe0, 0x2a, 0x09, 0x77, 0x69, 0x26, 0xa4, 0x22,
-	0xf6, 0xd7, 0x29, 0xc3, 0x3d, 0xae, 0xb8, 0xb2, 0x4b, 0x52, 0xaf, 0xfc, 0xbf, 0x7d, 0xae, 0x14,
-	0x3f, 0x67, 0x84, 0xe6, 0x82, 0x50, 0x29, 0x95, 0xa1, 0x46, 0x28, 0xa9, 0xfd, 0xd7, 0xe1, 0x89,
-	0xd2, 0x99, 0xd2, 0x24, 0xa5, 0x9a, 0xb9, 0x58, 0x52, 0x25, 0x29, 0x33, 0x34, 0x21, 0x39, 0xe5,
-	0x42, 0x5a, 0xb1, 0xd7, 0x0e, 0xd6, 0xc3, 0xb6, 0xd1, 0xac, 0x34, 0x1e, 0xc0, 0xdd, 0xa7, 0xb5,
-	0xd9, 0x03, 0x26, 0x55, 0x36, 0x66, 0x45, 0xc9, 0xb4, 0x41, 0x7b, 0xb0, 0x7b, 0x5a, 0xef, 0xf7,
-	0xc1, 0x21, 0x38, 0xda, 0x19, 0xbb, 0x4d, 0xfc, 0x0c, 0xa2, 0xa6, 0x54, 0xe7, 0x4a, 0x6a, 0x86,
-	0xee, 0x35, 0xb5, 0x97, 0x47, 0x7d, 0xbc, 0xb6, 0x05, 0x6c, 0x87, 0x8e, 0x77, 0x66, 0x5f, 0x0f,
-	0x82, 0x8f, 0x3f, 0x3e, 0x0d, 0xc1, 0xca, 0xf4, 0x45, 0xd3, 0x54, 0xaf, 0x00, 0x1e, 0x42, 0xf8,
-	0xeb, 0x50, 0xde, 0xf9, 0x16, 0x76, 0x0d, 0xe0, 0xba, 0x01, 0xec, 0x8a, 0xf7, 0x0d, 0xe0, 0x27,
-	0x94, 0x33, 0x3f, 0x3b, 0x6e, 0x4c, 0xc6, 0x1f, 0x00, 0xbc, 0xd2, 0xb2, 0xf7, 0xd0, 0xf7, 0x61,
-	0xcf, 0xc6, 0xeb, 0x7d, 0x70, 0x78, 0xe9, 0x7f, 0xa8, 0xfd, 0x18, 0x7a, 0xd4, 0x02, 0xec, 0x58,
-	0xc0, 0xdb, 0x1b, 0x01, 0x5d, 0x7a, 0x93, 0x70, 0xf4, 0xbe, 0x03, 0xbb, 0x96, 0x10, 0x4d, 0x01,
-	0xec, 0xda, 0x3c, 0x74, 0xf4, 0x17, 0x9a, 0x3f, 0x2e, 0x2a, 0x1c, 0x6c, 0xa1, 0x74, 0xa1, 0xf1,
-	0x8d, 0xe9, 0xe7, 0xef, 0xef, 0x3a, 0x11, 0xea, 0x93, 0xf5, 0x8f, 0xc3, 0x1e, 0x0c, 0xbd, 0x01,
-	0xb0, 0xe7, 0xba, 0x42, 0x9b, 0xbd, 0x57, 0xd7, 0x15, 0x0e, 0xb7, 0x91, 0x7a, 0x8e, 0x9b, 0x96,
-	0xe3, 0x00, 0x5d, 0xfb, 0x17, 0x87, 0x3e, 0x7e, 0x3c, 0x5b, 0x44, 0x60, 0xbe, 0x88, 0xc0, 0xb7,
-	0x45, 0x04, 0xde, 0x2e, 0xa3, 0x60, 0xbe, 0x8c, 0x82, 0x2f, 0xcb, 0x28, 0x78, 0x3e, 0xe2, 0xc2,
-	0x9c, 0x95, 0x29, 0x3e, 0x51, 0x99, 0xb5, 0xb8, 0x23, 0x99, 0x79, 0xa5, 0x26, 0x2f, 0x9d, 0xdf,
-	0xeb, 0xdf, 0x1c, 0xcd, 0x45, 0xce, 0x74, 0xda, 0xb3, 0x8f, 0xfd, 0xee, 0xcf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x83, 0x59, 0xeb, 0x98, 0xc6, 0x03, 0x00, 0x00,
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0xec, 0xb2, 0x69, 0xf2, 0x02, 0x4d, 0x3b, 0x14, 0xb4, 0xb5, 0x82, 0x37, 0x98, 0xa6,
+	0x4d, 0x22, 0xc5, 0x66, 0x83, 0x38, 0x14, 0x44, 0x51, 0x43, 0x81, 0x03, 0x11, 0x2a, 0xdb, 0x9e,
+	0xaa, 0x4a, 0xab, 0x59, 0x7b, 0xf0, 0x5a, 0xb1, 0x3d, 0xde, 0x1d, 0x3b, 0x50, 0x55, 0x5c, 0x7a,
+	0xe0, 0x1c, 0x89, 0x33, 0xe2, 0xc0, 0x05, 0x71, 0xe2, 0xce, 0x3f, 0xd0, 0x63, 0x05, 0x17, 0x4e,
+	0x2d, 0x4a, 0x90, 0xfa, 0x6f, 0x20, 0xcf, 0x8f, 0x5d, 0x7b, 0xd9, 0x75, 0xda, 0xa0, 0xbd, 0x24,
+	0x1e, 0xcf, 0x7b, 0xdf, 0xfb, 0xde, 0xf7, 0x7e, 0x78, 0xe1, 0xed, 0x3e, 0x19, 0x0c, 0x9c, 0x30,
+	0x18, 0x64, 0x81, 0x77, 0x48, 0x79, 0x1a, 0xc4, 0xbe, 0x73, 0xd8, 0x76, 0x06, 0x19, 0x1d, 0x3e,
+	0xb0, 0x93, 0x21, 0x4b, 0x19, 0x7e, 0x23, 0x37, 0xb1, 0x4b, 0x26, 0xf6, 0x61, 0xdb, 0xb8, 0x48,
+	0xa2, 0x20, 0x66, 0x8e, 0xf8, 0x2b, 0x2d, 0x8d, 0x4b, 0x3e, 0xf3, 0x99, 0x78, 0x74, 0xf2, 0x27,
+	0xf5, 0x76, 0xcd, 0x67, 0xcc, 0x0f, 0xa9, 0x43, 0x92, 0xc0, 0x21, 0x71, 0xcc, 0x52, 0x92, 0x06,
+	0x2c, 0xe6, 0xea, 0x76, 0xdb, 0x65, 0x3c, 0x62, 0xdc, 0xe9, 0x11, 0x4e, 0x65, 0x58, 0xe7, 0xb0,
+	0xdd, 0xa3, 0x29, 0x69, 0x3b, 0x09, 0xf1, 0x83, 0x58, 0x18, 0x2b, 0x5b, 0xb3, 0x68, 0xab, 0xad,
+	0x5c, 0x16, 0xe8, 0xfb, 0x96, 0x8a, 0x24, 0x4e, 0xbd, 0xec, 0x6b, 0x27, 0x0d, 0x22, 0xca, 0x53,
+	0x12, 0x25, 0xca, 0x60, 0x6b, 0x7a, 0xb6, 0xe5, 0xdc, 0x84, 0xa9, 0xb5, 0x05, 0x17, 0xbf, 0xca,
+	0xd9, 0xdc, 0xa2, 0x31, 0x8b, 0x3a, 0x74, 0x90, 0x51, 0x9e, 0xe2, 0x4b, 0xd0, 0xf0, 0xf2, 0x73,
+	0x13, 0xad, 0xa3, 0xcd, 0xe5, 0x8e, 0x3c, 0x58, 0x77, 0x00, 0x17, 0x4d, 0x79, 0xc2, 0x62, 0x4e,
+	0xf1, 0x47, 0x45, 0xdb, 0x95, 0xdd, 0x35, 0x7b, 0xaa, 0x8c, 0xb6, 0x70, 0xda, 0x5b, 0x7e, 0xfc,
+	0xb4, 0xb5, 0xf0, 0xcb, 0xf3, 0xdf, 0xb6, 0x91, 0x06, 0xbd, 0x5f, 0x04, 0xe5, 0x9a, 0xc0, 0x67,
+	0x00, 0x63, 0x55, 0x14, 0xf2, 0x55, 0x5b, 0xca, 0x62, 0xe7, 0xb2, 0xd8, 0xb2, 0x72, 0x4a, 0x1c,
+	0xfb, 0x36, 0xf1, 0xa9, 0xf2, 0xed, 0x14, 0x3c, 0xad, 0x9f, 0x10, 0xbc, 0x5e, 0x82, 0x57, 0xa4,
+	0x3f, 0x86, 0x45, 0x11, 0x9e, 0x37, 0xd1, 0x7a, 0xfd, 0x65, 0x58, 0x2b, 0x37, 0xfc, 0x79, 0x89,
+	0x60, 0x4d, 0x10, 0xbc, 0x76, 0x2a, 0x41, 0x19, 0xbd, 0xc4, 0xf0, 0x29, 0x82, 0xd5, 0x3b, 0x6e,
+	0x9f, 0x7a, 0x59, 0x48, 0xef, 0x0e, 0x49, 0xec, 0xf6, 0x29, 0xfe, 0x14, 0x56, 0xb2, 0x38, 0x64,
+	0xee, 0x41, 0x37, 0x2f, 0xac, 0x4a, 0xdf, 0xb0, 0x65, 0xd5, 0x6d, 0x5d, 0x75, 0xfb, 0xae, 0xae,
+	0xfa, 0xde, 0x52, 0x4e, 0xf0, 0xe8, 0x59, 0x0b, 0x75, 0x40, 0x3a, 0xe6, 0x57, 0xb8, 0x0f, 0x8b,
+	0x24, 0x62, 0x59, 0x9c, 0x36, 0x6b, 0x22, 0xc9, 0xcb, 0x25, 0x7e, 0x9a, 0xd9, 0x27, 0x2c, 0x88,
+	0xf7, 0xde, 0xcf, 0x01, 0x7e, 0x7d, 0xd6, 0xda, 0xf4, 0x83, 0xb4, 0x9f, 0xf5, 0x6c, 0x97, 0x45,
+	0x8e, 0x6a, 0x42, 0xf9, 0x6f, 0x87, 0x7b, 0x07, 0x4e, 0xfa, 0x20, 0xa1, 0x5c, 0x38, 0x70, 0xa5,
+	0x86, 0xc4, 0xc7, 0x06, 0x2c, 0x0d, 0x69, 0x48, 0x09, 0xa7, 0x5e, 0xb3, 0xbe, 0x8e, 0x36, 0x97,
+	0x3a, 0xa3, 0xb3, 0xf5, 0x7b, 0x1d, 0x5e, 0x13, 0x32, 0xea, 0x2c, 0xa7, 0x77, 0x17, 0xbe, 0x07,
+	0xab, 0x39, 0xf3, 0x2c, 0xe9, 0xa6, 0x52, 0x06, 0xae, 0x68, 0x5f, 0x9d, 0x51, 0x9b, 0x09, 0xd5,
+	0x8a, 0x55, 0x3a, 0x2f, 0x91, 0xd4, 0x0d, 0xc7, 0xf7, 0xe1, 0x82, 0x72, 0x1c, 0x83, 0xd7, 0xcf,
+	0x0a, 0xbe, 0xaa, 0xcc, 0x46, 0xe8, 0x09, 0xc0, 0x90, 0x7a, 0x94, 0x46, 0xa4, 0x17, 0xd2, 0xe6,
+	0x2b, 0x73, 0xd2, 0xba, 0x10, 0x23, 0xaf, 0x6c, 0x9e, 0x21, 0xf5, 0x9a, 0x8d, 0x79, 0x55, 0x56,
+	0xe2, 0x5b, 0x6d, 0xb8, 0x3c, 0x9e, 0x1f, 0x2d, 0x4a, 0xf5, 0x9a, 0x08, 0xc0, 0x98, 0xe6, 0xa2,
+	0x26, 0xef, 0x0b, 0x58, 0xe2, 0xea, 0x9d, 0x6a, 0xec, 0x2b, 0x55, 0xb3, 0xa7, 0xfd, 0x8b, 0x05,
+	0x18, 0x01, 0x58, 0x3f, 0x22, 0x38, 0xbf, 0x2f, 0xfc, 0x6e, 0x33, 0x1e, 0xe4, 0xf3, 0x84, 0x6f,
+	0xc0, 0xb9, 0x1e, 0x09, 0x49, 0xec, 0x6a, 0xf8, 0x0a, 0x6d, 0x0a, 0x98, 0xda, 0xa9, 0xc4, 0xaf,
+	0xf6, 0x7f, 0xf9, 0xdd, 0x00, 0x4b, 0x48, 0x71, 0xd3, 0x75, 0xf3, 0x39, 0x29, 0x53, 0x1d, 0x2d,
+	0xbb, 0x26, 0x9c, 0x23, 0x9e, 0x37, 0xa4, 0x9c, 0x2b, 0x21, 0xf5, 0xd1, 0x7a, 0x5e, 0x83, 0x77,
+	0x2a, 0x01, 0x94, 0xa8, 0x5f, 0xc2, 0x72, 0xa2, 0x5f, 0xaa, 0x8d, 0xb6, 0x31, 0x83, 0x75, 0x19,
+	0xa2, 0x48, 0x7b, 0x0c, 0x81, 0x1f, 0xc2, 0x85, 0x94, 0xa5, 0x24, 0xec, 0x16, 0xfa, 0x7a, 0x5e,
+	0x3b, 0x64, 0x55, 0x44, 0xea, 0x8c, 0x9b, 0x9b, 0xc3, 0xab, 0x32, 0xb8, 0x6a, 0xf1, 0xfa, 0x9c,
+	0x02, 0xaf, 0x88, 0x28, 0xfb, 0x22, 0xc8, 0xee, 0x51, 0x03, 0x1a, 0x42, 0x69, 0xfc, 0x08, 0x41,
+	0x43, 0x94, 0x16, 0x6f, 0xce, 0x90, 0xf0, 0x3f, 0xdf, 0x4b, 0x63, 0xeb, 0x05, 0x2c, 0x65, 0xa9,
+	0xac, 0x2b, 0x8f, 0xfe, 0xfc, 0xe7, 0x87, 0x9a, 0x89, 0xd7, 0x9c, 0xe9, 0xdf, 0x68, 0xb9, 0x0c,
+	0xbf, 0x47, 0xb0, 0x78, 0x4b, 0x7e, 0x69, 0x4e, 0xc7, 0xd6, 0x8d, 0x64, 0x6c, 0xbf, 0x88, 0xa9,
+	0xe2, 0xb1, 0x21, 0x78, 0xb4, 0xf0, 0x5b, 0x55, 0x3c, 0x38, 0xfe, 0x19, 0x4d, 0x6e, 0xef, 0x77,
+	0x4f, 0x0d, 0x32, 0xb1, 0x26, 0x8c, 0xf6, 0x4b, 0x78, 0x28, 0x76, 0x3b, 0x82, 0xdd, 0x35, 0xbc,
+	0x51, 0xc5, 0xae, 0xab, 0xe7, 0x0c, 0xff, 0x81, 0xe0, 0xcd, 0xe9, 0x23, 0x82, 0xaf, 0x57, 0x05,
+	0xaf, 0x9c, 0x4b, 0xe3, 0x83, 0xb3, 0xb8, 0xaa, 0x04, 0x6e, 0x8a, 0x04, 0x3e, 0xc4, 0xd7, 0x67,
+	0x24, 0x40, 0xa4, 0x7b, 0x57, 0x5e, 0x74, 0x47, 0xa3, 0xe7, 0x3c, 0x54, 0xb3, 0xff, 0xdd, 0xde,
+	0xfe, 0xe3, 0x63, 0x13, 0x3d, 0x39, 0x36, 0xd1, 0xdf, 0xc7, 0x26, 0x3a, 0x3a, 0x31, 0x17, 0x9e,
+	0x9c, 0x98, 0x0b, 0x7f, 0x9d, 0x98, 0x0b, 0xf7, 0x76, 0x0b, 0x8d, 0x9e, 0xc3, 0xef, 0xc4, 0x34,
+	0xfd, 0x86, 0x0d, 0x0f, 0x64, 0xac, 0x6f, 0x27, 0xa2, 0x89, 0xc6, 0xef, 0x2d, 0x8a, 0x9f, 0x0d,
+	0xef, 0xfd, 0x3b, 0x00, 0xab, 0x1c, 0xcb, 0x9e, 0x09, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -281,6 +730,12 @@ type QueryClient interface {
 	Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error)
 	// Denoms queries liquid vesting tokens info
 	Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	// DenomSchedule queries liquid vesting token schedule resolved at current
+	// block time
+	DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error)
+	// AccountLiquidPositions queries liquid vesting tokens held by account with
+	// their schedules resolved at current block time
+	AccountLiquidPositions(ctx context.Context, in *QueryAccountLiquidPositionsRequest, opts ...grpc.CallOption) (*QueryAccountLiquidPositionsResponse, error)
 }
 
 type queryClient struct {
@@ -309,12 +764,36 @@ func (c *queryClient) Denoms(ctx context.Context, in *QueryDenomsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error) {
+	out := new(QueryDenomScheduleResponse)
+	err := c.cc.Invoke(ctx, "/haqq.liquidvesting.v1.Query/DenomSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLiquidPositions(ctx context.Context, in *QueryAccountLiquidPositionsRequest, opts ...grpc.CallOption) (*QueryAccountLiquidPositionsResponse, error) {
+	out := new(QueryAccountLiquidPositionsResponse)
+	err := c.cc.Invoke(ctx, "/haqq.liquidvesting.v1.Query/AccountLiquidPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Denom queries liquid vesting token info by denom
 	Denom(context.Context, *QueryDenomRequest) (*QueryDenomResponse, error)
 	// Denoms queries liquid vesting tokens info
 	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
+	// DenomSchedule queries liquid vesting token schedule resolved at current
+	// block time
+	DenomSchedule(context.Context, *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error)
+	// AccountLiquidPositions queries liquid vesting tokens held by account with
+	// their schedules resolved at current block time
+	AccountLiquidPositions(context.Context, *QueryAccountLiquidPositionsRequest) (*QueryAccountLiquidPositionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -327,6 +806,12 @@ func (*UnimplementedQueryServer) Denom(ctx context.Context, req *QueryDenomReque
 func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenomsRequest) (*QueryDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
 }
+func (*UnimplementedQueryServer) DenomSchedule(ctx context.Context, req *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSchedule not implemented")
+}
+func (*UnimplementedQueryServer) AccountLiquidPositions(ctx context.Context, req *QueryAccountLiquidPositionsRequest) (*QueryAccountLiquidPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLiquidPositions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -368,20 +853,64 @@ func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "haqq.liquidvesting.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denom",
-			Handler:    _Query_Denom_Handler,
-		},
-		{
-			MethodName: "Denoms",
-			Handler:    _Query_Denoms_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
+func _Query_DenomSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.liquidvesting.v1.Query/DenomSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSchedule(ctx, req.(*QueryDenomScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLiquidPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLiquidPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLiquidPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.liquidvesting.v1.Query/AccountLiquidPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLiquidPositions(ctx, req.(*QueryAccountLiquidPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "haqq.liquidvesting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denom",
+			Handler:    _Query_Denom_Handler,
+		},
+		{
+			MethodName: "Denoms",
+			Handler:    _Query_Denoms_Handler,
+		},
+		{
+			MethodName: "DenomSchedule",
+			Handler:    _Query_DenomSchedule_Handler,
+		},
+		{
+			MethodName: "AccountLiquidPositions",
+			Handler:    _Query_AccountLiquidPositions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/liquidvesting/v1/query.proto",
 }
 
@@ -525,87 +1054,1289 @@ func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Redeemable) > 0 {
+		for iNdEx := len(m.Redeemable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redeemable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VestingTranches) > 0 {
+		for iNdEx := len(m.VestingTranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockupTranches) > 0 {
+		for iNdEx := len(m.LockupTranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLiquidPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLiquidPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLiquidPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountLiquidPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountLiquidPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountLiquidPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalLocked) > 0 {
+		for iNdEx := len(m.TotalLocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalRedeemable) > 0 {
+		for iNdEx := len(m.TotalRedeemable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRedeemable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Denom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockupTranches) > 0 {
+		for _, e := range m.LockupTranches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingTranches) > 0 {
+		for _, e := range m.VestingTranches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Redeemable) > 0 {
+		for _, e := range m.Redeemable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountLiquidPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountLiquidPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRedeemable) > 0 {
+		for _, e := range m.TotalRedeemable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalLocked) > 0 {
+		for _, e := range m.TotalLocked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, Denom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTranches = append(m.LockupTranches, ScheduleTranche{})
+			if err := m.LockupTranches[len(m.LockupTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingTranches = append(m.VestingTranches, ScheduleTranche{})
+			if err := m.VestingTranches[len(m.VestingTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemable = append(m.Redeemable, types.Coin{})
+			if err := m.Redeemable[len(m.Redeemable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Denom.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, e := range m.Denoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -628,10 +2359,10 @@ func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -687,7 +2418,7 @@ func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -710,15 +2441,15 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -745,7 +2476,7 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -770,7 +2501,7 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *LiquidPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -793,15 +2524,15 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -828,10 +2559,40 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -856,7 +2617,7 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountLiquidPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,15 +2640,97 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountLiquidPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountLiquidPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountLiquidPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountLiquidPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountLiquidPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -914,14 +2757,14 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, Denom{})
-			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, LiquidPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRedeemable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -948,10 +2791,42 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.TotalRedeemable = append(m.TotalRedeemable, types.Coin{})
+			if err := m.TotalRedeemable[len(m.TotalRedeemable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalLocked = append(m.TotalLocked, types.Coin{})
+			if err := m.TotalLocked[len(m.TotalLocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -62,6 +62,52 @@ func SubtractAmountFromPeriods(
 	return decreasedPeriods, diffPeriods, nil
 }
 
+// SubtractAmountFromSchedule subtracts coin amount from the schedule periods started
+// at startTime. The amount is subtracted proportionally from the periods upcoming at
+// readTime first and only the rest from the past periods. Returns the decreased
+// schedule and the residual schedule of the subtracted amount starting at readTime,
+// where the part subtracted from the past periods is released immediately.
+func SubtractAmountFromSchedule(
+	startTime, endTime int64,
+	periods sdkvesting.Periods,
+	subtrahend sdk.Coin,
+	readTime int64,
+) (decreasedPeriods, residualPeriods sdkvesting.Periods, err error) {
+	pastPeriods := ExtractPastPeriods(startTime, endTime, periods, readTime)
+	upcomingPeriods := ExtractUpcomingPeriods(startTime, endTime, periods, readTime)
+
+	fromUpcoming := math.MinInt(subtrahend.Amount, upcomingPeriods.TotalAmount().AmountOf(subtrahend.Denom))
+	fromPast := subtrahend.Amount.Sub(fromUpcoming)
+
+	residualPeriods = make(sdkvesting.Periods, 0, len(upcomingPeriods)+1)
+	if fromPast.IsPositive() {
+		pastPeriods, _, err = SubtractAmountFromPeriods(pastPeriods, sdk.NewCoin(subtrahend.Denom, fromPast))
+		if err != nil {
+			return nil, nil, err
+		}
+		residualPeriods = append(residualPeriods, sdkvesting.Period{
+			Length: 0,
+			Amount: sdk.NewCoins(sdk.NewCoin(subtrahend.Denom, fromPast)),
+		})
+	}
+
+	if fromUpcoming.IsPositive() {
+		var diffPeriods sdkvesting.Periods
+		upcomingPeriods, diffPeriods, err = SubtractAmountFromPeriods(upcomingPeriods, sdk.NewCoin(subtrahend.Denom, fromUpcoming))
+		if err != nil {
+			return nil, nil, err
+		}
+		diffPeriods[0].Length -= CurrentPeriodShift(startTime, readTime, periods)
+		residualPeriods = append(residualPeriods, diffPeriods...)
+	}
+
+	decreasedPeriods = make(sdkvesting.Periods, 0, len(periods))
+	decreasedPeriods = append(decreasedPeriods, pastPeriods...)
+	decreasedPeriods = append(decreasedPeriods, upcomingPeriods...)
+
+	return decreasedPeriods, residualPeriods, nil
+}
+
 // ExtractUpcomingPeriods takes the list of periods with started time and
 // returns list of periods which are currently upcoming
 func ExtractUpcomingPeriods(startDate, endDate int64, periods sdkvesting.Periods, readTime int64) sdkvesting.Periods {
@@ -128,6 +128,88 @@ func (suite *ScheduleTestSuite) TestSubtractAmountFromPeriods() {
 	}
 }
 
+func (suite *ScheduleTestSuite) TestSubtractAmountFromSchedule() {
+	periods := sdkvesting.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+	}
+
+	testCases := []struct {
+		name              string
+		subtrahend        sdk.Coin
+		readTime          int64
+		expectedDecreased sdkvesting.Periods
+		expectedResidual  sdkvesting.Periods
+		expectError       bool
+	}{
+		{
+			name:       "OK subtraction from upcoming periods only",
+			subtrahend: sdk.NewCoin("test", math.NewInt(100)),
+			readTime:   1700000150,
+			expectedDecreased: []sdkvesting.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+			},
+			expectedResidual: []sdkvesting.Period{
+				{Length: 50, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+			},
+		},
+		{
+			name:       "OK subtraction exceeding upcoming periods takes the rest from the past",
+			subtrahend: sdk.NewCoin("test", math.NewInt(250)),
+			readTime:   1700000150,
+			expectedDecreased: []sdkvesting.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+				{Length: 100, Amount: sdk.NewCoins()},
+				{Length: 100, Amount: sdk.NewCoins()},
+			},
+			expectedResidual: []sdkvesting.Period{
+				{Length: 0, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(50)))},
+				{Length: 50, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))},
+			},
+		},
+		{
+			name:       "OK subtraction before the schedule start",
+			subtrahend: sdk.NewCoin("test", math.NewInt(30)),
+			readTime:   1600000000,
+			expectedDecreased: []sdkvesting.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(90)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(90)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(90)))},
+			},
+			expectedResidual: []sdkvesting.Period{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))},
+			},
+		},
+		{
+			name:        "FAIL subtrahend is bigger than total schedule amount",
+			subtrahend:  sdk.NewCoin("test", math.NewInt(400)),
+			readTime:    1700000150,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			decreased, residual, err := SubtractAmountFromSchedule(1700000000, 1700000300, periods, tc.subtrahend, tc.readTime)
+
+			if tc.expectError {
+				suite.Error(err)
+			} else {
+				suite.NoError(err)
+				suite.Require().Equal(tc.expectedDecreased.String(), decreased.String())
+				suite.Require().Equal(tc.expectedResidual.String(), residual.String())
+			}
+		})
+	}
+}
+
 func (suite *ScheduleTestSuite) TestExtractUpcomingPeriods() {
 	testCases := []struct {
 		name            string
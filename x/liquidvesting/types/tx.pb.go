@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -253,51 +254,306 @@ func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
 
+// MsgMergeLiquid represents message to merge several liquid tokens into a new
+// one
+type MsgMergeLiquid struct {
+	// account holding liquid tokens subject for merge
+	MergeFrom string `protobuf:"bytes,1,opt,name=merge_from,json=mergeFrom,proto3" json:"merge_from,omitempty"`
+	// account to send resulted liquid token
+	MergeTo string `protobuf:"bytes,2,opt,name=merge_to,json=mergeTo,proto3" json:"merge_to,omitempty"`
+	// amounts of liquid tokens subject for merge, at least two different denoms
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *MsgMergeLiquid) Reset()         { *m = MsgMergeLiquid{} }
+func (m *MsgMergeLiquid) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLiquid) ProtoMessage()    {}
+func (*MsgMergeLiquid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfdce5e8d421730a, []int{4}
+}
+func (m *MsgMergeLiquid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLiquid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLiquid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLiquid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLiquid.Merge(m, src)
+}
+func (m *MsgMergeLiquid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLiquid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLiquid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLiquid proto.InternalMessageInfo
+
+func (m *MsgMergeLiquid) GetMergeFrom() string {
+	if m != nil {
+		return m.MergeFrom
+	}
+	return ""
+}
+
+func (m *MsgMergeLiquid) GetMergeTo() string {
+	if m != nil {
+		return m.MergeTo
+	}
+	return ""
+}
+
+func (m *MsgMergeLiquid) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+// MsgMergeLiquidResponse defines the Msg/MergeLiquid response type
+type MsgMergeLiquidResponse struct {
+	// amount of liquid tokens minted
+	Minted types.Coin `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted"`
+	// address of erc20 the merged liquid denom contract
+	ContractAddr string `protobuf:"bytes,2,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+}
+
+func (m *MsgMergeLiquidResponse) Reset()         { *m = MsgMergeLiquidResponse{} }
+func (m *MsgMergeLiquidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeLiquidResponse) ProtoMessage()    {}
+func (*MsgMergeLiquidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfdce5e8d421730a, []int{5}
+}
+func (m *MsgMergeLiquidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeLiquidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeLiquidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeLiquidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeLiquidResponse.Merge(m, src)
+}
+func (m *MsgMergeLiquidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeLiquidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeLiquidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeLiquidResponse proto.InternalMessageInfo
+
+func (m *MsgMergeLiquidResponse) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *MsgMergeLiquidResponse) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+// MsgSplitLiquid represents message to split arbitrary amount of liquid token
+// into a new one
+type MsgSplitLiquid struct {
+	// account holding liquid token subject for split
+	SplitFrom string `protobuf:"bytes,1,opt,name=split_from,json=splitFrom,proto3" json:"split_from,omitempty"`
+	// account to send resulted liquid token
+	SplitTo string `protobuf:"bytes,2,opt,name=split_to,json=splitTo,proto3" json:"split_to,omitempty"`
+	// amount of liquid token subject for split
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgSplitLiquid) Reset()         { *m = MsgSplitLiquid{} }
+func (m *MsgSplitLiquid) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLiquid) ProtoMessage()    {}
+func (*MsgSplitLiquid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfdce5e8d421730a, []int{6}
+}
+func (m *MsgSplitLiquid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLiquid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLiquid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLiquid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLiquid.Merge(m, src)
+}
+func (m *MsgSplitLiquid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLiquid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLiquid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLiquid proto.InternalMessageInfo
+
+func (m *MsgSplitLiquid) GetSplitFrom() string {
+	if m != nil {
+		return m.SplitFrom
+	}
+	return ""
+}
+
+func (m *MsgSplitLiquid) GetSplitTo() string {
+	if m != nil {
+		return m.SplitTo
+	}
+	return ""
+}
+
+func (m *MsgSplitLiquid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgSplitLiquidResponse defines the Msg/SplitLiquid response type
+type MsgSplitLiquidResponse struct {
+	// amount of liquid tokens minted
+	Minted types.Coin `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted"`
+	// address of erc20 the split liquid denom contract
+	ContractAddr string `protobuf:"bytes,2,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+}
+
+func (m *MsgSplitLiquidResponse) Reset()         { *m = MsgSplitLiquidResponse{} }
+func (m *MsgSplitLiquidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitLiquidResponse) ProtoMessage()    {}
+func (*MsgSplitLiquidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfdce5e8d421730a, []int{7}
+}
+func (m *MsgSplitLiquidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitLiquidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitLiquidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitLiquidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitLiquidResponse.Merge(m, src)
+}
+func (m *MsgSplitLiquidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitLiquidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitLiquidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitLiquidResponse proto.InternalMessageInfo
+
+func (m *MsgSplitLiquidResponse) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSplitLiquidResponse) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgLiquidate)(nil), "haqq.liquidvesting.v1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "haqq.liquidvesting.v1.MsgLiquidateResponse")
 	proto.RegisterType((*MsgRedeem)(nil), "haqq.liquidvesting.v1.MsgRedeem")
 	proto.RegisterType((*MsgRedeemResponse)(nil), "haqq.liquidvesting.v1.MsgRedeemResponse")
+	proto.RegisterType((*MsgMergeLiquid)(nil), "haqq.liquidvesting.v1.MsgMergeLiquid")
+	proto.RegisterType((*MsgMergeLiquidResponse)(nil), "haqq.liquidvesting.v1.MsgMergeLiquidResponse")
+	proto.RegisterType((*MsgSplitLiquid)(nil), "haqq.liquidvesting.v1.MsgSplitLiquid")
+	proto.RegisterType((*MsgSplitLiquidResponse)(nil), "haqq.liquidvesting.v1.MsgSplitLiquidResponse")
 }
 
 func init() { proto.RegisterFile("haqq/liquidvesting/v1/tx.proto", fileDescriptor_cfdce5e8d421730a) }
 
 var fileDescriptor_cfdce5e8d421730a = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x6e, 0x13, 0x31,
-	0x18, 0xc7, 0x73, 0xa9, 0x88, 0x88, 0x93, 0x22, 0xf5, 0x28, 0x22, 0x04, 0x74, 0x4d, 0x2f, 0xaa,
-	0x14, 0xa5, 0xc2, 0x56, 0xd2, 0x09, 0xc4, 0x42, 0x91, 0x98, 0x9a, 0x25, 0x62, 0x62, 0xa9, 0x9c,
-	0x9c, 0x71, 0x2d, 0x62, 0x7f, 0xc9, 0xd9, 0x09, 0xed, 0x82, 0x50, 0xc7, 0x4e, 0x48, 0x4c, 0xbc,
-	0x01, 0x63, 0x1e, 0x80, 0x07, 0xe8, 0x58, 0xc4, 0xc2, 0x84, 0x50, 0x82, 0x94, 0xd7, 0x40, 0xe7,
-	0xbb, 0x5c, 0x8f, 0xaa, 0x11, 0x95, 0x58, 0x4e, 0xf6, 0xff, 0xfb, 0xdb, 0xfe, 0xfb, 0x77, 0x9f,
-	0x91, 0x77, 0x44, 0x47, 0x23, 0x32, 0x10, 0xa3, 0xb1, 0x08, 0x26, 0x4c, 0x1b, 0xa1, 0x38, 0x99,
-	0xb4, 0x88, 0x39, 0xc6, 0xc3, 0x10, 0x0c, 0xb8, 0xf7, 0xa2, 0x3a, 0xfe, 0xab, 0x8e, 0x27, 0xad,
-	0xea, 0x06, 0x95, 0x42, 0x01, 0xb1, 0xdf, 0xd8, 0x59, 0xbd, 0xdf, 0x07, 0x2d, 0x41, 0x13, 0xa9,
-	0xed, 0x0e, 0x52, 0xf3, 0xa4, 0xb0, 0xc9, 0x81, 0x83, 0x1d, 0x92, 0x68, 0x94, 0xa8, 0x8f, 0x38,
-	0x00, 0x1f, 0x30, 0x42, 0x87, 0x82, 0x50, 0xa5, 0xc0, 0x50, 0x23, 0x40, 0xe9, 0xa4, 0xea, 0x25,
-	0x9b, 0xf5, 0xa8, 0x66, 0x64, 0xd2, 0xea, 0x31, 0x43, 0x5b, 0xa4, 0x0f, 0x42, 0xc5, 0x75, 0xff,
-	0x9b, 0x83, 0xca, 0x1d, 0xcd, 0x0f, 0x6c, 0x2e, 0x6a, 0x98, 0xbb, 0x83, 0xee, 0x0c, 0x96, 0x93,
-	0xc3, 0x37, 0x21, 0xc8, 0x8a, 0x53, 0x73, 0x1a, 0xc5, 0xee, 0x7a, 0xaa, 0xbe, 0x0c, 0x41, 0xba,
-	0xdb, 0xa8, 0x7c, 0x69, 0x33, 0x50, 0xc9, 0x5b, 0x53, 0x29, 0xd5, 0x5e, 0x81, 0xfb, 0x0c, 0x15,
-	0xa8, 0x84, 0xb1, 0x32, 0x95, 0xb5, 0x9a, 0xd3, 0x28, 0xb5, 0x1f, 0xe0, 0x38, 0x0b, 0x8e, 0xb2,
-	0xe0, 0x24, 0x0b, 0x7e, 0x01, 0x42, 0xed, 0x17, 0xcf, 0x7f, 0x6e, 0xe5, 0xbe, 0x2c, 0xa6, 0x4d,
-	0xa7, 0x9b, 0xac, 0x79, 0xfa, 0xe4, 0x74, 0x31, 0x6d, 0x5e, 0x89, 0x72, 0xb6, 0x98, 0x36, 0xb7,
-	0x2d, 0xe7, 0xe3, 0x2b, 0xa4, 0xb3, 0x57, 0xf0, 0x4f, 0xd0, 0x66, 0x76, 0xde, 0x65, 0x7a, 0x08,
-	0x4a, 0xb3, 0x28, 0x90, 0x14, 0xca, 0xb0, 0xc0, 0x5e, 0xe9, 0xc6, 0x81, 0xe2, 0x35, 0x6e, 0x1d,
-	0xad, 0xf7, 0x41, 0x99, 0x90, 0xf6, 0xcd, 0x21, 0x0d, 0x82, 0x30, 0xb9, 0x72, 0x79, 0x29, 0x3e,
-	0x0f, 0x82, 0xd0, 0xff, 0xea, 0xa0, 0x62, 0x47, 0xf3, 0x2e, 0x0b, 0x18, 0x93, 0xee, 0x16, 0x2a,
-	0x85, 0x76, 0x94, 0x05, 0x89, 0x62, 0xc9, 0x52, 0x7c, 0x88, 0x8a, 0x89, 0x21, 0x45, 0x78, 0x3b,
-	0x16, 0xfe, 0x9b, 0xdf, 0x5e, 0xc4, 0x2f, 0x7b, 0x7c, 0x04, 0xcf, 0x5b, 0x05, 0x2f, 0x0e, 0xec,
-	0xdf, 0x45, 0x1b, 0xe9, 0x64, 0x89, 0xad, 0xfd, 0x39, 0x8f, 0xd6, 0x3a, 0x9a, 0xbb, 0x67, 0x0e,
-	0x2a, 0x5e, 0xf6, 0x49, 0x1d, 0x5f, 0xdb, 0xd0, 0x38, 0x4b, 0xbe, 0xba, 0x7b, 0x03, 0xd3, 0xf2,
-	0x1c, 0x7f, 0xf7, 0xf4, 0xfb, 0xef, 0x4f, 0xf9, 0x1d, 0xbf, 0x4e, 0x56, 0x3d, 0x25, 0x92, 0xb6,
-	0x83, 0xfb, 0x1e, 0x15, 0x12, 0xc8, 0xb5, 0xd5, 0x67, 0xc4, 0x8e, 0x6a, 0xe3, 0x5f, 0x8e, 0x34,
-	0x42, 0xc3, 0x46, 0xf0, 0xfd, 0xda, 0xea, 0x08, 0x31, 0xd1, 0xea, 0xad, 0x0f, 0x11, 0xed, 0xfd,
-	0x83, 0xf3, 0x99, 0xe7, 0x5c, 0xcc, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0x3e, 0xce, 0xbd, 0xdc, 0xc5,
-	0xdc, 0xcb, 0xfd, 0x98, 0x7b, 0xb9, 0xd7, 0x6d, 0x2e, 0xcc, 0xd1, 0xb8, 0x87, 0xfb, 0x20, 0xed,
-	0x66, 0x8f, 0x15, 0x33, 0xef, 0x20, 0x7c, 0x4b, 0xae, 0xfd, 0x05, 0xe6, 0x64, 0xc8, 0x74, 0xaf,
-	0x60, 0xdf, 0xe4, 0xde, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x5f, 0x65, 0x4b, 0x4c, 0x04,
-	0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xf4, 0xf7, 0x03, 0x3a, 0x05, 0x12, 0x56, 0xd4, 0xb2, 0xea, 0x52, 0xb6, 0xc1,
+	0x34, 0x90, 0xee, 0xa4, 0x10, 0x63, 0x24, 0x5e, 0xc4, 0xc4, 0x13, 0xbd, 0x54, 0x4e, 0x5e, 0xc8,
+	0xb6, 0x3b, 0x2e, 0x2b, 0xdd, 0x99, 0xb2, 0x33, 0x54, 0x88, 0x89, 0x31, 0x1c, 0x39, 0x99, 0xf0,
+	0x26, 0x8c, 0x27, 0x5e, 0x80, 0x17, 0x0f, 0x26, 0x1c, 0x31, 0x5e, 0x3c, 0xa9, 0x01, 0x13, 0x8e,
+	0xbe, 0x05, 0x33, 0x33, 0xdb, 0xed, 0x40, 0x58, 0x2c, 0x21, 0xe1, 0xd2, 0xce, 0xf3, 0x67, 0x66,
+	0xbe, 0xcf, 0x27, 0xcf, 0x3c, 0x0b, 0xad, 0x35, 0x77, 0x63, 0x03, 0xb5, 0x82, 0x8d, 0xcd, 0xc0,
+	0xeb, 0x60, 0xc6, 0x03, 0xe2, 0xa3, 0x4e, 0x15, 0xf1, 0x2d, 0xa7, 0x1d, 0x51, 0x4e, 0x8d, 0x9b,
+	0x22, 0xee, 0x9c, 0x8a, 0x3b, 0x9d, 0xaa, 0x39, 0xee, 0x86, 0x01, 0xa1, 0x48, 0xfe, 0xaa, 0x4c,
+	0xf3, 0x76, 0x93, 0xb2, 0x90, 0x32, 0x14, 0x32, 0x79, 0x42, 0xc8, 0xfc, 0x38, 0x30, 0xe1, 0x53,
+	0x9f, 0xca, 0x25, 0x12, 0xab, 0xd8, 0x7b, 0xd7, 0xa7, 0xd4, 0x6f, 0x61, 0xe4, 0xb6, 0x03, 0xe4,
+	0x12, 0x42, 0xb9, 0xcb, 0x03, 0x4a, 0x58, 0x1c, 0xb5, 0xe2, 0xc3, 0x1a, 0x2e, 0xc3, 0xa8, 0x53,
+	0x6d, 0x60, 0xee, 0x56, 0x51, 0x93, 0x06, 0x44, 0xc5, 0xed, 0xaf, 0x00, 0x8e, 0xd4, 0x98, 0xbf,
+	0x2c, 0x75, 0xb9, 0x1c, 0x1b, 0x33, 0x70, 0xac, 0xd5, 0x35, 0x56, 0x5f, 0x46, 0x34, 0x2c, 0x80,
+	0x22, 0x28, 0xe7, 0xea, 0xa3, 0x89, 0xf7, 0x59, 0x44, 0x43, 0x63, 0x1a, 0x8e, 0xf4, 0xd2, 0x38,
+	0x2d, 0x0c, 0xc8, 0xa4, 0x7c, 0xe2, 0x5b, 0xa1, 0xc6, 0x63, 0x38, 0xe8, 0x86, 0x74, 0x93, 0xf0,
+	0x42, 0xb6, 0x08, 0xca, 0xf9, 0xf9, 0x49, 0x47, 0x69, 0x71, 0x84, 0x16, 0x27, 0xd6, 0xe2, 0x3c,
+	0xa5, 0x01, 0x59, 0xca, 0x1d, 0xfc, 0x98, 0xca, 0x7c, 0x38, 0xd9, 0x9f, 0x05, 0xf5, 0x78, 0xcf,
+	0xe2, 0xa3, 0x9d, 0x93, 0xfd, 0xd9, 0x33, 0x52, 0x76, 0x4f, 0xf6, 0x67, 0xa7, 0x25, 0xe7, 0xad,
+	0x33, 0xa4, 0xf5, 0x12, 0xec, 0x6d, 0x38, 0xa1, 0xdb, 0x75, 0xcc, 0xda, 0x94, 0x30, 0x2c, 0x04,
+	0x85, 0x01, 0xe1, 0xd8, 0x2b, 0x80, 0xcb, 0x08, 0x52, 0x7b, 0x8c, 0x12, 0x1c, 0x6d, 0x52, 0xc2,
+	0x23, 0xb7, 0xc9, 0x57, 0x5d, 0xcf, 0x8b, 0xe2, 0x92, 0x47, 0xba, 0xce, 0x27, 0x9e, 0x17, 0xd9,
+	0x9f, 0x00, 0xcc, 0xd5, 0x98, 0x5f, 0xc7, 0x1e, 0xc6, 0xa1, 0x31, 0x05, 0xf3, 0x91, 0x5c, 0xe9,
+	0x20, 0xa1, 0x72, 0x49, 0x8a, 0x77, 0x60, 0x2e, 0x4e, 0x48, 0x10, 0x0e, 0x2b, 0xc7, 0x95, 0xf9,
+	0x2d, 0x08, 0x7e, 0xfa, 0xf5, 0x02, 0x9e, 0x95, 0x06, 0x4f, 0x09, 0xb6, 0x6f, 0xc0, 0xf1, 0xc4,
+	0xe8, 0x62, 0xb3, 0xff, 0x00, 0x38, 0x56, 0x63, 0x7e, 0x0d, 0x47, 0x3e, 0x56, 0x50, 0x8d, 0x7b,
+	0x10, 0x86, 0xc2, 0xd4, 0xeb, 0xca, 0x49, 0x8f, 0x2c, 0x6b, 0x12, 0x0e, 0xab, 0x70, 0x52, 0xd5,
+	0x90, 0xb4, 0x57, 0xa8, 0xf1, 0x0a, 0x0e, 0x29, 0x81, 0xac, 0x90, 0x2d, 0x66, 0x2f, 0xae, 0xea,
+	0x81, 0xa8, 0xea, 0xe3, 0xcf, 0xa9, 0xb2, 0x1f, 0xf0, 0xb5, 0xcd, 0x86, 0xd3, 0xa4, 0x21, 0x8a,
+	0xdb, 0x59, 0xfd, 0x55, 0x98, 0xb7, 0x8e, 0xf8, 0x76, 0x1b, 0x33, 0xb9, 0x81, 0x29, 0x02, 0xdd,
+	0x0b, 0x16, 0x1f, 0x0a, 0x04, 0x9a, 0x50, 0x41, 0xa0, 0x94, 0x46, 0x40, 0x2b, 0xcf, 0x7e, 0x03,
+	0x6f, 0x9d, 0xf6, 0x5c, 0x67, 0x0b, 0x7d, 0x56, 0xb8, 0x9f, 0xb7, 0x5b, 0x01, 0xef, 0xe1, 0x66,
+	0xc2, 0x3c, 0x85, 0x5b, 0x7a, 0xba, 0xb8, 0x55, 0xb8, 0x87, 0x5b, 0xda, 0x57, 0xee, 0x21, 0x05,
+	0xb0, 0x77, 0xf5, 0x85, 0x00, 0x35, 0xc1, 0x31, 0x40, 0xcd, 0x73, 0x8d, 0x00, 0xe7, 0xbf, 0xfc,
+	0x07, 0xb3, 0x35, 0xe6, 0x1b, 0xbb, 0x00, 0xe6, 0x7a, 0x73, 0xad, 0xe4, 0x9c, 0x3b, 0x80, 0x1d,
+	0x7d, 0x52, 0x98, 0x73, 0x7d, 0x24, 0x25, 0xef, 0x62, 0x6e, 0xe7, 0xdb, 0xef, 0xbd, 0x81, 0x19,
+	0xbb, 0x84, 0xd2, 0x46, 0x3f, 0x4a, 0xc6, 0x97, 0xf1, 0x16, 0x0e, 0xc6, 0x43, 0xa1, 0x98, 0x7e,
+	0x87, 0xca, 0x30, 0xcb, 0xff, 0xca, 0x48, 0x24, 0x94, 0xa5, 0x04, 0xdb, 0x2e, 0xa6, 0x4b, 0x50,
+	0x13, 0xc0, 0xd8, 0x03, 0x30, 0xaf, 0xbf, 0xe0, 0x99, 0xf4, 0x3b, 0xb4, 0x34, 0xb3, 0xd2, 0x57,
+	0x5a, 0xa2, 0xc7, 0x91, 0x7a, 0xca, 0xf6, 0xfd, 0x74, 0x3d, 0xea, 0x39, 0xaa, 0x88, 0x54, 0xa5,
+	0x37, 0xfa, 0x05, 0xaa, 0xb4, 0x34, 0xb3, 0xd2, 0x57, 0xda, 0x65, 0x54, 0xa9, 0x1e, 0x57, 0x11,
+	0xf3, 0xff, 0x77, 0xa2, 0xe9, 0x96, 0x96, 0x0f, 0x8e, 0x2c, 0x70, 0x78, 0x64, 0x81, 0x5f, 0x47,
+	0x16, 0x78, 0x7f, 0x6c, 0x65, 0x0e, 0x8f, 0xad, 0xcc, 0xf7, 0x63, 0x2b, 0xf3, 0x62, 0x5e, 0x1b,
+	0x48, 0xe2, 0xc8, 0x0a, 0xc1, 0xfc, 0x35, 0x8d, 0xd6, 0xd1, 0xb9, 0x6f, 0x43, 0x0e, 0xa8, 0xc6,
+	0xa0, 0xfc, 0xde, 0x2e, 0xfc, 0x1d, 0x00, 0xd3, 0x0b, 0x80, 0x8c, 0x28, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -318,6 +574,12 @@ type MsgClient interface {
 	// Redeem burns liquid token and deposits corresponding amount of vesting
 	// token to the specified account
 	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	// MergeLiquid burns several liquid tokens and mints a new liquid token with
+	// the schedule combined from the burnt ones
+	MergeLiquid(ctx context.Context, in *MsgMergeLiquid, opts ...grpc.CallOption) (*MsgMergeLiquidResponse, error)
+	// SplitLiquid burns part of liquid token and mints a new liquid token with
+	// the proportional part of its schedule
+	SplitLiquid(ctx context.Context, in *MsgSplitLiquid, opts ...grpc.CallOption) (*MsgSplitLiquidResponse, error)
 }
 
 type msgClient struct {
@@ -346,6 +608,24 @@ func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) MergeLiquid(ctx context.Context, in *MsgMergeLiquid, opts ...grpc.CallOption) (*MsgMergeLiquidResponse, error) {
+	out := new(MsgMergeLiquidResponse)
+	err := c.cc.Invoke(ctx, "/haqq.liquidvesting.v1.Msg/MergeLiquid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitLiquid(ctx context.Context, in *MsgSplitLiquid, opts ...grpc.CallOption) (*MsgSplitLiquidResponse, error) {
+	out := new(MsgSplitLiquidResponse)
+	err := c.cc.Invoke(ctx, "/haqq.liquidvesting.v1.Msg/SplitLiquid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Liquidate transforms specified amount of tokens locked on vesting account
@@ -354,6 +634,12 @@ type MsgServer interface {
 	// Redeem burns liquid token and deposits corresponding amount of vesting
 	// token to the specified account
 	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	// MergeLiquid burns several liquid tokens and mints a new liquid token with
+	// the schedule combined from the burnt ones
+	MergeLiquid(context.Context, *MsgMergeLiquid) (*MsgMergeLiquidResponse, error)
+	// SplitLiquid burns part of liquid token and mints a new liquid token with
+	// the proportional part of its schedule
+	SplitLiquid(context.Context, *MsgSplitLiquid) (*MsgSplitLiquidResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -366,6 +652,12 @@ func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate)
 func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
 }
+func (*UnimplementedMsgServer) MergeLiquid(ctx context.Context, req *MsgMergeLiquid) (*MsgMergeLiquidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeLiquid not implemented")
+}
+func (*UnimplementedMsgServer) SplitLiquid(ctx context.Context, req *MsgSplitLiquid) (*MsgSplitLiquidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitLiquid not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -407,6 +699,42 @@ func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MergeLiquid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeLiquid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeLiquid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.liquidvesting.v1.Msg/MergeLiquid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeLiquid(ctx, req.(*MsgMergeLiquid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitLiquid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitLiquid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitLiquid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/haqq.liquidvesting.v1.Msg/SplitLiquid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitLiquid(ctx, req.(*MsgSplitLiquid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "haqq.liquidvesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -419,6 +747,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Redeem",
 			Handler:    _Msg_Redeem_Handler,
 		},
+		{
+			MethodName: "MergeLiquid",
+			Handler:    _Msg_MergeLiquid_Handler,
+		},
+		{
+			MethodName: "SplitLiquid",
+			Handler:    _Msg_SplitLiquid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "haqq/liquidvesting/v1/tx.proto",
@@ -536,131 +872,840 @@ func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.RedeemTo) > 0 {
-		i -= len(m.RedeemTo)
-		copy(dAtA[i:], m.RedeemTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemTo)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RedeemFrom) > 0 {
-		i -= len(m.RedeemFrom)
-		copy(dAtA[i:], m.RedeemFrom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemFrom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LiquidateFrom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LiquidateTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RedeemTo) > 0 {
+		i -= len(m.RedeemTo)
+		copy(dAtA[i:], m.RedeemTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemTo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedeemFrom) > 0 {
+		i -= len(m.RedeemFrom)
+		copy(dAtA[i:], m.RedeemFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RedeemFrom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLiquid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLiquid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLiquid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MergeTo) > 0 {
+		i -= len(m.MergeTo)
+		copy(dAtA[i:], m.MergeTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MergeTo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MergeFrom) > 0 {
+		i -= len(m.MergeFrom)
+		copy(dAtA[i:], m.MergeFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MergeFrom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeLiquidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeLiquidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeLiquidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLiquid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLiquid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLiquid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SplitTo) > 0 {
+		i -= len(m.SplitTo)
+		copy(dAtA[i:], m.SplitTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SplitTo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SplitFrom) > 0 {
+		i -= len(m.SplitFrom)
+		copy(dAtA[i:], m.SplitFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SplitFrom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitLiquidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitLiquidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitLiquidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidateFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LiquidateTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedeemFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RedeemTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMergeLiquid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MergeFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MergeTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMergeLiquidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSplitLiquid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SplitFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SplitTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitLiquidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidateFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidateFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidateTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Minted.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgRedeem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RedeemFrom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RedeemTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgRedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLiquid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -683,15 +1728,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLiquid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLiquid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidateFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -719,11 +1764,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidateFrom = string(dAtA[iNdEx:postIndex])
+			m.MergeFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidateTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeTo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -751,11 +1796,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidateTo = string(dAtA[iNdEx:postIndex])
+			m.MergeTo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -782,7 +1827,8 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amounts = append(m.Amounts, types.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -807,7 +1853,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeLiquidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,10 +1876,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeLiquidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeLiquidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -922,7 +1968,7 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLiquid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -945,15 +1991,15 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLiquid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLiquid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -981,11 +2027,11 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemFrom = string(dAtA[iNdEx:postIndex])
+			m.SplitFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedeemTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitTo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1013,7 +2059,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedeemTo = string(dAtA[iNdEx:postIndex])
+			m.SplitTo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1069,7 +2115,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitLiquidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1092,12 +2138,77 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitLiquidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitLiquidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
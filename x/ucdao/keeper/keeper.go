@@ -120,6 +120,15 @@ func (k BaseKeeper) Fund(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddres
 	// Update holders index
 	k.SetHoldersIndex(ctx, sender)
 
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFund,
+			sdk.NewAttribute(types.AttributeKeyDepositor, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
 	return nil
 }
 
@@ -144,6 +153,16 @@ func (k BaseKeeper) TransferOwnership(ctx sdk.Context, owner, newOwner sdk.AccAd
 	k.SetHoldersIndex(ctx, newOwner)
 	k.SetHoldersIndex(ctx, owner)
 
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferOwnership,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
 	return amount, nil
 }
 
@@ -2,10 +2,16 @@ package types
 
 const (
 	EventTypeConvertToEthiqExecuted = "convert_to_ethiq_executed"
+	EventTypeFund                   = "fund"
+	EventTypeTransferOwnership      = "transfer_ownership"
 
 	AttributeKeyIslmSpent      = "aISLM_spent"
 	AttributeKeyEthiqAmount    = "ethiq_amount"
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeySender         = "sender"
 	AttributeKeyRedeemedAmount = "redeemed_liquid_amount"
+	AttributeKeyDepositor      = "depositor"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyNewOwner       = "new_owner"
+	AttributeKeyAmount         = "amount"
 )